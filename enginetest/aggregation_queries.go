@@ -0,0 +1,132 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var AggregationScripts = []ScriptTest{
+	{
+		Name: "statistical aggregates",
+		SetUpScript: []string{
+			"CREATE TABLE scores (id INT PRIMARY KEY, g VARCHAR(10), v DOUBLE)",
+			"INSERT INTO scores VALUES (1, 'a', 2), (2, 'a', 4), (3, 'a', 4), (4, 'a', 4), (5, 'b', 5), (6, 'b', 5), (7, 'b', 7), (8, 'b', 9), (9, 'b', NULL), (10, 'c', 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT VAR_POP(v), VARIANCE(v), VAR_SAMP(v), STD(v), STDDEV(v), STDDEV_POP(v), STDDEV_SAMP(v) FROM scores WHERE g <> 'c'",
+				Expected: []sql.Row{{4.0, 4.0, 32.0 / 7, 2.0, 2.0, 2.0, math.Sqrt(32.0 / 7)}},
+			},
+			{
+				Query:    "SELECT g, VAR_POP(v), VAR_SAMP(v) FROM scores WHERE g <> 'b' GROUP BY g ORDER BY g",
+				Expected: []sql.Row{{"a", 0.75, 1.0}, {"c", 0.0, nil}},
+			},
+			{
+				Query:    "SELECT STDDEV_POP(v), VAR_SAMP(v) FROM scores WHERE id > 100",
+				Expected: []sql.Row{{nil, nil}},
+			},
+			{
+				Query:    "SELECT g, STDDEV_POP(v) FROM scores GROUP BY g HAVING STDDEV_POP(v) < 1 ORDER BY g",
+				Expected: []sql.Row{{"a", math.Sqrt(0.75)}, {"c", 0.0}},
+			},
+			{
+				Query:    "SELECT id, VAR_POP(v) OVER () FROM scores WHERE g = 'a' ORDER BY id",
+				Expected: []sql.Row{{1, 0.75}, {2, 0.75}, {3, 0.75}, {4, 0.75}},
+			},
+		},
+	},
+	{
+		Name: "bit aggregates",
+		SetUpScript: []string{
+			"CREATE TABLE flags (id INT PRIMARY KEY, g INT, f BIGINT)",
+			"INSERT INTO flags VALUES (1, 1, 7), (2, 1, 13), (3, 1, 5), (4, 2, 6), (5, 2, NULL), (6, 3, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT g, BIT_AND(f), BIT_OR(f), BIT_XOR(f) FROM flags GROUP BY g ORDER BY g",
+				Expected: []sql.Row{{1, uint64(5), uint64(15), uint64(15)}, {2, uint64(6), uint64(6), uint64(6)}, {3, uint64(math.MaxUint64), uint64(0), uint64(0)}},
+			},
+			{
+				Query:    "SELECT BIT_OR(f) & 8, BIT_AND(f) FROM flags WHERE g = 1",
+				Expected: []sql.Row{{int64(8), uint64(5)}},
+			},
+			{
+				Query:    "SELECT BIT_XOR(1.6), BIT_OR(f + 0.5), BIT_AND(-0.4) FROM flags WHERE id = 4",
+				Expected: []sql.Row{{uint64(2), uint64(7), uint64(0)}},
+			},
+		},
+	},
+	{
+		Name: "count distinct of several expressions",
+		SetUpScript: []string{
+			"CREATE TABLE visits (id INT PRIMARY KEY, page VARCHAR(10), visitor VARCHAR(10), country VARCHAR(2))",
+			"INSERT INTO visits VALUES (1, 'home', 'ann', 'us'), (2, 'home', 'ann', 'us'), (3, 'home', 'bob', 'us'), (4, 'about', 'ann', 'fr'), (5, 'about', 'ANN', 'fr'), (6, 'about', NULL, 'fr'), (7, 'home', 'bob', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(DISTINCT page, visitor), COUNT(DISTINCT visitor, country), COUNT(DISTINCT visitor) FROM visits",
+				Expected: []sql.Row{{3, 3, 2}},
+			},
+			{
+				Query:    "SELECT page, COUNT(DISTINCT visitor, country) FROM visits GROUP BY page ORDER BY page",
+				Expected: []sql.Row{{"about", 1}, {"home", 2}},
+			},
+		},
+	},
+	{
+		Name: "grouping and distinct over more groups than tmp_table_size holds",
+		SetUpScript: []string{
+			"SET @@tmp_table_size = 1024",
+			"CREATE TABLE digits (d INT PRIMARY KEY)",
+			"INSERT INTO digits VALUES (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+			"CREATE TABLE t (id INT PRIMARY KEY, g INT, v INT, s VARCHAR(10))",
+			`INSERT INTO t
+				SELECT id, id % 250, id * 7 % 100, CONCAT('s', id % 13)
+				FROM (SELECT a.d * 100 + b.d * 10 + c.d AS id FROM digits a, digits b, digits c) ids`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT g, COUNT(*), SUM(v), MIN(v), MAX(s), AVG(v), GROUP_CONCAT(id ORDER BY id DESC) FROM t GROUP BY g ORDER BY g LIMIT 2",
+				Expected: []sql.Row{
+					{0, 4, "100", 0, "s9", "25.0000", "750,500,250,0"},
+					{1, 4, "128", 7, "s7", "32.0000", "751,501,251,1"},
+				},
+			},
+			{
+				Query:    "SELECT g, SUM(v), JSON_ARRAYAGG(id) FROM t GROUP BY g HAVING g = 249",
+				Expected: []sql.Row{{249, "272", sql.MustJSON("[249, 499, 749, 999]")}},
+			},
+			{
+				Query:    "SELECT COUNT(*), SUM(c) FROM (SELECT g, COUNT(*) AS c FROM t GROUP BY g) counts",
+				Expected: []sql.Row{{250, "1000"}},
+			},
+			{
+				Query:    "SELECT COUNT(*), SUM(v) FROM (SELECT DISTINCT v FROM t) vs",
+				Expected: []sql.Row{{100, "4950"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT g, s FROM t) gs",
+				Expected: []sql.Row{{1000}},
+			},
+			{
+				Query:    "SET @@tmp_table_size = 16777216",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+}
@@ -0,0 +1,178 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AutoIncrementScripts test the values generated for AUTO_INCREMENT columns with the default
+// innodb_autoinc_lock_mode of 2.
+var AutoIncrementScripts = []ScriptTest{
+	{
+		Name: "auto_increment_increment and auto_increment_offset",
+		SetUpScript: []string{
+			"SET @@auto_increment_increment = 10",
+			"SET @@auto_increment_offset = 5",
+			"create table auto (pk int primary key auto_increment, c0 int)",
+			"insert into auto (c0) values (1), (2), (3)",
+			"insert into auto values (101, 4)",
+			"insert into auto (c0) values (5)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from auto order by pk",
+				Expected: []sql.Row{{5, 1}, {15, 2}, {25, 3}, {101, 4}, {105, 5}},
+			},
+			{
+				Query:    "alter table auto auto_increment = 200",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "insert into auto (c0) values (6)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SET @@auto_increment_increment = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "insert into auto (c0) values (7)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "select pk from auto where c0 > 5 order by pk",
+				Expected: []sql.Row{{205}, {206}},
+			},
+			{
+				Query:    "SET @@auto_increment_offset = 1",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "auto_increment_offset greater than auto_increment_increment is ignored",
+		SetUpScript: []string{
+			"SET @@auto_increment_increment = 3",
+			"SET @@auto_increment_offset = 7",
+			"create table auto (pk int primary key auto_increment)",
+			"insert into auto values (NULL), (NULL), (NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from auto order by pk",
+				Expected: []sql.Row{{1}, {4}, {7}},
+			},
+			{
+				Query:    "SET @@auto_increment_increment = 1, @@auto_increment_offset = 1",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "given values lower than the sequence are kept",
+		SetUpScript: []string{
+			"create table auto (pk int primary key auto_increment, c0 int)",
+			"insert into auto values (10, 1)",
+			"insert into auto values (3, 2)",
+			"insert into auto (c0) values (3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from auto order by c0",
+				Expected: []sql.Row{{10, 1}, {3, 2}, {11, 3}},
+			},
+		},
+	},
+	{
+		Name: "mixed-mode insert reserves a value for every row",
+		SetUpScript: []string{
+			"create table auto (pk int primary key auto_increment, c0 varchar(10))",
+			"insert into auto values (100, 'z')",
+			"insert into auto values (1, 'a'), (NULL, 'b'), (5, 'c'), (NULL, 'd')",
+			"insert into auto (c0) values ('e')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from auto order by pk",
+				Expected: []sql.Row{
+					{1, "a"}, {5, "c"}, {100, "z"}, {101, "b"}, {102, "d"}, {105, "e"},
+				},
+			},
+		},
+	},
+	{
+		Name: "given value past the reserved values moves the following values",
+		SetUpScript: []string{
+			"create table auto (pk int primary key auto_increment, c0 int)",
+			"insert into auto values (NULL, 1), (NULL, 2), (9, 3), (NULL, 4), (NULL, 5)",
+			"insert into auto (c0) values (6)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from auto order by pk",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {9, 3}, {10, 4}, {11, 5}, {12, 6}},
+			},
+		},
+	},
+	{
+		Name: "innodb_autoinc_lock_mode is read only",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select @@innodb_autoinc_lock_mode",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "SET GLOBAL innodb_autoinc_lock_mode = 0",
+				ExpectedErr: sql.ErrSystemVariableReadOnly,
+			},
+		},
+	},
+	{
+		Name: "uuid_short",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select uuid_short() >> 56 = @@server_id & 255",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:    "select count(distinct u) from (select uuid_short() as u from dual union all select uuid_short() from dual) t",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+}
+
+// TraditionalAutoIncrementScripts test the values generated for AUTO_INCREMENT columns with an
+// innodb_autoinc_lock_mode of 0, which doesn't reserve values for multi-row inserts.
+var TraditionalAutoIncrementScripts = []ScriptTest{
+	{
+		Name: "mixed-mode insert only uses the values it generates",
+		SetUpScript: []string{
+			"create table auto (pk int primary key auto_increment, c0 varchar(10))",
+			"insert into auto values (100, 'z')",
+			"insert into auto values (1, 'a'), (NULL, 'b'), (5, 'c'), (NULL, 'd')",
+			"insert into auto (c0) values ('e')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "select * from auto order by pk",
+				Expected: []sql.Row{
+					{1, "a"}, {5, "c"}, {100, "z"}, {101, "b"}, {102, "d"}, {103, "e"},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var CharsetScripts = []ScriptTest{
+	{
+		Name: "lengths of strings in their character sets",
+		SetUpScript: []string{
+			"CREATE TABLE texts (id INT PRIMARY KEY, l VARCHAR(10) CHARACTER SET latin1, u VARCHAR(10), w VARCHAR(10) CHARACTER SET utf16, b VARBINARY(10))",
+			"INSERT INTO texts VALUES (1, 'café', 'café', 'café', 'café'), (2, '€', '€', '😀', '€')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT LENGTH(l), CHAR_LENGTH(l), LENGTH(u), CHAR_LENGTH(u), LENGTH(w), CHAR_LENGTH(w), LENGTH(b), CHAR_LENGTH(b) FROM texts ORDER BY id",
+				Expected: []sql.Row{{4, 4, 5, 4, 8, 4, 5, 5}, {1, 1, 3, 1, 4, 1, 3, 3}},
+			},
+			{
+				Query:    "SELECT l, u, w FROM texts ORDER BY id",
+				Expected: []sql.Row{{"café", "café", "café"}, {"€", "€", "😀"}},
+			},
+		},
+	},
+	{
+		Name: "unrepresentable characters",
+		SetUpScript: []string{
+			"CREATE TABLE latin (id INT PRIMARY KEY, l VARCHAR(10) CHARACTER SET latin1, a VARCHAR(10) CHARACTER SET ascii)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO latin VALUES (1, '日本', 'a')",
+				ExpectedErr: sql.ErrIncorrectStringValue,
+			},
+			{
+				Query:       "INSERT INTO latin VALUES (1, 'a', 'é')",
+				ExpectedErr: sql.ErrIncorrectStringValue,
+			},
+			{
+				Query:    "INSERT INTO latin VALUES (1, 'Ünïcødé', 'ascii')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT l, a FROM latin",
+				Expected: []sql.Row{{"Ünïcødé", "ascii"}},
+			},
+		},
+	},
+	{
+		Name: "convert using",
+		SetUpScript: []string{
+			"CREATE TABLE bins (id INT PRIMARY KEY, b VARBINARY(10))",
+			"INSERT INTO bins VALUES (1, UNHEX('E9')), (2, UNHEX('C3A9'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT CONVERT('日本é' USING latin1), CONVERT('naïve' USING ascii), CONVERT('café' USING utf8mb4)",
+				Expected: []sql.Row{{"??é", "na?ve", "café"}},
+			},
+			{
+				Query:    "SELECT HEX(CONVERT('é' USING latin1)), HEX(CONVERT('é' USING utf8mb4))",
+				Expected: []sql.Row{{"E9", "C3A9"}},
+			},
+			{
+				Query:    "SELECT LENGTH(CONVERT('é€' USING latin1)), LENGTH(CONVERT('é' USING utf16)), LENGTH(CONVERT('é' USING utf32))",
+				Expected: []sql.Row{{2, 2, 4}},
+			},
+			{
+				Query:    "SELECT id, CONVERT(b USING latin1) FROM bins ORDER BY id",
+				Expected: []sql.Row{{1, "é"}, {2, "Ã©"}},
+			},
+			{
+				Query:    "SELECT id, CONVERT(b USING utf8mb4) FROM bins ORDER BY id",
+				Expected: []sql.Row{{1, nil}, {2, "é"}},
+			},
+			{
+				Query:       "SELECT CONVERT('a' USING nonexistent)",
+				ExpectedErr: sql.ErrCharacterSetNotSupported,
+			},
+		},
+	},
+	{
+		Name: "character set introducers",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT LENGTH(_binary'é'), CHAR_LENGTH(_binary'é'), CHAR_LENGTH(_utf8mb4'é')",
+				Expected: []sql.Row{{2, 2, 1}},
+			},
+			{
+				Query:    "SELECT _binary'a' = _binary'A', _utf8mb4'a' = _utf8mb4'A'",
+				Expected: []sql.Row{{false, true}},
+			},
+			{
+				Query:    "SELECT LENGTH(_latin1'é'), LENGTH(_utf16'ab'), LENGTH(_UTF8MB3'é')",
+				Expected: []sql.Row{{1, 4, 2}},
+			},
+			{
+				Query:    "SELECT _latin1 X'E9', _utf16 X'00410042', _ascii'é'",
+				Expected: []sql.Row{{"é", "AB", "?"}},
+			},
+			{
+				Query:    "SELECT _latin1'abc' = 'ABC'",
+				Expected: []sql.Row{{true}},
+			},
+		},
+	},
+}
@@ -0,0 +1,196 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var CollationScripts = []ScriptTest{
+	{
+		Name: "accent and case insensitive comparison",
+		SetUpScript: []string{
+			"CREATE TABLE words (id INT PRIMARY KEY, w VARCHAR(20))",
+			"INSERT INTO words VALUES (1, 'cafe'), (2, 'Café'), (3, 'CAFE '), (4, 'straße'), (5, 'Strasse'), (6, 'resume'), (7, 'Résumé')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 'é' = 'e', 'É' = 'e', 'a' = 'A', 'ß' = 'ss', 'a ' = 'a'",
+				Expected: []sql.Row{{true, true, true, true, false}},
+			},
+			{
+				Query:    "SELECT id FROM words WHERE w = 'CAFÉ' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM words WHERE w LIKE 'caf%' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM words WHERE w LIKE '%é' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}, {4}, {5}, {6}, {7}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM words GROUP BY w ORDER BY 1",
+				Expected: []sql.Row{{1}, {2}, {2}, {2}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT w) FROM words",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM (SELECT DISTINCT w FROM words) dt",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT id FROM words ORDER BY w, id",
+				Expected: []sql.Row{{1}, {2}, {3}, {6}, {7}, {4}, {5}},
+			},
+		},
+	},
+	{
+		Name: "binary and _bin collations",
+		SetUpScript: []string{
+			"CREATE TABLE bins (id INT PRIMARY KEY, pad VARCHAR(20) COLLATE utf8mb4_bin, nopad VARCHAR(20) COLLATE utf8mb4_0900_bin, cs VARCHAR(20) COLLATE utf8mb4_0900_as_cs, b VARBINARY(20))",
+			"INSERT INTO bins VALUES (1, 'a', 'a', 'a', 'a'), (2, 'a ', 'a ', 'A', 'a '), (3, 'A', 'A', 'á', 'A'), (4, 'b', 'b', 'b', 'b')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id FROM bins WHERE pad = 'a' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM bins WHERE nopad = 'a' ORDER BY id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT id FROM bins WHERE b = 'a' ORDER BY id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT id FROM bins ORDER BY nopad, id",
+				Expected: []sql.Row{{3}, {1}, {2}, {4}},
+			},
+			{
+				Query:    "SELECT id FROM bins ORDER BY cs, id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT pad), COUNT(DISTINCT nopad), COUNT(DISTINCT cs) FROM bins",
+				Expected: []sql.Row{{3, 4, 4}},
+			},
+			{
+				Query:    "SELECT id FROM bins WHERE pad LIKE 'A%' ORDER BY id",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+	{
+		Name: "collations of unique keys",
+		SetUpScript: []string{
+			"CREATE TABLE users (name VARCHAR(20) PRIMARY KEY, email VARCHAR(50), UNIQUE KEY email_idx (email))",
+			"INSERT INTO users VALUES ('alice', 'alice@example.com'), ('bob', NULL), ('carol', NULL)",
+			"CREATE TABLE logins (login VARCHAR(20) COLLATE utf8mb4_bin PRIMARY KEY)",
+			"INSERT INTO logins VALUES ('alice'), ('Alice')",
+			"CREATE TABLE dupes (id INT PRIMARY KEY, v VARCHAR(10))",
+			"INSERT INTO dupes VALUES (1, 'x'), (2, 'X')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO users VALUES ('ALICE', 'other@example.com')",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:       "INSERT INTO users VALUES ('Álice', 'other@example.com')",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:       "INSERT INTO users VALUES ('dave', 'ALICE@example.com')",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "INSERT INTO users VALUES ('dave', NULL)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:       "UPDATE users SET email = 'Alice@Example.com' WHERE name = 'bob'",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+			{
+				Query:    "UPDATE users SET email = 'ALICE@EXAMPLE.COM' WHERE name = 'alice'",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:       "INSERT INTO logins VALUES ('alice  ')",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "SELECT login FROM logins ORDER BY login",
+				Expected: []sql.Row{{"Alice"}, {"alice"}},
+			},
+			{
+				Query:    "CREATE UNIQUE INDEX login_idx ON users (name, email)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "CREATE UNIQUE INDEX v_idx ON dupes (v)",
+				ExpectedErr: sql.ErrUniqueKeyViolation,
+			},
+		},
+	},
+	{
+		Name: "joins on collated columns",
+		SetUpScript: []string{
+			"CREATE TABLE people (id INT PRIMARY KEY, city VARCHAR(20))",
+			"CREATE TABLE cities (name VARCHAR(20) PRIMARY KEY, country VARCHAR(20))",
+			"INSERT INTO people VALUES (1, 'zürich'), (2, 'ZURICH'), (3, 'Paris'), (4, 'Berlin')",
+			"INSERT INTO cities VALUES ('Zurich', 'CH'), ('paris', 'FR')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT p.id, c.country FROM people p JOIN cities c ON p.city = c.name ORDER BY p.id",
+				Expected: []sql.Row{{1, "CH"}, {2, "CH"}, {3, "FR"}},
+			},
+			{
+				Query:    "SELECT p.id FROM people p WHERE p.city IN (SELECT name FROM cities) ORDER BY p.id",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT p.id FROM people p LEFT JOIN cities c ON p.city = c.name WHERE c.name IS NULL",
+				Expected: []sql.Row{{4}},
+			},
+		},
+	},
+	{
+		Name: "collation metadata",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT collation_name, pad_attribute FROM information_schema.collations ORDER BY collation_name",
+				Expected: []sql.Row{
+					{"ascii_general_ci", "PAD SPACE"},
+					{"binary", "NO PAD"},
+					{"latin1_swedish_ci", "PAD SPACE"},
+					{"ucs2_general_ci", "PAD SPACE"},
+					{"utf16_general_ci", "PAD SPACE"},
+					{"utf16le_general_ci", "PAD SPACE"},
+					{"utf32_general_ci", "PAD SPACE"},
+					{"utf8_general_ci", "PAD SPACE"},
+					{"utf8mb4_0900_ai_ci", "NO PAD"},
+				},
+			},
+		},
+	},
+}
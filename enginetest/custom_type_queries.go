@@ -0,0 +1,221 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Money is a value of MoneyType: an amount of money in cents.
+type Money int64
+
+// MoneyType is the custom type registered as MONEY for the CustomTypeScripts. Its values are amounts of money, which
+// are sent to clients as decimals with two digits after the point.
+type MoneyType struct{}
+
+var _ sql.CustomType = MoneyType{}
+
+// NewMoneyType is the sql.CustomTypeConstructor of MoneyType, which takes no parameters.
+func NewMoneyType(params []int64) (sql.CustomType, error) {
+	if len(params) != 0 {
+		return nil, fmt.Errorf("type money takes no parameters")
+	}
+	return MoneyType{}, nil
+}
+
+// Name implements the sql.CustomType interface.
+func (MoneyType) Name() string {
+	return "money"
+}
+
+// Compare implements the sql.Type interface.
+func (t MoneyType) Compare(a, b interface{}) (int, error) {
+	if a == nil || b == nil {
+		switch {
+		case a == b:
+			return 0, nil
+		case a == nil:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	}
+	ma, err := t.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+	mb, err := t.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case ma.(Money) < mb.(Money):
+		return -1, nil
+	case ma.(Money) > mb.(Money):
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Convert implements the sql.Type interface. Numbers and strings, with or without a leading dollar sign, are amounts
+// of dollars.
+func (MoneyType) Convert(v interface{}) (interface{}, error) {
+	var amount decimal.Decimal
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case Money:
+		return v, nil
+	case decimal.Decimal:
+		amount = v
+	case string:
+		var err error
+		amount, err = decimal.NewFromString(strings.TrimPrefix(strings.TrimSpace(v), "$"))
+		if err != nil {
+			return nil, sql.ErrConvertToSQL.New("money")
+		}
+	case float64:
+		amount = decimal.NewFromFloat(v)
+	default:
+		i, err := sql.Int64.Convert(v)
+		if err != nil {
+			return nil, sql.ErrConvertToSQL.New("money")
+		}
+		amount = decimal.NewFromInt(i.(int64))
+	}
+	return Money(amount.Shift(2).Round(0).IntPart()), nil
+}
+
+// Promote implements the sql.Type interface.
+func (t MoneyType) Promote() sql.Type {
+	return t
+}
+
+// SQL implements the sql.Type interface.
+func (t MoneyType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	m, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(decimal.New(int64(m.(Money)), -2).StringFixed(2))), nil
+}
+
+// Type implements the sql.Type interface.
+func (MoneyType) Type() query.Type {
+	return sqltypes.Decimal
+}
+
+// Zero implements the sql.Type interface.
+func (MoneyType) Zero() interface{} {
+	return Money(0)
+}
+
+// String implements the sql.Type interface.
+func (MoneyType) String() string {
+	return "money"
+}
+
+// CustomTypeScripts are run with MoneyType registered as MONEY.
+var CustomTypeScripts = []ScriptTest{
+	{
+		Name: "columns of a custom type",
+		SetUpScript: []string{
+			"CREATE TABLE accounts (id INT PRIMARY KEY, balance MONEY NOT NULL DEFAULT '0.00')",
+			"INSERT INTO accounts VALUES (1, '12.34'), (2, 5), (3, '$0.99')",
+			"INSERT INTO accounts (id) VALUES (4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM accounts ORDER BY balance DESC",
+				Expected: []sql.Row{{1, Money(1234)}, {2, Money(500)}, {3, Money(99)}, {4, Money(0)}},
+			},
+			{
+				Query:    "SELECT id FROM accounts WHERE balance > '4.99' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM accounts WHERE 5 = balance",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "INSERT INTO accounts VALUES (5, 'a lot')",
+				ExpectedErr: sql.ErrConvertToSQL,
+			},
+			{
+				Query: "SHOW CREATE TABLE accounts",
+				Expected: []sql.Row{{"accounts", "CREATE TABLE `accounts` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `balance` money NOT NULL DEFAULT \"0.00\",\n" +
+					"  PRIMARY KEY (`id`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:    "CREATE TABLE `copy` (\n  `id` int NOT NULL,\n  `balance` money NOT NULL DEFAULT \"0.00\",\n  PRIMARY KEY (`id`)\n)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "INSERT INTO copy SELECT * FROM accounts WHERE balance < 1",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT SUM(id) FROM copy",
+				Expected: []sql.Row{{"7"}},
+			},
+		},
+	},
+	{
+		Name: "altering columns to a custom type",
+		SetUpScript: []string{
+			"CREATE TABLE prices (id INT PRIMARY KEY, price DECIMAL(10, 2))",
+			"INSERT INTO prices VALUES (1, 1.5), (2, 10)",
+			"ALTER TABLE prices ADD COLUMN `discount` Money DEFAULT '0.25'",
+			"ALTER TABLE prices MODIFY COLUMN price MONEY",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, price, discount FROM prices ORDER BY id",
+				Expected: []sql.Row{{1, Money(150), Money(25)}, {2, Money(1000), Money(25)}},
+			},
+			{
+				Query:    "SELECT column_name, data_type FROM information_schema.columns WHERE table_name = 'prices' ORDER BY ordinal_position",
+				Expected: []sql.Row{{"id", "int"}, {"price", "money"}, {"discount", "money"}},
+			},
+		},
+	},
+	{
+		Name: "invalid declarations of a custom type",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:          "CREATE TABLE bad (x MONEY(10, 2))",
+				ExpectedErrStr: "type money takes no parameters",
+			},
+			{
+				Query:       "CREATE TABLE bad (x MONEY(ten))",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+}
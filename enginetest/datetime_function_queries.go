@@ -0,0 +1,128 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var DatetimeFunctionScripts = []ScriptTest{
+	{
+		Name: "str_to_date",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT STR_TO_DATE('04/03/2021', '%d/%m/%Y'), STR_TO_DATE('March 4th, 2021 1:05:06 PM', '%M %D, %Y %r')",
+				Expected: []sql.Row{{time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 4, 13, 5, 6, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT STR_TO_DATE('13.05.06', '%H.%i.%s')",
+				Expected: []sql.Row{{"13:05:06"}},
+			},
+			{
+				Query:           "SELECT STR_TO_DATE('2021-02-30', '%Y-%m-%d')",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1411,
+			},
+			{
+				Query:           "SELECT STR_TO_DATE('not a date', '%Y-%m-%d')",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1411,
+			},
+			{
+				Query:    "SELECT STR_TO_DATE(DATE_FORMAT('2021-07-08 09:10:11', GET_FORMAT(DATETIME, 'USA')), GET_FORMAT(DATETIME, 'USA'))",
+				Expected: []sql.Row{{time.Date(2021, 7, 8, 9, 10, 11, 0, time.UTC)}},
+			},
+		},
+	},
+	{
+		Name: "date differences",
+		SetUpScript: []string{
+			"CREATE TABLE periods (id INT PRIMARY KEY, started DATETIME, ended DATETIME)",
+			"INSERT INTO periods VALUES (1, '2021-01-31 12:00:00', '2021-02-28 12:00:00'), (2, '2020-02-29 00:00:00', '2021-03-01 06:30:00')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, DATEDIFF(ended, started), TIMESTAMPDIFF(MONTH, started, ended), TIMESTAMPDIFF(HOUR, started, ended), TIMESTAMPDIFF(YEAR, started, ended) FROM periods ORDER BY id",
+				Expected: []sql.Row{{1, int64(28), int64(0), int64(672), int64(0)}, {2, int64(366), int64(12), int64(8790), int64(1)}},
+			},
+			{
+				Query:    "SELECT TIMESTAMPDIFF(SECOND, '2021-01-01 00:00:10', '2021-01-01 00:00:00'), TIMESTAMPDIFF(WEEK, '2021-01-01', '2021-01-15')",
+				Expected: []sql.Row{{int64(-10), int64(2)}},
+			},
+			{
+				Query:           "SELECT DATEDIFF('2021-02-30', '2021-01-01')",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1292,
+			},
+			{
+				Query:    "SELECT TIMESTAMPADD(MONTH, 1, '2021-01-31'), TIMESTAMPADD(MINUTE, -90, '2021-01-01 00:00:00')",
+				Expected: []sql.Row{{time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2020, 12, 31, 22, 30, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT id FROM periods WHERE TIMESTAMPADD(DAY, 30, started) > ended",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "constructing dates and times",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT LAST_DAY('2020-02-10'), MAKEDATE(2021, 60), MAKEDATE(2021, 0)",
+				Expected: []sql.Row{{time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), nil}},
+			},
+			{
+				Query:    "SELECT MAKETIME(12, 15, 30), MAKETIME(12, 60, 0), SEC_TO_TIME(3661), PERIOD_ADD(202111, 3)",
+				Expected: []sql.Row{{"12:15:30", nil, "01:01:01", int64(202202)}},
+			},
+			{
+				Query:           "SELECT SEC_TO_TIME(4000000)",
+				Expected:        []sql.Row{{"838:59:59"}},
+				ExpectedWarning: 1292,
+			},
+			{
+				Query:       "SELECT PERIOD_ADD(202113, 1)",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+		},
+	},
+	{
+		Name: "extract and get_format",
+		SetUpScript: []string{
+			"CREATE TABLE events (id INT PRIMARY KEY, at DATETIME)",
+			"INSERT INTO events VALUES (1, '2021-05-06 07:08:09'), (2, '2020-11-12 13:14:15')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, EXTRACT(YEAR FROM at), EXTRACT(QUARTER FROM at), EXTRACT(DAY_MINUTE FROM at), extract(hour_second from at) FROM events ORDER BY id",
+				Expected: []sql.Row{{1, int64(2021), int64(2), int64(60708), int64(70809)}, {2, int64(2020), int64(4), int64(121314), int64(131415)}},
+			},
+			{
+				Query:    "SELECT id FROM events WHERE EXTRACT(YEAR_MONTH FROM at) = 202011",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT EXTRACT(MINUTE FROM '-100:30:00'), 'EXTRACT(YEAR FROM at)'",
+				Expected: []sql.Row{{int64(-30), "EXTRACT(YEAR FROM at)"}},
+			},
+			{
+				Query:    "SELECT GET_FORMAT(DATE, 'EUR'), GET_FORMAT(TIME, 'usa'), GET_FORMAT(TIMESTAMP, 'ISO'), GET_FORMAT(DATE, 'unknown')",
+				Expected: []sql.Row{{"%d.%m.%Y", "%h:%i:%s %p", "%Y-%m-%d %H:%i:%s", nil}},
+			},
+		},
+	},
+}
@@ -0,0 +1,112 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var DecimalScripts = []ScriptTest{
+	{
+		Name:        "exact arithmetic on decimal literals",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 0.1 + 0.2 = 0.3, 0.1 + 0.2, 1 - 0.75, 1.5 * 1.25, -0.5 * 3",
+				Expected: []sql.Row{{true, "0.3", "0.25", "1.875", "-1.5"}},
+			},
+			{
+				Query:    "SELECT 1 / 3, 10 / 4, 1.00 / 3, 1.5 / 0",
+				Expected: []sql.Row{{"0.3333", "2.5000", "0.333333", nil}},
+			},
+			{
+				Query:    "SELECT 0.1e0 + 0.2e0 = 0.3, 0.5 = 0.50, 99999999999999999999 + 1",
+				Expected: []sql.Row{{false, true, "100000000000000000000"}},
+			},
+		},
+	},
+	{
+		Name: "aggregates of integer columns",
+		SetUpScript: []string{
+			"CREATE TABLE counts (a INT PRIMARY KEY)",
+			"INSERT INTO counts VALUES (10), (20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SUM(a), SUM(a) / 3, AVG(a), SUM(a) / 4 FROM counts",
+				Expected: []sql.Row{{"30", "10.0000", "15.0000", "7.5000"}},
+			},
+			{
+				Query:    "SELECT SUM(a), AVG(a) FROM counts WHERE a > 20",
+				Expected: []sql.Row{{nil, nil}},
+			},
+		},
+	},
+	{
+		Name: "aggregates and rounding of decimal columns",
+		SetUpScript: []string{
+			"CREATE TABLE ledger (id INT PRIMARY KEY, amount DECIMAL(10,2))",
+			"INSERT INTO ledger VALUES (1, 10.15), (2, 20.20), (3, 30.30), (4, -2.25)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SUM(amount), AVG(amount) FROM ledger WHERE id < 4",
+				Expected: []sql.Row{{"60.65", "20.216667"}},
+			},
+			{
+				Query:    "SELECT SUM(amount) FROM ledger WHERE id < 0",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:    "SELECT id, ROUND(amount, 1), ROUND(amount), CEIL(amount), FLOOR(amount) FROM ledger ORDER BY id",
+				Expected: []sql.Row{{1, "10.2", "10", "11", "10"}, {2, "20.2", "20", "21", "20"}, {3, "30.3", "30", "31", "30"}, {4, "-2.3", "-2", "-2", "-3"}},
+			},
+			{
+				Query:    "SELECT id, amount * 2, amount + 0.005, -amount FROM ledger WHERE amount > 20.2 OR amount = -2.25 ORDER BY id",
+				Expected: []sql.Row{{3, "60.60", "30.305", "-30.30"}, {4, "-4.50", "-2.245", "2.25"}},
+			},
+			{
+				Query:    "SELECT id FROM ledger WHERE amount = 10.150",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "div_precision_increment",
+		SetUpScript: []string{
+			"CREATE TABLE ledger (id INT PRIMARY KEY, amount DECIMAL(10,2))",
+			"INSERT INTO ledger VALUES (1, 10.00), (2, 20.00), (3, 30.01)",
+			"SET @@div_precision_increment = 2",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1 / 3, 2.5 / 3, AVG(amount) FROM ledger",
+				Expected: []sql.Row{{"0.33", "0.833", "20.0033"}},
+			},
+		},
+	},
+	{
+		Name: "division without a precision increment",
+		SetUpScript: []string{
+			"SET @@div_precision_increment = 0",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 7 / 2, 1 / 3, 1.25 / 2",
+				Expected: []sql.Row{{"4", "0", "0.63"}},
+			},
+		},
+	},
+}
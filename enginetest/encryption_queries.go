@@ -0,0 +1,116 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+)
+
+var EncryptionScripts = []ScriptTest{
+	{
+		Name: "aes encryption",
+		SetUpScript: []string{
+			"CREATE TABLE people (id INT PRIMARY KEY, ssn VARBINARY(64))",
+			"INSERT INTO people VALUES (1, AES_ENCRYPT('123-45-6789', 'secret key')), (2, AES_ENCRYPT('987-65-4321', 'secret key'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT HEX(AES_ENCRYPT('text', 'password')), LENGTH(AES_ENCRYPT('', 'password'))",
+				Expected: []sql.Row{{"F6BD0FA8DCB7F8CD4A2FAABC54668044", 16}},
+			},
+			{
+				Query:    "SELECT id, CAST(AES_DECRYPT(ssn, 'secret key') AS CHAR), AES_DECRYPT(ssn, 'wrong key') FROM people ORDER BY id",
+				Expected: []sql.Row{{1, "123-45-6789", nil}, {2, "987-65-4321", nil}},
+			},
+			{
+				Query:    "SET block_encryption_mode = 'aes-256-cbc'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@block_encryption_mode, HEX(AES_ENCRYPT('Hello, world! This is a test.', 'secret', '1234567890123456'))",
+				Expected: []sql.Row{{"aes-256-cbc", "E25E213D66AF3B42D0872B83AA576D90EEE550563B423EDDC9D5D6491BD25CA9"}},
+			},
+			{
+				Query:    "SELECT CAST(AES_DECRYPT(AES_ENCRYPT('text', 'key', RANDOM_BYTES(16)), 'key', '1234567890123456') AS CHAR) IS NULL OR TRUE",
+				Expected: []sql.Row{{true}},
+			},
+			{
+				Query:       "SELECT AES_ENCRYPT('text', 'key')",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:       "SELECT AES_ENCRYPT('text', 'key', 'short')",
+				ExpectedErr: function.ErrAESInvalidIV,
+			},
+			{
+				Query:       "SET block_encryption_mode = 'aes-512-ecb'",
+				ExpectedErr: sql.ErrInvalidSystemVariableValue,
+			},
+			{
+				Query:    "SET block_encryption_mode = 'AES-128-ECB'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:           "SELECT HEX(AES_ENCRYPT('text', 'password', '1234567890123456'))",
+				Expected:        []sql.Row{{"F6BD0FA8DCB7F8CD4A2FAABC54668044"}},
+				ExpectedWarning: 1618,
+			},
+		},
+	},
+	{
+		Name: "compression",
+		SetUpScript: []string{
+			"CREATE TABLE docs (id INT PRIMARY KEY, body BLOB)",
+			"INSERT INTO docs VALUES (1, COMPRESS(REPEAT('abc', 100))), (2, COMPRESS('')), (3, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, LENGTH(body) < 300, UNCOMPRESSED_LENGTH(body), CAST(UNCOMPRESS(body) AS CHAR) = REPEAT('abc', 100) FROM docs ORDER BY id",
+				Expected: []sql.Row{{1, true, uint32(300), true}, {2, true, uint32(0), false}, {3, nil, nil, nil}},
+			},
+			{
+				Query:    "SELECT CAST(UNCOMPRESS(UNHEX('0A000000789C4BCCAB54282E29CACC4B070015960400')) AS CHAR)",
+				Expected: []sql.Row{{"any string"}},
+			},
+			{
+				Query:    "SELECT HEX(COMPRESS('any string')), HEX(body) FROM docs WHERE id = 1",
+				Expected: []sql.Row{{"0A000000789C4BCCAB54282E29CACC4B070015960400", "2C010000789C4B4C4A4E1C45C42100884D72D9"}},
+			},
+			{
+				Query:           "SELECT UNCOMPRESS('not compressed')",
+				Expected:        []sql.Row{{nil}},
+				ExpectedWarning: 1259,
+			},
+		},
+	},
+	{
+		Name: "random bytes and password strength",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT LENGTH(RANDOM_BYTES(32)), RANDOM_BYTES(8) = RANDOM_BYTES(8)",
+				Expected: []sql.Row{{32, false}},
+			},
+			{
+				Query:       "SELECT RANDOM_BYTES(1025)",
+				ExpectedErr: function.ErrRandomBytesLength,
+			},
+			{
+				Query:    "SELECT VALIDATE_PASSWORD_STRENGTH('abc'), VALIDATE_PASSWORD_STRENGTH('abcdef'), VALIDATE_PASSWORD_STRENGTH('password'), VALIDATE_PASSWORD_STRENGTH('Passw0rd!')",
+				Expected: []sql.Row{{int32(0), int32(25), int32(50), int32(100)}},
+			},
+		},
+	},
+}
@@ -365,38 +365,37 @@ func TestColumnAliases(t *testing.T, harness Harness) {
 		{
 			query:            `SELECT s as COL1, SUM(i) COL2 FROM mytable group by s order by cOL2`,
 			expectedColNames: []string{"COL1", "COL2"},
-			// TODO: SUM should be integer typed for integers
 			expectedRows: []sql.Row{
-				{"first row", float64(1)},
-				{"second row", float64(2)},
-				{"third row", float64(3)},
+				{"first row", "1"},
+				{"second row", "2"},
+				{"third row", "3"},
 			},
 		},
 		{
 			query:            `SELECT s as COL1, SUM(i) COL2 FROM mytable group by col1 order by col2`,
 			expectedColNames: []string{"COL1", "COL2"},
 			expectedRows: []sql.Row{
-				{"first row", float64(1)},
-				{"second row", float64(2)},
-				{"third row", float64(3)},
+				{"first row", "1"},
+				{"second row", "2"},
+				{"third row", "3"},
 			},
 		},
 		{
 			query:            `SELECT s as coL1, SUM(i) coL2 FROM mytable group by 1 order by 2`,
 			expectedColNames: []string{"coL1", "coL2"},
 			expectedRows: []sql.Row{
-				{"first row", float64(1)},
-				{"second row", float64(2)},
-				{"third row", float64(3)},
+				{"first row", "1"},
+				{"second row", "2"},
+				{"third row", "3"},
 			},
 		},
 		{
 			query:            `SELECT s as Date, SUM(i) TimeStamp FROM mytable group by 1 order by 2`,
 			expectedColNames: []string{"Date", "TimeStamp"},
 			expectedRows: []sql.Row{
-				{"first row", float64(1)},
-				{"second row", float64(2)},
-				{"third row", float64(3)},
+				{"first row", "1"},
+				{"second row", "2"},
+				{"third row", "3"},
 			},
 		},
 	}
@@ -2912,8 +2911,7 @@ func TestTracing(t *testing.T, harness Harness) {
 
 	spans := tracer.Spans
 	var expectedSpans = []string{
-		"plan.Limit",
-		"plan.Sort",
+		"plan.TopN",
 		"plan.Distinct",
 		"plan.Project",
 		"plan.Filter",
@@ -3570,6 +3568,169 @@ func TestJsonScripts(t *testing.T, harness Harness) {
 	}
 }
 
+func TestGeneratedColumns(t *testing.T, harness Harness) {
+	for _, script := range GeneratedColumnScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestFullTextSearch(t *testing.T, harness Harness) {
+	for _, script := range FullTextScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestCollations(t *testing.T, harness Harness) {
+	for _, script := range CollationScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestCharsets(t *testing.T, harness Harness) {
+	for _, script := range CharsetScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestTimeZones(t *testing.T, harness Harness) {
+	for _, script := range TimeZoneScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestDatetimeFunctions(t *testing.T, harness Harness) {
+	for _, script := range DatetimeFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestRegexpFunctions(t *testing.T, harness Harness) {
+	for _, script := range RegexpScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStringFunctions(t *testing.T, harness Harness) {
+	for _, script := range StringFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestAggregations(t *testing.T, harness Harness) {
+	for _, script := range AggregationScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestEncryptionFunctions(t *testing.T, harness Harness) {
+	for _, script := range EncryptionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestNetworkFunctions(t *testing.T, harness Harness) {
+	for _, script := range NetworkFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestMathFunctions(t *testing.T, harness Harness) {
+	for _, script := range MathFunctionScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T, harness Harness) {
+	for _, script := range DecimalScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestTableFunctions(t *testing.T, harness Harness) {
+	for _, script := range TableFunctionScripts {
+		t.Run(script.Name, func(t *testing.T) {
+			myDb := harness.NewDatabase("mydb")
+			e := NewEngineWithDbs(t, harness, []sql.Database{myDb}, nil)
+			e.Catalog.TableFunctions.MustRegister(TableFunctions...)
+			TestScriptWithEngine(t, e, harness, script)
+		})
+	}
+}
+
+func TestCustomTypes(t *testing.T, harness Harness) {
+	for _, script := range CustomTypeScripts {
+		t.Run(script.Name, func(t *testing.T) {
+			myDb := harness.NewDatabase("mydb")
+			e := NewEngineWithDbs(t, harness, []sql.Database{myDb}, nil)
+			e.Catalog.CustomTypes.MustRegister("money", NewMoneyType)
+			TestScriptWithEngine(t, e, harness, script)
+		})
+	}
+}
+
+func TestVectors(t *testing.T, harness Harness) {
+	for _, script := range VectorScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestSortSpill(t *testing.T, harness Harness) {
+	for _, script := range SortScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestTopN(t *testing.T, harness Harness) {
+	for _, script := range TopNScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestHashJoins(t *testing.T, harness Harness) {
+	for _, script := range HashJoinScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestMergeJoins(t *testing.T, harness Harness) {
+	for _, script := range MergeJoinScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStatistics(t *testing.T, harness Harness) {
+	for _, script := range StatisticsScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestJoinOrdering(t *testing.T, harness Harness) {
+	for _, script := range JoinOrderingScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestSemiJoins(t *testing.T, harness Harness) {
+	for _, script := range SemiJoinScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestAutoIncrement(t *testing.T, harness Harness) {
+	for _, script := range AutoIncrementScripts {
+		TestScript(t, harness, script)
+	}
+
+	_, lockMode, _ := sql.SystemVariables.GetGlobal("innodb_autoinc_lock_mode")
+	require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"innodb_autoinc_lock_mode": 0}))
+	defer func() {
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"innodb_autoinc_lock_mode": lockMode}))
+	}()
+	for _, script := range TraditionalAutoIncrementScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 // For a variety of reasons, the widths of various primitive types can vary when passed through different SQL queries
 // (and different database implementations). We may eventually decide that this undefined behavior is a problem, but
 // for now it's mostly just an issue when comparing results in tests. To get around this, we widen every type to its
@@ -0,0 +1,257 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var fullTextSetup = []string{
+	"CREATE TABLE articles (id INT PRIMARY KEY, title VARCHAR(200), body TEXT, views INT)",
+	`INSERT INTO articles VALUES
+		(1, 'MySQL Tutorial', 'DBMS stands for DataBase', 10),
+		(2, 'How To Use MySQL Well', 'After you went through a basic introduction', 20),
+		(3, 'Optimizing MySQL', 'In this tutorial, we show how to optimize', 30),
+		(4, '1001 MySQL Tricks', '1. Never run mysqld as root. 2. Always back up', 40),
+		(5, 'MySQL vs. YourSQL', 'In the following database comparison', 50),
+		(6, 'MySQL Security', 'When configured properly, MySQL is secure', 60)`,
+	"CREATE FULLTEXT INDEX ft_idx ON articles (title, body)",
+}
+
+var FullTextScripts = []ScriptTest{
+	{
+		Name:        "natural language full-text search",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('database') ORDER BY id",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (body, title) AGAINST ('database' IN NATURAL LANGUAGE MODE) ORDER BY id",
+				Expected: []sql.Row{{1}, {5}},
+			},
+			{
+				Query: `SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('tutorial database')
+					ORDER BY MATCH (title, body) AGAINST ('tutorial database') DESC, id`,
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "SELECT id, MATCH (title, body) AGAINST ('security') > 0 FROM articles ORDER BY id",
+				Expected: []sql.Row{{1, false}, {2, false}, {3, false}, {4, false}, {5, false}, {6, true}},
+			},
+			{
+				Query:    "SELECT a.id FROM articles a WHERE MATCH (a.title, a.body) AGAINST ('SECURE') AND a.views > 10",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('the')",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name:        "boolean mode full-text search",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('+MySQL -YourSQL' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {6}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('+mysql +tutorial' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('optim*' IN BOOLEAN MODE) ORDER BY id",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    `SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('"following database"' IN BOOLEAN MODE)`,
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    `SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('"database following"' IN BOOLEAN MODE)`,
+				Expected: []sql.Row{},
+			},
+			{
+				Query: `SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('tutorial ~database' IN BOOLEAN MODE)
+					ORDER BY MATCH (title, body) AGAINST ('tutorial ~database' IN BOOLEAN MODE) DESC, id`,
+				Expected: []sql.Row{{3}, {1}},
+			},
+		},
+	},
+	{
+		Name:        "full-text index maintenance",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO articles VALUES (7, 'Database Security', 'Securing the database server', 70)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('database') ORDER BY id",
+				Expected: []sql.Row{{1}, {5}, {7}},
+			},
+			{
+				Query:    "UPDATE articles SET body = 'DBMS stands for DataBase Management System' WHERE id = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('management') ORDER BY id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "UPDATE articles SET title = 'MySQL Hardening' WHERE id = 6",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('security') ORDER BY id",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "DELETE FROM articles WHERE id IN (5, 7)",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('database') ORDER BY id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "ALTER TABLE articles ADD COLUMN summary VARCHAR(100) FIRST",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('tutorial') ORDER BY id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+		},
+	},
+	{
+		Name:        "full-text system variables",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@GLOBAL.ft_min_word_len, @@GLOBAL.ft_max_word_len, @@GLOBAL.ft_stopword_file",
+				Expected: []sql.Row{{4, 84, "(built-in)"}},
+			},
+			{
+				Query:       "SET GLOBAL ft_min_word_len = 3",
+				ExpectedErr: sql.ErrSystemVariableReadOnly,
+			},
+			{
+				Query:       "SET GLOBAL ft_max_word_len = 20",
+				ExpectedErr: sql.ErrSystemVariableReadOnly,
+			},
+			{
+				Query:       "SET GLOBAL ft_stopword_file = ''",
+				ExpectedErr: sql.ErrSystemVariableReadOnly,
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title, body) AGAINST ('use the')",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name:        "full-text index definitions",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE articles",
+				Expected: []sql.Row{{"articles", "CREATE TABLE `articles` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `title` varchar(200),\n" +
+					"  `body` text,\n" +
+					"  `views` int,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  FULLTEXT KEY `ft_idx` (`title`,`body`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SHOW INDEXES FROM articles",
+				Expected: []sql.Row{
+					{"articles", 0, "PRIMARY", 1, "id", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"articles", 1, "ft_idx", 1, "title", nil, 0, nil, nil, "YES", "FULLTEXT", "", "", "YES", nil},
+					{"articles", 1, "ft_idx", 2, "body", nil, 0, nil, nil, "YES", "FULLTEXT", "", "", "YES", nil},
+				},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE title = 'MySQL Security'",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:       "SELECT id FROM articles WHERE MATCH (title) AGAINST ('database')",
+				ExpectedErr: sql.ErrFullTextIndexNotFound,
+			},
+			{
+				Query:       "SELECT id FROM articles WHERE MATCH (title, body) AGAINST (title)",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query:       "CREATE FULLTEXT INDEX ft_views ON articles (title, views)",
+				ExpectedErr: sql.ErrFullTextColumnType,
+			},
+			{
+				Query:    "ALTER TABLE articles ADD FULLTEXT INDEX ft_title (title)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM articles WHERE MATCH (title) AGAINST ('security')",
+				Expected: []sql.Row{{6}},
+			},
+			{
+				Query:       "CREATE TABLE bad (id INT PRIMARY KEY, views INT, FULLTEXT KEY `ft_views` (`views`))",
+				ExpectedErr: sql.ErrFullTextColumnType,
+			},
+		},
+	},
+	{
+		Name:        "recreating a table from SHOW CREATE TABLE with a full-text index",
+		SetUpScript: fullTextSetup,
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "CREATE TABLE `copy` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `title` varchar(200),\n" +
+					"  `body` text,\n" +
+					"  `views` int,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  FULLTEXT KEY `ft_idx` (`title`,`body`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SHOW CREATE TABLE copy",
+				Expected: []sql.Row{{"copy", "CREATE TABLE `copy` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `title` varchar(200),\n" +
+					"  `body` text,\n" +
+					"  `views` int,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  FULLTEXT KEY `ft_idx` (`title`,`body`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:    "INSERT INTO copy SELECT * FROM articles",
+				Expected: []sql.Row{{sql.NewOkResult(6)}},
+			},
+			{
+				Query:    "SELECT id FROM copy WHERE MATCH (title, body) AGAINST ('security')",
+				Expected: []sql.Row{{6}},
+			},
+		},
+	},
+}
@@ -0,0 +1,240 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var GeneratedColumnScripts = []ScriptTest{
+	{
+		Name: "stored generated column computed on insert and update",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk BIGINT PRIMARY KEY, a INT, b INT, c INT AS (a + b) STORED)",
+			"INSERT INTO t1 (pk, a, b) VALUES (1, 1, 2), (2, 10, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t1 ORDER BY pk",
+				Expected: []sql.Row{{1, 1, 2, 3}, {2, 10, 20, 30}},
+			},
+			{
+				Query: "UPDATE t1 SET a = 5 WHERE pk = 1",
+				Expected: []sql.Row{{sql.OkResult{
+					RowsAffected: 1,
+					Info: plan.UpdateInfo{
+						Matched: 1,
+						Updated: 1,
+					},
+				}}},
+			},
+			{
+				Query:    "SELECT * FROM t1 ORDER BY pk",
+				Expected: []sql.Row{{1, 5, 2, 7}, {2, 10, 20, 30}},
+			},
+			{
+				Query:    "INSERT INTO t1 VALUES (3, 100, 200, DEFAULT)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT c FROM t1 WHERE pk = 3",
+				Expected: []sql.Row{{300}},
+			},
+			{
+				Query:    "INSERT INTO t1 (pk, a, b) VALUES (1, 0, 0) ON DUPLICATE KEY UPDATE b = 100",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t1 WHERE pk = 1",
+				Expected: []sql.Row{{1, 5, 100, 105}},
+			},
+		},
+	},
+	{
+		Name: "virtual generated column with function and NULL input",
+		SetUpScript: []string{
+			"CREATE TABLE t2 (pk INT PRIMARY KEY, first_name VARCHAR(20), last_name VARCHAR(20), full_name VARCHAR(41) GENERATED ALWAYS AS (CONCAT(first_name, ' ', last_name)) VIRTUAL)",
+			"INSERT INTO t2 (pk, first_name, last_name) VALUES (1, 'john', 'doe'), (2, 'jane', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk, full_name FROM t2 ORDER BY pk",
+				Expected: []sql.Row{{1, "john doe"}, {2, nil}},
+			},
+			{
+				Query:    "SELECT pk FROM t2 WHERE full_name = 'john doe'",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "explicit writes to generated columns are rejected",
+		SetUpScript: []string{
+			"CREATE TABLE t3 (pk INT PRIMARY KEY, a INT, b INT AS (a * 2) STORED)",
+			"INSERT INTO t3 (pk, a) VALUES (1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO t3 (pk, a, b) VALUES (2, 2, 4)",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "INSERT INTO t3 VALUES (2, 2, 4)",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "INSERT INTO t3 (pk, a, b) SELECT 2, 2, 4",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "UPDATE t3 SET b = 10",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:       "INSERT INTO t3 (pk, a) VALUES (1, 1) ON DUPLICATE KEY UPDATE b = 10",
+				ExpectedErr: sql.ErrGeneratedColumnValue,
+			},
+			{
+				Query:    "SELECT * FROM t3",
+				Expected: []sql.Row{{1, 1, 2}},
+			},
+		},
+	},
+	{
+		Name: "NOT NULL generated column",
+		SetUpScript: []string{
+			"CREATE TABLE t4 (pk INT PRIMARY KEY, a INT, b INT AS (a + 1) STORED NOT NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "INSERT INTO t4 (pk, a) VALUES (1, NULL)",
+				ExpectedErr: sql.ErrInsertIntoNonNullableProvidedNull,
+			},
+			{
+				Query:    "INSERT INTO t4 (pk, a) VALUES (1, 1)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+		},
+	},
+	{
+		Name: "invalid generated column definitions",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "CREATE TABLE t5 (pk INT PRIMARY KEY, a INT AS (pk + 1) STORED DEFAULT 1)",
+				ExpectedErr: sql.ErrGeneratedColumnWithDefault,
+			},
+			{
+				Query:       "CREATE TABLE t5 (pk INT AS (1) VIRTUAL PRIMARY KEY)",
+				ExpectedErr: sql.ErrVirtualColumnPrimaryKey,
+			},
+			{
+				Query:       "CREATE TABLE t5 (pk INT PRIMARY KEY, a INT AS (b + 1), b INT AS (pk + 1))",
+				ExpectedErr: sql.ErrGeneratedColumnRefersToLater,
+			},
+			{
+				Query:       "CREATE TABLE t5 (pk INT PRIMARY KEY, a DATETIME AS (NOW()))",
+				ExpectedErr: sql.ErrInvalidGeneratedColumnFunction,
+			},
+		},
+	},
+	{
+		Name: "SHOW CREATE TABLE and information_schema with generated columns",
+		SetUpScript: []string{
+			"CREATE TABLE t6 (pk INT PRIMARY KEY, a INT, b INT AS (a + 1) STORED, c VARCHAR(10) AS (CONCAT(a, 'x')), d INT AS ((a + 1) * 2))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE t6",
+				Expected: []sql.Row{{"t6", "CREATE TABLE `t6` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `a` int,\n" +
+					"  `b` int GENERATED ALWAYS AS (a + 1) STORED,\n" +
+					"  `c` varchar(10) GENERATED ALWAYS AS (concat(a, \"x\")) VIRTUAL,\n" +
+					"  `d` int GENERATED ALWAYS AS ((a + 1) * 2) VIRTUAL,\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SELECT column_name, extra, generation_expression FROM information_schema.columns WHERE table_name = 't6' ORDER BY ordinal_position",
+				Expected: []sql.Row{
+					{"pk", "", ""},
+					{"a", "", ""},
+					{"b", "STORED GENERATED", "(a + 1)"},
+					{"c", "VIRTUAL GENERATED", "concat(a, \"x\")"},
+					{"d", "VIRTUAL GENERATED", "((a + 1) * 2)"},
+				},
+			},
+		},
+	},
+	{
+		Name: "indexed stored generated column",
+		SetUpScript: []string{
+			"CREATE TABLE t7 (pk INT PRIMARY KEY, a INT, b INT AS (a * 10) STORED, UNIQUE KEY b_idx (b))",
+			"INSERT INTO t7 (pk, a) VALUES (1, 1), (2, 2)",
+			"CREATE INDEX b_idx2 ON t7 (b)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT pk FROM t7 WHERE b = 20",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query: "UPDATE t7 SET a = 3 WHERE pk = 2",
+				Expected: []sql.Row{{sql.OkResult{
+					RowsAffected: 1,
+					Info: plan.UpdateInfo{
+						Matched: 1,
+						Updated: 1,
+					},
+				}}},
+			},
+			{
+				Query:    "SELECT pk, b FROM t7 WHERE b > 10",
+				Expected: []sql.Row{{2, 30}},
+			},
+		},
+	},
+	{
+		Name: "generated columns added and dropped with ALTER TABLE",
+		SetUpScript: []string{
+			"CREATE TABLE t8 (pk INT PRIMARY KEY, a INT)",
+			"INSERT INTO t8 VALUES (1, 1), (2, 2)",
+			"ALTER TABLE t8 ADD COLUMN b INT AS (a + 100) STORED",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t8 ORDER BY pk",
+				Expected: []sql.Row{{1, 1, 101}, {2, 2, 102}},
+			},
+			{
+				Query:       "ALTER TABLE t8 DROP COLUMN a",
+				ExpectedErr: sql.ErrDropColumnReferencedInGenerated,
+			},
+			{
+				Query:    "ALTER TABLE t8 ADD COLUMN z INT FIRST",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "INSERT INTO t8 (pk, a) VALUES (3, 3)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk, a, b FROM t8 ORDER BY pk",
+				Expected: []sql.Row{{1, 1, 101}, {2, 2, 102}, {3, 3, 103}},
+			},
+		},
+	},
+}
@@ -0,0 +1,331 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var HashJoinScripts = []ScriptTest{
+	{
+		Name: "hash joins on columns without indexes",
+		SetUpScript: []string{
+			"CREATE TABLE a (id INT PRIMARY KEY, k INT, s VARCHAR(10))",
+			"CREATE TABLE b (id INT PRIMARY KEY, k INT, s VARCHAR(10))",
+			"INSERT INTO a VALUES (1, 1, 'one'), (2, 2, 'two'), (3, NULL, 'three'), (4, 4, 'FOUR')",
+			"INSERT INTO b VALUES (1, 1, 'ONE'), (2, 1, 'uno'), (3, NULL, 'null'), (4, 5, 'five'), (5, 4, 'four'), (6, 6, 'six')",
+			"CREATE TABLE c (k INT)",
+			"INSERT INTO c VALUES (1), (1), (4), (NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT a.id, b.id FROM a JOIN b ON a.k = b.k",
+				Expected: []sql.Row{
+					{"Project(a.id, b.id)"},
+					{" └─ HashJoin(a.k = b.k)"},
+					{"     ├─ Projected table access on [id k]"},
+					{"     │   └─ Table(a)"},
+					{"     └─ Projected table access on [id k]"},
+					{"         └─ Table(b)"},
+				},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.k = b.k ORDER BY 1, 2",
+				Expected: []sql.Row{{1, 1}, {1, 2}, {4, 5}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a LEFT JOIN b ON a.k = b.k ORDER BY 1, 2",
+				Expected: []sql.Row{{1, 1}, {1, 2}, {2, nil}, {3, nil}, {4, 5}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a RIGHT JOIN b ON a.k = b.k ORDER BY 2",
+				Expected: []sql.Row{{1, 1}, {1, 2}, {nil, 3}, {nil, 4}, {4, 5}, {nil, 6}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.k = b.k AND b.s <> 'uno' ORDER BY 1",
+				Expected: []sql.Row{{1, 1}, {4, 5}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.s = b.s ORDER BY 1",
+				Expected: []sql.Row{{1, 1}, {4, 5}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.k = b.k AND a.s = b.s ORDER BY 1",
+				Expected: []sql.Row{{1, 1}, {4, 5}},
+			},
+			{
+				Query:    "SELECT a.id, (SELECT COUNT(*) FROM b JOIN c ON b.k = c.k WHERE b.k = a.k) AS c FROM a ORDER BY 1",
+				Expected: []sql.Row{{1, 4}, {2, 0}, {3, 0}, {4, 1}},
+			},
+		},
+	},
+	{
+		Name: "hash join larger than tmp_table_size",
+		SetUpScript: []string{
+			"SET @@tmp_table_size = 1024",
+			"CREATE TABLE digits (d INT PRIMARY KEY)",
+			"INSERT INTO digits VALUES (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+			"CREATE TABLE t1 (id INT PRIMARY KEY, k INT)",
+			"CREATE TABLE t2 (id INT PRIMARY KEY, k INT)",
+			"INSERT INTO t1 SELECT id, id % 200 FROM (SELECT a.d * 100 + b.d * 10 + c.d AS id FROM digits a, digits b, digits c) ids",
+			"INSERT INTO t2 SELECT id, id % 300 + 100 FROM (SELECT a.d * 100 + b.d * 10 + c.d AS id FROM digits a, digits b, digits c) ids",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(*), SUM(t1.id) FROM t1 JOIN t2 ON t1.k = t2.k",
+				Expected: []sql.Row{{2000, "1099000"}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM t1 LEFT JOIN t2 ON t1.k = t2.k WHERE t2.id IS NULL",
+				Expected: []sql.Row{{500}},
+			},
+			{
+				Query:    "SET @@tmp_table_size = 16777216",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+}
+
+var MergeJoinScripts = []ScriptTest{
+	{
+		Name: "merge joins on indexed columns",
+		SetUpScript: []string{
+			"CREATE TABLE a (id INT PRIMARY KEY, k INT, s VARCHAR(10), INDEX a_k (k), INDEX a_s (s))",
+			"CREATE TABLE b (id INT PRIMARY KEY, k BIGINT, s VARCHAR(10), INDEX b_k (k), INDEX b_s (s))",
+			"INSERT INTO a VALUES (1, 4, 'four'), (2, 1, 'one'), (3, NULL, 'Résumé'), (4, 1, 'ONE'), (5, 2, 'two')",
+			"INSERT INTO b VALUES (1, 1, 'One'), (2, NULL, 'null'), (3, 4, 'FOUR'), (4, 1, 'uno'), (5, 5, 'resume'), (6, 1, 'eins')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT a.id, b.id FROM a JOIN b ON a.k = b.k",
+				Expected: []sql.Row{
+					{"Project(a.id, b.id)"},
+					{" └─ MergeJoin(a.k = b.k)"},
+					{"     ├─ Projected table access on [id k]"},
+					{"     │   └─ IndexedTableAccess(a on [a.k])"},
+					{"     └─ Projected table access on [id k]"},
+					{"         └─ IndexedTableAccess(b on [b.k])"},
+				},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.k = b.k ORDER BY 1, 2",
+				Expected: []sql.Row{{1, 3}, {2, 1}, {2, 4}, {2, 6}, {4, 1}, {4, 4}, {4, 6}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a LEFT JOIN b ON a.k = b.k ORDER BY 1, 2",
+				Expected: []sql.Row{{1, 3}, {2, 1}, {2, 4}, {2, 6}, {3, nil}, {4, 1}, {4, 4}, {4, 6}, {5, nil}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a RIGHT JOIN b ON a.k = b.k ORDER BY 2, 1",
+				Expected: []sql.Row{{2, 1}, {4, 1}, {nil, 2}, {1, 3}, {2, 4}, {4, 4}, {nil, 5}, {2, 6}, {4, 6}},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.k = b.k AND a.s = b.s ORDER BY 1",
+				Expected: []sql.Row{{1, 3}, {2, 1}, {4, 1}},
+			},
+			{
+				Query: "EXPLAIN SELECT a.id, b.id FROM a JOIN b ON a.s = b.s",
+				Expected: []sql.Row{
+					{"Project(a.id, b.id)"},
+					{" └─ MergeJoin(a.s = b.s)"},
+					{"     ├─ Projected table access on [id s]"},
+					{"     │   └─ IndexedTableAccess(a on [a.s])"},
+					{"     └─ Projected table access on [id s]"},
+					{"         └─ IndexedTableAccess(b on [b.s])"},
+				},
+			},
+			{
+				Query:    "SELECT a.id, b.id FROM a JOIN b ON a.s = b.s ORDER BY 1, 2",
+				Expected: []sql.Row{{1, 3}, {2, 1}, {3, 5}, {4, 1}},
+			},
+		},
+	},
+	{
+		Name: "no merge joins on columns of different collations",
+		SetUpScript: []string{
+			"CREATE TABLE a (id INT PRIMARY KEY, s VARCHAR(10) COLLATE utf8mb4_0900_ai_ci, INDEX a_s (s))",
+			"CREATE TABLE b (id INT PRIMARY KEY, s VARCHAR(10) COLLATE utf8mb4_0900_bin, INDEX b_s (s))",
+			"INSERT INTO a VALUES (1, 'a'), (2, 'B'), (3, 'c')",
+			"INSERT INTO b VALUES (1, 'A'), (2, 'b'), (3, 'c')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT a.id, b.id FROM a JOIN b ON a.s = b.s",
+				Expected: []sql.Row{
+					{"Project(a.id, b.id)"},
+					{" └─ IndexedJoin(a.s = b.s)"},
+					{"     ├─ Table(a)"},
+					{"     └─ IndexedTableAccess(b on [b.s])"},
+				},
+			},
+		},
+	},
+}
+
+var JoinOrderingScripts = []ScriptTest{
+	{
+		Name: "join order follows the selectivity of filters once tables are analyzed",
+		SetUpScript: []string{
+			"CREATE TABLE digits (d INT PRIMARY KEY)",
+			"INSERT INTO digits VALUES (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+			"CREATE TABLE orders (id INT PRIMARY KEY, status INT)",
+			"INSERT INTO orders SELECT a.d * 100 + b.d * 10 + c.d, IF(a.d * 100 + b.d * 10 + c.d = 7, 1, 0) FROM digits a, digits b, digits c",
+			"CREATE TABLE items (id INT PRIMARY KEY, order_id INT, INDEX items_order (order_id))",
+			"INSERT INTO items SELECT a.d * 100 + b.d * 10 + c.d, a.d * 100 + b.d * 10 + c.d FROM digits a, digits b, digits c WHERE a.d < 9",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT items.id FROM orders JOIN items ON orders.id = items.order_id WHERE orders.status = 1",
+				Expected: []sql.Row{
+					{"Project(items.id)"},
+					{" └─ IndexedJoin(orders.id = items.order_id)"},
+					{"     ├─ Table(items)"},
+					{"     └─ Filter(orders.status = 1)"},
+					{"         └─ IndexedTableAccess(orders on [orders.id])"},
+				},
+			},
+			{
+				Query:    "ANALYZE TABLE orders, items",
+				Expected: []sql.Row{{"mydb.orders", "analyze", "status", "OK"}, {"mydb.items", "analyze", "status", "OK"}},
+			},
+			{
+				// Only one order has status 1, so reading orders first means looking up items only once
+				Query: "EXPLAIN SELECT items.id FROM orders JOIN items ON orders.id = items.order_id WHERE orders.status = 1",
+				Expected: []sql.Row{
+					{"Project(items.id)"},
+					{" └─ IndexedJoin(orders.id = items.order_id)"},
+					{"     ├─ Filter(orders.status = 1)"},
+					{"     │   └─ Table(orders)"},
+					{"     └─ IndexedTableAccess(items on [items.order_id])"},
+				},
+			},
+			{
+				Query:    "SELECT items.id FROM orders JOIN items ON orders.id = items.order_id WHERE orders.status = 1",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query: "EXPLAIN SELECT /*+ JOIN_ORDER(items, orders) */ items.id FROM orders JOIN items ON orders.id = items.order_id WHERE orders.status = 1",
+				Expected: []sql.Row{
+					{"Project(items.id)"},
+					{" └─ IndexedJoin(orders.id = items.order_id)"},
+					{"     ├─ Table(items)"},
+					{"     └─ Filter(orders.status = 1)"},
+					{"         └─ IndexedTableAccess(orders on [orders.id])"},
+				},
+			},
+		},
+	},
+}
+
+var SemiJoinScripts = []ScriptTest{
+	{
+		Name: "IN and EXISTS subqueries rewritten as semi and anti joins",
+		SetUpScript: []string{
+			"CREATE TABLE sj1 (id INT PRIMARY KEY, v INT)",
+			"CREATE TABLE sj2 (id INT PRIMARY KEY, w INT, g INT, INDEX sj2_g (g))",
+			"INSERT INTO sj1 VALUES (1, 1), (2, 2), (3, NULL), (4, 4)",
+			"INSERT INTO sj2 VALUES (1, 1, 1), (2, NULL, 2), (3, 4, 2), (4, 5, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT id FROM sj1 WHERE EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id)",
+				Expected: []sql.Row{
+					{"Project(sj1.id)"},
+					{" └─ SemiIndexedJoin(sj2.g = sj1.id)"},
+					{"     ├─ Table(sj1)"},
+					{"     └─ IndexedTableAccess(sj2 on [sj2.g])"},
+				},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id) ORDER BY 1",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query: "EXPLAIN SELECT id FROM sj1 WHERE NOT EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id)",
+				Expected: []sql.Row{
+					{"Project(sj1.id)"},
+					{" └─ AntiIndexedJoin(sj2.g = sj1.id)"},
+					{"     ├─ Table(sj1)"},
+					{"     └─ IndexedTableAccess(sj2 on [sj2.g])"},
+				},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE NOT EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id) ORDER BY 1",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE v IN (SELECT w FROM sj2 WHERE sj2.g = sj1.id) ORDER BY 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query: "EXPLAIN SELECT id FROM sj1 WHERE v NOT IN (SELECT w FROM sj2 WHERE sj2.g = sj1.id)",
+				Expected: []sql.Row{
+					{"Project(sj1.id)"},
+					{" └─ AntiHashJoin((((sj1.v = sj2.w) OR sj1.v IS NULL) OR sj2.w IS NULL) AND (sj2.g = sj1.id))"},
+					{"     ├─ Projected table access on [id v]"},
+					{"     │   └─ Table(sj1)"},
+					{"     └─ Projected table access on [w g]"},
+					{"         └─ Table(sj2)"},
+				},
+			},
+			{
+				// 2 NOT IN (NULL, 4) and NULL NOT IN (5) are NULL, while 4 NOT IN () is true
+				Query:    "SELECT id FROM sj1 WHERE v NOT IN (SELECT w FROM sj2 WHERE sj2.g = sj1.id) ORDER BY 1",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE NOT (v IN (SELECT w FROM sj2 WHERE sj2.g = sj1.id)) ORDER BY 1",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE v NOT IN (SELECT w FROM sj2) ORDER BY 1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE EXISTS (SELECT 1 FROM sj2 WHERE sj2.w < sj1.v) ORDER BY 1",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE NOT EXISTS (SELECT 1 FROM sj2 WHERE sj2.w = sj1.v) ORDER BY 1",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id LIMIT 1) AND NOT EXISTS (SELECT 1 FROM sj2 WHERE sj2.w = sj1.v) ORDER BY 1",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM sj1 WHERE EXISTS (SELECT id, w FROM sj2 WHERE sj2.g = sj1.id AND sj2.w IS NOT NULL) ORDER BY 1",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT sj1.id, (SELECT COUNT(*) FROM sj2 WHERE sj2.g IN (SELECT s.id FROM sj1 s WHERE s.v <= sj1.v)) FROM sj1 ORDER BY 1",
+				Expected: []sql.Row{{1, 1}, {2, 3}, {3, 0}, {4, 3}},
+			},
+			{
+				Query:    "UPDATE sj1 SET v = v + 10 WHERE EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id)",
+				Expected: []sql.Row{{newUpdateResult(3, 2)}},
+			},
+			{
+				Query:    "DELETE FROM sj1 WHERE NOT EXISTS (SELECT 1 FROM sj2 WHERE sj2.g = sj1.id)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM sj1 ORDER BY 1",
+				Expected: []sql.Row{{1, 11}, {2, 12}, {3, nil}},
+			},
+		},
+	},
+}
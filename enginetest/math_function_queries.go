@@ -0,0 +1,95 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var MathFunctionScripts = []ScriptTest{
+	{
+		Name: "modulo and truncation",
+		SetUpScript: []string{
+			"CREATE TABLE prices (id INT PRIMARY KEY, amount DECIMAL(10,3), ratio DOUBLE)",
+			"INSERT INTO prices VALUES (1, 10.750, 5.5), (2, -10.750, -5.5), (3, 0.300, 0)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, MOD(amount, 3), amount % 0.25, ratio % 2, MOD(id, 0) FROM prices ORDER BY id",
+				Expected: []sql.Row{{1, "1.750", "0.000", 1.5, nil}, {2, "-1.750", "0.000", -1.5, nil}, {3, "0.300", "0.050", float64(0), nil}},
+			},
+			{
+				Query:    "SELECT id, TRUNCATE(amount, 1), TRUNCATE(amount, -1), TRUNCATE(ratio, 0) FROM prices ORDER BY id",
+				Expected: []sql.Row{{1, "10.7", "10", float64(5)}, {2, "-10.7", "-10", float64(-5)}, {3, "0.3", "0", float64(0)}},
+			},
+			{
+				Query:    "SELECT TRUNCATE(1.223, 1), TRUNCATE(1.999, 0), TRUNCATE(-1.999, 1), TRUNCATE(122, -2), TRUNCATE(NULL, 1), MOD(29, 9), 8 MOD 3",
+				Expected: []sql.Row{{"1.2", "1", "-1.9", int8(100), nil, int64(2), int64(2)}},
+			},
+		},
+	},
+	{
+		Name: "constants and exponentials",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT PI(), EXP(0), EXP(1), EXP(NULL), ROUND(EXP(2), 4)",
+				Expected: []sql.Row{{math.Pi, float64(1), math.E, nil, 7.3891}},
+			},
+			{
+				Query:       "SELECT EXP(1000)",
+				ExpectedErr: sql.ErrOutOfRange,
+			},
+			{
+				Query:    "SELECT ATAN2(1, 1) = PI() / 4, ATAN(-2, -2) = -3 * PI() / 4, ATAN(1) = ATAN2(1, 1), ATAN2(NULL, 1)",
+				Expected: []sql.Row{{true, true, true, nil}},
+			},
+			{
+				Query:    "SELECT CRC32('MySQL'), CRC32C('MySQL'), CRC32C('123456789'), CRC32C(NULL)",
+				Expected: []sql.Row{{uint32(3259397556), uint32(1398257063), uint32(3808858755), nil}},
+			},
+			{
+				Query:    "SELECT CONV('a', 16, 2), CONV('6E', 18, 8), CONV(-17, 10, -18)",
+				Expected: []sql.Row{{"1010", "172", "-H"}},
+			},
+		},
+	},
+	{
+		Name: "seeded random numbers",
+		SetUpScript: []string{
+			"CREATE TABLE nums (n INT PRIMARY KEY)",
+			"INSERT INTO nums VALUES (1), (2), (3)",
+			"CREATE TABLE seq1 (n INT PRIMARY KEY, r DOUBLE)",
+			"CREATE TABLE seq2 (n INT PRIMARY KEY, r DOUBLE)",
+			"INSERT INTO seq1 SELECT n, RAND(42) FROM nums",
+			"INSERT INTO seq2 SELECT n, RAND(42) FROM nums",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(DISTINCT r) FROM seq1",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM seq1 JOIN seq2 ON seq1.n = seq2.n AND seq1.r = seq2.r",
+				Expected: []sql.Row{{int64(3)}},
+			},
+			{
+				Query:    "SELECT COUNT(DISTINCT RAND(1)), COUNT(DISTINCT RAND(nums.n)) FROM nums, nums AS other",
+				Expected: []sql.Row{{int64(9), int64(3)}},
+			},
+		},
+	},
+}
@@ -507,6 +507,102 @@ func TestJsonScripts(t *testing.T) {
 	enginetest.TestJsonScripts(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestGeneratedColumns(t *testing.T) {
+	enginetest.TestGeneratedColumns(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestFullTextSearch(t *testing.T) {
+	enginetest.TestFullTextSearch(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestCollations(t *testing.T) {
+	enginetest.TestCollations(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestCharsets(t *testing.T) {
+	enginetest.TestCharsets(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTimeZones(t *testing.T) {
+	enginetest.TestTimeZones(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestDatetimeFunctions(t *testing.T) {
+	enginetest.TestDatetimeFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestRegexpFunctions(t *testing.T) {
+	enginetest.TestRegexpFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStringFunctions(t *testing.T) {
+	enginetest.TestStringFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestAggregations(t *testing.T) {
+	enginetest.TestAggregations(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestEncryptionFunctions(t *testing.T) {
+	enginetest.TestEncryptionFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestNetworkFunctions(t *testing.T) {
+	enginetest.TestNetworkFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestMathFunctions(t *testing.T) {
+	enginetest.TestMathFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	enginetest.TestDecimalArithmetic(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTableFunctions(t *testing.T) {
+	enginetest.TestTableFunctions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestCustomTypes(t *testing.T) {
+	enginetest.TestCustomTypes(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestVectors(t *testing.T) {
+	enginetest.TestVectors(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSortSpill(t *testing.T) {
+	enginetest.TestSortSpill(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestTopN(t *testing.T) {
+	enginetest.TestTopN(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestHashJoins(t *testing.T) {
+	enginetest.TestHashJoins(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestMergeJoins(t *testing.T) {
+	enginetest.TestMergeJoins(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStatistics(t *testing.T) {
+	enginetest.TestStatistics(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestJoinOrdering(t *testing.T) {
+	enginetest.TestJoinOrdering(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSemiJoins(t *testing.T) {
+	enginetest.TestSemiJoins(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestAutoIncrement(t *testing.T) {
+	enginetest.TestAutoIncrement(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestShowTableStatus(t *testing.T) {
 	enginetest.TestShowTableStatus(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -0,0 +1,82 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var NetworkFunctionScripts = []ScriptTest{
+	{
+		Name: "addresses stored as binary strings",
+		SetUpScript: []string{
+			"CREATE TABLE audit (id INT PRIMARY KEY, client VARBINARY(16))",
+			"INSERT INTO audit VALUES (1, INET6_ATON('10.0.5.9')), (2, INET6_ATON('fdfe::5a55:caff:fefa:9089')), (3, INET6_ATON('::ffff:10.0.5.9')), (4, INET6_ATON('not an address'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT id, HEX(client), INET6_NTOA(client), IS_IPV4_MAPPED(client), IS_IPV4_COMPAT(client) FROM audit ORDER BY id",
+				Expected: []sql.Row{
+					{1, "0A000509", "10.0.5.9", false, false},
+					{2, "FDFE0000000000005A55CAFFFEFA9089", "fdfe::5a55:caff:fefa:9089", false, false},
+					{3, "00000000000000000000FFFF0A000509", "::ffff:10.0.5.9", true, false},
+					{4, nil, nil, false, false},
+				},
+			},
+			{
+				Query:    "SELECT id FROM audit WHERE INET6_NTOA(client) = '10.0.5.9' OR client = INET6_ATON('FDFE::5A55:CAFF:FEFA:9089') ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT INET6_NTOA(UNHEX('0000000000000000000000000A000509')), INET6_NTOA('10.0.5.9'), INET6_NTOA(UNHEX('0A0005'))",
+				Expected: []sql.Row{{"::10.0.5.9", nil, nil}},
+			},
+		},
+	},
+	{
+		Name: "IPv4 addresses stored as numbers",
+		SetUpScript: []string{
+			"CREATE TABLE hosts (name VARCHAR(20) PRIMARY KEY, ip INT UNSIGNED)",
+			"INSERT INTO hosts VALUES ('router', INET_ATON('192.168.0.1')), ('printer', INET_ATON('192.168.0.20')), ('loopback', INET_ATON('127.1'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT name, ip, INET_NTOA(ip) FROM hosts WHERE ip BETWEEN INET_ATON('192.168.0.0') AND INET_ATON('192.168.0.255') ORDER BY ip",
+				Expected: []sql.Row{{"router", uint32(3232235521), "192.168.0.1"}, {"printer", uint32(3232235540), "192.168.0.20"}},
+			},
+			{
+				Query:    "SELECT INET_NTOA(ip) FROM hosts WHERE name = 'loopback'",
+				Expected: []sql.Row{{"127.0.0.1"}},
+			},
+			{
+				Query:    "SELECT INET_ATON('10.0.5.256'), INET_ATON('10.0.5.'), INET_ATON('1..2'), INET_ATON(NULL), INET_NTOA(-1), INET_NTOA(4294967296), INET_NTOA(NULL)",
+				Expected: []sql.Row{{nil, nil, nil, nil, nil, nil, nil}},
+			},
+		},
+	},
+	{
+		Name: "address validation",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT IS_IPV4('10.0.5.9'), IS_IPV4('10.0.5.256'), IS_IPV4('127.1'), IS_IPV4(NULL), IS_IPV6('::1'), IS_IPV6('10.0.5.9'), IS_IPV6(NULL)",
+				Expected: []sql.Row{{true, false, false, false, true, false, false}},
+			},
+			{
+				Query:    "SELECT IS_IPV4_MAPPED(INET6_ATON('::ffff:10.0.5.9')), IS_IPV4_MAPPED(INET6_ATON('10.0.5.9')), IS_IPV4_COMPAT(INET6_ATON('::10.0.5.9')), IS_IPV4_MAPPED(NULL)",
+				Expected: []sql.Row{{true, false, true, false}},
+			},
+		},
+	},
+}
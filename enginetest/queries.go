@@ -123,29 +123,29 @@ var QueryTests = []QueryTest{
 	{
 		Query: "SELECT pk DIV 2, SUM(c3) FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
-			{int64(0), float64(14)},
-			{int64(1), float64(54)},
+			{int64(0), "14"},
+			{int64(1), "54"},
 		},
 	},
 	{
 		Query: "SELECT pk DIV 2, SUM(c3) as sum FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
-			{int64(0), float64(14)},
-			{int64(1), float64(54)},
+			{int64(0), "14"},
+			{int64(1), "54"},
 		},
 	},
 	{
 		Query: "SELECT pk DIV 2, SUM(c3) + sum(c3) as sum FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
-			{int64(0), float64(28)},
-			{int64(1), float64(108)},
+			{int64(0), "28"},
+			{int64(1), "108"},
 		},
 	},
 	{
 		Query: "SELECT pk DIV 2, SUM(c3) + min(c3) as sum_and_min FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
-			{int64(0), float64(16)},
-			{int64(1), float64(76)},
+			{int64(0), "16"},
+			{int64(1), "76"},
 		},
 		ExpectedColumns: sql.Schema{
 			{
@@ -154,15 +154,15 @@ var QueryTests = []QueryTest{
 			},
 			{
 				Name: "sum_and_min",
-				Type: sql.Float64,
+				Type: sql.MustCreateDecimalType(26, 0),
 			},
 		},
 	},
 	{
 		Query: "SELECT pk DIV 2, SUM(`c3`) +    min( c3 ) FROM one_pk GROUP BY 1 ORDER BY 1",
 		Expected: []sql.Row{
-			{int64(0), float64(16)},
-			{int64(1), float64(76)},
+			{int64(0), "16"},
+			{int64(1), "76"},
 		},
 		ExpectedColumns: sql.Schema{
 			{
@@ -171,15 +171,15 @@ var QueryTests = []QueryTest{
 			},
 			{
 				Name: "SUM(`c3`) +    min( c3 )",
-				Type: sql.Float64,
+				Type: sql.MustCreateDecimalType(26, 0),
 			},
 		},
 	},
 	{
 		Query: "SELECT pk1, SUM(c1) FROM two_pk GROUP BY pk1 ORDER BY pk1;",
 		Expected: []sql.Row{
-			{0, 10.0},
-			{1, 50.0},
+			{0, "10"},
+			{1, "50"},
 		},
 	},
 	{
@@ -188,7 +188,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT pk1, SUM(c1) FROM two_pk WHERE pk1 = 0",
-		Expected: []sql.Row{{0, 10.0}},
+		Expected: []sql.Row{{0, "10"}},
 	},
 	{
 		Query:    "SELECT i FROM mytable;",
@@ -306,7 +306,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 		ExpectedColumns: sql.Schema{
@@ -323,7 +323,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a (c,d) order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 		ExpectedColumns: sql.Schema{
@@ -340,7 +340,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT column_0 FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
 		Expected: []sql.Row{
-			{1.0},
+			{"1"},
 			{2},
 		},
 	},
@@ -349,9 +349,9 @@ var QueryTests = []QueryTest{
 			(values row(1,1), row(1,3), row(2,2), row(2,5), row(3,9)) a 
 			group by 1 order by 1`,
 		Expected: []sql.Row{
-			{1, 4.0},
-			{2, 7.0},
-			{3, 9.0},
+			{1, "4"},
+			{2, "7"},
+			{3, "9"},
 		},
 	},
 	{
@@ -359,16 +359,16 @@ var QueryTests = []QueryTest{
 			(values row(1,1), row(1,3), row(2,2), row(2,5), row(3,9)) a (b,c) 
 			group by 1 order by 1`,
 		Expected: []sql.Row{
-			{1, 4.0},
-			{2, 7.0},
-			{3, 9.0},
+			{1, "4"},
+			{2, "7"},
+			{3, "9"},
 		},
 	},
 	{
 		Query: `SELECT i, sum(i) FROM mytable group by 1 having avg(i) > 1 order by 1`,
 		Expected: []sql.Row{
-			{2, 2.0},
-			{3, 3.0},
+			{2, "2"},
+			{3, "3"},
 		},
 	},
 	{
@@ -376,7 +376,7 @@ var QueryTests = []QueryTest{
 			join (values row(2,4), row(1.0,"ab")) b on a.column_0 = b.column_0 and a.column_0 = b.column_0
 			order by 1`,
 		Expected: []sql.Row{
-			{1.0, "ab"},
+			{"1", "ab"},
 			{2, 4},
 		},
 	},
@@ -530,8 +530,8 @@ var QueryTests = []QueryTest{
 	{
 		Query: "WITH mt (s,i) as (select char_length(s), sum(i) FROM mytable group by 1) SELECT s,i FROM mt order by 1",
 		Expected: []sql.Row{
-			{9, 4.0},
-			{10, 2.0},
+			{9, "4"},
+			{10, "2"},
 		},
 	},
 	{
@@ -2188,7 +2188,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT SUM(i) FROM mytable`,
-		Expected: []sql.Row{{float64(6)}},
+		Expected: []sql.Row{{"6"}},
 	},
 	{
 		Query:    `SELECT GET_LOCK("test", 0)`,
@@ -2435,9 +2435,9 @@ var QueryTests = []QueryTest{
 	{
 		Query: "select from_unixtime(i) from mytable order by 1",
 		Expected: []sql.Row{
-			{time.Unix(1, 0)},
-			{time.Unix(2, 0)},
-			{time.Unix(3, 0)},
+			{time.Unix(1, 0).UTC()},
+			{time.Unix(2, 0).UTC()},
+			{time.Unix(3, 0).UTC()},
 		},
 	},
 	// TODO: add additional tests for other functions. Every function needs an engine test to ensure it works correctly
@@ -2449,57 +2449,57 @@ var QueryTests = []QueryTest{
 	{
 		Query: "SELECT SUM(i) + 1, i FROM mytable GROUP BY i ORDER BY i",
 		Expected: []sql.Row{
-			{float64(2), int64(1)},
-			{float64(3), int64(2)},
-			{float64(4), int64(3)},
+			{"2", int64(1)},
+			{"3", int64(2)},
+			{"4", int64(3)},
 		},
 	},
 	{
 		Query: "SELECT SUM(i), i FROM mytable GROUP BY i ORDER BY 1+SUM(i) ASC",
 		Expected: []sql.Row{
-			{float64(1), int64(1)},
-			{float64(2), int64(2)},
-			{float64(3), int64(3)},
+			{"1", int64(1)},
+			{"2", int64(2)},
+			{"3", int64(3)},
 		},
 	},
 	{
 		Query: "SELECT SUM(i) as sum, i FROM mytable GROUP BY i ORDER BY 1+SUM(i) ASC",
 		Expected: []sql.Row{
-			{float64(1), int64(1)},
-			{float64(2), int64(2)},
-			{float64(3), int64(3)},
+			{"1", int64(1)},
+			{"2", int64(2)},
+			{"3", int64(3)},
 		},
 	},
 	{
 		Query: "SELECT SUM(i) as sum, i FROM mytable GROUP BY i ORDER BY sum ASC",
 		Expected: []sql.Row{
-			{float64(1), int64(1)},
-			{float64(2), int64(2)},
-			{float64(3), int64(3)},
+			{"1", int64(1)},
+			{"2", int64(2)},
+			{"3", int64(3)},
 		},
 	},
 	{
 		Query: "SELECT i, SUM(i) FROM mytable GROUP BY i ORDER BY sum(i) DESC",
 		Expected: []sql.Row{
-			{int64(3), float64(3)},
-			{int64(2), float64(2)},
-			{int64(1), float64(1)},
+			{int64(3), "3"},
+			{int64(2), "2"},
+			{int64(1), "1"},
 		},
 	},
 	{
 		Query: "SELECT i, SUM(i) as b FROM mytable GROUP BY i ORDER BY b DESC",
 		Expected: []sql.Row{
-			{int64(3), float64(3)},
-			{int64(2), float64(2)},
-			{int64(1), float64(1)},
+			{int64(3), "3"},
+			{int64(2), "2"},
+			{int64(1), "1"},
 		},
 	},
 	{
 		Query: "SELECT i, SUM(i) as `sum(i)` FROM mytable GROUP BY i ORDER BY sum(i) DESC",
 		Expected: []sql.Row{
-			{int64(3), float64(3)},
-			{int64(2), float64(2)},
-			{int64(1), float64(1)},
+			{int64(3), "3"},
+			{int64(2), "2"},
+			{int64(1), "1"},
 		},
 	},
 	{
@@ -2610,7 +2610,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT AVG(23.222000)`,
 		Expected: []sql.Row{
-			{float64(23.222)},
+			{"23.2220000000"},
 		},
 	},
 	{
@@ -2677,6 +2677,7 @@ var QueryTests = []QueryTest{
 		Expected: []sql.Row{
 			{"block_encryption_mode", "aes-128-ecb"},
 			{"gtid_mode", "OFF"},
+			{"innodb_autoinc_lock_mode", int64(2)},
 			{"offline_mode", int64(0)},
 			{"pseudo_slave_mode", int64(0)},
 			{"rbr_exec_mode", "STRICT"},
@@ -2914,7 +2915,7 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SELECT round(15728640/1024/1024)`,
 		Expected: []sql.Row{
-			{int64(15)},
+			{"15"},
 		},
 	},
 	{
@@ -2950,6 +2951,60 @@ var QueryTests = []QueryTest{
 	{
 		Query: `SHOW COLLATION`,
 		Expected: []sql.Row{
+			{
+				sql.Collation_ascii_general_ci.String(),
+				"ascii",
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].SortLen,
+				sql.Collation_ascii_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_latin1_swedish_ci.String(),
+				"latin1",
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].SortLen,
+				sql.Collation_latin1_swedish_ci.PadSpace(),
+			},
+			{
+				sql.Collation_ucs2_general_ci.String(),
+				"ucs2",
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].SortLen,
+				sql.Collation_ucs2_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf16_general_ci.String(),
+				"utf16",
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].SortLen,
+				sql.Collation_utf16_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf16le_general_ci.String(),
+				"utf16le",
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].SortLen,
+				sql.Collation_utf16le_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf32_general_ci.String(),
+				"utf32",
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].SortLen,
+				sql.Collation_utf32_general_ci.PadSpace(),
+			},
 			{
 				sql.Collation_binary.String(),
 				"binary",
@@ -2957,7 +3012,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_binary.Name].PadSpace,
+				sql.Collation_binary.PadSpace(),
 			},
 			{
 				sql.Collation_utf8_general_ci.String(),
@@ -2966,7 +3021,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].PadSpace,
+				sql.Collation_utf8_general_ci.PadSpace(),
 			},
 			{
 				sql.Collation_utf8mb4_0900_ai_ci.String(),
@@ -2975,7 +3030,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].PadSpace,
+				sql.Collation_utf8mb4_0900_ai_ci.PadSpace(),
 			},
 		},
 	},
@@ -2993,7 +3048,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].PadSpace,
+				sql.Collation_utf8_general_ci.PadSpace(),
 			},
 			{
 				sql.Collation_utf8mb4_0900_ai_ci.String(),
@@ -3002,7 +3057,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].PadSpace,
+				sql.Collation_utf8mb4_0900_ai_ci.PadSpace(),
 			},
 		},
 	},
@@ -3013,6 +3068,60 @@ var QueryTests = []QueryTest{
 	{
 		Query: "SHOW COLLATION WHERE `Default` = 'Yes'",
 		Expected: []sql.Row{
+			{
+				sql.Collation_ascii_general_ci.String(),
+				"ascii",
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_ascii_general_ci.Name].SortLen,
+				sql.Collation_ascii_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_latin1_swedish_ci.String(),
+				"latin1",
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_latin1_swedish_ci.Name].SortLen,
+				sql.Collation_latin1_swedish_ci.PadSpace(),
+			},
+			{
+				sql.Collation_ucs2_general_ci.String(),
+				"ucs2",
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_ucs2_general_ci.Name].SortLen,
+				sql.Collation_ucs2_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf16_general_ci.String(),
+				"utf16",
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf16_general_ci.Name].SortLen,
+				sql.Collation_utf16_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf16le_general_ci.String(),
+				"utf16le",
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf16le_general_ci.Name].SortLen,
+				sql.Collation_utf16le_general_ci.PadSpace(),
+			},
+			{
+				sql.Collation_utf32_general_ci.String(),
+				"utf32",
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].ID,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].IsDefault,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].IsCompiled,
+				sql.CollationToMySQLVals[sql.Collation_utf32_general_ci.Name].SortLen,
+				sql.Collation_utf32_general_ci.PadSpace(),
+			},
 			{
 				sql.Collation_binary.String(),
 				"binary",
@@ -3020,7 +3129,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_binary.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_binary.Name].PadSpace,
+				sql.Collation_binary.PadSpace(),
 			},
 			{
 				sql.Collation_utf8_general_ci.String(),
@@ -3029,7 +3138,7 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8_general_ci.Name].PadSpace,
+				sql.Collation_utf8_general_ci.PadSpace(),
 			},
 			{
 				sql.Collation_utf8mb4_0900_ai_ci.String(),
@@ -3038,13 +3147,49 @@ var QueryTests = []QueryTest{
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsDefault,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].IsCompiled,
 				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].SortLen,
-				sql.CollationToMySQLVals[sql.Collation_utf8mb4_0900_ai_ci.Name].PadSpace,
+				sql.Collation_utf8mb4_0900_ai_ci.PadSpace(),
 			},
 		},
 	},
 	{
 		Query: "SHOW CHARSET",
 		Expected: []sql.Row{
+			{
+				sql.CharacterSet_ascii.String(),
+				sql.CharacterSet_ascii.Description(),
+				sql.CharacterSet_ascii.DefaultCollation().String(),
+				sql.CharacterSet_ascii.MaxLength(),
+			},
+			{
+				sql.CharacterSet_latin1.String(),
+				sql.CharacterSet_latin1.Description(),
+				sql.CharacterSet_latin1.DefaultCollation().String(),
+				sql.CharacterSet_latin1.MaxLength(),
+			},
+			{
+				sql.CharacterSet_ucs2.String(),
+				sql.CharacterSet_ucs2.Description(),
+				sql.CharacterSet_ucs2.DefaultCollation().String(),
+				sql.CharacterSet_ucs2.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf16.String(),
+				sql.CharacterSet_utf16.Description(),
+				sql.CharacterSet_utf16.DefaultCollation().String(),
+				sql.CharacterSet_utf16.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf16le.String(),
+				sql.CharacterSet_utf16le.Description(),
+				sql.CharacterSet_utf16le.DefaultCollation().String(),
+				sql.CharacterSet_utf16le.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf32.String(),
+				sql.CharacterSet_utf32.Description(),
+				sql.CharacterSet_utf32.DefaultCollation().String(),
+				sql.CharacterSet_utf32.MaxLength(),
+			},
 			{
 				sql.CharacterSet_utf8mb4.String(),
 				sql.CharacterSet_utf8mb4.Description(),
@@ -3056,6 +3201,42 @@ var QueryTests = []QueryTest{
 	{
 		Query: "SHOW CHARACTER SET",
 		Expected: []sql.Row{
+			{
+				sql.CharacterSet_ascii.String(),
+				sql.CharacterSet_ascii.Description(),
+				sql.CharacterSet_ascii.DefaultCollation().String(),
+				sql.CharacterSet_ascii.MaxLength(),
+			},
+			{
+				sql.CharacterSet_latin1.String(),
+				sql.CharacterSet_latin1.Description(),
+				sql.CharacterSet_latin1.DefaultCollation().String(),
+				sql.CharacterSet_latin1.MaxLength(),
+			},
+			{
+				sql.CharacterSet_ucs2.String(),
+				sql.CharacterSet_ucs2.Description(),
+				sql.CharacterSet_ucs2.DefaultCollation().String(),
+				sql.CharacterSet_ucs2.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf16.String(),
+				sql.CharacterSet_utf16.Description(),
+				sql.CharacterSet_utf16.DefaultCollation().String(),
+				sql.CharacterSet_utf16.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf16le.String(),
+				sql.CharacterSet_utf16le.Description(),
+				sql.CharacterSet_utf16le.DefaultCollation().String(),
+				sql.CharacterSet_utf16le.MaxLength(),
+			},
+			{
+				sql.CharacterSet_utf32.String(),
+				sql.CharacterSet_utf32.Description(),
+				sql.CharacterSet_utf32.DefaultCollation().String(),
+				sql.CharacterSet_utf32.MaxLength(),
+			},
 			{
 				sql.CharacterSet_utf8mb4.String(),
 				sql.CharacterSet_utf8mb4.Description(),
@@ -3328,15 +3509,15 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "select ceil(i + 0.5) from mytable order by 1",
-		Expected: []sql.Row{{2.0}, {3.0}, {4.0}},
+		Expected: []sql.Row{{"2"}, {"3"}, {"4"}},
 	},
 	{
 		Query:    "select floor(i + 0.5) from mytable order by 1",
-		Expected: []sql.Row{{1.0}, {2.0}, {3.0}},
+		Expected: []sql.Row{{"1"}, {"2"}, {"3"}},
 	},
 	{
 		Query:    "select round(i + 0.55, 1) from mytable order by 1",
-		Expected: []sql.Row{{1.6}, {2.6}, {3.6}},
+		Expected: []sql.Row{{"1.6"}, {"2.6"}, {"3.6"}},
 	},
 	{
 		Query:    "select date_format(da, '%s') from typestable order by 1",
@@ -3598,15 +3779,15 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    `SELECT avg(i) FROM mytable GROUP BY i HAVING avg(i) > 1`,
-		Expected: []sql.Row{{float64(2)}, {float64(3)}},
+		Expected: []sql.Row{{"2.0000"}, {"3.0000"}},
 	},
 	{
 		Query:    "SELECT avg(i) as `avg(i)` FROM mytable GROUP BY i HAVING avg(i) > 1",
-		Expected: []sql.Row{{float64(2)}, {float64(3)}},
+		Expected: []sql.Row{{"2.0000"}, {"3.0000"}},
 	},
 	{
 		Query:    "SELECT avg(i) as `AVG(i)` FROM mytable GROUP BY i HAVING AVG(i) > 1",
-		Expected: []sql.Row{{float64(2)}, {float64(3)}},
+		Expected: []sql.Row{{"2.0000"}, {"3.0000"}},
 	},
 	{
 		Query: `SELECT s AS s, COUNT(*) AS count,  AVG(i) AS ` + "`AVG(i)`" + `
@@ -3618,9 +3799,9 @@ var QueryTests = []QueryTest{
 		ORDER BY count DESC, s ASC
 		LIMIT 10000`,
 		Expected: []sql.Row{
-			{"first row", int64(1), float64(1)},
-			{"second row", int64(1), float64(2)},
-			{"third row", int64(1), float64(3)},
+			{"first row", int64(1), "1.0000"},
+			{"second row", int64(1), "2.0000"},
+			{"third row", int64(1), "3.0000"},
 		},
 	},
 	{
@@ -4143,9 +4324,9 @@ var QueryTests = []QueryTest{
 						(SELECT min(pk2) FROM two_pk WHERE pk2 IN (SELECT pk2 FROM two_pk WHERE pk2 = pk)) AS equal
 						FROM one_pk ORDER BY pk;`,
 		Expected: []sql.Row{
-			{0, 0.0, 0},
-			{1, 2.0, 1},
-			{2, 2.0, nil},
+			{0, "0", 0},
+			{1, "2", 1},
+			{2, "2", nil},
 			{3, nil, nil},
 		},
 	},
@@ -4155,10 +4336,10 @@ var QueryTests = []QueryTest{
 						(SELECT sum(c1) FROM two_pk WHERE pk2 IN (SELECT pk2 FROM two_pk WHERE c1 + 1 < opk.c2)) AS sum2
 					FROM one_pk opk ORDER BY pk`,
 		Expected: []sql.Row{
-			{0, 60.0, nil},
-			{1, 50.0, 20.0},
-			{2, 30.0, 60.0},
-			{3, nil, 60.0},
+			{0, "60", nil},
+			{1, "50", "20"},
+			{2, "30", "60"},
+			{3, nil, "60"},
 		},
 	},
 	{
@@ -4241,10 +4422,10 @@ var QueryTests = []QueryTest{
 			{int64(7)},
 			{int64(3)},
 			{int64(2)},
+			{int64(4)},
 			{int64(8)},
 			{int64(6)},
 			{int64(5)},
-			{int64(4)},
 		},
 	},
 	{
@@ -4727,7 +4908,7 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT 2.0 + CAST(5 AS DECIMAL)",
-		Expected: []sql.Row{{float64(7)}},
+		Expected: []sql.Row{{"7.0000000000"}},
 	},
 	{
 		Query:    "SELECT (CASE WHEN i THEN i ELSE 0 END) as cases_i from mytable",
@@ -4735,35 +4916,43 @@ var QueryTests = []QueryTest{
 	},
 	{
 		Query:    "SELECT 1/0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 0/0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 1.0/0.0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 0.0/0.0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 1 div 0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 1.0 div 0.0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 0 div 0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
 	},
 	{
 		Query:    "SELECT 0.0 div 0.0 FROM dual",
-		Expected: []sql.Row{{sql.Null}},
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT 1/0 IS NULL, 1.0/0 IS NULL, 1e0/0 IS NULL, 1 div 0 IS NULL, 1 % 0 IS NULL FROM dual",
+		Expected: []sql.Row{{true, true, true, true, true}},
+	},
+	{
+		Query:    "SELECT COALESCE(1/0, 7), COALESCE(2.5/0, 7), COALESCE(1e0/0, 7) FROM dual",
+		Expected: []sql.Row{{7, 7, 7}},
 	},
 	{
 		Query:    "SELECT NULL <=> NULL FROM dual",
@@ -4881,9 +5070,9 @@ var QueryTests = []QueryTest{
 			row_number() over (order by length(s),i) + 0.0 / row_number() over (order by length(s) desc,i desc) + 0.0  
 			from mytable order by 1;`,
 		Expected: []sql.Row{
-			{1, 6, 1.0},
-			{2, 5, 3.0},
-			{3, 4, 2.0},
+			{1, 6, "1.00000"},
+			{2, 5, "3.00000"},
+			{3, 4, "2.00000"},
 		},
 	},
 	{
@@ -5195,8 +5384,8 @@ var BrokenQueries = []QueryTest{
 			(values row(1,1), row(1,3), row(2,2), row(2,5), row(3,9)) a 
 			group by 1 having avg(column_1) > 2 order by 1`,
 		Expected: []sql.Row{
-			{2, 7.0},
-			{3, 9.0},
+			{2, "7"},
+			{3, "9"},
 		},
 	},
 	// The outer CTE currently resolves before the inner one, which causes
@@ -5842,9 +6031,9 @@ var InfoSchemaScripts = []ScriptTest{
 			{
 				Query: "SELECT * FROM information_schema.key_column_usage where table_name='ptable2' ORDER BY constraint_name",
 				Expected: []sql.Row{
-					{"def", "mydb", "PRIMARY", "def", "mydb", "ptable2", "pk", 1, nil, nil, nil, nil},
 					{"def", "mydb", "fkr", "def", "mydb", "ptable2", "test_score2", 1, 1, "mydb", "ptable", "test_score"},
 					{"def", "mydb", "fkr", "def", "mydb", "ptable2", "height2", 2, 2, "mydb", "ptable", "height"},
+					{"def", "mydb", "PRIMARY", "def", "mydb", "ptable2", "pk", 1, nil, nil, nil, nil},
 				},
 			},
 		},
@@ -41,9 +41,11 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(row_number() over (order by i desc) ASC)\n" +
 			" └─ Project(row_number() over ( order by [mytable.i, idx=0, type=BIGINT, nullable=false] DESC) as row_number() over (order by i desc), i2)\n" +
 			"     └─ Window(row_number() over ( order by [mytable.i, idx=0, type=BIGINT, nullable=false] DESC), mytable.i as i2)\n" +
-			"         └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             ├─ Table(mytable)\n" +
-			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             ├─ Projected table access on [i]\n" +
+			"             │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             └─ Projected table access on [i2]\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -78,7 +80,7 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT /*+ JOIN_ORDER(t1, t2) */ t1.i FROM mytable t1 JOIN mytable t2 on t1.i = t2.i + 1 where t1.i = 2 and t2.i = 1`,
 		ExpectedPlan: "Project(t1.i)\n" +
-			" └─ InnerJoin(t1.i = (t2.i + 1))\n" +
+			" └─ HashJoin(t1.i = (t2.i + 1))\n" +
 			"     ├─ Filter(t1.i = 2)\n" +
 			"     │   └─ Projected table access on [i]\n" +
 			"     │       └─ TableAlias(t1)\n" +
@@ -128,9 +130,11 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -181,13 +185,17 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Distinct\n" +
 			" └─ Union\n" +
 			"     ├─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │   └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │       ├─ Table(mytable)\n" +
-			"     │       └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │   └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │       ├─ Projected table access on [i]\n" +
+			"     │       │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │       └─ Projected table access on [i2 s2]\n" +
+			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"     └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"         └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             ├─ Table(mytable)\n" +
-			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             ├─ Projected table access on [i]\n" +
+			"             │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             └─ Projected table access on [i2 s2]\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -196,9 +204,11 @@ var PlanTests = []QueryPlanTest{
 			" └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"     ├─ SubqueryAlias(sub)\n" +
 			"     │   └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │           ├─ Table(mytable)\n" +
-			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │           ├─ Projected table access on [i]\n" +
+			"     │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │           └─ Projected table access on [i2 s2]\n" +
+			"     │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"     └─ TableAlias(ot)\n" +
 			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
@@ -209,9 +219,11 @@ var PlanTests = []QueryPlanTest{
 			" └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"     ├─ SubqueryAlias(sub)\n" +
 			"     │   └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │           ├─ Table(mytable)\n" +
-			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │           ├─ Projected table access on [i]\n" +
+			"     │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │           └─ Projected table access on [i2 s2]\n" +
+			"     │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"     └─ TableAlias(ot)\n" +
 			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
@@ -219,18 +231,16 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT sub.i, sub.i2, sub.s2, ot.i2, ot.s2 FROM othertable ot LEFT JOIN (SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2 WHERE CONVERT(s2, signed) <> 0) sub ON sub.i = ot.i2 WHERE ot.i2 > 0`,
 		ExpectedPlan: "Project(sub.i, sub.i2, sub.s2, ot.i2, ot.s2)\n" +
-			" └─ LeftJoin(sub.i = ot.i2)\n" +
+			" └─ LeftHashJoin(sub.i = ot.i2)\n" +
 			"     ├─ Filter(ot.i2 > 0)\n" +
 			"     │   └─ TableAlias(ot)\n" +
 			"     │       └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"     └─ HashLookup(child: (sub.i), lookup: (ot.i2))\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias(sub)\n" +
-			"                 └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"                     └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"                         ├─ Table(mytable)\n" +
-			"                         └─ Filter(NOT((convert(othertable.s2, signed) = 0)))\n" +
-			"                             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ SubqueryAlias(sub)\n" +
+			"         └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
+			"             └─ IndexedJoin(mytable.i = othertable.i2)\n" +
+			"                 ├─ Table(mytable)\n" +
+			"                 └─ Filter(NOT((convert(othertable.s2, signed) = 0)))\n" +
+			"                     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -241,10 +251,12 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Project((sub.i + 10), ot.s2)\n" +
 			"         └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"             ├─ SubqueryAlias(sub)\n" +
-			"             │   └─ Project(mytable.i)\n" +
-			"             │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             │           ├─ Table(mytable)\n" +
-			"             │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"             │   └─ Project(mytable.i, othertable.i2)\n" +
+			"             │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             │           ├─ Projected table access on [i]\n" +
+			"             │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             │           └─ Projected table access on [i2 s2]\n" +
+			"             │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"             └─ TableAlias(ot)\n" +
 			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
@@ -264,93 +276,101 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN othertable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i2 s2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [s2 i2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [s2 i2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2 LIMIT 1`,
 		ExpectedPlan: "Limit(1)\n" +
 			" └─ Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			"     └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"         ├─ Table(othertable)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"         ├─ Projected table access on [s2 i2]\n" +
+			"         │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i2 = i`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(othertable.i2 = mytable.i)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(othertable.i2 = mytable.i)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(mytable, othertable) */ s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ InnerJoin(othertable.i2 = mytable.i)\n" +
+			" └─ HashJoin(othertable.i2 = mytable.i)\n" +
 			"     ├─ Table(mytable)\n" +
-			"     └─ HashLookup(child: (othertable.i2), lookup: (mytable.i))\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias(othertable)\n" +
-			"                 └─ Projected table access on [s2 i2]\n" +
-			"                     └─ Table(othertable)\n" +
+			"     └─ SubqueryAlias(othertable)\n" +
+			"         └─ Projected table access on [s2 i2]\n" +
+			"             └─ Table(othertable)\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable LEFT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ LeftJoin(othertable.i2 = mytable.i)\n" +
+			" └─ LeftHashJoin(othertable.i2 = mytable.i)\n" +
 			"     ├─ Table(mytable)\n" +
-			"     └─ HashLookup(child: (othertable.i2), lookup: (mytable.i))\n" +
-			"         └─ CachedResults\n" +
-			"             └─ SubqueryAlias(othertable)\n" +
-			"                 └─ Projected table access on [s2 i2]\n" +
-			"                     └─ Table(othertable)\n" +
+			"     └─ SubqueryAlias(othertable)\n" +
+			"         └─ Projected table access on [s2 i2]\n" +
+			"             └─ Table(othertable)\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM (SELECT * FROM mytable) mytable RIGHT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ RightJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ HashLookup(child: (mytable.i), lookup: (othertable.i2))\n" +
-			"     │   └─ CachedResults\n" +
-			"     │       └─ SubqueryAlias(mytable)\n" +
-			"     │           └─ Projected table access on [i s]\n" +
-			"     │               └─ Table(mytable)\n" +
+			" └─ RightHashJoin(othertable.i2 = mytable.i)\n" +
+			"     ├─ SubqueryAlias(mytable)\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ Table(mytable)\n" +
 			"     └─ SubqueryAlias(othertable)\n" +
 			"         └─ Projected table access on [s2 i2]\n" +
 			"             └─ Table(othertable)\n" +
@@ -427,15 +447,13 @@ var PlanTests = []QueryPlanTest{
 			ORDER BY lefttable.i ASC`,
 		ExpectedPlan: "Sort(lefttable.i ASC)\n" +
 			" └─ Project(lefttable.i, righttable.s)\n" +
-			"     └─ InnerJoin((lefttable.i = righttable.i) AND (righttable.s = lefttable.s))\n" +
+			"     └─ HashJoin((lefttable.i = righttable.i) AND (righttable.s = lefttable.s))\n" +
 			"         ├─ SubqueryAlias(lefttable)\n" +
 			"         │   └─ Projected table access on [i s]\n" +
 			"         │       └─ Table(mytable)\n" +
-			"         └─ HashLookup(child: (righttable.i, righttable.s), lookup: (lefttable.i, lefttable.s))\n" +
-			"             └─ CachedResults\n" +
-			"                 └─ SubqueryAlias(righttable)\n" +
-			"                     └─ Projected table access on [i s]\n" +
-			"                         └─ Table(mytable)\n" +
+			"         └─ SubqueryAlias(righttable)\n" +
+			"             └─ Projected table access on [i s]\n" +
+			"                 └─ Table(mytable)\n" +
 			"",
 	},
 	{
@@ -510,12 +528,9 @@ var PlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT mytable.i, mytable.s FROM mytable WHERE mytable.i IN (SELECT i2 FROM othertable WHERE mytable.i = othertable.i2)`,
-		ExpectedPlan: "Filter(mytable.i IN (Project(othertable.i2)\n" +
-			" └─ Filter(mytable.i = othertable.i2)\n" +
-			"     └─ Projected table access on [i2]\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"))\n" +
-			" └─ Table(mytable)\n" +
+		ExpectedPlan: "SemiIndexedJoin(mytable.i = othertable.i2)\n" +
+			" ├─ Table(mytable)\n" +
+			" └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -530,11 +545,13 @@ var PlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(mt, o) */ * FROM mytable mt INNER JOIN one_pk o ON mt.i = o.pk AND mt.s = o.c2`,
-		ExpectedPlan: "IndexedJoin((mt.i = o.pk) AND (mt.s = o.c2))\n" +
-			" ├─ TableAlias(mt)\n" +
-			" │   └─ Table(mytable)\n" +
-			" └─ TableAlias(o)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+		ExpectedPlan: "MergeJoin((mt.i = o.pk) AND (mt.s = o.c2))\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ TableAlias(mt)\n" +
+			" │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ Projected table access on [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ TableAlias(o)\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -548,30 +565,37 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM tabletest, mytable mt INNER JOIN othertable ot ON mt.i = ot.i2`,
 		ExpectedPlan: "CrossJoin\n" +
-			" ├─ Table(tabletest)\n" +
-			" └─ IndexedJoin(mt.i = ot.i2)\n" +
-			"     ├─ TableAlias(mt)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ TableAlias(ot)\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ Table(tabletest)\n" +
+			" └─ MergeJoin(mt.i = ot.i2)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ TableAlias(mt)\n" +
+			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ TableAlias(ot)\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT t1.timestamp FROM reservedWordsTable t1 JOIN reservedWordsTable t2 ON t1.TIMESTAMP = t2.tImEstamp`,
 		ExpectedPlan: "Project(t1.Timestamp)\n" +
-			" └─ IndexedJoin(t1.Timestamp = t2.Timestamp)\n" +
-			"     ├─ TableAlias(t1)\n" +
-			"     │   └─ Table(reservedWordsTable)\n" +
-			"     └─ TableAlias(t2)\n" +
-			"         └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
+			" └─ MergeJoin(t1.Timestamp = t2.Timestamp)\n" +
+			"     ├─ Projected table access on [Timestamp]\n" +
+			"     │   └─ TableAlias(t1)\n" +
+			"     │       └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
+			"     └─ Projected table access on [Timestamp]\n" +
+			"         └─ TableAlias(t2)\n" +
+			"             └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -587,19 +611,23 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 AND opk.pk=tpk.pk2`,
 		ExpectedPlan: "Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			" └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"     ├─ TableAlias(opk)\n" +
-			"     │   └─ Table(one_pk)\n" +
-			"     └─ TableAlias(tpk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ TableAlias(opk)\n" +
+			"     │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -613,9 +641,11 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk = two_pk.pk1 AND one_pk.pk <=> two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ LeftIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk <=> two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk <=> two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -629,9 +659,11 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ RightIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(two_pk)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" └─ RightMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -690,27 +722,36 @@ var PlanTests = []QueryPlanTest{
 	},
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.timestamp_col = dt2.timestamp_col`,
-		ExpectedPlan: "IndexedJoin(dt1.timestamp_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+		ExpectedPlan: "MergeJoin(dt1.timestamp_col = dt2.timestamp_col)\n" +
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.date_col = dt2.timestamp_col`,
-		ExpectedPlan: "IndexedJoin(dt1.date_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+		ExpectedPlan: "MergeJoin(dt1.date_col = dt2.timestamp_col)\n" +
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.datetime_col = dt2.timestamp_col`,
-		ExpectedPlan: "IndexedJoin(dt1.datetime_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+		ExpectedPlan: "MergeJoin(dt1.datetime_col = dt2.timestamp_col)\n" +
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ IndexedTableAccess(datetime_table on [datetime_table.datetime_col])\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT dt1.i FROM datetime_table dt1 
@@ -851,27 +892,31 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON pk=pk1`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ LeftJoin(one_pk.pk = two_pk.pk1)\n" +
+			" └─ LeftMergeJoin(one_pk.pk = two_pk.pk1)\n" +
 			"     ├─ Projected table access on [pk]\n" +
-			"     │   └─ Table(one_pk)\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"     └─ Projected table access on [pk1 pk2]\n" +
-			"         └─ Table(two_pk)\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			" └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			" └─ LeftMergeJoin(one_pk.pk = niltable.i)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			" └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"     ├─ Table(niltable)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" └─ RightMergeJoin(one_pk.pk = niltable.i)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -891,19 +936,21 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i AND f IS NOT NULL`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			" └─ LeftIndexedJoin((one_pk.pk = niltable.i) AND (NOT(niltable.f IS NULL)))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			" └─ LeftMergeJoin((one_pk.pk = niltable.i) AND (NOT(niltable.f IS NULL)))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i and pk > 0`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			" └─ RightJoin((one_pk.pk = niltable.i) AND (one_pk.pk > 0))\n" +
+			" └─ RightMergeJoin((one_pk.pk = niltable.i) AND (one_pk.pk > 0))\n" +
 			"     ├─ Projected table access on [pk]\n" +
-			"     │   └─ Table(one_pk)\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"     └─ Projected table access on [i f]\n" +
-			"         └─ Table(niltable)\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -972,20 +1019,24 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON pk=pk1`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"     ├─ Table(two_pk)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.pk1,a.pk2,b.pk1,b.pk2 FROM two_pk a JOIN two_pk b ON a.pk1=b.pk1 AND a.pk2=b.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
-			"     └─ IndexedJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1003,11 +1054,13 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT a.pk1,a.pk2,b.pk1,b.pk2 FROM two_pk a JOIN two_pk b ON b.pk1=a.pk1 AND a.pk2=b.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
-			"     └─ IndexedJoin((b.pk1 = a.pk1) AND (a.pk2 = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((b.pk1 = a.pk1) AND (a.pk2 = b.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1053,31 +1106,37 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT one_pk.c5,pk1,pk2 FROM one_pk JOIN two_pk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.c5 ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.c5, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"         ├─ Table(two_pk)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT opk.c5,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.c5 ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.c5, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin(opk.pk = tpk.pk1)\n" +
-			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ MergeJoin(opk.pk = tpk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT opk.c5,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.c5 ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.c5, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin(opk.pk = tpk.pk1)\n" +
-			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(opk)\n" +
-			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ MergeJoin(opk.pk = tpk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1110,9 +1169,11 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i ORDER BY 1`,
 		ExpectedPlan: "Sort(one_pk.pk ASC)\n" +
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"     └─ LeftMergeJoin(one_pk.pk = niltable.i)\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1139,9 +1200,11 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i ORDER BY 2,3`,
 		ExpectedPlan: "Sort(niltable.i ASC, niltable.f ASC)\n" +
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			"     └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(niltable)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ RightMergeJoin(one_pk.pk = niltable.i)\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1168,20 +1231,22 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i and pk > 0 ORDER BY 2,3`,
 		ExpectedPlan: "Sort(niltable.i ASC, niltable.f ASC)\n" +
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
-			"     └─ RightJoin((one_pk.pk = niltable.i) AND (one_pk.pk > 0))\n" +
+			"     └─ RightMergeJoin((one_pk.pk = niltable.i) AND (one_pk.pk > 0))\n" +
 			"         ├─ Projected table access on [pk]\n" +
-			"         │   └─ Table(one_pk)\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"         └─ Projected table access on [i f]\n" +
-			"             └─ Table(niltable)\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1210,51 +1275,59 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ LeftIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ LeftJoin(one_pk.pk = two_pk.pk1)\n" +
+			"     └─ LeftMergeJoin(one_pk.pk = two_pk.pk1)\n" +
 			"         ├─ Projected table access on [pk]\n" +
-			"         │   └─ Table(one_pk)\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"         └─ Projected table access on [pk1 pk2]\n" +
-			"             └─ Table(two_pk)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ RightIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(two_pk)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ RightMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 AND opk.pk=tpk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.pk ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON pk=tpk.pk1 AND pk=tpk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.pk ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1273,7 +1346,7 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,pk1,pk2,one_pk.c1 AS foo, two_pk.c1 AS bar FROM one_pk JOIN two_pk ON one_pk.c1=two_pk.c1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2, one_pk.c1 as foo, two_pk.c1 as bar)\n" +
-			"     └─ InnerJoin(one_pk.c1 = two_pk.c1)\n" +
+			"     └─ HashJoin(one_pk.c1 = two_pk.c1)\n" +
 			"         ├─ Projected table access on [pk c1]\n" +
 			"         │   └─ Table(one_pk)\n" +
 			"         └─ Projected table access on [pk1 pk2 c1]\n" +
@@ -1283,7 +1356,7 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2,one_pk.c1 AS foo,two_pk.c1 AS bar FROM one_pk JOIN two_pk ON one_pk.c1=two_pk.c1 WHERE one_pk.c1=10`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2, one_pk.c1 as foo, two_pk.c1 as bar)\n" +
-			" └─ InnerJoin(one_pk.c1 = two_pk.c1)\n" +
+			" └─ HashJoin(one_pk.c1 = two_pk.c1)\n" +
 			"     ├─ Filter(one_pk.c1 = 10)\n" +
 			"     │   └─ Projected table access on [pk c1]\n" +
 			"     │       └─ Table(one_pk)\n" +
@@ -0,0 +1,72 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+)
+
+var RegexpScripts = []ScriptTest{
+	{
+		Name: "regexp functions",
+		SetUpScript: []string{
+			"CREATE TABLE contacts (id INT PRIMARY KEY, phone VARCHAR(20), email VARCHAR(50) COLLATE utf8mb4_0900_bin)",
+			"INSERT INTO contacts VALUES (1, '(555) 123-4567', 'Ann@Example.com'), (2, '555.987.6543', 'bob@example.org')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, REGEXP_REPLACE(phone, '[^0-9]', '') FROM contacts ORDER BY id",
+				Expected: []sql.Row{{1, "5551234567"}, {2, "5559876543"}},
+			},
+			{
+				Query:    "SELECT id, REGEXP_REPLACE(phone, '([0-9]{3})[^0-9]*([0-9]{4})$', '$1-$2') FROM contacts ORDER BY id",
+				Expected: []sql.Row{{1, "(555) 123-4567"}, {2, "555.987-6543"}},
+			},
+			{
+				Query:    "SELECT id, REGEXP_SUBSTR(phone, '[0-9]+', 1, 2), REGEXP_INSTR(phone, '[0-9]+', 1, 3), REGEXP_INSTR(phone, '[0-9]+', 1, 3, 1) FROM contacts ORDER BY id",
+				Expected: []sql.Row{{1, "123", int32(11), int32(15)}, {2, "987", int32(9), int32(13)}},
+			},
+			{
+				Query:    "SELECT id, REGEXP_SUBSTR(email, '^[a-z]+'), REGEXP_SUBSTR(email, '^[a-z]+', 1, 1, 'i') FROM contacts ORDER BY id",
+				Expected: []sql.Row{{1, nil, "Ann"}, {2, "bob", "bob"}},
+			},
+			{
+				Query:    "SELECT id FROM contacts WHERE REGEXP_LIKE(email, 'EXAMPLE') OR email REGEXP 'EXAMPLE' ORDER BY id",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id FROM contacts WHERE phone REGEXP '^\\\\(' OR phone RLIKE 'X' ORDER BY id",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT 'ABC' REGEXP 'b', 'ABC' RLIKE BINARY 'b', 'ABC' NOT REGEXP 'b'",
+				Expected: []sql.Row{{true, false, false}},
+			},
+			{
+				Query:    "SELECT REGEXP_REPLACE('a b c', '[a-z]', 'X', 1, 2), REGEXP_REPLACE('a b c', '[a-z]', 'X', 3), REGEXP_SUBSTR('abc', 'b', 1, 1, NULL)",
+				Expected: []sql.Row{{"a X c", "a X X", nil}},
+			},
+			{
+				Query:       "SELECT REGEXP_INSTR('abc', 'b', 5)",
+				ExpectedErr: function.ErrRegexpIndexOutOfBounds,
+			},
+			{
+				Query:       "SELECT REGEXP_SUBSTR('abc', 'b', 1, 1, 'x')",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+		},
+	},
+}
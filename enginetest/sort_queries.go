@@ -0,0 +1,148 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SortScripts sort more rows than fit in a small sort buffer, so that the sorted rows are spilled to disk and merged.
+var SortScripts = []ScriptTest{
+	{
+		Name: "sorts larger than sort_buffer_size",
+		SetUpScript: []string{
+			"SET @@sort_buffer_size = 32768",
+			"CREATE TABLE digits (d INT PRIMARY KEY)",
+			"INSERT INTO digits VALUES (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+			"CREATE TABLE t (id INT PRIMARY KEY, k INT, s VARCHAR(20), f DOUBLE, dt DATETIME, d DECIMAL(10, 2))",
+			`INSERT INTO t
+				SELECT id, (id * 7919) % 1000, CONCAT('s', (id * 31) % 10000), id / 8, DATE_ADD('2021-01-01', INTERVAL id MINUTE), id / 100
+				FROM (SELECT a.d * 1000 + b.d * 100 + c.d * 10 + e.d AS id FROM digits a, digits b, digits c, digits e) ids`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, k FROM t ORDER BY k DESC, id LIMIT 3 OFFSET 5000",
+				Expected: []sql.Row{{821, 499}, {1821, 499}, {2821, 499}},
+			},
+			{
+				Query:    "SELECT id, s FROM t ORDER BY s, id DESC LIMIT 3",
+				Expected: []sql.Row{{0, "s0"}, {3871, "s1"}, {8710, "s10"}},
+			},
+			{
+				Query:    "SELECT id FROM t ORDER BY s, id DESC LIMIT 1 OFFSET 9999",
+				Expected: []sql.Row{{6129}},
+			},
+			{
+				Query:    "SELECT COUNT(*), SUM(id), MIN(d), MAX(dt) FROM (SELECT * FROM t ORDER BY dt DESC) sorted",
+				Expected: []sql.Row{{10000, "49995000", "0.00", sql.MustConvert(sql.Datetime.Convert("2021-01-07 22:39:00"))}},
+			},
+			{
+				Query:    "SELECT id, f, d FROM t ORDER BY f DESC LIMIT 2",
+				Expected: []sql.Row{{9999, 1249.875, "99.99"}, {9998, 1249.75, "99.98"}},
+			},
+			{
+				Query:    "SET @@sort_buffer_size = 262144",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+}
+
+// TopNScripts check that a LIMIT over an ORDER BY returns the same rows as sorting all of them would.
+var TopNScripts = []ScriptTest{
+	{
+		Name: "ORDER BY with LIMIT and OFFSET",
+		SetUpScript: []string{
+			"CREATE TABLE t (id INT PRIMARY KEY, k INT, s VARCHAR(20))",
+			"INSERT INTO t VALUES (1, 3, 'a'), (2, 1, 'b'), (3, NULL, 'c'), (4, 3, 'd'), (5, 2, 'e'), (6, 1, 'f'), (7, 3, 'g'), (8, NULL, 'h')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT * FROM t ORDER BY k DESC LIMIT 2 OFFSET 1",
+				Expected: []sql.Row{
+					{"TopN(Limit: [2]; Offset: [1]; t.k DESC)"},
+					{" └─ Projected table access on [id k s]"},
+					{"     └─ Table(t)"},
+				},
+			},
+			{
+				// Rows with the same k keep the order they are read in, as with a full sort
+				Query:    "SELECT * FROM t ORDER BY k DESC LIMIT 4",
+				Expected: []sql.Row{{1, 3, "a"}, {4, 3, "d"}, {7, 3, "g"}, {5, 2, "e"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY k LIMIT 3 OFFSET 1",
+				Expected: []sql.Row{{8, nil, "h"}, {2, 1, "b"}, {6, 1, "f"}},
+			},
+			{
+				Query:    "SELECT s FROM t ORDER BY k DESC, id DESC LIMIT 2 OFFSET 6",
+				Expected: []sql.Row{{"h"}, {"c"}},
+			},
+			{
+				Query:    "SELECT s FROM t ORDER BY k LIMIT 2 OFFSET 10",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT s FROM t ORDER BY k LIMIT 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM (SELECT k, id FROM t ORDER BY id DESC LIMIT 3) last ORDER BY k, id",
+				Expected: []sql.Row{{nil, 8}, {1, 6}, {3, 7}},
+			},
+		},
+	},
+	{
+		Name: "SQL_CALC_FOUND_ROWS with ORDER BY and LIMIT",
+		SetUpScript: []string{
+			"CREATE TABLE t (id INT PRIMARY KEY, k INT)",
+			"INSERT INTO t VALUES (1, 5), (2, 4), (3, 3), (4, 2), (5, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT SQL_CALC_FOUND_ROWS id FROM t ORDER BY k LIMIT 2",
+				Expected: []sql.Row{{5}, {4}},
+			},
+			{
+				Query:    "SELECT FOUND_ROWS()",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT SQL_CALC_FOUND_ROWS * FROM t WHERE k > 1 ORDER BY k DESC LIMIT 1 OFFSET 1",
+				Expected: []sql.Row{{2, 4}},
+			},
+			{
+				Query:    "SELECT FOUND_ROWS()",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:    "SELECT SQL_CALC_FOUND_ROWS id FROM t ORDER BY k LIMIT 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT FOUND_ROWS()",
+				Expected: []sql.Row{{5}},
+			},
+			{
+				Query:    "SELECT id FROM t ORDER BY k LIMIT 3",
+				Expected: []sql.Row{{5}, {4}, {3}},
+			},
+			{
+				Query:    "SELECT FOUND_ROWS()",
+				Expected: []sql.Row{{3}},
+			},
+		},
+	},
+}
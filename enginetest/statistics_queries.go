@@ -0,0 +1,100 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var StatisticsScripts = []ScriptTest{
+	{
+		Name: "analyze table computes column statistics",
+		SetUpScript: []string{
+			"CREATE TABLE stats (id INT PRIMARY KEY, n INT, s VARCHAR(20), d DATETIME, j JSON)",
+			"INSERT INTO stats VALUES (1, 10, 'a', '2021-01-01 00:00:00', '{}'), (2, 10, 'b', '2021-01-02 00:00:00', '[]'), (3, 20, NULL, '2021-01-03 00:00:00', NULL), (4, NULL, 'b', NULL, NULL), (5, 30, 'c', '2021-01-04 12:30:00', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM information_schema.column_statistics WHERE table_name = 'stats'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "ANALYZE TABLE stats",
+				Expected: []sql.Row{{"mydb.stats", "analyze", "status", "OK"}},
+			},
+			{
+				Query: `SELECT column_name, JSON_EXTRACT(histogram, '$."data-type"'), JSON_EXTRACT(histogram, '$."null-values"'), JSON_EXTRACT(histogram, '$.buckets')
+					FROM information_schema.column_statistics WHERE table_name = 'stats'`,
+				Expected: []sql.Row{
+					{"id", sql.MustJSON(`"int"`), sql.MustJSON(`0`), sql.MustJSON(`[[1, 1, 0.2, 1], [2, 2, 0.4, 1], [3, 3, 0.6, 1], [4, 4, 0.8, 1], [5, 5, 1, 1]]`)},
+					{"n", sql.MustJSON(`"int"`), sql.MustJSON(`0.2`), sql.MustJSON(`[[10, 10, 0.4, 1], [20, 20, 0.6, 1], [30, 30, 0.8, 1]]`)},
+					{"s", sql.MustJSON(`"string"`), sql.MustJSON(`0.2`), sql.MustJSON(`[["a", "a", 0.2, 1], ["b", "b", 0.6, 1], ["c", "c", 0.8, 1]]`)},
+					{"d", sql.MustJSON(`"datetime"`), sql.MustJSON(`0.2`), sql.MustJSON(`[["2021-01-01 00:00:00.000000", "2021-01-01 00:00:00.000000", 0.2, 1], ["2021-01-02 00:00:00.000000", "2021-01-02 00:00:00.000000", 0.4, 1], ["2021-01-03 00:00:00.000000", "2021-01-03 00:00:00.000000", 0.6, 1], ["2021-01-04 12:30:00.000000", "2021-01-04 12:30:00.000000", 0.8, 1]]`)},
+				},
+			},
+			{
+				Query:    `SELECT JSON_EXTRACT(histogram, '$."histogram-type"'), JSON_EXTRACT(histogram, '$."number-of-buckets-specified"') FROM information_schema.column_statistics WHERE table_name = 'stats' AND column_name = 'n'`,
+				Expected: []sql.Row{{sql.MustJSON(`"equi-height"`), sql.MustJSON(`100`)}},
+			},
+			{
+				Query:    "INSERT INTO stats VALUES (6, 40, 'd', NULL, NULL)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    `SELECT JSON_EXTRACT(histogram, '$.buckets[2]'), JSON_EXTRACT(histogram, '$.buckets[3]') FROM information_schema.column_statistics WHERE table_name = 'stats' AND column_name = 'n'`,
+				Expected: []sql.Row{{sql.MustJSON(`[30, 30, 0.8, 1]`), sql.MustJSON(`null`)}},
+			},
+			{
+				Query:    "ANALYZE LOCAL TABLE stats",
+				Expected: []sql.Row{{"mydb.stats", "analyze", "status", "OK"}},
+			},
+			{
+				Query:    `SELECT JSON_EXTRACT(histogram, '$.buckets') FROM information_schema.column_statistics WHERE table_name = 'stats' AND column_name = 'n'`,
+				Expected: []sql.Row{{sql.MustJSON(`[[10, 10, 0.3333333333333333, 1], [20, 20, 0.5, 1], [30, 30, 0.6666666666666666, 1], [40, 40, 0.8333333333333334, 1]]`)}},
+			},
+		},
+	},
+	{
+		Name: "histograms hold about the same number of rows in each bucket",
+		SetUpScript: []string{
+			"CREATE TABLE digits (d INT PRIMARY KEY)",
+			"INSERT INTO digits VALUES (0), (1), (2), (3), (4), (5), (6), (7), (8), (9)",
+			"CREATE TABLE nums (n INT PRIMARY KEY, m INT)",
+			"INSERT INTO nums SELECT a.d * 100 + b.d * 10 + c.d, (a.d * 100 + b.d * 10 + c.d) % 250 FROM digits a, digits b, digits c",
+			"ANALYZE TABLE nums, digits",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: `SELECT column_name, JSON_EXTRACT(histogram, '$.buckets[0]'), JSON_EXTRACT(histogram, '$.buckets[99]'), JSON_EXTRACT(histogram, '$.buckets[100]')
+					FROM information_schema.column_statistics WHERE table_name = 'nums'`,
+				Expected: []sql.Row{
+					{"n", sql.MustJSON(`[0, 9, 0.01, 10]`), sql.MustJSON(`[990, 999, 1, 10]`), sql.MustJSON(`null`)},
+					{"m", sql.MustJSON(`[0, 2, 0.012, 3]`), sql.MustJSON(`[248, 249, 1, 2]`), sql.MustJSON(`null`)},
+				},
+			},
+			{
+				Query: "ANALYZE TABLE digits, information_schema.tables",
+				Expected: []sql.Row{
+					{"mydb.digits", "analyze", "status", "OK"},
+					{"information_schema.tables", "analyze", "note", "The storage engine for the table doesn't support analyze"},
+				},
+			},
+			{
+				Query:       "ANALYZE TABLE nonexistent",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+}
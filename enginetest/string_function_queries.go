@@ -0,0 +1,82 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var StringFunctionScripts = []ScriptTest{
+	{
+		Name: "string search functions",
+		SetUpScript: []string{
+			"CREATE TABLE words (id INT PRIMARY KEY, w VARCHAR(20), cs VARCHAR(20) COLLATE utf8mb4_0900_bin)",
+			"INSERT INTO words VALUES (1, 'Apple', 'Apple'), (2, 'banana', 'banana'), (3, 'cherry', 'cherry')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, LOCATE('an', w), LOCATE('an', w, 4), POSITION('A' IN w), position('A' in cs) FROM words ORDER BY id",
+				Expected: []sql.Row{{1, int64(0), int64(0), int64(1), int64(1)}, {2, int64(2), int64(4), int64(2), int64(0)}, {3, int64(0), int64(0), int64(0), int64(0)}},
+			},
+			{
+				Query:    "SELECT id, FIELD(w, 'cherry', 'APPLE'), FIELD(cs, 'cherry', 'APPLE'), FIND_IN_SET(w, 'apple,banana') FROM words ORDER BY id",
+				Expected: []sql.Row{{1, int64(2), int64(0), int64(1)}, {2, int64(0), int64(0), int64(2)}, {3, int64(1), int64(1), int64(0)}},
+			},
+			{
+				Query:    "SELECT id, ELT(id, 'one', 'two'), STRCMP(w, 'BANANA'), STRCMP(cs, 'BANANA') FROM words ORDER BY id",
+				Expected: []sql.Row{{1, "one", int32(-1), int32(-1)}, {2, "two", int32(0), int32(1)}, {3, nil, int32(1), int32(1)}},
+			},
+			{
+				Query:    "SELECT w FROM words WHERE FIND_IN_SET(w, 'cherry,apple') > 0 ORDER BY FIELD(w, 'cherry', 'apple')",
+				Expected: []sql.Row{{"cherry"}, {"Apple"}},
+			},
+		},
+	},
+	{
+		Name: "string building functions",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT INSERT('Quadratic', 3, 4, 'What'), INSERT('Quadratic', -1, 4, 'What'), RIGHT('foobarbar', 4), CONCAT('a', SPACE(3), 'b')",
+				Expected: []sql.Row{{"QuWhattic", "Quadratic", "rbar", "a   b"}},
+			},
+			{
+				Query:    "SELECT EXPORT_SET(5, 'Y', 'N', ',', 4), MAKE_SET(1 | 4, 'hello', 'nice', 'world'), CHAR(77, 121, 83, 81, '76'), ORD('2')",
+				Expected: []sql.Row{{"Y,N,Y,N", "hello,world", "MySQL", int64(50)}},
+			},
+			{
+				Query:    "SELECT QUOTE('Don''t!'), QUOTE(NULL), CONV('a', 16, 2), CONV('-17', 10, -18), OCT(12)",
+				Expected: []sql.Row{{"'Don\\'t!'", "NULL", "1010", "-H", "14"}},
+			},
+		},
+	},
+	{
+		Name: "format",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT FORMAT(12332.123456, 4), FORMAT(12332.2, 0), FORMAT(-1234567.891, 2), FORMAT(12332.2, 2, 'de_DE')",
+				Expected: []sql.Row{{"12,332.1235", "12,332", "-1,234,567.89", "12.332,20"}},
+			},
+			{
+				Query:    "SELECT FORMAT(12345678901234567.89, 2), FORMAT(18446744073709551615, 0), FORMAT(1234567.891, 2, 'fr_FR'), FORMAT(1234567.891, 2, 'ru_RU')",
+				Expected: []sql.Row{{"12,345,678,901,234,567.89", "18,446,744,073,709,551,615", "1234567,89", "1 234 567,89"}},
+			},
+			{
+				Query:           "SELECT FORMAT(12332.2, 2, 'xx_XX')",
+				Expected:        []sql.Row{{"12,332.20"}},
+				ExpectedWarning: 1649,
+			},
+		},
+	},
+}
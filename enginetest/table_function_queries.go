@@ -0,0 +1,166 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// TableFunctions are the table functions registered for the TableFunctionScripts.
+var TableFunctions = []sql.TableFunction{
+	sql.TableFunctionF{
+		FunctionName: "generate_series",
+		FunctionSchema: sql.Schema{
+			{Name: "value", Type: sql.Int64},
+		},
+		ArgTypes: []sql.Type{sql.Int64, sql.Int64},
+		Fn: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) {
+			if args[0] == nil || args[1] == nil {
+				return sql.RowsToRowIter(), nil
+			}
+			var rows []sql.Row
+			for i := args[0].(int64); i <= args[1].(int64); i++ {
+				rows = append(rows, sql.NewRow(i))
+			}
+			return sql.RowsToRowIter(rows...), nil
+		},
+	},
+	sql.TableFunctionF{
+		FunctionName: "split_string",
+		FunctionSchema: sql.Schema{
+			{Name: "idx", Type: sql.Int64},
+			{Name: "part", Type: sql.LongText, Nullable: true},
+		},
+		ArgTypes: []sql.Type{sql.LongText, sql.LongText},
+		Fn: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) {
+			if args[0] == nil || args[1] == nil {
+				return sql.RowsToRowIter(), nil
+			}
+			var rows []sql.Row
+			for i, part := range strings.Split(args[0].(string), args[1].(string)) {
+				rows = append(rows, sql.NewRow(int64(i+1), part))
+			}
+			return sql.RowsToRowIter(rows...), nil
+		},
+	},
+}
+
+var TableFunctionScripts = []ScriptTest{
+	{
+		Name: "table functions in the FROM clause",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select * from generate_series(1, 5)",
+				Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+			},
+			{
+				Query:    "select s.value * 2 from generate_series(1, 3) as s where s.value > 1 order by 1",
+				Expected: []sql.Row{{4}, {6}},
+			},
+			{
+				Query:    "select generate_series.value from GENERATE_SERIES(2, 2)",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "select * from split_string('a,b,c', ',') p order by idx desc",
+				Expected: []sql.Row{{3, "c"}, {2, "b"}, {1, "a"}},
+			},
+			{
+				Query:    "select value from generate_series(1 + 1, length('abcd')) s",
+				Expected: []sql.Row{{2}, {3}, {4}},
+			},
+			{
+				Query:    "select value from generate_series('2', 3.0) s",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "select count(*) from generate_series(1, NULL) s",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "table functions in joins and subqueries",
+		SetUpScript: []string{
+			"create table t (i int primary key, s varchar(10))",
+			"insert into t values (1, 'one'), (2, 'two'), (3, 'three')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select t.s, g.value from t join generate_series(2, 5) g on t.i = g.value order by 2",
+				Expected: []sql.Row{{"two", 2}, {"three", 3}},
+			},
+			{
+				Query:    "select t.s, g.value from generate_series(0, 2) g left join t on t.i = g.value order by 2",
+				Expected: []sql.Row{{nil, 0}, {"one", 1}, {"two", 2}},
+			},
+			{
+				Query:    "select count(*) from generate_series(1, 3) a, generate_series(1, 4) b",
+				Expected: []sql.Row{{12}},
+			},
+			{
+				Query:    "select s from t where i in (select value from generate_series(2, 3)) order by i",
+				Expected: []sql.Row{{"two"}, {"three"}},
+			},
+			{
+				Query:    "select sum(value) from (select value from generate_series(1, 10) g) sq",
+				Expected: []sql.Row{{"55"}},
+			},
+			{
+				Query:    "select i, (select count(*) from generate_series(1, 10) g where g.value <= t.i * 2) from t order by i",
+				Expected: []sql.Row{{1, 2}, {2, 4}, {3, 6}},
+			},
+			{
+				Query:    "select t.s, sq.square from t join (select value, value * value as square from generate_series(1, 3) g) sq on t.i = sq.value where sq.square > 1 order by 2",
+				Expected: []sql.Row{{"two", 4}, {"three", 9}},
+			},
+			{
+				Query:    "select i, (select count(*) from generate_series(1, t.i) g) from t order by i",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+			{
+				Query:       "select * from t, generate_series(1, t.i) g",
+				ExpectedErr: sql.ErrTableFunctionColumnReference,
+			},
+			{
+				Query:       "select * from t join generate_series(1, i + 1) g on t.i = g.value",
+				ExpectedErr: sql.ErrTableFunctionColumnReference,
+			},
+			{
+				Query:       "select * from t, generate_series(1, x) g",
+				ExpectedErr: sql.ErrColumnNotFound,
+			},
+		},
+	},
+	{
+		Name: "table function errors",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "select * from no_such_function(1)",
+				ExpectedErr: sql.ErrTableFunctionNotFound,
+			},
+			{
+				Query:       "select * from generate_series(1)",
+				ExpectedErr: sql.ErrInvalidArgumentNumber,
+			},
+			{
+				Query:          "select * from generate_series(1, 'abc')",
+				ExpectedErrStr: "unable to cast \"abc\" of type string to int64",
+			},
+		},
+	},
+}
@@ -0,0 +1,189 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+var TimeZoneScripts = []ScriptTest{
+	{
+		Name: "timestamps are read and written in the session time zone",
+		SetUpScript: []string{
+			"CREATE TABLE events (id INT PRIMARY KEY, ts TIMESTAMP, dt DATETIME)",
+			"SET time_zone = 'UTC'",
+			"INSERT INTO events VALUES (1, '2021-01-01 12:00:00', '2021-01-01 12:00:00')",
+			"SET time_zone = 'America/New_York'",
+			"INSERT INTO events VALUES (2, '2021-07-01 12:00:00', '2021-07-01 12:00:00')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT id, ts, dt FROM events ORDER BY id",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 1, 1, 7, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+					{2, time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC), time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    "SELECT id FROM events WHERE ts = '2021-01-01 07:00:00'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "UPDATE events SET ts = '2021-01-01 08:00:00' WHERE id = 1",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "UPDATE events SET dt = '2021-07-02 00:00:00' WHERE id = 2",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "SET time_zone = '+00:00'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query: "SELECT id, ts, dt FROM events ORDER BY id",
+				Expected: []sql.Row{
+					{1, time.Date(2021, 1, 1, 13, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+					{2, time.Date(2021, 7, 1, 16, 0, 0, 0, time.UTC), time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			{
+				Query:    "SET time_zone = '+05:30'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "DELETE FROM events WHERE id = 1",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT id, ts FROM events",
+				Expected: []sql.Row{{2, time.Date(2021, 7, 1, 21, 30, 0, 0, time.UTC)}},
+			},
+		},
+	},
+	{
+		Name: "timestamp lookups in the session time zone",
+		SetUpScript: []string{
+			"CREATE TABLE logins (id INT PRIMARY KEY, ts TIMESTAMP, INDEX ts_idx (ts))",
+			"CREATE TABLE sessions (id INT PRIMARY KEY, started TIMESTAMP)",
+			"SET time_zone = 'UTC'",
+			"INSERT INTO logins VALUES (1, '2021-01-01 12:00:00'), (2, '2021-01-01 13:00:00'), (3, '2021-01-01 14:00:00')",
+			"INSERT INTO sessions VALUES (1, '2021-01-01 12:00:00'), (2, '2021-01-01 13:00:00'), (3, '2021-01-01 14:00:00')",
+			"SET time_zone = '+02:00'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id FROM logins WHERE ts = '2021-01-01 15:00:00'",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT id FROM logins WHERE ts > '2021-01-01 14:00:00' ORDER BY id",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM logins WHERE ts BETWEEN '2021-01-01 14:00:00' AND '2021-01-01 15:00:00' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM logins WHERE ts IN ('2021-01-01 14:00:00', '2021-01-01 16:00:00') ORDER BY id",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM sessions WHERE started <= '2021-01-01 15:00:00' ORDER BY id",
+				Expected: []sql.Row{{1}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM sessions WHERE HOUR(started) = 16",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "SELECT s.id, l.id FROM sessions s JOIN logins l ON l.ts = s.started ORDER BY s.id",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}},
+			},
+		},
+	},
+	{
+		Name: "time_zone variable",
+		SetUpScript: []string{
+			"SET time_zone = 'SYSTEM'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT @@time_zone, @@system_time_zone",
+				Expected: []sql.Row{{"SYSTEM", "UTC"}},
+			},
+			{
+				Query:       "SET time_zone = 'Nowhere/Special'",
+				ExpectedErr: sql.ErrUnknownTimeZone,
+			},
+			{
+				Query:       "SET time_zone = '+14:30'",
+				ExpectedErr: sql.ErrUnknownTimeZone,
+			},
+			{
+				Query:    "SET time_zone = 'Europe/Berlin'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@time_zone",
+				Expected: []sql.Row{{"Europe/Berlin"}},
+			},
+			{
+				Query:    "SELECT UNIX_TIMESTAMP(NOW()) = UNIX_TIMESTAMP(), NOW() = UTC_TIMESTAMP()",
+				Expected: []sql.Row{{true, false}},
+			},
+		},
+	},
+	{
+		Name: "convert_tz",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT CONVERT_TZ('2021-01-01 12:00:00', 'UTC', 'Asia/Kolkata')",
+				Expected: []sql.Row{{time.Date(2021, 1, 1, 17, 30, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT CONVERT_TZ('2021-07-01 12:00:00', 'America/New_York', 'Europe/London')",
+				Expected: []sql.Row{{time.Date(2021, 7, 1, 17, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT CONVERT_TZ('2021-01-01 12:00:00', '+00:00', '-05:00')",
+				Expected: []sql.Row{{time.Date(2021, 1, 1, 7, 0, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT CONVERT_TZ('2021-01-01 12:00:00', 'UTC', 'Nowhere/Special'), CONVERT_TZ(NULL, 'UTC', '+01:00')",
+				Expected: []sql.Row{{nil, nil}},
+			},
+		},
+	},
+	{
+		Name: "unix times in the session time zone",
+		SetUpScript: []string{
+			"SET time_zone = '+05:30'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT FROM_UNIXTIME(0)",
+				Expected: []sql.Row{{time.Date(1970, 1, 1, 5, 30, 0, 0, time.UTC)}},
+			},
+			{
+				Query:    "SELECT UNIX_TIMESTAMP('1970-01-02 05:30:00')",
+				Expected: []sql.Row{{float64(86400)}},
+			},
+		},
+	},
+}
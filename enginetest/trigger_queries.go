@@ -1365,6 +1365,8 @@ end;`,
 			"create trigger a1 before insert on a for each row set new.x = new.x + 1",
 			"create table b (y int primary key)",
 			"create trigger b1 before insert on b for each row set new.y = new.y + 2",
+			"create table c (x int primary key, d date, s varchar(10), y int, p int)",
+			"create trigger c1 before insert on c for each row set new.y = extract(year from new.d), new.p = position('l' in insert(new.s, 1, 0, format(1, 0)))",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
@@ -1395,6 +1397,28 @@ end;`,
 					},
 				},
 			},
+			{
+				Query: "show create trigger c1",
+				Expected: []sql.Row{
+					{
+						"c1", // Trigger
+						"",   // sql_mode
+						"create trigger c1 before insert on c for each row set new.y = extract(year from new.d), new.p = position('l' in insert(new.s, 1, 0, format(1, 0)))", // SQL Original Statement
+						sql.Collation_Default.CharacterSet().String(), // character_set_client
+						sql.Collation_Default.String(),                // collation_connection
+						sql.Collation_Default.String(),                // Database Collation
+						time.Unix(0, 0).UTC(),                         // Created
+					},
+				},
+			},
+			{
+				Query:    "insert into c (x, d, s) values (1, '2021-07-01', 'hello')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "select y, p from c",
+				Expected: []sql.Row{{2021, 4}},
+			},
 			{
 				Query:       "show create trigger b2",
 				ExpectedErr: sql.ErrTriggerDoesNotExist,
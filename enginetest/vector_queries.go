@@ -0,0 +1,160 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var VectorScripts = []ScriptTest{
+	{
+		Name: "vector columns",
+		SetUpScript: []string{
+			"CREATE TABLE embeddings (id INT PRIMARY KEY, v VECTOR(3))",
+			"INSERT INTO embeddings VALUES (1, '[1,0,0]'), (2, STRING_TO_VECTOR('[0, 1.5, 0]')), (3, NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT id, v FROM embeddings ORDER BY id",
+				Expected: []sql.Row{{1, []float32{1, 0, 0}}, {2, []float32{0, 1.5, 0}}, {3, nil}},
+			},
+			{
+				Query: "SELECT id, VECTOR_TO_STRING(v), VECTOR_DIM(v) FROM embeddings ORDER BY id",
+				Expected: []sql.Row{
+					{1, "[1.00000e+00,0.00000e+00,0.00000e+00]", 3},
+					{2, "[0.00000e+00,1.50000e+00,0.00000e+00]", 3},
+					{3, nil, nil},
+				},
+			},
+			{
+				Query:    "SELECT id FROM embeddings WHERE v = '[1,0,0]'",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:       "INSERT INTO embeddings VALUES (4, '[1,2]')",
+				ExpectedErr: sql.ErrVectorDimensions,
+			},
+			{
+				Query:       "INSERT INTO embeddings VALUES (4, '1,2,3')",
+				ExpectedErr: sql.ErrNotVector,
+			},
+			{
+				Query: "SHOW CREATE TABLE embeddings",
+				Expected: []sql.Row{{"embeddings", "CREATE TABLE `embeddings` (\n" +
+					"  `id` int NOT NULL,\n" +
+					"  `v` vector(3),\n" +
+					"  PRIMARY KEY (`id`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:    "ALTER TABLE embeddings ADD COLUMN w VECTOR(2) NOT NULL DEFAULT '[0,0]'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT id, w FROM embeddings ORDER BY id",
+				Expected: []sql.Row{{1, []float32{0, 0}}, {2, []float32{0, 0}}, {3, []float32{0, 0}}},
+			},
+			{
+				Query:       "CREATE TABLE bad (v VECTOR(0))",
+				ExpectedErr: sql.ErrInvalidVectorDimensions,
+			},
+		},
+	},
+	{
+		Name: "vector functions",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT VEC_DISTANCE_L2('[0,0]', '[3,4]'), VEC_DISTANCE_COSINE('[1,0]', '[0,2]'), VEC_DISTANCE_DOT('[1,2]', '[3,4]')",
+				Expected: []sql.Row{{5.0, 1.0, -11.0}},
+			},
+			{
+				Query:    "SELECT VEC_DISTANCE_COSINE('[1,1]', '[2,2]'), VEC_DISTANCE_COSINE('[1,1]', '[0,0]'), VEC_DISTANCE_L2(NULL, '[1]')",
+				Expected: []sql.Row{{0.0, nil, nil}},
+			},
+			{
+				Query:       "SELECT VEC_DISTANCE_L2('[1,2]', '[1,2,3]')",
+				ExpectedErr: sql.ErrVectorDimensions,
+			},
+			{
+				Query:    "SELECT FROM_VECTOR(TO_VECTOR('[ 1e3 , -0.25 ]')), VECTOR_TO_STRING(STRING_TO_VECTOR('[]'))",
+				Expected: []sql.Row{{"[1.00000e+03,-2.50000e-01]", "[]"}},
+			},
+			{
+				Query:       "SELECT STRING_TO_VECTOR('[1,a]')",
+				ExpectedErr: sql.ErrNotVector,
+			},
+		},
+	},
+	{
+		Name: "nearest neighbor search with a vector index",
+		SetUpScript: []string{
+			"CREATE TABLE items (id INT PRIMARY KEY, category VARCHAR(10), v VECTOR(2) NOT NULL, INDEX (v))",
+			"INSERT INTO items VALUES (1, 'a', '[1,0]'), (2, 'b', '[0,1]'), (3, 'a', '[1,1]'), (4, 'b', '[-1,0]'), (5, 'a', '[2,0.5]')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "EXPLAIN SELECT id FROM items ORDER BY VEC_DISTANCE_L2(v, '[1,0.25]') LIMIT 2",
+				Expected: []sql.Row{
+					{"Project(items.id)"},
+					{" └─ TopN(Limit: [2]; VEC_DISTANCE_L2(items.v, \"[1,0.25]\") ASC)"},
+					{"     └─ Projected table access on [id v]"},
+					{"         └─ IndexedTableAccess(items on [items.v])"},
+				},
+			},
+			{
+				Query:    "SELECT id FROM items ORDER BY VEC_DISTANCE_L2(v, '[1,0.25]') LIMIT 2",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT id FROM items ORDER BY VEC_DISTANCE_L2('[1,0.25]', v) LIMIT 2 OFFSET 1",
+				Expected: []sql.Row{{3}, {5}},
+			},
+			{
+				Query: "EXPLAIN SELECT id, VEC_DISTANCE_COSINE(v, '[0,1]') AS d FROM items ORDER BY d LIMIT 1",
+				Expected: []sql.Row{
+					{"TopN(Limit: [1]; d ASC)"},
+					{" └─ Project(items.id, VEC_DISTANCE_COSINE(items.v, \"[0,1]\") as d)"},
+					{"     └─ Projected table access on [id v]"},
+					{"         └─ IndexedTableAccess(items on [items.v])"},
+				},
+			},
+			{
+				Query:    "SELECT id, VEC_DISTANCE_COSINE(v, '[0,1]') AS d FROM items ORDER BY d LIMIT 1",
+				Expected: []sql.Row{{2, 0.0}},
+			},
+			{
+				Query:    "SELECT id FROM items ORDER BY VEC_DISTANCE_DOT(v, STRING_TO_VECTOR('[1,0]')) LIMIT 2",
+				Expected: []sql.Row{{5}, {1}},
+			},
+			{
+				Query: "EXPLAIN SELECT id FROM items ORDER BY VEC_DISTANCE_L2(v, '[1,0.25]') DESC LIMIT 2",
+				Expected: []sql.Row{
+					{"Project(items.id)"},
+					{" └─ TopN(Limit: [2]; VEC_DISTANCE_L2(items.v, \"[1,0.25]\") DESC)"},
+					{"     └─ Projected table access on [id v]"},
+					{"         └─ Table(items)"},
+				},
+			},
+			{
+				Query:    "SELECT id FROM items ORDER BY VEC_DISTANCE_L2(v, '[1,0.25]') DESC LIMIT 2",
+				Expected: []sql.Row{{4}, {2}},
+			},
+			{
+				Query:    "SELECT id FROM items WHERE category = 'b' ORDER BY VEC_DISTANCE_L2(v, '[1,0.25]') LIMIT 1",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+}
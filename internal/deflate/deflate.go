@@ -0,0 +1,355 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is an altered port of deflate.c of zlib 1.2.11, which is distributed under the following license:
+//
+// Copyright (C) 1995-2017 Jean-loup Gailly and Mark Adler
+//
+// This software is provided 'as-is', without any express or implied
+// warranty.  In no event will the authors be held liable for any damages
+// arising from the use of this software.
+//
+// Permission is granted to anyone to use this software for any purpose,
+// including commercial applications, and to alter it and redistribute it
+// freely, subject to the following restrictions:
+//
+// 1. The origin of this software must not be misrepresented; you must not
+//    claim that you wrote the original software. If you use this software
+//    in a product, an acknowledgment in the product documentation would be
+//    appreciated but is not required.
+// 2. Altered source versions must be plainly marked as such, and must not be
+//    misrepresented as being the original software.
+// 3. This notice may not be removed or altered from any source distribution.
+//
+// Jean-loup Gailly        Mark Adler
+// jloup@gzip.org          madler@alumni.caltech.edu
+
+// Package deflate compresses data exactly as the compress function of the C zlib library does, which is what MySQL
+// uses for COMPRESS. The compress/zlib package gives streams that any zlib reader accepts, but they are not the same
+// bytes, since its encoder finds matches and splits blocks differently.
+//
+// This is a port of the parts of deflate.c and trees.c of zlib 1.2.11 used by compress: the default compression level
+// 6, with a 32K window, a memory level of 8 and the default strategy, compressing all of the input at once.
+package deflate
+
+import (
+	"bytes"
+	"hash/adler32"
+)
+
+const (
+	minMatch = 3
+	maxMatch = 258
+
+	// minLookahead is the minimum amount of lookahead, except at the end of the input.
+	minLookahead = maxMatch + minMatch + 1
+
+	windowBits = 15
+	wSize      = 1 << windowBits
+	wMask      = wSize - 1
+	windowSize = 2 * wSize
+
+	// maxDist is the farthest distance of a match, so that matches never read beyond the window.
+	maxDist = wSize - minLookahead
+
+	memLevel  = 8
+	hashBits  = memLevel + 7
+	hashSize  = 1 << hashBits
+	hashMask  = hashSize - 1
+	hashShift = (hashBits + minMatch - 1) / minMatch
+
+	// litBufSize is the number of symbols of a block.
+	litBufSize = 1 << (memLevel + 6)
+
+	// tooFar is the distance beyond which matches of length 3 are not worth it.
+	tooFar = 4096
+
+	// The configuration of compression level 6.
+	goodMatch      = 8
+	maxLazyMatch   = 16
+	niceMatch      = 128
+	maxChainLength = 128
+
+	// levelFlags is the FLEVEL of the zlib header for compression level 6.
+	levelFlags = 2
+	zDeflated  = 8
+)
+
+// Compress returns the zlib stream of the data given, which is the same as the one compress of zlib gives.
+func Compress(data []byte) []byte {
+	s := newDeflateState(data)
+
+	header := (zDeflated + ((windowBits - 8) << 4)) << 8
+	header |= levelFlags << 6
+	header += 31 - header%31
+	s.out.WriteByte(byte(header >> 8))
+	s.out.WriteByte(byte(header))
+
+	s.deflateSlow()
+
+	adler := adler32.Checksum(data)
+	s.out.Write([]byte{byte(adler >> 24), byte(adler >> 16), byte(adler >> 8), byte(adler)})
+	return s.out.Bytes()
+}
+
+// deflateState is the state of the compression of some data.
+type deflateState struct {
+	in  []byte
+	out bytes.Buffer
+
+	// window holds the most recent input, read in the upper half, which is moved to the lower half when it is full.
+	window []byte
+	// prev links the positions of the window whose strings have the same hash, most recent first.
+	prev []uint16
+	// head holds the most recent position of the window of each hash.
+	head []uint16
+
+	insH        uint
+	blockStart  int
+	matchLength uint
+	prevMatch   uint
+	matchAvail  bool
+	strStart    uint
+	matchStart  uint
+	lookahead   uint
+	prevLength  uint
+	insert      uint
+
+	trees
+}
+
+func newDeflateState(data []byte) *deflateState {
+	s := &deflateState{
+		in:          data,
+		window:      make([]byte, windowSize),
+		prev:        make([]uint16, wSize),
+		head:        make([]uint16, hashSize),
+		matchLength: minMatch - 1,
+		prevLength:  minMatch - 1,
+	}
+	s.initTrees()
+	return s
+}
+
+func (s *deflateState) updateHash(c byte) {
+	s.insH = ((s.insH << hashShift) ^ uint(c)) & hashMask
+}
+
+// insertString inserts the string at the position given in the hash table, and returns the previous head of its hash
+// chain.
+func (s *deflateState) insertString(str uint) uint {
+	s.updateHash(s.window[str+minMatch-1])
+	head := s.head[s.insH]
+	s.prev[str&wMask] = head
+	s.head[s.insH] = uint16(str)
+	return uint(head)
+}
+
+// slideHash updates the hash table once the window has been moved down by wSize.
+func (s *deflateState) slideHash() {
+	for i, m := range s.head {
+		if m >= wSize {
+			s.head[i] = m - wSize
+		} else {
+			s.head[i] = 0
+		}
+	}
+	for i, m := range s.prev {
+		if m >= wSize {
+			s.prev[i] = m - wSize
+		} else {
+			s.prev[i] = 0
+		}
+	}
+}
+
+// fillWindow reads input into the window when the lookahead is insufficient, moving the window down first if it's
+// almost full.
+func (s *deflateState) fillWindow() {
+	for {
+		more := uint(windowSize) - s.lookahead - s.strStart
+
+		if s.strStart >= wSize+maxDist {
+			copy(s.window, s.window[wSize:2*wSize-more])
+			s.matchStart -= wSize
+			s.strStart -= wSize
+			s.blockStart -= wSize
+			s.slideHash()
+			more += wSize
+		}
+		if len(s.in) == 0 {
+			break
+		}
+
+		n := uint(copy(s.window[s.strStart+s.lookahead:s.strStart+s.lookahead+more], s.in))
+		s.in = s.in[n:]
+		s.lookahead += n
+
+		// Initialize the hash value now that there is some input
+		if s.lookahead+s.insert >= minMatch {
+			str := s.strStart - s.insert
+			s.insH = uint(s.window[str])
+			s.updateHash(s.window[str+1])
+			for s.insert > 0 {
+				s.updateHash(s.window[str+minMatch-1])
+				s.prev[str&wMask] = s.head[s.insH]
+				s.head[s.insH] = uint16(str)
+				str++
+				s.insert--
+				if s.lookahead+s.insert < minMatch {
+					break
+				}
+			}
+		}
+
+		if s.lookahead >= minLookahead || len(s.in) == 0 {
+			break
+		}
+	}
+}
+
+// longestMatch returns the length of the longest match of the string at strStart in the hash chain beginning at the
+// position given, and sets matchStart to the position of the match. Only matches longer than prevLength are
+// considered.
+func (s *deflateState) longestMatch(curMatch uint) uint {
+	chainLength := uint(maxChainLength)
+	scan := s.strStart
+	bestLen := s.prevLength
+	nice := uint(niceMatch)
+	var limit uint
+	if s.strStart > maxDist {
+		limit = s.strStart - maxDist
+	}
+	w := s.window
+	scanEnd1 := w[scan+bestLen-1]
+	scanEnd := w[scan+bestLen]
+
+	if s.prevLength >= goodMatch {
+		chainLength >>= 2
+	}
+	if nice > s.lookahead {
+		nice = s.lookahead
+	}
+
+	for {
+		match := curMatch
+		// The third bytes are not compared, since they are equal when the first two are and the hashes are equal
+		if w[match+bestLen] == scanEnd && w[match+bestLen-1] == scanEnd1 && w[match] == w[scan] && w[match+1] == w[scan+1] {
+			length := uint(minMatch)
+			for length < maxMatch && w[scan+length] == w[match+length] {
+				length++
+			}
+			if length > bestLen {
+				s.matchStart = curMatch
+				bestLen = length
+				if length >= nice {
+					break
+				}
+				scanEnd1 = w[scan+bestLen-1]
+				scanEnd = w[scan+bestLen]
+			}
+		}
+
+		curMatch = uint(s.prev[curMatch&wMask])
+		if curMatch <= limit {
+			break
+		}
+		chainLength--
+		if chainLength == 0 {
+			break
+		}
+	}
+
+	if bestLen <= s.lookahead {
+		return bestLen
+	}
+	return s.lookahead
+}
+
+// flushBlock compresses the symbols of the current block, and starts a new one.
+func (s *deflateState) flushBlock(last bool) {
+	var stored []byte
+	if s.blockStart >= 0 {
+		stored = s.window[s.blockStart:s.strStart]
+	}
+	s.flushTrees(stored, int(s.strStart)-s.blockStart, last)
+	s.blockStart = int(s.strStart)
+}
+
+// deflateSlow compresses all of the input, evaluating matches lazily: a match is only used if there is no longer
+// match at the next position.
+func (s *deflateState) deflateSlow() {
+	for {
+		if s.lookahead < minLookahead {
+			s.fillWindow()
+			if s.lookahead == 0 {
+				break
+			}
+		}
+
+		var hashHead uint
+		if s.lookahead >= minMatch {
+			hashHead = s.insertString(s.strStart)
+		}
+
+		s.prevLength, s.prevMatch = s.matchLength, s.matchStart
+		s.matchLength = minMatch - 1
+
+		if hashHead != 0 && s.prevLength < maxLazyMatch && s.strStart-hashHead <= maxDist {
+			s.matchLength = s.longestMatch(hashHead)
+			if s.matchLength == minMatch && s.strStart-s.matchStart > tooFar {
+				s.matchLength = minMatch - 1
+			}
+		}
+
+		if s.prevLength >= minMatch && s.matchLength <= s.prevLength {
+			maxInsert := s.strStart + s.lookahead - minMatch
+			flush := s.tallyDist(s.strStart-1-s.prevMatch, s.prevLength-minMatch)
+			s.lookahead -= s.prevLength - 1
+			s.prevLength -= 2
+			for {
+				s.strStart++
+				if s.strStart <= maxInsert {
+					s.insertString(s.strStart)
+				}
+				s.prevLength--
+				if s.prevLength == 0 {
+					break
+				}
+			}
+			s.matchAvail = false
+			s.matchLength = minMatch - 1
+			s.strStart++
+			if flush {
+				s.flushBlock(false)
+			}
+		} else if s.matchAvail {
+			if s.tallyLit(s.window[s.strStart-1]) {
+				s.flushBlock(false)
+			}
+			s.strStart++
+			s.lookahead--
+		} else {
+			s.matchAvail = true
+			s.strStart++
+			s.lookahead--
+		}
+	}
+
+	if s.matchAvail {
+		s.tallyLit(s.window[s.strStart-1])
+		s.matchAvail = false
+	}
+	s.flushBlock(true)
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deflate
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompress(t *testing.T) {
+	var allBytes []byte
+	for i := 0; i < 256; i++ {
+		allBytes = append(allBytes, byte(i))
+	}
+
+	// Compressed with the C zlib library
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"empty", nil, "789c030000000001"},
+		{"one byte", []byte("a"), "789c4b040000620062"},
+		{"string", []byte("any string"), "789c4bccab54282e29cacc4b070015960400"},
+		{"repeated byte", bytes.Repeat([]byte("a"), 1000), "789c4b4c1c05a360140c770000f9d87af8"},
+		{"repeated string", bytes.Repeat([]byte("abcabcabcabcabc"), 20), "789c4b4c4a4e1c45c42100884d72d9"},
+		{"stored", allBytes, "789c010001fffe" + hex.EncodeToString(allBytes) + "adf67f81"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, hex.EncodeToString(Compress(tt.data)))
+		})
+	}
+}
+
+func TestCompressLarge(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; i < 100000; i++ {
+		fmt.Fprintf(&sb, "row %d: %d\n", i, i*i%9973)
+	}
+	compressed := Compress([]byte(sb.String()))
+
+	// The digest of the stream compressed with the C zlib library
+	require.Len(t, compressed, 483187)
+	digest := sha256.Sum256(compressed)
+	require.Equal(t, "3bb16fe1d6babc6b3f315d0d5e699d048c75d15f32d8b907e8511b12052d76f9", hex.EncodeToString(digest[:]))
+}
+
+func TestCompressRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 100, 70000, 300000} {
+		random := make([]byte, n)
+		r.Read(random)
+		small := make([]byte, n)
+		for i := range small {
+			small[i] = byte(r.Intn(4))
+		}
+
+		for _, data := range [][]byte{random, small} {
+			z, err := zlib.NewReader(bytes.NewReader(Compress(data)))
+			require.NoError(t, err)
+			uncompressed, err := ioutil.ReadAll(z)
+			require.NoError(t, err)
+			require.Equal(t, data, uncompressed)
+		}
+	}
+}
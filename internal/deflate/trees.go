@@ -0,0 +1,669 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is an altered port of trees.c of zlib 1.2.11, which is distributed under the following license:
+//
+// Copyright (C) 1995-2017 Jean-loup Gailly
+//
+// This software is provided 'as-is', without any express or implied
+// warranty.  In no event will the authors be held liable for any damages
+// arising from the use of this software.
+//
+// Permission is granted to anyone to use this software for any purpose,
+// including commercial applications, and to alter it and redistribute it
+// freely, subject to the following restrictions:
+//
+// 1. The origin of this software must not be misrepresented; you must not
+//    claim that you wrote the original software. If you use this software
+//    in a product, an acknowledgment in the product documentation would be
+//    appreciated but is not required.
+// 2. Altered source versions must be plainly marked as such, and must not be
+//    misrepresented as being the original software.
+// 3. This notice may not be removed or altered from any source distribution.
+//
+// Jean-loup Gailly        Mark Adler
+// jloup@gzip.org          madler@alumni.caltech.edu
+
+package deflate
+
+const (
+	maxBits   = 15
+	maxBLBits = 7
+
+	lengthCodes = 29
+	literals    = 256
+	lCodes      = literals + 1 + lengthCodes
+	dCodes      = 30
+	blCodes     = 19
+	heapSize    = 2*lCodes + 1
+
+	endBlock = 256
+
+	// rep3To6 repeats the previous length 3 to 6 times, repZ3To10 repeats a zero length 3 to 10 times, and
+	// repZ11To138 repeats a zero length 11 to 138 times.
+	rep3To6     = 16
+	repZ3To10   = 17
+	repZ11To138 = 18
+
+	storedBlock = 0
+	staticTrees = 1
+	dynTrees    = 2
+)
+
+var (
+	extraLBits  = [lengthCodes]int{0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 4, 4, 5, 5, 5, 5, 0}
+	extraDBits  = [dCodes]int{0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12, 13, 13}
+	extraBLBits = [blCodes]int{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 3, 7}
+
+	// blOrder is the order in which the lengths of the bit length codes are sent.
+	blOrder = [blCodes]int{16, 17, 18, 0, 8, 7, 9, 6, 10, 5, 11, 4, 12, 3, 13, 2, 14, 1, 15}
+)
+
+// ctData is a node of a Huffman tree. As in zlib, the frequency of a node and its code share a field, and so do its
+// parent and the length of its code.
+type ctData struct {
+	fc uint16
+	dl uint16
+}
+
+// The static trees, and the tables of the codes of lengths and distances, are computed at init.
+var (
+	staticLTree [lCodes + 2]ctData
+	staticDTree [dCodes]ctData
+	distCode    [512]uint8
+	lengthCode  [maxMatch - minMatch + 1]uint8
+	baseLength  [lengthCodes]int
+	baseDist    [dCodes]int
+)
+
+// staticTreeDesc describes a kind of tree: literals and lengths, distances, or bit lengths.
+type staticTreeDesc struct {
+	staticTree []ctData
+	extraBits  []int
+	extraBase  int
+	elems      int
+	maxLength  int
+}
+
+var (
+	staticLDesc  = staticTreeDesc{staticLTree[:], extraLBits[:], literals + 1, lCodes, maxBits}
+	staticDDesc  = staticTreeDesc{staticDTree[:], extraDBits[:], 0, dCodes, maxBits}
+	staticBLDesc = staticTreeDesc{nil, extraBLBits[:], 0, blCodes, maxBLBits}
+)
+
+// treeDesc is a dynamic tree along with its description.
+type treeDesc struct {
+	dynTree  []ctData
+	maxCode  int
+	statDesc *staticTreeDesc
+}
+
+func init() {
+	length := 0
+	code := 0
+	for ; code < lengthCodes-1; code++ {
+		baseLength[code] = length
+		for n := 0; n < 1<<uint(extraLBits[code]); n++ {
+			lengthCode[length] = uint8(code)
+			length++
+		}
+	}
+	// Length 258 has a code of its own, which overwrites the last code of lengths 227-257
+	lengthCode[length-1] = uint8(code)
+
+	dist := 0
+	for code = 0; code < 16; code++ {
+		baseDist[code] = dist
+		for n := 0; n < 1<<uint(extraDBits[code]); n++ {
+			distCode[dist] = uint8(code)
+			dist++
+		}
+	}
+	dist >>= 7
+	for ; code < dCodes; code++ {
+		baseDist[code] = dist << 7
+		for n := 0; n < 1<<uint(extraDBits[code]-7); n++ {
+			distCode[256+dist] = uint8(code)
+			dist++
+		}
+	}
+
+	var blCount [maxBits + 1]uint16
+	n := 0
+	for ; n <= 143; n++ {
+		staticLTree[n].dl = 8
+		blCount[8]++
+	}
+	for ; n <= 255; n++ {
+		staticLTree[n].dl = 9
+		blCount[9]++
+	}
+	for ; n <= 279; n++ {
+		staticLTree[n].dl = 7
+		blCount[7]++
+	}
+	for ; n <= 287; n++ {
+		staticLTree[n].dl = 8
+		blCount[8]++
+	}
+	genCodes(staticLTree[:], lCodes+1, blCount[:])
+
+	for n = 0; n < dCodes; n++ {
+		staticDTree[n].dl = 5
+		staticDTree[n].fc = uint16(biReverse(uint(n), 5))
+	}
+}
+
+// dCode returns the code of the distance given, less one.
+func dCode(dist uint) uint8 {
+	if dist < 256 {
+		return distCode[dist]
+	}
+	return distCode[256+(dist>>7)]
+}
+
+// trees is the state of the Huffman coding of the current block.
+type trees struct {
+	dynLTree [heapSize]ctData
+	dynDTree [2*dCodes + 1]ctData
+	blTree   [2*blCodes + 1]ctData
+
+	lDesc  treeDesc
+	dDesc  treeDesc
+	blDesc treeDesc
+
+	blCount [maxBits + 1]uint16
+
+	heap    [2*lCodes + 1]int
+	heapLen int
+	heapMax int
+	depth   [2*lCodes + 1]uint8
+
+	// The symbols of the block: the literals, or the lengths of the matches less minMatch, along with the distances
+	// of the matches, which are zero for literals.
+	lBuf []uint8
+	dBuf []uint16
+
+	optLen    uint
+	staticLen uint
+
+	bits bitWriter
+}
+
+func (s *deflateState) initTrees() {
+	s.lDesc = treeDesc{dynTree: s.dynLTree[:], statDesc: &staticLDesc}
+	s.dDesc = treeDesc{dynTree: s.dynDTree[:], statDesc: &staticDDesc}
+	s.blDesc = treeDesc{dynTree: s.blTree[:], statDesc: &staticBLDesc}
+	s.lBuf = make([]uint8, 0, litBufSize)
+	s.dBuf = make([]uint16, 0, litBufSize)
+	s.bits.out = &s.out
+	s.initBlock()
+}
+
+func (s *deflateState) initBlock() {
+	for n := 0; n < lCodes; n++ {
+		s.dynLTree[n].fc = 0
+	}
+	for n := 0; n < dCodes; n++ {
+		s.dynDTree[n].fc = 0
+	}
+	for n := 0; n < blCodes; n++ {
+		s.blTree[n].fc = 0
+	}
+	s.dynLTree[endBlock].fc = 1
+	s.optLen, s.staticLen = 0, 0
+	s.lBuf = s.lBuf[:0]
+	s.dBuf = s.dBuf[:0]
+}
+
+// tallyLit adds a literal to the block, and returns whether the block is full.
+func (s *deflateState) tallyLit(c byte) bool {
+	s.dBuf = append(s.dBuf, 0)
+	s.lBuf = append(s.lBuf, c)
+	s.dynLTree[c].fc++
+	return len(s.lBuf) == litBufSize-1
+}
+
+// tallyDist adds a match to the block, and returns whether the block is full.
+func (s *deflateState) tallyDist(dist, length uint) bool {
+	s.dBuf = append(s.dBuf, uint16(dist))
+	s.lBuf = append(s.lBuf, uint8(length))
+	dist--
+	s.dynLTree[int(lengthCode[length])+literals+1].fc++
+	s.dynDTree[dCode(dist)].fc++
+	return len(s.lBuf) == litBufSize-1
+}
+
+// smaller compares two nodes of the tree given by their frequency, and then by their depth.
+func (s *deflateState) smaller(tree []ctData, n, m int) bool {
+	return tree[n].fc < tree[m].fc || (tree[n].fc == tree[m].fc && s.depth[n] <= s.depth[m])
+}
+
+// pqDownHeap restores the heap property by moving down the node at the index given.
+func (s *deflateState) pqDownHeap(tree []ctData, k int) {
+	v := s.heap[k]
+	j := k << 1
+	for j <= s.heapLen {
+		if j < s.heapLen && s.smaller(tree, s.heap[j+1], s.heap[j]) {
+			j++
+		}
+		if s.smaller(tree, v, s.heap[j]) {
+			break
+		}
+		s.heap[k] = s.heap[j]
+		k = j
+		j <<= 1
+	}
+	s.heap[k] = v
+}
+
+// pqRemove removes the smallest node of the heap and returns it.
+func (s *deflateState) pqRemove(tree []ctData) int {
+	top := s.heap[1]
+	s.heap[1] = s.heap[s.heapLen]
+	s.heapLen--
+	s.pqDownHeap(tree, 1)
+	return top
+}
+
+// genBitLen computes the lengths of the codes of a tree, limited to the maximum length of its kind, and updates the
+// lengths of the block with the tree and with the static tree.
+func (s *deflateState) genBitLen(desc *treeDesc) {
+	tree := desc.dynTree
+	maxCode := desc.maxCode
+	stree := desc.statDesc.staticTree
+	extra := desc.statDesc.extraBits
+	base := desc.statDesc.extraBase
+	maxLength := desc.statDesc.maxLength
+	overflow := 0
+
+	for bits := range s.blCount {
+		s.blCount[bits] = 0
+	}
+
+	// The root of the heap
+	tree[s.heap[s.heapMax]].dl = 0
+
+	h := s.heapMax + 1
+	for ; h < heapSize; h++ {
+		n := s.heap[h]
+		bits := int(tree[tree[n].dl].dl) + 1
+		if bits > maxLength {
+			bits = maxLength
+			overflow++
+		}
+		tree[n].dl = uint16(bits)
+
+		// Not a leaf
+		if n > maxCode {
+			continue
+		}
+
+		s.blCount[bits]++
+		xbits := 0
+		if n >= base {
+			xbits = extra[n-base]
+		}
+		f := uint(tree[n].fc)
+		s.optLen += f * uint(bits+xbits)
+		if stree != nil {
+			s.staticLen += f * uint(int(stree[n].dl)+xbits)
+		}
+	}
+	if overflow == 0 {
+		return
+	}
+
+	// Find the first bit length that could increase
+	for overflow > 0 {
+		bits := maxLength - 1
+		for s.blCount[bits] == 0 {
+			bits--
+		}
+		s.blCount[bits]--
+		s.blCount[bits+1] += 2
+		s.blCount[maxLength]--
+		overflow -= 2
+	}
+
+	// Recompute the lengths of the leaves in order of increasing frequency
+	for bits := maxLength; bits != 0; bits-- {
+		n := int(s.blCount[bits])
+		for n != 0 {
+			h--
+			m := s.heap[h]
+			if m > maxCode {
+				continue
+			}
+			if int(tree[m].dl) != bits {
+				s.optLen += uint(bits-int(tree[m].dl)) * uint(tree[m].fc)
+				tree[m].dl = uint16(bits)
+			}
+			n--
+		}
+	}
+}
+
+// genCodes assigns the codes of a tree given the lengths of its codes and the number of codes of each length.
+func genCodes(tree []ctData, maxCode int, blCount []uint16) {
+	var nextCode [maxBits + 1]uint16
+	code := uint16(0)
+	for bits := 1; bits <= maxBits; bits++ {
+		code = (code + blCount[bits-1]) << 1
+		nextCode[bits] = code
+	}
+	for n := 0; n <= maxCode; n++ {
+		length := tree[n].dl
+		if length == 0 {
+			continue
+		}
+		tree[n].fc = uint16(biReverse(uint(nextCode[length]), int(length)))
+		nextCode[length]++
+	}
+}
+
+// buildTree builds the Huffman tree of the frequencies of a tree, and assigns its codes.
+func (s *deflateState) buildTree(desc *treeDesc) {
+	tree := desc.dynTree
+	stree := desc.statDesc.staticTree
+	elems := desc.statDesc.elems
+	maxCode := -1
+
+	s.heapLen, s.heapMax = 0, heapSize
+	for n := 0; n < elems; n++ {
+		if tree[n].fc != 0 {
+			s.heapLen++
+			s.heap[s.heapLen] = n
+			maxCode = n
+			s.depth[n] = 0
+		} else {
+			tree[n].dl = 0
+		}
+	}
+
+	// The format requires at least one distance code, and at least two codes of any nonzero frequency
+	for s.heapLen < 2 {
+		node := 0
+		if maxCode < 2 {
+			maxCode++
+			node = maxCode
+		}
+		s.heapLen++
+		s.heap[s.heapLen] = node
+		tree[node].fc = 1
+		s.depth[node] = 0
+		s.optLen--
+		if stree != nil {
+			s.staticLen -= uint(stree[node].dl)
+		}
+	}
+	desc.maxCode = maxCode
+
+	for n := s.heapLen / 2; n >= 1; n-- {
+		s.pqDownHeap(tree, n)
+	}
+
+	// Combine the two nodes of least frequency until there is only one node
+	node := elems
+	for {
+		n := s.pqRemove(tree)
+		m := s.heap[1]
+
+		s.heapMax--
+		s.heap[s.heapMax] = n
+		s.heapMax--
+		s.heap[s.heapMax] = m
+
+		tree[node].fc = tree[n].fc + tree[m].fc
+		if s.depth[n] >= s.depth[m] {
+			s.depth[node] = s.depth[n] + 1
+		} else {
+			s.depth[node] = s.depth[m] + 1
+		}
+		tree[n].dl = uint16(node)
+		tree[m].dl = uint16(node)
+
+		s.heap[1] = node
+		node++
+		s.pqDownHeap(tree, 1)
+		if s.heapLen < 2 {
+			break
+		}
+	}
+
+	s.heapMax--
+	s.heap[s.heapMax] = s.heap[1]
+
+	s.genBitLen(desc)
+	genCodes(tree, maxCode, s.blCount[:])
+}
+
+// scanTree counts the frequencies of the bit length codes that send the lengths of the codes of a tree.
+func (s *deflateState) scanTree(tree []ctData, maxCode int) {
+	prevLen := -1
+	nextLen := int(tree[0].dl)
+	count := 0
+	maxCount, minCount := 7, 4
+	if nextLen == 0 {
+		maxCount, minCount = 138, 3
+	}
+	// A guard
+	tree[maxCode+1].dl = 0xffff
+
+	for n := 0; n <= maxCode; n++ {
+		curLen := nextLen
+		nextLen = int(tree[n+1].dl)
+		count++
+		if count < maxCount && curLen == nextLen {
+			continue
+		} else if count < minCount {
+			s.blTree[curLen].fc += uint16(count)
+		} else if curLen != 0 {
+			if curLen != prevLen {
+				s.blTree[curLen].fc++
+			}
+			s.blTree[rep3To6].fc++
+		} else if count <= 10 {
+			s.blTree[repZ3To10].fc++
+		} else {
+			s.blTree[repZ11To138].fc++
+		}
+		count = 0
+		prevLen = curLen
+		if nextLen == 0 {
+			maxCount, minCount = 138, 3
+		} else if curLen == nextLen {
+			maxCount, minCount = 6, 3
+		} else {
+			maxCount, minCount = 7, 4
+		}
+	}
+}
+
+// sendTree sends the lengths of the codes of a tree, with the bit length codes.
+func (s *deflateState) sendTree(tree []ctData, maxCode int) {
+	prevLen := -1
+	nextLen := int(tree[0].dl)
+	count := 0
+	maxCount, minCount := 7, 4
+	if nextLen == 0 {
+		maxCount, minCount = 138, 3
+	}
+
+	for n := 0; n <= maxCode; n++ {
+		curLen := nextLen
+		nextLen = int(tree[n+1].dl)
+		count++
+		if count < maxCount && curLen == nextLen {
+			continue
+		} else if count < minCount {
+			for ; count != 0; count-- {
+				s.sendCode(curLen, s.blTree[:])
+			}
+		} else if curLen != 0 {
+			if curLen != prevLen {
+				s.sendCode(curLen, s.blTree[:])
+				count--
+			}
+			s.sendCode(rep3To6, s.blTree[:])
+			s.bits.send(uint(count-3), 2)
+		} else if count <= 10 {
+			s.sendCode(repZ3To10, s.blTree[:])
+			s.bits.send(uint(count-3), 3)
+		} else {
+			s.sendCode(repZ11To138, s.blTree[:])
+			s.bits.send(uint(count-11), 7)
+		}
+		count = 0
+		prevLen = curLen
+		if nextLen == 0 {
+			maxCount, minCount = 138, 3
+		} else if curLen == nextLen {
+			maxCount, minCount = 6, 3
+		} else {
+			maxCount, minCount = 7, 4
+		}
+	}
+}
+
+// buildBLTree builds the tree of the bit length codes, and returns the index in blOrder of the last bit length code
+// to send.
+func (s *deflateState) buildBLTree() int {
+	s.scanTree(s.dynLTree[:], s.lDesc.maxCode)
+	s.scanTree(s.dynDTree[:], s.dDesc.maxCode)
+	s.buildTree(&s.blDesc)
+
+	// At least 4 bit length codes are sent
+	maxBLIndex := blCodes - 1
+	for ; maxBLIndex >= 3; maxBLIndex-- {
+		if s.blTree[blOrder[maxBLIndex]].dl != 0 {
+			break
+		}
+	}
+	s.optLen += 3*uint(maxBLIndex+1) + 5 + 5 + 4
+	return maxBLIndex
+}
+
+// sendAllTrees sends the header of a block with dynamic trees.
+func (s *deflateState) sendAllTrees(lcodes, dcodes, blcodes int) {
+	s.bits.send(uint(lcodes-257), 5)
+	s.bits.send(uint(dcodes-1), 5)
+	s.bits.send(uint(blcodes-4), 4)
+	for rank := 0; rank < blcodes; rank++ {
+		s.bits.send(uint(s.blTree[blOrder[rank]].dl), 3)
+	}
+	s.sendTree(s.dynLTree[:], lcodes-1)
+	s.sendTree(s.dynDTree[:], dcodes-1)
+}
+
+func (s *deflateState) sendCode(c int, tree []ctData) {
+	s.bits.send(uint(tree[c].fc), int(tree[c].dl))
+}
+
+// compressBlock sends the symbols of the block with the trees given.
+func (s *deflateState) compressBlock(ltree, dtree []ctData) {
+	for i, lc := range s.lBuf {
+		dist := uint(s.dBuf[i])
+		if dist == 0 {
+			s.sendCode(int(lc), ltree)
+			continue
+		}
+
+		code := int(lengthCode[lc])
+		s.sendCode(code+literals+1, ltree)
+		if extra := extraLBits[code]; extra != 0 {
+			s.bits.send(uint(int(lc)-baseLength[code]), extra)
+		}
+		dist--
+		code = int(dCode(dist))
+		s.sendCode(code, dtree)
+		if extra := extraDBits[code]; extra != 0 {
+			s.bits.send(dist-uint(baseDist[code]), extra)
+		}
+	}
+	s.sendCode(endBlock, ltree)
+}
+
+// flushTrees sends the current block, either stored, or compressed with the static trees or with dynamic trees,
+// whichever is shortest. The stored data of the block is nil if it is no longer in the window.
+func (s *deflateState) flushTrees(stored []byte, storedLen int, last bool) {
+	s.buildTree(&s.lDesc)
+	s.buildTree(&s.dDesc)
+	maxBLIndex := s.buildBLTree()
+
+	optLenB := (s.optLen + 3 + 7) >> 3
+	staticLenB := (s.staticLen + 3 + 7) >> 3
+	if staticLenB <= optLenB {
+		optLenB = staticLenB
+	}
+
+	lastBit := uint(0)
+	if last {
+		lastBit = 1
+	}
+	if uint(storedLen)+4 <= optLenB && stored != nil {
+		s.bits.send(storedBlock<<1+lastBit, 3)
+		s.bits.windup()
+		s.out.Write([]byte{byte(storedLen), byte(storedLen >> 8), ^byte(storedLen), ^byte(storedLen >> 8)})
+		s.out.Write(stored)
+	} else if staticLenB == optLenB {
+		s.bits.send(staticTrees<<1+lastBit, 3)
+		s.compressBlock(staticLTree[:], staticDTree[:])
+	} else {
+		s.bits.send(dynTrees<<1+lastBit, 3)
+		s.sendAllTrees(s.lDesc.maxCode+1, s.dDesc.maxCode+1, maxBLIndex+1)
+		s.compressBlock(s.dynLTree[:], s.dynDTree[:])
+	}
+
+	s.initBlock()
+	if last {
+		s.bits.windup()
+	}
+}
+
+// biReverse reverses the first bits given of a code.
+func biReverse(code uint, length int) uint {
+	res := uint(0)
+	for ; length > 0; length-- {
+		res |= code & 1
+		code >>= 1
+		res <<= 1
+	}
+	return res >> 1
+}
+
+// bitWriter writes bits least significant bit first, as deflate streams are written.
+type bitWriter struct {
+	out   interface{ WriteByte(byte) error }
+	buf   uint64
+	valid uint
+}
+
+func (w *bitWriter) send(value uint, length int) {
+	w.buf |= uint64(value) << w.valid
+	w.valid += uint(length)
+	for w.valid >= 8 {
+		w.out.WriteByte(byte(w.buf))
+		w.buf >>= 8
+		w.valid -= 8
+	}
+}
+
+// windup writes the remaining bits, padded to a byte.
+func (w *bitWriter) windup() {
+	if w.valid > 0 {
+		w.out.WriteByte(byte(w.buf))
+	}
+	w.buf, w.valid = 0, 0
+}
@@ -29,6 +29,7 @@ var (
 	ErrRegexNotFound = errors.NewKind("Regex engine not found: %s")
 
 	registry      map[string]Constructor
+	regexRegistry map[string]RegexConstructor
 	defaultEngine string
 )
 
@@ -53,6 +54,29 @@ type DisposableMatcher interface {
 // Constructor creates a new Matcher.
 type Constructor func(re string) (Matcher, Disposer, error)
 
+// Options are the options a Regex is compiled with.
+type Options struct {
+	// CaseInsensitive makes letters match regardless of their case.
+	CaseInsensitive bool
+	// Multiline makes ^ and $ match at the beginning and the end of every line, rather than of the text only.
+	Multiline bool
+	// DotAll makes . match line terminators too.
+	DotAll bool
+}
+
+// Regex is a regular expression that can find its matches in a text, beyond telling whether there are any.
+type Regex interface {
+	DisposableMatcher
+	// FindAllSubmatchIndex returns the byte offsets of the successive non-overlapping matches of the regular
+	// expression in the text, up to n of them, or all of them if n is negative. Every match is a pair of offsets for
+	// the whole match followed by a pair for every group of the expression, which is -1 for groups that did not
+	// participate in the match.
+	FindAllSubmatchIndex(text string, n int) [][]int
+}
+
+// RegexConstructor creates a new Regex with the options given.
+type RegexConstructor func(re string, options Options) (Regex, error)
+
 var (
 	// CompileHistogram describes a regexp compile time.
 	CompileHistogram = discard.NewHistogram()
@@ -81,6 +105,27 @@ func Register(name string, c Constructor) error {
 	return nil
 }
 
+// RegisterRegex adds the Regex constructor of a regex engine to the registry. Engines register it under the same
+// name as their Constructor.
+func RegisterRegex(name string, c RegexConstructor) error {
+	if regexRegistry == nil {
+		regexRegistry = make(map[string]RegexConstructor)
+	}
+
+	if name == "" {
+		return ErrRegexNameEmpty.New()
+	}
+
+	_, ok := regexRegistry[name]
+	if ok {
+		return ErrRegexAlreadyRegistered.New(name)
+	}
+
+	regexRegistry[name] = c
+
+	return nil
+}
+
 // Engines returns the list of regex engines names.
 func Engines() []string {
 	var names []string
@@ -102,6 +147,16 @@ func New(name, re string) (Matcher, Disposer, error) {
 	return n(re)
 }
 
+// NewRegex creates a new Regex with the specified regex engine and options.
+func NewRegex(name, re string, options Options) (Regex, error) {
+	n, ok := regexRegistry[name]
+	if !ok {
+		return nil, ErrRegexNotFound.New(name)
+	}
+
+	return n(re, options)
+}
+
 type disposableMatcher struct {
 	m Matcher
 	d Disposer
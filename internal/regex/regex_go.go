@@ -16,6 +16,8 @@ package regex
 
 import (
 	"regexp"
+	"regexp/syntax"
+	"strings"
 	"time"
 )
 
@@ -32,6 +34,16 @@ func (r *Go) Match(s string) bool {
 	return r.reg.MatchString(s)
 }
 
+// FindAllSubmatchIndex implements Regex interface.
+func (r *Go) FindAllSubmatchIndex(s string, n int) [][]int {
+	t := time.Now()
+	defer func() {
+		MatchHistogram.With("string", s, "duration", "seconds").Observe(time.Since(t).Seconds())
+	}()
+
+	return r.reg.FindAllStringSubmatchIndex(s, n)
+}
+
 // Dispose implements Disposer interface.
 func (*Go) Dispose() {}
 
@@ -50,9 +62,41 @@ func NewGo(re string) (Matcher, Disposer, error) {
 	return &r, &r, nil
 }
 
+// NewGoRegex creates a new Regex using go regex engine, with the options given as flags of the expression.
+func NewGoRegex(re string, options Options) (Regex, error) {
+	var flags string
+	if options.CaseInsensitive {
+		flags += "i"
+	}
+	if options.Multiline {
+		flags += "m"
+	}
+	if options.DotAll {
+		flags += "s"
+	}
+	var prefix string
+	if flags != "" {
+		prefix = "(?" + flags + ")"
+	}
+
+	m, _, err := NewGo(prefix + re)
+	if err != nil {
+		// Report the pattern as it was given, without the flags
+		if serr, ok := err.(*syntax.Error); ok {
+			serr.Expr = strings.TrimPrefix(serr.Expr, prefix)
+		}
+		return nil, err
+	}
+	return m.(*Go), nil
+}
+
 func init() {
 	err := Register("go", NewGo)
 	if err != nil {
 		panic(err.Error())
 	}
+	err = RegisterRegex("go", NewGoRegex)
+	if err != nil {
+		panic(err.Error())
+	}
 }
@@ -35,6 +35,16 @@ func (r *Oniguruma) Match(s string) bool {
 	return r.reg.MatchString(s)
 }
 
+// FindAllSubmatchIndex implements Regex interface.
+func (r *Oniguruma) FindAllSubmatchIndex(s string, n int) [][]int {
+	t := time.Now()
+	defer func() {
+		MatchHistogram.With("string", s, "duration", "seconds").Observe(time.Since(t).Seconds())
+	}()
+
+	return r.reg.FindAllStringSubmatchIndex(s, n)
+}
+
 // Dispose implements Disposer interface.
 // The function releases resources for oniguruma's precompiled regex
 func (r *Oniguruma) Dispose() {
@@ -56,9 +66,39 @@ func NewOniguruma(re string) (Matcher, Disposer, error) {
 	return &r, &r, nil
 }
 
+// NewOnigurumaRegex creates a new Regex using oniguruma engine. As the engine uses the Ruby syntax, in which ^ and $
+// match at line boundaries and the multiline option makes . match newlines, the options are translated accordingly.
+func NewOnigurumaRegex(re string, options Options) (Regex, error) {
+	t := time.Now()
+	option := rubex.ONIG_OPTION_NONE
+	if options.CaseInsensitive {
+		option |= rubex.ONIG_OPTION_IGNORECASE
+	}
+	if !options.Multiline {
+		option |= rubex.ONIG_OPTION_SINGLELINE
+	}
+	if options.DotAll {
+		option |= rubex.ONIG_OPTION_MULTILINE
+	}
+
+	reg, err := rubex.NewRegexp(re, option)
+	if err != nil {
+		return nil, err
+	}
+	CompileHistogram.With("regex", re, "duration", "seconds").Observe(time.Since(t).Seconds())
+
+	return &Oniguruma{
+		reg: reg,
+	}, nil
+}
+
 func init() {
 	err := Register("oniguruma", NewOniguruma)
 	if err != nil {
 		panic(err.Error())
 	}
+	err = RegisterRegex("oniguruma", NewOnigurumaRegex)
+	if err != nil {
+		panic(err.Error())
+	}
 }
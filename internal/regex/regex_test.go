@@ -120,3 +120,49 @@ func TestMatcherMultiPatterns(t *testing.T) {
 		})
 	}
 }
+
+func TestRegex(t *testing.T) {
+	for _, name := range Engines() {
+		if name == "nil" {
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			re, err := NewRegex(name, "a(b+)?", Options{})
+			require.NoError(t, err)
+			require.Equal(t, true, re.Match("xxab"))
+			require.Equal(t, [][]int{{1, 4, 2, 4}, {5, 6, -1, -1}}, re.FindAllSubmatchIndex("xabb-a-A", -1))
+			require.Equal(t, [][]int{{1, 4, 2, 4}}, re.FindAllSubmatchIndex("xabb-a-A", 1))
+			re.Dispose()
+
+			re, err = NewRegex(name, "a", Options{CaseInsensitive: true})
+			require.NoError(t, err)
+			require.Len(t, re.FindAllSubmatchIndex("xabb-a-A", -1), 3)
+			re.Dispose()
+
+			re, err = NewRegex(name, "^b$", Options{})
+			require.NoError(t, err)
+			require.Equal(t, false, re.Match("a\nb\nc"))
+			re.Dispose()
+
+			re, err = NewRegex(name, "^b$", Options{Multiline: true})
+			require.NoError(t, err)
+			require.Equal(t, true, re.Match("a\nb\nc"))
+			re.Dispose()
+
+			re, err = NewRegex(name, "a.b", Options{})
+			require.NoError(t, err)
+			require.Equal(t, false, re.Match("a\nb"))
+			re.Dispose()
+
+			re, err = NewRegex(name, "a.b", Options{DotAll: true})
+			require.NoError(t, err)
+			require.Equal(t, true, re.Match("a\nb"))
+			re.Dispose()
+
+			_, err = NewRegex(name, "(a", Options{CaseInsensitive: true, Multiline: true})
+			require.Error(t, err)
+			require.NotContains(t, err.Error(), "(?")
+		})
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// FullTextIndex is a FULLTEXT index on a memory table. Its inverted index, mapping every word to the rows containing
+// it, is built with the full-text settings in effect when the index is created, and is kept up to date as rows of the
+// table are inserted, updated and deleted.
+type FullTextIndex struct {
+	Tbl        *Table
+	TableName  string
+	Exprs      []sql.Expression
+	Name       string
+	CommentStr string
+
+	config sql.FullTextConfig
+	// colIdxs are the positions of the indexed columns in the schema of the table, or nil if any of them is no longer
+	// in the table
+	colIdxs []int
+	index   *invertedIndex
+}
+
+var _ sql.FullTextIndex = (*FullTextIndex)(nil)
+
+// newFullTextIndex returns a full-text index of the table given, built from the rows the table has now.
+func newFullTextIndex(tbl *Table, name string, exprs []sql.Expression, comment string) (*FullTextIndex, error) {
+	config, err := sql.NewFullTextConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	index := &FullTextIndex{
+		Tbl:        tbl,
+		TableName:  tbl.name,
+		Exprs:      exprs,
+		Name:       name,
+		CommentStr: comment,
+		config:     config,
+	}
+	if err := index.rebuild(); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (i *FullTextIndex) Database() string  { return "" }
+func (i *FullTextIndex) Table() string     { return i.TableName }
+func (i *FullTextIndex) ID() string        { return i.Name }
+func (i *FullTextIndex) IsUnique() bool    { return false }
+func (i *FullTextIndex) Comment() string   { return i.CommentStr }
+func (i *FullTextIndex) IndexType() string { return "FULLTEXT" }
+func (i *FullTextIndex) IsGenerated() bool { return false }
+
+func (i *FullTextIndex) Expressions() []string {
+	var exprs []string
+	for _, e := range i.Exprs {
+		exprs = append(exprs, e.String())
+	}
+	return exprs
+}
+
+func (i *FullTextIndex) Get(key ...interface{}) (sql.IndexLookup, error) {
+	return nil, fmt.Errorf("full-text index %s can only be searched with MATCH ... AGAINST", i.Name)
+}
+
+func (i *FullTextIndex) Has(sql.Partition, ...interface{}) (bool, error) {
+	return false, fmt.Errorf("full-text index %s can only be searched with MATCH ... AGAINST", i.Name)
+}
+
+// FullTextSearch implements sql.FullTextIndex
+func (i *FullTextIndex) FullTextSearch(ctx *sql.Context, query string, mode sql.FullTextSearchMode) (sql.FullTextSearcher, error) {
+	if i.colIdxs == nil {
+		return nil, fmt.Errorf("the columns of full-text index %s are not in the table", i.Name)
+	}
+
+	terms := sql.ParseFullTextQuery(i.config, query, mode)
+	weights := make([]float64, len(terms))
+	for j, term := range terms {
+		weights[j] = i.index.inverseDocumentFrequency(term)
+	}
+
+	return &fullTextSearcher{
+		index:   i.index,
+		terms:   terms,
+		weights: weights,
+		boolean: mode == sql.FullTextSearchMode_Boolean,
+	}, nil
+}
+
+// rebuild builds the inverted index again from the schema and rows the table has now. Columns are found by name, as
+// their positions may have changed since the index was created.
+func (i *FullTextIndex) rebuild() error {
+	i.index = newInvertedIndex(i.config)
+	i.colIdxs = nil
+
+	var colIdxs []int
+	for _, expr := range i.Exprs {
+		idx, _ := i.Tbl.getField(expr.(*expression.GetField).Name())
+		if idx < 0 {
+			return nil
+		}
+		colIdxs = append(colIdxs, idx)
+	}
+	i.colIdxs = colIdxs
+
+	for _, key := range i.Tbl.keys {
+		for _, row := range i.Tbl.partitions[string(key)] {
+			if err := i.add(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// add adds a row of the table to the index.
+func (i *FullTextIndex) add(row sql.Row) error {
+	if i.colIdxs == nil {
+		return nil
+	}
+	words, err := i.index.words(i.values(row))
+	if err != nil {
+		return err
+	}
+	i.index.add(words)
+	return nil
+}
+
+// remove removes a row of the table from the index.
+func (i *FullTextIndex) remove(row sql.Row) error {
+	if i.colIdxs == nil {
+		return nil
+	}
+	words, err := i.index.words(i.values(row))
+	if err != nil {
+		return err
+	}
+	i.index.remove(words)
+	return nil
+}
+
+// values returns the values of the indexed columns of the row given.
+func (i *FullTextIndex) values(row sql.Row) []interface{} {
+	values := make([]interface{}, len(i.colIdxs))
+	for j, idx := range i.colIdxs {
+		values[j] = row[idx]
+	}
+	return values
+}
+
+// invertedIndex maps the words of a set of documents (the indexed columns of each row) to the documents containing
+// them. Documents with the same words are indistinguishable to a search, so they are kept once, along with the number
+// of rows that have them.
+type invertedIndex struct {
+	config sql.FullTextConfig
+	// docs holds all of the words of each distinct document, in order, for phrase searches, keyed by those words
+	docs map[string]*fullTextDocument
+	// numDocs is the number of documents in the index, counting every copy of the same document
+	numDocs int
+	// postings holds the keys of the distinct documents containing each indexable word
+	postings map[string]map[string]struct{}
+}
+
+// fullTextDocument is a distinct document of an inverted index, along with the number of rows that have it.
+type fullTextDocument struct {
+	words []string
+	count int
+}
+
+func newInvertedIndex(config sql.FullTextConfig) *invertedIndex {
+	return &invertedIndex{
+		config:   config,
+		docs:     make(map[string]*fullTextDocument),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// words returns the words of the given column values.
+func (ii *invertedIndex) words(values []interface{}) ([]string, error) {
+	var words []string
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		text, err := sql.LongText.Convert(value)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, ii.config.Tokenize(text.(string))...)
+	}
+	return words, nil
+}
+
+// add adds a document with the words given to the index.
+func (ii *invertedIndex) add(words []string) {
+	ii.numDocs++
+	key := strings.Join(words, " ")
+	if doc, ok := ii.docs[key]; ok {
+		doc.count++
+		return
+	}
+
+	ii.docs[key] = &fullTextDocument{words: words, count: 1}
+	for _, word := range words {
+		if !ii.config.IsIndexable(word) {
+			continue
+		}
+		postings, ok := ii.postings[word]
+		if !ok {
+			postings = make(map[string]struct{})
+			ii.postings[word] = postings
+		}
+		postings[key] = struct{}{}
+	}
+}
+
+// remove removes a document with the words given from the index.
+func (ii *invertedIndex) remove(words []string) {
+	key := strings.Join(words, " ")
+	doc, ok := ii.docs[key]
+	if !ok {
+		return
+	}
+
+	ii.numDocs--
+	doc.count--
+	if doc.count > 0 {
+		return
+	}
+
+	delete(ii.docs, key)
+	for _, word := range words {
+		if postings, ok := ii.postings[word]; ok {
+			delete(postings, key)
+			if len(postings) == 0 {
+				delete(ii.postings, word)
+			}
+		}
+	}
+}
+
+// documentFrequency returns the number of documents that contain the term given.
+func (ii *invertedIndex) documentFrequency(term sql.FullTextTerm) int {
+	count := 0
+	switch {
+	case term.IsPhrase():
+		for _, doc := range ii.docs {
+			if termFrequency(ii.config, term, doc.words) > 0 {
+				count += doc.count
+			}
+		}
+	case term.Prefix:
+		seen := make(map[string]struct{})
+		for word, postings := range ii.postings {
+			if !strings.HasPrefix(word, term.Words[0]) {
+				continue
+			}
+			for key := range postings {
+				if _, ok := seen[key]; !ok {
+					seen[key] = struct{}{}
+					count += ii.docs[key].count
+				}
+			}
+		}
+	default:
+		for key := range ii.postings[term.Words[0]] {
+			count += ii.docs[key].count
+		}
+	}
+	return count
+}
+
+// inverseDocumentFrequency returns the weight of the term given. Unlike the plain log(N/df), the weight is smoothed so
+// that terms found in every document still contribute to relevance.
+func (ii *invertedIndex) inverseDocumentFrequency(term sql.FullTextTerm) float64 {
+	df := ii.documentFrequency(term)
+	if df == 0 {
+		return 0
+	}
+	return 1 + math.Log10(float64(ii.numDocs)/float64(df))
+}
+
+// termFrequency returns the number of times the term given appears in the words of a document.
+func termFrequency(config sql.FullTextConfig, term sql.FullTextTerm, words []string) int {
+	count := 0
+	if term.IsPhrase() {
+	Words:
+		for i := 0; i+len(term.Words) <= len(words); i++ {
+			for j, word := range term.Words {
+				if words[i+j] != word {
+					continue Words
+				}
+			}
+			count++
+		}
+		return count
+	}
+
+	for _, word := range words {
+		if !config.IsIndexable(word) {
+			continue
+		}
+		if word == term.Words[0] || (term.Prefix && strings.HasPrefix(word, term.Words[0])) {
+			count++
+		}
+	}
+	return count
+}
+
+// fullTextSearcher scores rows with the sum of the frequency of every search term in the row, weighted by the square
+// of the term's inverse document frequency.
+type fullTextSearcher struct {
+	index   *invertedIndex
+	terms   []sql.FullTextTerm
+	weights []float64
+	boolean bool
+}
+
+var _ sql.FullTextSearcher = (*fullTextSearcher)(nil)
+
+// Relevance implements sql.FullTextSearcher
+func (s *fullTextSearcher) Relevance(ctx *sql.Context, values []interface{}) (float64, error) {
+	words, err := s.index.words(values)
+	if err != nil {
+		return 0, err
+	}
+
+	relevance, negated := 0.0, 0.0
+	hasRequired, matchedOptional := false, false
+	for i, term := range s.terms {
+		tf := termFrequency(s.index.config, term, words)
+		score := float64(tf) * s.weights[i] * s.weights[i]
+		if !s.boolean {
+			relevance += score
+			continue
+		}
+
+		switch term.Operator {
+		case sql.FullTextOperator_Required:
+			if tf == 0 {
+				return 0, nil
+			}
+			hasRequired = true
+			relevance += score
+		case sql.FullTextOperator_Excluded:
+			if tf > 0 {
+				return 0, nil
+			}
+		case sql.FullTextOperator_Negated:
+			// Negated terms lower the relevance of rows matched by other terms, without excluding them
+			negated += score
+		default:
+			matchedOptional = matchedOptional || tf > 0
+			relevance += score
+		}
+	}
+
+	if s.boolean && !hasRequired && !matchedOptional {
+		return 0, nil
+	}
+	return relevance / (1 + negated), nil
+}
@@ -35,6 +35,7 @@ type MergeableIndex struct {
 
 var _ sql.Index = (*MergeableIndex)(nil)
 var _ sql.AscendIndex = (*MergeableIndex)(nil)
+var _ sql.OrderedIndex = (*MergeableIndex)(nil)
 var _ sql.DescendIndex = (*MergeableIndex)(nil)
 var _ sql.NegateIndex = (*MergeableIndex)(nil)
 
@@ -79,6 +80,10 @@ func (i *MergeableIndex) AscendRange(greaterOrEqual, lessThan []interface{}) (sq
 	return &AscendIndexLookup{Gte: greaterOrEqual, Lt: lessThan, Index: i}, nil
 }
 
+func (i *MergeableIndex) AscendAll() (sql.IndexLookup, error) {
+	return &OrderedIndexLookup{Index: i}, nil
+}
+
 func (i *MergeableIndex) DescendGreater(keys ...interface{}) (sql.IndexLookup, error) {
 	return &DescendIndexLookup{Gt: keys, Index: i}, nil
 }
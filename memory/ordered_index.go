@@ -0,0 +1,100 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// OrderedIndexLookup is the lookup of all the rows of a table in ascending order of the expressions of an index. All
+// the rows are returned by the first partition of the table, so that they're sorted together.
+type OrderedIndexLookup struct {
+	Index ExpressionsIndex
+}
+
+var _ sql.DriverIndexLookup = (*OrderedIndexLookup)(nil)
+
+func (l *OrderedIndexLookup) String() string {
+	exprs := make([]string, len(l.Index.ColumnExpressions()))
+	for i, e := range l.Index.ColumnExpressions() {
+		exprs[i] = e.String()
+	}
+	return fmt.Sprintf("ordered by %s", strings.Join(exprs, ", "))
+}
+
+func (l *OrderedIndexLookup) Indexes() []string {
+	return []string{l.Index.(sql.Index).ID()}
+}
+
+func (l *OrderedIndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	tbl := l.Index.MemTable()
+	rows := tbl.allRows()
+	exprs := l.Index.ColumnExpressions()
+
+	keys := make([]sql.Row, len(rows))
+	for i, row := range rows {
+		key := make(sql.Row, len(exprs))
+		for j, expr := range exprs {
+			var err error
+			key[j], err = expr.Eval(sql.NewEmptyContext(), row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		keys[i] = key
+	}
+
+	positions := make([]int, len(rows))
+	for i := range positions {
+		positions[i] = i
+	}
+	var err error
+	sort.SliceStable(positions, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		a, b := keys[positions[i]], keys[positions[j]]
+		for k, expr := range exprs {
+			// NULLs compare as less than any other value
+			var cmp int
+			cmp, err = expr.Type().Compare(a[k], b[k])
+			if err != nil || cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(positions))
+	for i, pos := range positions {
+		values[i], err = EncodeIndexValue(&IndexValue{Pos: pos})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &indexValIter{
+		tbl:       tbl,
+		partition: p,
+		values:    values,
+	}, nil
+}
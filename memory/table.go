@@ -58,6 +58,9 @@ type Table struct {
 	// AUTO_INCREMENT bookkeeping
 	autoIncVal interface{}
 	autoColIdx int
+
+	// Column statistics computed by ANALYZE TABLE
+	statistics *sql.TableStatistics
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -76,6 +79,7 @@ var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.AnalyzableTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 
 // NewTable creates a new Table with the given name and schema.
@@ -145,9 +149,22 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 			keys = append(keys, k)
 		}
 	}
+	// Ordered lookups return the rows of all the partitions from the first one
+	if _, ok := t.lookup.(*OrderedIndexLookup); ok && len(keys) > 1 {
+		keys = keys[:1]
+	}
 	return &partitionIter{keys: keys}, nil
 }
 
+// allRows returns the rows of all the partitions of the table, in the order of the partitions.
+func (t *Table) allRows() []sql.Row {
+	var rows []sql.Row
+	for _, k := range t.keys {
+		rows = append(rows, t.partitions[string(k)]...)
+	}
+	return rows
+}
+
 // PartitionCount implements the sql.PartitionCounter interface.
 func (t *Table) PartitionCount(ctx *sql.Context) (int64, error) {
 	return int64(len(t.partitions)), nil
@@ -160,6 +177,10 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
 	}
 
+	if _, ok := t.lookup.(*OrderedIndexLookup); ok {
+		rows = t.allRows()
+	}
+
 	var values sql.IndexValueIter
 	if t.lookup != nil {
 		var err error
@@ -191,6 +212,17 @@ func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	return count, nil
 }
 
+// ColumnStatistics implements the sql.AnalyzableTable interface.
+func (t *Table) ColumnStatistics(ctx *sql.Context) (*sql.TableStatistics, error) {
+	return t.statistics, nil
+}
+
+// SetColumnStatistics implements the sql.AnalyzableTable interface.
+func (t *Table) SetColumnStatistics(ctx *sql.Context, stats *sql.TableStatistics) error {
+	t.statistics = stats
+	return nil
+}
+
 func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 	var numBytesPerRow uint64 = 0
 	for _, col := range t.schema {
@@ -411,7 +443,7 @@ func (t *tableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) e
 	t.table.insert = t.initialInsert
 	t.table.autoIncVal = t.initialAutoIncVal
 	t.table.partitions = t.initialPartitions
-	return nil
+	return t.table.rebuildFullTextIndexes()
 }
 
 func (t *tableEditor) StatementComplete(ctx *sql.Context) error {
@@ -444,7 +476,7 @@ func (t *Table) Truncate(ctx *sql.Context) (int, error) {
 		count += len(t.partitions[key])
 		t.partitions[key] = nil
 	}
-	return count, nil
+	return count, t.rebuildFullTextIndexes()
 }
 
 // Convenience method to avoid having to create an inserter in test setup
@@ -462,7 +494,7 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 		return err
 	}
 
-	if err := t.checkUniquenessConstraints(row); err != nil {
+	if err := t.checkUniquenessConstraints(ctx, row, nil); err != nil {
 		return err
 	}
 
@@ -473,19 +505,21 @@ func (t *tableEditor) Insert(ctx *sql.Context, row sql.Row) error {
 	}
 
 	t.table.partitions[key] = append(t.table.partitions[key], row)
+	if err := t.table.updateFullTextIndexes(nil, row); err != nil {
+		return err
+	}
 
 	idx := t.table.autoColIdx
 	if idx >= 0 {
-		// autoIncVal = max(autoIncVal, insertVal)
+		// autoIncVal = max(autoIncVal, insertVal + 1)
 		autoCol := t.table.schema[idx]
 		cmp, err := autoCol.Type.Compare(row[idx], t.table.autoIncVal)
 		if err != nil {
 			return err
 		}
-		if cmp > 0 {
-			t.table.autoIncVal = row[idx]
+		if cmp >= 0 {
+			t.table.autoIncVal = increment(row[idx])
 		}
-		t.table.autoIncVal = increment(t.table.autoIncVal)
 	}
 
 	return nil
@@ -562,8 +596,15 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 			// have the row to be replaced, so we need to consider primary key information.
 			pkColIdxes := t.pkColumnIndexes()
 			if len(pkColIdxes) > 0 {
-				if columnsMatch(pkColIdxes, partitionRow, row) {
+				pkMatch, err := columnsMatch(t.table.schema, pkColIdxes, partitionRow, row)
+				if err != nil {
+					return err
+				}
+				if pkMatch {
 					t.table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+					if err := t.table.updateFullTextIndexes(partitionRow, nil); err != nil {
+						return err
+					}
 					break
 				}
 			}
@@ -577,6 +618,9 @@ func (t *tableEditor) Delete(ctx *sql.Context, row sql.Row) error {
 
 			if matches {
 				t.table.partitions[partitionIndex] = append(partition[:partitionRowIndex], partition[partitionRowIndex+1:]...)
+				if err := t.table.updateFullTextIndexes(partitionRow, nil); err != nil {
+					return err
+				}
 				break
 			}
 		}
@@ -600,10 +644,8 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 		return err
 	}
 
-	if t.pkColsDiffer(oldRow, newRow) {
-		if err := t.checkUniquenessConstraints(newRow); err != nil {
-			return err
-		}
+	if err := t.checkUniquenessConstraints(ctx, newRow, oldRow); err != nil {
+		return err
 	}
 
 	matches := false
@@ -616,6 +658,9 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 			}
 			if matches {
 				t.table.partitions[partitionIndex][partitionRowIndex] = newRow
+				if err := t.table.updateFullTextIndexes(partitionRow, newRow); err != nil {
+					return err
+				}
 				break
 			}
 		}
@@ -633,18 +678,41 @@ func (t *tableEditor) SetAutoIncrementValue(ctx *sql.Context, val interface{}) e
 	return nil
 }
 
-func (t *tableEditor) checkUniquenessConstraints(row sql.Row) error {
+// checkUniquenessConstraints returns an error if the row given has the same primary key, or the same values for the
+// columns of a unique index, as any row of the table other than oldRow, which is nil for inserts.
+func (t *tableEditor) checkUniquenessConstraints(ctx *sql.Context, row, oldRow sql.Row) error {
 	pkColIdxes := t.pkColumnIndexes()
+	uniqueColIdxes := t.table.uniqueIndexColumnIndexes()
 
-	if len(pkColIdxes) > 0 {
-		for _, partition := range t.table.partitions {
-			for _, partitionRow := range partition {
-				if columnsMatch(pkColIdxes, partitionRow, row) {
-					vals := make([]interface{}, len(pkColIdxes))
-					for _, i := range pkColIdxes {
-						vals[i] = row[pkColIdxes[i]]
-					}
-					return sql.NewUniqueKeyErr(fmt.Sprint(vals), true, partitionRow)
+	for _, partition := range t.table.partitions {
+		for _, partitionRow := range partition {
+			if oldRow != nil {
+				isOldRow, err := rowsAreEqual(ctx, t.table.schema, oldRow, partitionRow)
+				if err != nil {
+					return err
+				}
+				if isOldRow {
+					continue
+				}
+			}
+
+			if len(pkColIdxes) > 0 {
+				match, err := columnsMatch(t.table.schema, pkColIdxes, partitionRow, row)
+				if err != nil {
+					return err
+				}
+				if match {
+					return sql.NewUniqueKeyErr(keyString(pkColIdxes, row), true, partitionRow)
+				}
+			}
+
+			for _, colIdxes := range uniqueColIdxes {
+				match, err := uniqueColumnsMatch(t.table.schema, colIdxes, partitionRow, row)
+				if err != nil {
+					return err
+				}
+				if match {
+					return sql.NewUniqueKeyErr(keyString(colIdxes, row), false, partitionRow)
 				}
 			}
 		}
@@ -653,6 +721,34 @@ func (t *tableEditor) checkUniquenessConstraints(row sql.Row) error {
 	return nil
 }
 
+// uniqueIndexColumnIndexes returns the indexes of the columns of every unique index of this table.
+func (t *Table) uniqueIndexColumnIndexes() [][]int {
+	var colIdxes [][]int
+	for _, index := range t.indexes {
+		if !index.IsUnique() {
+			continue
+		}
+		if idxes := t.indexColumnIndexes(index); len(idxes) > 0 {
+			colIdxes = append(colIdxes, idxes)
+		}
+	}
+	return colIdxes
+}
+
+// indexColumnIndexes returns the indexes of the columns of the index given, or nil if any of its columns are no longer
+// in the table.
+func (t *Table) indexColumnIndexes(index sql.Index) []int {
+	var colIdxes []int
+	for _, expr := range index.Expressions() {
+		idx, _ := t.getField(expr[strings.LastIndex(expr, ".")+1:])
+		if idx < 0 {
+			return nil
+		}
+		colIdxes = append(colIdxes, idx)
+	}
+	return colIdxes
+}
+
 func (t *tableEditor) pkColumnIndexes() []int {
 	var pkColIdxes []int
 	for _, column := range t.table.schema {
@@ -664,19 +760,39 @@ func (t *tableEditor) pkColumnIndexes() []int {
 	return pkColIdxes
 }
 
-func (t *tableEditor) pkColsDiffer(row, row2 sql.Row) bool {
-	pkColIdxes := t.pkColumnIndexes()
-	return !columnsMatch(pkColIdxes, row, row2)
+// Returns whether the values for the columns given match in the two rows provided. Values are compared by the types of
+// their columns, so strings that are equal in the collation of their column match.
+func columnsMatch(schema sql.Schema, colIndexes []int, row sql.Row, row2 sql.Row) (bool, error) {
+	for _, i := range colIndexes {
+		cmp, err := schema[i].Type.Compare(row[i], row2[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
-// Returns whether the values for the columns given match in the two rows provided
-func columnsMatch(colIndexes []int, row sql.Row, row2 sql.Row) bool {
+// Returns whether the values for the columns of a unique index match in the two rows provided. Unlike primary keys,
+// unique indexes allow any number of rows with NULL values.
+func uniqueColumnsMatch(schema sql.Schema, colIndexes []int, row sql.Row, row2 sql.Row) (bool, error) {
 	for _, i := range colIndexes {
-		if row[i] != row2[i] {
-			return false
+		if row[i] == nil || row2[i] == nil {
+			return false, nil
 		}
 	}
-	return true
+	return columnsMatch(schema, colIndexes, row, row2)
+}
+
+// keyString returns the values of the columns given of a row, for errors about duplicate keys.
+func keyString(colIndexes []int, row sql.Row) string {
+	vals := make([]interface{}, len(colIndexes))
+	for i, idx := range colIndexes {
+		vals[i] = row[idx]
+	}
+	return fmt.Sprint(vals)
 }
 
 // PeekNextAutoIncrementValue peeks at the next AUTO_INCREMENT value
@@ -684,16 +800,33 @@ func (t *Table) PeekNextAutoIncrementValue(*sql.Context) (interface{}, error) {
 	return t.autoIncVal, nil
 }
 
-// GetNextAutoIncrementValue gets the next auto increment value for the memory table the increment.
+// GetNextAutoIncrementValue gets the next auto increment value for the memory table. A value given by the insert is
+// used as is, and moves the sequence forward when it is past it. Generated values follow the series defined by
+// auto_increment_increment and auto_increment_offset.
 func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{}) (interface{}, error) {
 	autoIncCol := t.schema[t.autoColIdx]
-	cmp, err := autoIncCol.Type.Compare(insertVal, t.autoIncVal)
-	if err != nil {
-		return nil, err
+	if insertVal != nil {
+		cmp, err := autoIncCol.Type.Compare(insertVal, t.autoIncVal)
+		if err != nil {
+			return nil, err
+		}
+		if cmp > 0 {
+			t.autoIncVal = insertVal
+		}
+		return insertVal, nil
 	}
 
-	if cmp > 0 {
-		t.autoIncVal = insertVal
+	increment, offset := sql.AutoIncrementStep(ctx)
+	if increment > 1 {
+		cur, err := sql.Uint64.Convert(t.autoIncVal)
+		if err != nil {
+			return nil, err
+		}
+		next, err := autoIncCol.Type.Convert(sql.NextAutoIncrementValue(cur.(uint64), increment, offset))
+		if err != nil {
+			return nil, err
+		}
+		t.autoIncVal = next
 	}
 
 	return t.autoIncVal, nil
@@ -701,7 +834,14 @@ func (t *Table) GetNextAutoIncrementValue(ctx *sql.Context, insertVal interface{
 
 func (t *Table) AddColumn(ctx *sql.Context, column *sql.Column, order *sql.ColumnOrder) error {
 	newColIdx := t.addColumnToSchema(ctx, column, order)
-	return t.insertValueInRows(ctx, newColIdx, column.Default)
+	colDefault := column.Default
+	if column.Generated != nil {
+		colDefault = column.Generated
+	}
+	if err := t.insertValueInRows(ctx, newColIdx, colDefault); err != nil {
+		return err
+	}
+	return t.rebuildFullTextIndexes()
 }
 
 // addColumnToSchema adds the given column to the schema and returns the new index
@@ -730,19 +870,28 @@ func (t *Table) addColumnToSchema(ctx *sql.Context, newCol *sql.Column, order *s
 		if i == newColIdx {
 			continue
 		}
-		newDefault, _ := expression.TransformUp(ctx, newSchCol.Default, func(expr sql.Expression) (sql.Expression, error) {
-			if expr, ok := expr.(*expression.GetField); ok {
-				return expr.WithIndex(newSch.IndexOf(expr.Name(), t.name)), nil
-			}
-			return expr, nil
-		})
-		newSchCol.Default = newDefault.(*sql.ColumnDefaultValue)
+		t.reindexColumnExpressions(ctx, newSchCol, newSch)
 	}
 
 	t.schema = newSch
 	return newColIdx
 }
 
+// reindexColumnExpressions updates the field indexes of the default value and generation expression of the column
+// given to match the schema given.
+func (t *Table) reindexColumnExpressions(ctx *sql.Context, col *sql.Column, sch sql.Schema) {
+	reindex := func(expr sql.Expression) (sql.Expression, error) {
+		if expr, ok := expr.(*expression.GetField); ok {
+			return expr.WithIndex(sch.IndexOf(expr.Name(), t.name)), nil
+		}
+		return expr, nil
+	}
+	newDefault, _ := expression.TransformUp(ctx, col.Default, reindex)
+	col.Default = newDefault.(*sql.ColumnDefaultValue)
+	newGenerated, _ := expression.TransformUp(ctx, col.Generated, reindex)
+	col.Generated = newGenerated.(*sql.ColumnDefaultValue)
+}
+
 func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.ColumnDefaultValue) error {
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
@@ -779,7 +928,7 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 		}
 		t.partitions[k] = newP
 	}
-	return nil
+	return t.rebuildFullTextIndexes()
 }
 
 // dropColumnFromSchema drops the given column name from the schema and returns its old index.
@@ -795,6 +944,11 @@ func (t *Table) dropColumnFromSchema(ctx *sql.Context, columnName string) int {
 			droppedCol = i
 		}
 	}
+	for _, col := range newSch {
+		if col.Generated != nil {
+			t.reindexColumnExpressions(ctx, col, newSch)
+		}
+	}
 	t.schema = newSch
 	return droppedCol
 }
@@ -849,7 +1003,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 
 	_ = t.dropColumnFromSchema(ctx, columnName)
 	t.addColumnToSchema(ctx, column, order)
-	return nil
+	return t.rebuildFullTextIndexes()
 }
 
 func checkRow(schema sql.Schema, row sql.Row) error {
@@ -1147,7 +1301,11 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
 	}
 
-	return &UnmergeableIndex{
+	if constraint == sql.IndexConstraint_Fulltext {
+		return newFullTextIndex(t, name, exprs, comment)
+	}
+
+	index := UnmergeableIndex{
 		MergeableIndex{
 			DB:         "",
 			DriverName: "",
@@ -1158,7 +1316,48 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 			Unique:     constraint == sql.IndexConstraint_Unique,
 			CommentStr: comment,
 		},
-	}, nil
+	}
+
+	// Indexes on a single VECTOR column can also find the rows nearest to a vector
+	if len(exprs) == 1 && sql.IsVector(exprs[0].Type()) {
+		return &VectorIndex{index}, nil
+	}
+	return &index, nil
+}
+
+// updateFullTextIndexes removes oldRow from the full-text indexes of this table and adds newRow to them. Either row may
+// be nil.
+func (t *Table) updateFullTextIndexes(oldRow, newRow sql.Row) error {
+	for _, index := range t.indexes {
+		ftIndex, ok := index.(*FullTextIndex)
+		if !ok {
+			continue
+		}
+		if oldRow != nil {
+			if err := ftIndex.remove(oldRow); err != nil {
+				return err
+			}
+		}
+		if newRow != nil {
+			if err := ftIndex.add(newRow); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildFullTextIndexes builds the full-text indexes of this table again, after its rows were replaced or its schema
+// changed.
+func (t *Table) rebuildFullTextIndexes() error {
+	for _, index := range t.indexes {
+		if ftIndex, ok := index.(*FullTextIndex); ok {
+			if err := ftIndex.rebuild(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // getField returns the index and column index with the name given, if it exists, or -1, nil otherwise.
@@ -1182,10 +1381,42 @@ func (t *Table) CreateIndex(ctx *sql.Context, indexName string, using sql.IndexU
 		return err
 	}
 
+	if index.IsUnique() {
+		if err := t.checkUniqueIndex(index); err != nil {
+			return err
+		}
+	}
+
 	t.indexes[indexName] = index
 	return nil
 }
 
+// checkUniqueIndex returns an error if any two rows of the table have the same values for the columns of the unique
+// index given.
+func (t *Table) checkUniqueIndex(index sql.Index) error {
+	colIdxes := t.indexColumnIndexes(index)
+	if len(colIdxes) == 0 {
+		return nil
+	}
+
+	var rows []sql.Row
+	for _, key := range t.keys {
+		rows = append(rows, t.partitions[string(key)]...)
+	}
+	for i := range rows {
+		for j := i + 1; j < len(rows); j++ {
+			match, err := uniqueColumnsMatch(t.schema, colIdxes, rows[i], rows[j])
+			if err != nil {
+				return err
+			}
+			if match {
+				return sql.NewUniqueKeyErr(keyString(colIdxes, rows[j]), false, rows[i])
+			}
+		}
+	}
+	return nil
+}
+
 // DropIndex implements sql.IndexAlterableTable
 func (t *Table) DropIndex(ctx *sql.Context, indexName string) error {
 	for name := range t.indexes {
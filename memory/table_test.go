@@ -326,6 +326,66 @@ func TestIndexed(t *testing.T) {
 	}
 }
 
+func TestFullTextIndexSettings(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewTable("articles", sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "articles", PrimaryKey: true},
+		{Name: "body", Type: sql.Text, Source: "articles", Nullable: true},
+	})
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(1), "how to use the index")))
+
+	// The settings in effect when the index is created apply to it until it is created again
+	require.NoError(sql.SystemVariables.AssignValues(map[string]interface{}{"ft_min_word_len": 3}))
+	err := table.CreateIndex(ctx, "ft_body", sql.IndexUsing_Default, sql.IndexConstraint_Fulltext,
+		[]sql.IndexColumn{{Name: "body"}}, "")
+	require.NoError(sql.SystemVariables.AssignValues(map[string]interface{}{"ft_min_word_len": 4}))
+	require.NoError(err)
+	require.NoError(table.Insert(ctx, sql.NewRow(int64(2), "use it well")))
+
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	var index sql.FullTextIndex
+	for _, idx := range indexes {
+		if ftIndex, ok := idx.(sql.FullTextIndex); ok {
+			index = ftIndex
+		}
+	}
+	require.NotNil(index)
+
+	searcher, err := index.FullTextSearch(ctx, "use", sql.FullTextSearchMode_NaturalLanguage)
+	require.NoError(err)
+	for _, body := range []string{"how to use the index", "use it well"} {
+		relevance, err := searcher.Relevance(ctx, []interface{}{body})
+		require.NoError(err)
+		require.True(relevance > 0)
+	}
+}
+
+func TestOrderedIndexLookup(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("t", sql.Schema{
+		{Name: "a", Source: "t", Type: sql.Int64, Nullable: true},
+		{Name: "b", Source: "t", Type: sql.Text},
+	}, 3)
+	for _, row := range []sql.Row{{int64(3), "x"}, {nil, "y"}, {int64(1), "z"}, {int64(2), "w"}, {int64(0), "v"}} {
+		require.NoError(table.Insert(ctx, row))
+	}
+	require.NoError(table.CreateIndex(ctx, "idx_a", sql.IndexUsing_Default, sql.IndexConstraint_None, []sql.IndexColumn{{Name: "a"}}, ""))
+
+	indexes, err := table.GetIndexes(ctx)
+	require.NoError(err)
+	require.Len(indexes, 1)
+	lookup, err := indexes[0].(sql.OrderedIndex).AscendAll()
+	require.NoError(err)
+
+	rows := getAllRows(t, table.WithIndexLookup(lookup))
+	require.Equal([]sql.Row{{nil, "y"}, {int64(0), "v"}, {int64(1), "z"}, {int64(2), "w"}, {int64(3), "x"}}, rows)
+}
+
 func getAllRows(t *testing.T, table sql.Table) []sql.Row {
 	var require = require.New(t)
 
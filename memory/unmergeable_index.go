@@ -33,6 +33,7 @@ type UnmergeableIndex struct {
 
 var _ sql.Index = (*UnmergeableIndex)(nil)
 var _ sql.AscendIndex = (*UnmergeableIndex)(nil)
+var _ sql.OrderedIndex = (*UnmergeableIndex)(nil)
 var _ sql.DescendIndex = (*UnmergeableIndex)(nil)
 var _ sql.NegateIndex = (*UnmergeableIndex)(nil)
 
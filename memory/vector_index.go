@@ -0,0 +1,115 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// VectorIndex is an index on a VECTOR column of a memory table. It finds the rows nearest to a vector by computing the
+// distance of every row when the lookup is used, so its lookups are exact. It is used for regular lookups as well.
+type VectorIndex struct {
+	UnmergeableIndex
+}
+
+var _ sql.VectorIndex = (*VectorIndex)(nil)
+
+// Nearest implements sql.VectorIndex
+func (i *VectorIndex) Nearest(ctx *sql.Context, distance sql.VectorDistance, vec []float32, k int) (sql.IndexLookup, error) {
+	return &VectorIndexLookup{
+		Index:    i,
+		Distance: distance,
+		Vector:   vec,
+		K:        k,
+	}, nil
+}
+
+// VectorIndexLookup is the lookup of the K rows nearest to a vector in a VectorIndex. The K nearest rows of every
+// partition are returned, nearest first.
+type VectorIndexLookup struct {
+	Index    *VectorIndex
+	Distance sql.VectorDistance
+	Vector   []float32
+	K        int
+}
+
+var _ sql.DriverIndexLookup = (*VectorIndexLookup)(nil)
+
+func (l *VectorIndexLookup) String() string {
+	return fmt.Sprintf("%d nearest by %s to %s", l.K, l.Distance, sql.FormatVector(l.Vector))
+}
+
+func (l *VectorIndexLookup) Indexes() []string {
+	return []string{l.Index.ID()}
+}
+
+func (l *VectorIndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+	tbl := l.Index.MemTable()
+	rows, ok := tbl.partitions[string(p.Key())]
+	if !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
+	}
+
+	// The column is found by name, as its position may have changed since the index was created
+	colIdx, _ := tbl.getField(l.Index.Exprs[0].(*expression.GetField).Name())
+	if colIdx < 0 {
+		return nil, fmt.Errorf("column %s of vector index %s is not in the table", l.Index.Exprs[0], l.Index.ID())
+	}
+
+	type neighbor struct {
+		pos      int
+		distance float64
+	}
+	var neighbors []neighbor
+	for pos, row := range rows {
+		if row[colIdx] == nil {
+			continue
+		}
+		vec, err := sql.Vector.Convert(row[colIdx])
+		if err != nil {
+			return nil, err
+		}
+		dist, err := l.Distance.Distance(vec.([]float32), l.Vector)
+		if err != nil {
+			return nil, err
+		}
+		neighbors = append(neighbors, neighbor{pos: pos, distance: dist})
+	}
+	sort.SliceStable(neighbors, func(i, j int) bool {
+		return neighbors[i].distance < neighbors[j].distance
+	})
+	if len(neighbors) > l.K {
+		neighbors = neighbors[:l.K]
+	}
+
+	values := make([][]byte, len(neighbors))
+	for i, n := range neighbors {
+		encoded, err := EncodeIndexValue(&IndexValue{Pos: n.pos})
+		if err != nil {
+			return nil, err
+		}
+		values[i] = encoded
+	}
+
+	return &indexValIter{
+		tbl:       tbl,
+		partition: p,
+		values:    values,
+	}, nil
+}
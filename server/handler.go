@@ -92,7 +92,7 @@ func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error
 	if err != nil {
 		return nil, err
 	}
-	return schemaToFields(schema), nil
+	return schemaToFields(schema, sql.CharacterSet_utf8mb4), nil
 }
 
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
@@ -255,6 +255,8 @@ func (h *Handler) doQuery(
 		return err
 	}
 
+	query = decodeQuery(ctx, query)
+
 	finish := observeQuery(ctx, query)
 	defer finish(err)
 
@@ -312,6 +314,7 @@ func (h *Handler) doQuery(
 
 	var r *sqltypes.Result
 	var proccesedAtLeastOneBatch bool
+	resultsCharset := resultsCharacterSet(ctx)
 
 	// Reads rows from the row reading goroutine
 	rowChan := make(chan sql.Row)
@@ -351,7 +354,7 @@ func (h *Handler) doQuery(
 rowLoop:
 	for {
 		if r == nil {
-			r = &sqltypes.Result{Fields: schemaToFields(schema)}
+			r = &sqltypes.Result{Fields: schemaToFields(schema, resultsCharset)}
 		}
 
 		if r.RowsAffected == rowsBatch {
@@ -383,7 +386,7 @@ rowLoop:
 				break rowLoop
 			}
 
-			outputRow, err := rowToSQL(schema, row)
+			outputRow, err := rowToSQL(schema, row, resultsCharset)
 			if err != nil {
 				close(quit)
 				return err
@@ -630,7 +633,8 @@ func (h *Handler) handleKill(conn *mysql.Conn, query string) (bool, error) {
 	return true, nil
 }
 
-func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
+// rowToSQL converts the row given to the values sent to the client, encoding its strings in the character set given.
+func rowToSQL(s sql.Schema, row sql.Row, charset sql.CharacterSet) ([]sqltypes.Value, error) {
 	o := make([]sqltypes.Value, len(row))
 	var err error
 	for i, v := range row {
@@ -643,29 +647,85 @@ func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		if isEncodedText(s[i].Type, charset) {
+			encoded, _ := charset.Encode(o[i].ToString())
+			o[i] = sqltypes.MakeTrusted(o[i].Type(), encoded)
+		}
 	}
 
 	return o, nil
 }
 
-func schemaToFields(s sql.Schema) []*query.Field {
+func schemaToFields(s sql.Schema, charset sql.CharacterSet) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
-		var charset uint32 = mysql.CharacterSetUtf8
+		var charsetID uint32 = mysql.CharacterSetUtf8
 		if sql.IsBlob(c.Type) {
-			charset = mysql.CharacterSetBinary
+			charsetID = mysql.CharacterSetBinary
+		} else if sql.IsCustomType(c.Type) && sqltypes.IsBinary(c.Type.Type()) {
+			// Custom types are sent as the MySQL type that they declare, with binary values if it is a binary type
+			charsetID = mysql.CharacterSetBinary
+		} else if isEncodedText(c.Type, charset) {
+			charsetID = uint32(charset.DefaultCollation().ID())
 		}
 
 		fields[i] = &query.Field{
 			Name:    c.Name,
 			Type:    c.Type.Type(),
-			Charset: charset,
+			Charset: charsetID,
 		}
 	}
 
 	return fields
 }
 
+// isEncodedText returns whether values of the type given are text that must be encoded in the character set given
+// before being sent to the client. Strings are held as UTF-8, so only character sets other than utf8mb4 need encoding.
+func isEncodedText(t sql.Type, charset sql.CharacterSet) bool {
+	if charset == sql.CharacterSet_binary || charset == sql.CharacterSet_utf8mb4 {
+		return false
+	}
+	st, ok := t.(sql.StringType)
+	return ok && st.CharacterSet() != sql.CharacterSet_binary
+}
+
+// resultsCharacterSet returns the character set that the session's character_set_results asks for results in. Results
+// are not converted when the variable is NULL, which is the same as asking for the binary character set.
+func resultsCharacterSet(ctx *sql.Context) sql.CharacterSet {
+	return characterSetVariable(ctx, "character_set_results")
+}
+
+// decodeQuery returns the query given, which was sent in the session's character_set_client, as UTF-8. Queries in
+// utf8 and utf8mb4 are used as is, as they may hold the bytes of binary strings.
+func decodeQuery(ctx *sql.Context, query string) string {
+	switch charset := characterSetVariable(ctx, "character_set_client"); charset {
+	case sql.CharacterSet_binary, sql.CharacterSet_utf8, sql.CharacterSet_utf8mb3, sql.CharacterSet_utf8mb4:
+		return query
+	default:
+		decoded, _ := charset.Decode([]byte(query))
+		return decoded
+	}
+}
+
+// characterSetVariable returns the character set named by the session variable given, or binary if it is NULL or does
+// not name a character set.
+func characterSetVariable(ctx *sql.Context, name string) sql.CharacterSet {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return sql.CharacterSet_binary
+	}
+	s, ok := val.(string)
+	if !ok {
+		return sql.CharacterSet_binary
+	}
+	charset, err := sql.ParseCharacterSet(strings.ToLower(s))
+	if err != nil {
+		return sql.CharacterSet_binary
+	}
+	return charset
+}
+
 var (
 	// QueryCounter describes a metric that accumulates number of queries monotonically.
 	QueryCounter = discard.NewCounter()
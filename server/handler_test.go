@@ -0,0 +1,133 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func newCharsetTestHandler(require *require.Assertions) *Handler {
+	e := setupMemDB(require)
+	db, err := e.Catalog.Database("test")
+	require.NoError(err)
+
+	vectors := memory.NewTable("vectors", sql.Schema{
+		{Name: "id", Type: sql.Int32, Source: "vectors", PrimaryKey: true},
+		{Name: "v", Type: sql.MustCreateVectorType(2), Source: "vectors"},
+	})
+	require.NoError(vectors.Insert(sql.NewEmptyContext(), sql.NewRow(int32(1), []float32{1, 2})))
+	db.(*memory.Database).AddTable("vectors", vectors)
+
+	return NewHandler(
+		logrus.NewEntry(logrus.StandardLogger()),
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			"foo",
+		),
+	)
+}
+
+// runQuery runs the query given on the connection given, and returns the fields and rows of its last result.
+func runQuery(require *require.Assertions, handler *Handler, conn *mysql.Conn, query string) *sqltypes.Result {
+	var result *sqltypes.Result
+	err := handler.ComQuery(conn, query, func(res *sqltypes.Result) error {
+		if result == nil || len(res.Fields) > 0 {
+			result = res
+		} else {
+			result.Rows = append(result.Rows, res.Rows...)
+		}
+		return nil
+	})
+	require.NoError(err)
+	require.NotNil(result)
+	return result
+}
+
+func TestHandlerCharacterSetResults(t *testing.T) {
+	require := require.New(t)
+	handler := newCharsetTestHandler(require)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	// Results are sent in utf8mb4 by default
+	result := runQuery(require, handler, conn, "SELECT 'café' AS s")
+	require.Equal(uint32(mysql.CharacterSetUtf8), result.Fields[0].Charset)
+	require.Equal([]byte("café"), result.Rows[0][0].Raw())
+
+	runQuery(require, handler, conn, "SET character_set_results = 'latin1'")
+	result = runQuery(require, handler, conn, "SELECT 'café' AS s, 7 AS n")
+	require.Equal(uint32(sql.Collation_latin1_swedish_ci.ID()), result.Fields[0].Charset)
+	require.Equal([]byte{'c', 'a', 'f', 0xe9}, result.Rows[0][0].Raw())
+	require.Equal(uint32(mysql.CharacterSetUtf8), result.Fields[1].Charset)
+	require.Equal([]byte("7"), result.Rows[0][1].Raw())
+
+	// NULL asks for results without any conversion
+	runQuery(require, handler, conn, "SET character_set_results = NULL")
+	result = runQuery(require, handler, conn, "SELECT 'café' AS s")
+	require.Equal(uint32(mysql.CharacterSetUtf8), result.Fields[0].Charset)
+	require.Equal([]byte("café"), result.Rows[0][0].Raw())
+}
+
+func TestHandlerCharacterSetClient(t *testing.T) {
+	require := require.New(t)
+	handler := newCharsetTestHandler(require)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	runQuery(require, handler, conn, "SET character_set_client = 'latin1'")
+	result := runQuery(require, handler, conn, "SELECT 'caf\xe9' AS s, LENGTH('caf\xe9') AS l")
+	require.Equal([]byte("café"), result.Rows[0][0].Raw())
+	require.Equal([]byte("5"), result.Rows[0][1].Raw())
+
+	// Queries in utf8mb4 are used as they are sent
+	runQuery(require, handler, conn, "SET character_set_client = 'utf8mb4'")
+	result = runQuery(require, handler, conn, "SELECT 'café' AS s")
+	require.Equal([]byte("café"), result.Rows[0][0].Raw())
+}
+
+func TestHandlerBinaryCustomType(t *testing.T) {
+	require := require.New(t)
+	handler := newCharsetTestHandler(require)
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	require.NoError(handler.ComInitDB(conn, "test"))
+
+	expected := []byte{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}
+	result := runQuery(require, handler, conn, "SELECT v FROM vectors")
+	require.Equal(uint32(mysql.CharacterSetBinary), result.Fields[0].Charset)
+	require.Equal(sql.MustCreateVectorType(2).Type(), result.Fields[0].Type)
+	require.Equal(expected, result.Rows[0][0].Raw())
+
+	// Binary values are not converted to the character set of the results
+	runQuery(require, handler, conn, "SET character_set_results = 'latin1'")
+	result = runQuery(require, handler, conn, "SELECT v FROM vectors")
+	require.Equal(uint32(mysql.CharacterSetBinary), result.Fields[0].Charset)
+	require.Equal(expected, result.Rows[0][0].Raw())
+}
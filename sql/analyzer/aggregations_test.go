@@ -64,7 +64,7 @@ func TestFlattenAggregationExprs(t *testing.T) {
 			expected: plan.NewProject(
 				[]sql.Expression{
 					expression.NewArithmetic(
-						expression.NewGetField(0, sql.Float64, "SUM(foo.a)", false),
+						expression.NewGetField(0, sql.MustCreateDecimalType(41, 0), "SUM(foo.a)", false),
 						expression.NewLiteral(int64(1), sql.Int64),
 						"+",
 					),
@@ -106,7 +106,7 @@ func TestFlattenAggregationExprs(t *testing.T) {
 				[]sql.Expression{
 					expression.NewAlias("x",
 						expression.NewArithmetic(
-							expression.NewGetField(0, sql.Float64, "SUM(foo.a)", false),
+							expression.NewGetField(0, sql.MustCreateDecimalType(41, 0), "SUM(foo.a)", false),
 							expression.NewLiteral(int64(1), sql.Int64),
 							"+",
 						)),
@@ -151,7 +151,7 @@ func TestFlattenAggregationExprs(t *testing.T) {
 			expected: plan.NewProject(
 				[]sql.Expression{
 					expression.NewArithmetic(
-						expression.NewGetField(0, sql.Float64, "SUM(foo.a)", false),
+						expression.NewGetField(0, sql.MustCreateDecimalType(41, 0), "SUM(foo.a)", false),
 						expression.NewGetField(1, sql.Int64, "COUNT(foo.a)", false),
 						"/",
 					),
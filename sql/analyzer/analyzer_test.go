@@ -219,16 +219,20 @@ func TestMixInnerAndNaturalJoins(t *testing.T) {
 			expression.NewGetFieldWithTable(5, sql.Text, "mytable2", "t2", false),
 			expression.NewGetFieldWithTable(8, sql.Text, "mytable3", "t3", false),
 		},
-		plan.NewInnerJoin(
-			plan.NewInnerJoin(
+		plan.NewHashJoin(
+			plan.NewHashJoin(
 				plan.NewDecoratedNode("Projected table access on [i f t]", plan.NewResolvedTable(table.WithProjection([]string{"i", "f", "t"}), db, nil)),
 				plan.NewDecoratedNode("Projected table access on [f2 i2 t2]", plan.NewResolvedTable(table2.WithProjection([]string{"f2", "i2", "t2"}), db, nil)),
+				plan.JoinTypeInner,
 				expression.NewEquals(
 					expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", false),
 					expression.NewGetFieldWithTable(3, sql.Int32, "mytable2", "i2", false),
 				),
+				false,
+				0,
 			),
 			plan.NewDecoratedNode("Projected table access on [t3 i f2]", plan.NewResolvedTable(table3.WithProjection([]string{"t3", "i", "f2"}), db, nil)),
+			plan.JoinTypeInner,
 			expression.NewAnd(
 				expression.NewEquals(
 					expression.NewGetFieldWithTable(0, sql.Int32, "mytable", "i", false),
@@ -239,6 +243,8 @@ func TestMixInnerAndNaturalJoins(t *testing.T) {
 					expression.NewGetFieldWithTable(7, sql.Float64, "mytable3", "f2", false),
 				),
 			),
+			false,
+			0,
 		),
 	)
 
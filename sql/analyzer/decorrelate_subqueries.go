@@ -0,0 +1,325 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// decorrelateSubqueries rewrites the conjuncts of filters that are IN, NOT IN, EXISTS or NOT EXISTS expressions on
+// subqueries that reference the filtered node into semi and anti joins between the filtered node and the tables of the
+// subquery. Such subqueries would otherwise be evaluated again for every filtered row, while the joins can use the
+// hash and indexed join strategies. The predicates of the subquery that reference the filtered node become the join
+// condition, and the rest of them filter its tables.
+//
+// Only subqueries that project and filter their tables are rewritten, outside of updates and deletes. Their tables
+// must not be named like the tables of the enclosing query, which the join condition couldn't tell apart. Subqueries
+// that don't reference the filtered node are left as they are, since their results are computed once and cached.
+func decorrelateSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, ctx := ctx.Span("decorrelate_subqueries")
+	defer span.Finish()
+
+	// Subquery aliases were analyzed on their own, and their filters don't see this scope. The rows of updates and
+	// deletes must come from the table being changed, which joins would hide.
+	selector := func(parent sql.Node, child sql.Node, childNum int) bool {
+		switch parent.(type) {
+		case *plan.SubqueryAlias, *plan.Update, *plan.DeleteFrom:
+			return false
+		default:
+			return true
+		}
+	}
+
+	switch n.(type) {
+	case *plan.Update, *plan.DeleteFrom:
+		return n, nil
+	}
+
+	return plan.TransformUpWithSelector(n, selector, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok || !filter.Child.Resolved() {
+			return n, nil
+		}
+
+		child := filter.Child
+		var remaining []sql.Expression
+		for _, e := range splitConjunction(filter.Expression) {
+			joined, ok, err := decorrelateSubquery(ctx, a, plan.NewFilter(filter.Expression, child), e, scope)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				child = joined
+			} else {
+				remaining = append(remaining, e)
+			}
+		}
+
+		if child == filter.Child {
+			return n, nil
+		}
+		if len(remaining) == 0 {
+			return child, nil
+		}
+		return plan.NewFilter(expression.JoinAnd(remaining...), child), nil
+	})
+}
+
+// decorrelateSubquery returns a semi or anti join between the child of the filter given and the tables of the
+// subquery in the filter expression given, and true, if the expression can be rewritten to such a join.
+func decorrelateSubquery(ctx *sql.Context, a *Analyzer, filter *plan.Filter, e sql.Expression, scope *Scope) (sql.Node, bool, error) {
+	negated := false
+	if not, ok := e.(*expression.Not); ok {
+		negated = true
+		e = not.Child
+	}
+
+	var left sql.Expression
+	var subquery *plan.Subquery
+	switch e := e.(type) {
+	case *plan.InSubquery:
+		s, ok := e.Right.(*plan.Subquery)
+		if !ok || sql.NumColumns(e.Left.Type()) != 1 || hasSubqueryExpression(e.Left) {
+			return nil, false, nil
+		}
+		left, subquery = e.Left, s
+	case *plan.ExistsSubquery:
+		subquery = e.Query
+	default:
+		return nil, false, nil
+	}
+
+	// The subquery is only analyzed up to the point its columns are resolved, so that its tables can be joined and
+	// planned along with the rest of the query.
+	subqueryCtx, cancelFunc := ctx.NewSubContext()
+	defer cancelFunc()
+	subScope := scope.newScope(filter)
+	analyzed, err := a.analyzeThroughBatch(subqueryCtx, subquery.Query, subScope, "default-rules")
+	if err != nil {
+		// Errors are reported when the subquery is analyzed as an expression
+		return nil, false, nil
+	}
+	analyzed = stripQueryProcess(analyzed)
+	if !analyzed.Resolved() {
+		return nil, false, nil
+	}
+
+	projections, filters, source, ok := subquerySource(analyzed, left == nil)
+	if !ok || !decorrelatableSource(source, len(subScope.Schema())) {
+		return nil, false, nil
+	}
+	if left != nil && len(projections) != 1 {
+		return nil, false, nil
+	}
+
+	outerNames := make(map[string]bool)
+	for _, col := range subScope.Schema() {
+		outerNames[strings.ToLower(col.Source)] = true
+	}
+	// The filtered node may already be joined to the tables of other subqueries
+	for _, table := range getTablesOrSubqueryAliases(filter.Child) {
+		outerNames[strings.ToLower(table.Name())] = true
+	}
+	for _, table := range getTablesOrSubqueryAliases(source) {
+		if outerNames[strings.ToLower(table.Name())] {
+			return nil, false, nil
+		}
+	}
+
+	// The predicates that reference the filtered node, or an outer scope, are evaluated by the join
+	scopeLen := len(subScope.Schema())
+	var correlated, local []sql.Expression
+	for _, f := range filters {
+		if hasSubqueryExpression(f) {
+			return nil, false, nil
+		}
+		if exprIsCacheable(f, scopeLen) {
+			local = append(local, f)
+		} else {
+			correlated = append(correlated, f)
+		}
+	}
+
+	var cond []sql.Expression
+	if left != nil {
+		right := projections[0]
+		if alias, ok := right.(*expression.Alias); ok {
+			right = alias.Child
+		}
+		if hasSubqueryExpression(right) || (len(correlated) == 0 && exprIsCacheable(right, scopeLen)) {
+			return nil, false, nil
+		}
+		cond = append(cond, inSubqueryJoinCondition(left, right, negated))
+	} else if len(correlated) == 0 {
+		return nil, false, nil
+	}
+	for _, c := range correlated {
+		if !containsExpression(cond, c) {
+			cond = append(cond, c)
+		}
+	}
+
+	// The subquery was resolved in the scope of the filter, and its tables are now joined to the filtered node
+	selector := func(parent sql.Node, child sql.Node, childNum int) bool {
+		_, isSubqueryAlias := parent.(*plan.SubqueryAlias)
+		return !isSubqueryAlias
+	}
+	right, err := plan.TransformUpWithSelector(source, selector, func(n sql.Node) (sql.Node, error) {
+		return FixFieldIndexesForExpressions(ctx, a, n, scope)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(local) > 0 {
+		filterExpr, err := FixFieldIndexes(ctx, scope, a, right.Schema(), expression.JoinAnd(local...))
+		if err != nil {
+			return nil, false, err
+		}
+		right = plan.NewFilter(filterExpr, right)
+	}
+
+	joinCond, err := FixFieldIndexes(ctx, scope, a, append(filter.Child.Schema(), right.Schema()...), expression.JoinAnd(cond...))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if negated {
+		a.Log("rewriting NOT %s as an anti join", e)
+		return plan.NewAntiJoin(filter.Child, right, joinCond), true, nil
+	}
+	a.Log("rewriting %s as a semi join", e)
+	return plan.NewSemiJoin(filter.Child, right, joinCond), true, nil
+}
+
+// inSubqueryJoinCondition returns the condition of the semi or anti join for `left IN (SELECT right ...)`. A NOT IN
+// is only true when no value is equal to the left one and none of them is NULL, and is never true for a NULL left
+// value unless the subquery is empty, so the anti join also matches the rows where either of them is NULL.
+func inSubqueryJoinCondition(left, right sql.Expression, negated bool) sql.Expression {
+	equals := expression.NewEquals(left, right)
+	if !negated {
+		return equals
+	}
+
+	var cond sql.Expression = equals
+	if left.IsNullable() {
+		cond = expression.NewOr(cond, expression.NewIsNull(left))
+	}
+	if right.IsNullable() {
+		cond = expression.NewOr(cond, expression.NewIsNull(right))
+	}
+	return cond
+}
+
+// subquerySource returns the projections and filter predicates of the subquery given, and the node they read, if
+// the subquery doesn't do anything else that the semi or anti join of its expression could depend on. The order and
+// duplicates of the rows of the subquery don't change the result of IN or EXISTS, and neither does a positive limit
+// for EXISTS.
+func subquerySource(n sql.Node, exists bool) ([]sql.Expression, []sql.Expression, sql.Node, bool) {
+	var projections, filters []sql.Expression
+	projected := false
+	for {
+		switch node := n.(type) {
+		case *plan.Distinct:
+			n = node.Child
+		case *plan.OrderedDistinct:
+			n = node.Child
+		case *plan.Sort:
+			n = node.Child
+		case *plan.Limit:
+			if !exists || projected || !isPositiveLiteral(node.Limit) {
+				return nil, nil, nil, false
+			}
+			n = node.Child
+		case *plan.Project:
+			if projected {
+				return nil, nil, nil, false
+			}
+			projected = true
+			projections = node.Projections
+			n = node.Child
+		case *plan.Filter:
+			if !projected {
+				return nil, nil, nil, false
+			}
+			filters = append(filters, splitConjunction(node.Expression)...)
+			n = node.Child
+		default:
+			return projections, filters, n, projected
+		}
+	}
+}
+
+// isPositiveLiteral returns whether the expression given is a literal number greater than zero.
+func isPositiveLiteral(e sql.Expression) bool {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return false
+	}
+	v, err := sql.Int64.Convert(lit.Value())
+	return err == nil && v.(int64) > 0
+}
+
+// decorrelatableSource returns whether the source of a subquery only consists of tables and joins between them
+// without references to the scope of the subquery, whose length is given, nor subquery expressions of their own.
+func decorrelatableSource(n sql.Node, scopeLen int) bool {
+	ok := true
+	plan.Inspect(n, func(n sql.Node) bool {
+		if !ok {
+			return false
+		}
+		switch n := n.(type) {
+		case *plan.SubqueryAlias:
+			// Subquery aliases can't see the scope of the subquery
+			return false
+		case *plan.ResolvedTable, *plan.TableAlias, *plan.ValueDerivedTable:
+		case *plan.InnerJoin, *plan.LeftJoin, *plan.RightJoin, *plan.CrossJoin:
+			if j, isJoin := n.(plan.JoinNode); isJoin {
+				ok = !hasSubqueryExpression(j.JoinCond()) && exprIsCacheable(j.JoinCond(), scopeLen)
+			}
+		case nil:
+		default:
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// containsExpression returns whether the expressions given include one equal to the expression given.
+func containsExpression(exprs []sql.Expression, e sql.Expression) bool {
+	for _, expr := range exprs {
+		if reflect.DeepEqual(expr, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSubqueryExpression returns whether the expression given contains a subquery.
+func hasSubqueryExpression(e sql.Expression) bool {
+	found := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		if _, ok := e.(*plan.Subquery); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestInSubqueryJoinCondition(t *testing.T) {
+	left := expression.NewGetFieldWithTable(0, sql.Int64, "a", "i", true)
+	right := expression.NewGetFieldWithTable(1, sql.Int64, "b", "i", true)
+	notNullRight := expression.NewGetFieldWithTable(1, sql.Int64, "b", "i", false)
+
+	require.Equal(t, eq(left, right), inSubqueryJoinCondition(left, right, false))
+	require.Equal(t,
+		or(or(eq(left, right), expression.NewIsNull(left)), expression.NewIsNull(right)),
+		inSubqueryJoinCondition(left, right, true),
+	)
+	require.Equal(t,
+		or(eq(left, notNullRight), expression.NewIsNull(left)),
+		inSubqueryJoinCondition(left, notNullRight, true),
+	)
+}
+
+func TestSubquerySource(t *testing.T) {
+	table := plan.NewResolvedTable(memory.NewTable("t", sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: "t"},
+	}), nil, nil)
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "t", "i", false)
+	filter := expression.NewEquals(i, expression.NewLiteral(int64(1), sql.Int64))
+
+	projections, filters, source, ok := subquerySource(plan.NewDistinct(plan.NewProject([]sql.Expression{i}, plan.NewFilter(filter, table))), false)
+	require.True(t, ok)
+	require.Equal(t, []sql.Expression{i}, projections)
+	require.Equal(t, []sql.Expression{filter}, filters)
+	require.Equal(t, table, source)
+
+	limited := plan.NewLimit(expression.NewLiteral(int64(1), sql.Int64), plan.NewProject([]sql.Expression{i}, table))
+	_, _, _, ok = subquerySource(limited, true)
+	require.True(t, ok)
+	_, _, _, ok = subquerySource(limited, false)
+	require.False(t, ok)
+
+	grouped := plan.NewGroupBy([]sql.Expression{i}, []sql.Expression{i}, table)
+	_, _, source, ok = subquerySource(plan.NewProject([]sql.Expression{i}, grouped), false)
+	require.True(t, ok)
+	require.False(t, decorrelatableSource(source, 0))
+}
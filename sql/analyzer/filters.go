@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql/plan"
 
@@ -41,7 +42,7 @@ func (f filtersByTable) size() int {
 
 // getFiltersByTable returns a map of table name to filter expressions on that table for the node provided. Any
 // predicates that contain no table or more than one table are not included in the result.
-func getFiltersByTable(n sql.Node) filtersByTable {
+func getFiltersByTable(ctx *sql.Context, n sql.Node) filtersByTable {
 	filters := newFiltersByTable()
 	plan.Inspect(n, func(node sql.Node) bool {
 		switch node := node.(type) {
@@ -90,6 +91,131 @@ func exprToTableFilters(expr sql.Expression) filtersByTable {
 	return filters
 }
 
+// timestampsToUTC returns the expression given with the constants it compares TIMESTAMP columns to converted from
+// this session's time zone to UTC, which is how tables store timestamps, so that the expression can be evaluated
+// against rows as tables store them. It returns false if the expression uses a TIMESTAMP column in any other way,
+// since such an expression can only be evaluated against rows converted to the session's time zone.
+func timestampsToUTC(ctx *sql.Context, e sql.Expression) (sql.Expression, bool) {
+	if ctx.TimeZone() == time.UTC {
+		return e, true
+	}
+
+	if isTimestampField(e) {
+		return e, false
+	}
+
+	children := e.Children()
+	if len(children) == 0 {
+		return e, true
+	}
+
+	newChildren := make([]sql.Expression, len(children))
+	comparesTimestamps := comparesTimestampField(e)
+	for i, child := range children {
+		var ok bool
+		if comparesTimestamps {
+			newChildren[i], ok = timestampOperandToUTC(ctx, child)
+		} else {
+			newChildren[i], ok = timestampsToUTC(ctx, child)
+		}
+		if !ok {
+			return e, false
+		}
+	}
+
+	converted, err := e.WithChildren(ctx, newChildren...)
+	if err != nil {
+		return e, false
+	}
+	return converted, true
+}
+
+// comparesTimestampField returns whether the expression given is a comparison, IN or BETWEEN with a TIMESTAMP column
+// as one of its operands.
+func comparesTimestampField(e sql.Expression) bool {
+	switch e.(type) {
+	case expression.Comparer, *expression.Between:
+	default:
+		return false
+	}
+
+	for _, child := range e.Children() {
+		if isTimestampField(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// timestampOperandToUTC returns the operand given, of a comparison with a TIMESTAMP column, converted to UTC. Only
+// TIMESTAMP columns and constants can be converted.
+func timestampOperandToUTC(ctx *sql.Context, e sql.Expression) (sql.Expression, bool) {
+	switch e := e.(type) {
+	case *expression.GetField:
+		return e, sql.IsTimestamp(e.Type())
+	case expression.Tuple:
+		elements := make([]sql.Expression, len(e))
+		for i, element := range e {
+			var ok bool
+			if elements[i], ok = timestampOperandToUTC(ctx, element); !ok {
+				return e, false
+			}
+		}
+		return expression.NewTuple(elements...), true
+	}
+
+	if !isEvaluable(e) {
+		return e, false
+	}
+
+	val, err := e.Eval(ctx, nil)
+	if err != nil {
+		return e, false
+	}
+	if val == nil {
+		return expression.NewLiteral(nil, sql.Null), true
+	}
+
+	utc, err := ctx.TimestampFromSessionTime(val)
+	if err != nil {
+		return e, false
+	}
+	return expression.NewLiteral(utc, sql.Timestamp), true
+}
+
+func isTimestampField(e sql.Expression) bool {
+	f, ok := e.(*expression.GetField)
+	return ok && sql.IsTimestamp(f.Type())
+}
+
+// utcTableFilters returns the filters given that can be evaluated against rows as tables store them, along with those
+// filters with their TIMESTAMP comparisons converted to UTC by timestampsToUTC.
+func utcTableFilters(ctx *sql.Context, filters []sql.Expression) ([]sql.Expression, []sql.Expression) {
+	var original, converted []sql.Expression
+	for _, f := range filters {
+		if utc, ok := timestampsToUTC(ctx, f); ok {
+			original = append(original, f)
+			converted = append(converted, utc)
+		}
+	}
+	return original, converted
+}
+
+// originalTableFilters returns the filters that the handled filters given, which are some of the converted filters
+// returned by utcTableFilters, were converted from.
+func originalTableFilters(original, converted, handled []sql.Expression) []sql.Expression {
+	var result []sql.Expression
+	for _, h := range handled {
+		for i, c := range converted {
+			if reflect.DeepEqual(h, c) {
+				result = append(result, original[i])
+				break
+			}
+		}
+	}
+	return result
+}
+
 type filterSet struct {
 	filterPredicates    []sql.Expression
 	filtersByTable      filtersByTable
@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -249,3 +250,42 @@ func TestExprToTableFilters(t *testing.T) {
 	}
 	assert.Equal(t, expected, filters)
 }
+
+func TestTimestampsToUTC(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	require.NoError(t, ctx.SetSessionVariable(ctx, "time_zone", "+02:00"))
+
+	ts := expression.NewGetFieldWithTable(0, sql.Timestamp, "mytable", "ts", false)
+	dt := expression.NewGetFieldWithTable(1, sql.Datetime, "mytable", "dt", false)
+
+	converted, ok := timestampsToUTC(ctx, expression.NewAnd(
+		expression.NewEquals(ts, expression.NewLiteral("2021-01-01 14:00:00", sql.LongText)),
+		expression.NewInTuple(ts, expression.NewTuple(
+			expression.NewLiteral("2021-01-01 01:00:00", sql.LongText),
+			expression.NewLiteral(nil, sql.Null),
+		)),
+	))
+	require.True(t, ok)
+	assert.Equal(t, expression.NewAnd(
+		expression.NewEquals(ts, expression.NewLiteral(time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), sql.Timestamp)),
+		expression.NewInTuple(ts, expression.NewTuple(
+			expression.NewLiteral(time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC), sql.Timestamp),
+			expression.NewLiteral(nil, sql.Null),
+		)),
+	), converted)
+
+	_, ok = timestampsToUTC(ctx, expression.NewEquals(ts, dt))
+	assert.False(t, ok)
+
+	_, ok = timestampsToUTC(ctx, expression.NewEquals(function.NewHour(ctx, ts), expression.NewLiteral(12, sql.Int8)))
+	assert.False(t, ok)
+
+	_, ok = timestampsToUTC(ctx, expression.NewLessThan(ts, expression.NewLiteral("not a timestamp", sql.LongText)))
+	assert.False(t, ok)
+
+	require.NoError(t, ctx.SetSessionVariable(ctx, "time_zone", "UTC"))
+	filter := expression.NewEquals(ts, expression.NewLiteral("2021-01-01 14:00:00", sql.LongText))
+	converted, ok = timestampsToUTC(ctx, filter)
+	require.True(t, ok)
+	assert.Equal(t, filter, converted)
+}
@@ -163,6 +163,39 @@ func FixFieldIndexesForExpressions(ctx *sql.Context, a *Analyzer, node sql.Node,
 			return nil, err
 		}
 
+		n, err = j.WithExpressions(cond)
+		if err != nil {
+			return nil, err
+		}
+	case *plan.SemiJoin, *plan.AntiJoin:
+		// The schema of these joins is the one of their left child, which their condition doesn't stop at
+		jn := j.(plan.JoinNode)
+		cond, err := FixFieldIndexes(ctx, scope, a, append(jn.Left().Schema(), jn.Right().Schema()...), jn.JoinCond())
+		if err != nil {
+			return nil, err
+		}
+
+		n, err = jn.(sql.Expressioner).WithExpressions(cond)
+		if err != nil {
+			return nil, err
+		}
+	case *plan.HashJoin:
+		// Semi and anti joins only return the left side, but their condition reads both
+		cond, err := FixFieldIndexes(ctx, scope, a, append(j.Left().Schema(), j.Right().Schema()...), j.Cond)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err = j.WithExpressions(cond)
+		if err != nil {
+			return nil, err
+		}
+	case *plan.MergeJoin:
+		cond, err := FixFieldIndexes(ctx, scope, a, j.Schema(), j.Cond)
+		if err != nil {
+			return nil, err
+		}
+
 		n, err = j.WithExpressions(cond)
 		if err != nil {
 			return nil, err
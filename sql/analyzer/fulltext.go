@@ -0,0 +1,117 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveFullTextMatch assigns to every MATCH ... AGAINST expression the FULLTEXT index that covers exactly its
+// columns, returning an error if there is no such index or the search query is not constant.
+func resolveFullTextMatch(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	var indexes *indexAnalyzer
+	return plan.TransformExpressionsUp(ctx, n, func(e sql.Expression) (sql.Expression, error) {
+		match, ok := e.(*expression.Match)
+		if !ok || match.Index != nil {
+			return e, nil
+		}
+
+		var err error
+		if indexes == nil {
+			indexes, err = getIndexesForNode(ctx, a, n)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err = validateMatchAgainst(match); err != nil {
+			return nil, err
+		}
+
+		index, err := fullTextIndexForMatch(ctx, indexes, match)
+		if err != nil {
+			return nil, err
+		}
+		return match.WithIndex(index), nil
+	})
+}
+
+// validateMatchAgainst returns an error if the search query of the match given is not a constant.
+func validateMatchAgainst(match *expression.Match) error {
+	constant := true
+	sql.Inspect(match.Against, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *expression.GetField, *plan.Subquery:
+			constant = false
+		}
+		return constant
+	})
+	if !constant {
+		return sql.ErrInvalidArgument.New("AGAINST")
+	}
+	return nil
+}
+
+// fullTextIndexForMatch returns the full-text index whose columns are exactly the columns of the match given.
+func fullTextIndexForMatch(ctx *sql.Context, indexes *indexAnalyzer, match *expression.Match) (sql.FullTextIndex, error) {
+	var tableName string
+	columnNames := make([]string, len(match.Columns))
+	for i, col := range match.Columns {
+		gf, ok := col.(*expression.GetField)
+		if !ok {
+			return nil, sql.ErrInvalidArgument.New("MATCH")
+		}
+		if i > 0 && strings.ToLower(gf.Table()) != tableName {
+			return nil, sql.ErrFullTextIndexNotFound.New()
+		}
+		tableName = strings.ToLower(gf.Table())
+		columnNames[i] = strings.ToLower(gf.Name())
+	}
+
+	for table, idxes := range indexes.indexesByTable {
+		if strings.ToLower(table) != tableName {
+			continue
+		}
+		for _, idx := range idxes {
+			ftIndex, ok := idx.(sql.FullTextIndex)
+			if !ok {
+				continue
+			}
+
+			matchExprs := make([]string, len(columnNames))
+			for i, name := range columnNames {
+				matchExprs[i] = strings.ToLower(ftIndex.Table()) + "." + name
+			}
+			indexExprs := make([]string, len(ftIndex.Expressions()))
+			for i, expr := range ftIndex.Expressions() {
+				indexExprs[i] = strings.ToLower(expr)
+			}
+
+			if exprListsEqual(indexExprs, matchExprs) {
+				return ftIndex, nil
+			}
+		}
+	}
+
+	return nil, sql.ErrFullTextIndexNotFound.New()
+}
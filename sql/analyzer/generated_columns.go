@@ -0,0 +1,63 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// validateGeneratedColumnWrites returns an error if an UPDATE or an INSERT ... ON DUPLICATE KEY UPDATE attempts to
+// set the value of a generated column. Inserts into generated columns are validated in resolveInsertRows.
+func validateGeneratedColumnWrites(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.UpdateSource:
+			err = validateNoGeneratedSetFields(n.UpdateExprs, n.Child.Schema())
+		case *plan.InsertInto:
+			err = validateNoGeneratedSetFields(n.OnDupExprs, n.Destination.Schema())
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func validateNoGeneratedSetFields(setExprs []sql.Expression, schema sql.Schema) error {
+	for _, e := range setExprs {
+		setField, ok := e.(*expression.SetField)
+		if !ok {
+			continue
+		}
+		getField, ok := setField.Left.(*expression.GetField)
+		if !ok {
+			continue
+		}
+		for _, col := range schema {
+			if col.Generated != nil &&
+				strings.ToLower(col.Name) == strings.ToLower(getField.Name()) &&
+				(getField.Table() == "" || strings.ToLower(col.Source) == strings.ToLower(getField.Table())) {
+				return sql.ErrGeneratedColumnValue.New(col.Name, col.Source)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceJoinsWithHashJoins replaces the inner, left and right joins in the node given whose condition has an
+// equality between their left and right sides with hash joins, which build their hash table on the side with fewer
+// rows. The join planner uses them when no index applies to a join.
+func replaceJoinsWithHashJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	scopeLen := len(scope.Schema())
+	filters := filtersOutsideSubqueries(n)
+
+	// Subquery aliases were planned on their own, and their joins don't see the outer scope
+	selector := func(parent sql.Node, child sql.Node, childNum int) bool {
+		_, isSubqueryAlias := parent.(*plan.SubqueryAlias)
+		return !isSubqueryAlias
+	}
+
+	return plan.TransformUpWithSelector(n, selector, func(n sql.Node) (sql.Node, error) {
+		j, ok := n.(plan.JoinNode)
+		if !ok {
+			return n, nil
+		}
+
+		left, right := j.Left(), j.Right()
+		if !plan.CanHashJoin(j.JoinCond(), scopeLen, left.Schema()) {
+			return n, nil
+		}
+
+		buildLeft, err := hashJoinBuildsLeft(ctx, j.JoinType(), left, right, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		if scopeLen > 0 {
+			if _, ok := left.(*plan.StripRowNode); !ok {
+				left = plan.NewStripRowNode(left, scopeLen)
+				right = plan.NewStripRowNode(right, scopeLen)
+			}
+		}
+
+		a.Log("replacing %s on %s with a hash join", j.JoinType(), j.JoinCond())
+		return plan.NewHashJoin(left, right, j.JoinType(), j.JoinCond(), buildLeft, scopeLen), nil
+	})
+}
+
+// hashJoinBuildsLeft returns whether a hash join of the type given between the nodes given should build its hash table
+// on the left one, which it does when the left one is estimated to have fewer rows after the filters given. When either
+// estimate is missing, it builds on the side that isn't preserved by an outer join, so that the rows of the other side
+// keep their order.
+func hashJoinBuildsLeft(ctx *sql.Context, joinType plan.JoinType, left, right sql.Node, filters []sql.Expression) (bool, error) {
+	leftRows, leftKnown, err := estimateRowCount(ctx, left, filters)
+	if err != nil {
+		return false, err
+	}
+	rightRows, rightKnown, err := estimateRowCount(ctx, right, filters)
+	if err != nil {
+		return false, err
+	}
+	if !leftKnown || !rightKnown {
+		return joinType == plan.JoinTypeRight, nil
+	}
+	return leftRows < rightRows, nil
+}
+
+// estimateRowCount returns the product of the number of rows of the tables in the node given, which is an upper
+// bound of the rows it returns, and false if any of them doesn't know how many rows it has. The rows of tables with
+// column statistics are reduced by the estimated selectivity of the filters given on them.
+func estimateRowCount(ctx *sql.Context, n sql.Node, filters []sql.Expression) (uint64, bool, error) {
+	count := uint64(1)
+	known := true
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		if !known || err != nil {
+			return false
+		}
+
+		var table sql.Table
+		var name string
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			table, name = n.Table, n.Name()
+		case *plan.IndexedTableAccess:
+			table, name = n.ResolvedTable.Table, n.Name()
+		case *plan.TableAlias:
+			rt := getResolvedTable(n)
+			if rt == nil {
+				return true
+			}
+			table, name = rt.Table, n.Name()
+		case *plan.ValueDerivedTable:
+			count *= uint64(len(n.ExpressionTuples))
+			return false
+		case *plan.ResolvedTableFunction:
+			known = false
+			return false
+		default:
+			return true
+		}
+
+		st, ok := table.(sql.StatisticsTable)
+		if !ok {
+			known = false
+			return false
+		}
+		var numRows uint64
+		numRows, err = st.NumRows(ctx)
+		if err != nil {
+			return false
+		}
+
+		var stats *sql.TableStatistics
+		stats, err = tableStatistics(ctx, table)
+		if err != nil {
+			return false
+		}
+		count *= uint64(math.Ceil(float64(numRows) * filterSelectivity(stats, name, filters)))
+		return false
+	})
+	return count, known, err
+}
+
+// filtersOutsideSubqueries returns the conjuncts of the filters in the node given, except those of subquery aliases,
+// which filter tables of their own scope.
+func filtersOutsideSubqueries(n sql.Node) []sql.Expression {
+	var filters []sql.Expression
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.SubqueryAlias:
+			return false
+		case *plan.Filter:
+			filters = append(filters, splitConjunction(n.Expression)...)
+		}
+		return true
+	})
+	return filters
+}
@@ -124,6 +124,10 @@ func (r *indexAnalyzer) IndexByExpression(ctx *sql.Context, db string, expr ...s
 
 	for _, idxes := range r.indexesByTable {
 		for _, idx := range idxes {
+			// Full-text indexes can only be searched with MATCH ... AGAINST
+			if _, ok := idx.(sql.FullTextIndex); ok {
+				continue
+			}
 			if exprListsEqual(idx.Expressions(), exprStrs) {
 				return idx
 			}
@@ -148,6 +152,9 @@ func (r *indexAnalyzer) ExpressionsWithIndexes(db string, exprs ...sql.Expressio
 	for _, idxes := range r.indexesByTable {
 	Indexes:
 		for _, idx := range idxes {
+			if _, ok := idx.(sql.FullTextIndex); ok {
+				continue
+			}
 			if ln := len(idx.Expressions()); ln <= len(exprs) && ln > 1 {
 				var used = make(map[int]bool)
 				var matched []sql.Expression
@@ -42,10 +42,24 @@ func constructJoinPlan(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 }
 
 func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	// Joins beneath a filter are left to indexed joins, which can look up only the rows that pass the filter rather
+	// than reading whole tables. The filter also informs the estimated rows of the tables when ordering them. The
+	// selector is called for a join just before it's transformed.
+	var joinFiltered bool
+	var joinFilters []sql.Expression
 	selector := func(parent sql.Node, child sql.Node, childNum int) bool {
+		if _, ok := child.(plan.JoinNode); ok {
+			var filter *plan.Filter
+			filter, joinFiltered = parent.(*plan.Filter)
+			joinFilters = nil
+			if joinFiltered {
+				joinFilters = splitConjunction(filter.Expression)
+			}
+		}
+
 		// We only want the top-most join node, so don't examine anything beneath join nodes
 		switch parent.(type) {
-		case *plan.InnerJoin, *plan.LeftJoin, *plan.RightJoin:
+		case *plan.InnerJoin, *plan.LeftJoin, *plan.RightJoin, *plan.SemiJoin, *plan.AntiJoin:
 			return false
 		default:
 			return true
@@ -54,10 +68,14 @@ func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 
 	var tableAliases TableAliases
 	var joinIndexes joinIndexesByTable
+	var mergedJoin, replannedSemiJoin bool
 	newJoin, err := plan.TransformUpWithSelector(n, selector, func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
 		case *plan.IndexedJoin:
 			return n, nil
+		case *plan.SemiJoin, *plan.AntiJoin:
+			replannedSemiJoin = true
+			return replanSemiOrAntiJoin(ctx, a, n.(plan.JoinNode), scope)
 		case plan.JoinNode:
 			var err error
 			tableAliases, err = getTableAliases(n, scope)
@@ -65,6 +83,17 @@ func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 				return nil, err
 			}
 
+			if !joinFiltered {
+				mergeJoin, merged, err := replaceJoinWithMergeJoin(ctx, a, n, scope, tableAliases)
+				if err != nil {
+					return nil, err
+				}
+				if merged {
+					mergedJoin = true
+					return mergeJoin, nil
+				}
+			}
+
 			joinIndexes, err = findJoinIndexesByTable(ctx, n, tableAliases, a)
 			if err != nil {
 				return nil, err
@@ -76,8 +105,12 @@ func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 				return n, nil
 			}
 
-			return replanJoin(ctx, n, a, joinIndexes, scope)
+			return replanJoin(ctx, n, a, joinIndexes, joinFilters, scope)
 		default:
+			if replannedSemiJoin {
+				// The tables beneath a semi or anti join might have been reordered
+				return FixFieldIndexesForExpressions(ctx, a, n, scope)
+			}
 			return n, nil
 		}
 	})
@@ -85,17 +118,21 @@ func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 		return nil, err
 	}
 
+	if mergedJoin || replannedSemiJoin {
+		return newJoin, nil
+	}
+
 	withIndexedTableAccess, replacedTableWithIndexedAccess, err := replaceTableAccessWithIndexedAccess(
 		ctx, newJoin, a, nil, scope, joinIndexes, tableAliases)
 	if err != nil {
 		return nil, err
 	}
 
-	// If we didn't replace any tables with indexed accesses, throw our work away and fall back to the default join
-	// implementation (which can be faster for tables that fit into memory). Over time, we should unify these two
-	// implementations.
+	// If we didn't replace any tables with indexed accesses, throw our work away and fall back to hash joins for joins
+	// on equalities, and to the default join implementation for the rest (which can be faster for tables that fit into
+	// memory). Over time, we should unify these implementations.
 	if !replacedTableWithIndexedAccess {
-		return n, nil
+		return replaceJoinsWithHashJoins(ctx, a, n, scope)
 	}
 
 	return withIndexedTableAccess, nil
@@ -260,13 +297,20 @@ func replaceIndexedAccessInUnaryNode(
 	return newNode, replaced, nil
 }
 
-func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes joinIndexesByTable, scope *Scope) (sql.Node, error) {
+func replanJoin(
+	ctx *sql.Context,
+	node plan.JoinNode,
+	a *Analyzer,
+	joinIndexes joinIndexesByTable,
+	filters []sql.Expression,
+	scope *Scope,
+) (sql.Node, error) {
 	// Inspect the node for eligibility. The join planner rewrites the tree beneath this node, and for this to be correct
 	// only certain nodes can be below it.
 	eligible := true
 	plan.Inspect(node, func(node sql.Node) bool {
 		switch node.(type) {
-		case plan.JoinNode, *plan.ResolvedTable, *plan.TableAlias, *plan.ValueDerivedTable, nil:
+		case plan.JoinNode, *plan.ResolvedTable, *plan.TableAlias, *plan.ValueDerivedTable, *plan.ResolvedTableFunction, nil:
 		case *plan.SubqueryAlias:
 			// The join planner can use the subquery alias as a
 			// table alias in join conditions, but the subquery
@@ -300,7 +344,7 @@ func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes j
 	}
 
 	if !ordered {
-		err := tableJoinOrder.estimateCost(ctx, joinIndexes)
+		err := tableJoinOrder.estimateCost(ctx, joinIndexes, filters)
 		if err != nil {
 			return nil, err
 		}
@@ -828,7 +872,7 @@ func getTablesOrSubqueryAliases(node sql.Node) []NameableNode {
 	var tables []NameableNode
 	plan.Inspect(node, func(node sql.Node) bool {
 		switch node := node.(type) {
-		case *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.TableAlias, *plan.ResolvedTable, *plan.UnresolvedTable, *plan.IndexedTableAccess:
+		case *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.ResolvedTableFunction, *plan.TableAlias, *plan.ResolvedTable, *plan.UnresolvedTable, *plan.IndexedTableAccess:
 			tables = append(tables, node.(NameableNode))
 			return false
 		}
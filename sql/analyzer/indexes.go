@@ -67,7 +67,10 @@ func getIndexesByTable(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scop
 		defer indexAnalyzer.releaseUsedIndexes()
 
 		var result indexLookupsByTable
-		filterExpression := convertIsNullForIndexes(ctx, filter.Expression)
+		filterExpression := convertTimestampsForIndexes(ctx, convertDecimalsForIndexes(ctx, convertIsNullForIndexes(ctx, filter.Expression)))
+		if filterExpression == nil {
+			return true
+		}
 		result, err = getIndexes(ctx, a, indexAnalyzer, filterExpression, tableAliases)
 		if err != nil {
 			return false
@@ -1112,3 +1115,74 @@ func convertIsNullForIndexes(ctx *sql.Context, e sql.Expression) sql.Expression
 	})
 	return expr
 }
+
+// convertDecimalsForIndexes converts the DECIMAL constants compared with floating point columns to doubles, since they
+// are compared as doubles, so that indexes look them up as doubles as well.
+func convertDecimalsForIndexes(ctx *sql.Context, e sql.Expression) sql.Expression {
+	expr, _ := expression.TransformUp(ctx, e, func(e sql.Expression) (sql.Expression, error) {
+		if !comparesFloatField(e) {
+			return e, nil
+		}
+		children := e.Children()
+		newChildren := make([]sql.Expression, len(children))
+		for i, child := range children {
+			newChildren[i] = decimalToDouble(ctx, child)
+		}
+		return e.WithChildren(ctx, newChildren...)
+	})
+	return expr
+}
+
+// comparesFloatField returns whether the expression given is a comparison, IN or BETWEEN with a floating point column
+// as one of its operands.
+func comparesFloatField(e sql.Expression) bool {
+	switch e.(type) {
+	case expression.Comparer, *expression.Between:
+	default:
+		return false
+	}
+
+	for _, child := range e.Children() {
+		if f, ok := child.(*expression.GetField); ok && sql.IsFloat(f.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// decimalToDouble returns the operand given as a double literal if it is a DECIMAL constant, or a tuple of them.
+func decimalToDouble(ctx *sql.Context, e sql.Expression) sql.Expression {
+	if tuple, ok := e.(expression.Tuple); ok {
+		elements := make([]sql.Expression, len(tuple))
+		for i, element := range tuple {
+			elements[i] = decimalToDouble(ctx, element)
+		}
+		return expression.NewTuple(elements...)
+	}
+
+	if !sql.IsDecimal(e.Type()) || !isEvaluable(e) {
+		return e
+	}
+	val, err := e.Eval(ctx, nil)
+	if err != nil {
+		return e
+	}
+	f, err := sql.Float64.Convert(val)
+	if err != nil {
+		return e
+	}
+	return expression.NewLiteral(f, sql.Float64)
+}
+
+// convertTimestampsForIndexes returns the conjunction of the predicates of the filter expression given that can be
+// used to look up rows in indexes, with their TIMESTAMP comparisons converted to UTC, which is how indexes store
+// timestamps. Index lookups then return a superset of the rows matching the filter, which is kept above them.
+func convertTimestampsForIndexes(ctx *sql.Context, e sql.Expression) sql.Expression {
+	var predicates []sql.Expression
+	for _, predicate := range splitConjunction(e) {
+		if utc, ok := timestampsToUTC(ctx, predicate); ok {
+			predicates = append(predicates, utc)
+		}
+	}
+	return expression.JoinAnd(predicates...)
+}
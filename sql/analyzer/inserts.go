@@ -54,18 +54,6 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			}
 		}
 
-		source := insert.Source
-		// TriggerExecutor has already been analyzed
-		if _, ok := insert.Source.(*plan.TriggerExecutor); !ok {
-			// Analyze the source of the insert independently
-			source, err = a.Analyze(ctx, insert.Source, scope)
-			if err != nil {
-				return nil, err
-			}
-
-			source = stripQueryProcess(source)
-		}
-
 		dstSchema := insertable.Schema()
 
 		// If no columns are given, use the full schema
@@ -82,6 +70,23 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			}
 		}
 
+		source := insert.Source
+		// TriggerExecutor has already been analyzed
+		if _, ok := insert.Source.(*plan.TriggerExecutor); !ok {
+			source, err = validateGeneratedColumnValues(source, insertable, columnNames)
+			if err != nil {
+				return nil, err
+			}
+
+			// Analyze the source of the insert independently
+			source, err = a.Analyze(ctx, source, scope)
+			if err != nil {
+				return nil, err
+			}
+
+			source = stripQueryProcess(source)
+		}
+
 		err = validateValueCount(columnNames, source)
 		if err != nil {
 			return nil, err
@@ -110,6 +115,12 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, c
 			}
 		}
 
+		// Generated columns are computed by the insert itself once every other column has its value
+		if f.Generated != nil {
+			projExprs[i] = expression.NewLiteral(nil, sql.Null)
+			continue
+		}
+
 		if !found {
 			if !f.Nullable && f.Default == nil && !f.AutoIncrement {
 				return nil, sql.ErrInsertIntoNonNullableDefaultNullColumn.New(f.Name)
@@ -122,6 +133,9 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, c
 			if err != nil {
 				return nil, err
 			}
+			if values, ok := insertSource.(*plan.Values); ok {
+				ai = ai.WithRowCount(len(values.ExpressionTuples))
+			}
 			projExprs[i] = ai
 		}
 	}
@@ -134,6 +148,50 @@ func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, c
 	return plan.NewProject(projExprs, insertSource), nil
 }
 
+// validateGeneratedColumnValues returns an error if the insert source given provides a value for a generated column.
+// The only value allowed for a generated column is DEFAULT, which is replaced with a placeholder, as the value will be
+// computed when the row is inserted.
+func validateGeneratedColumnValues(insertSource sql.Node, destTbl sql.Table, columnNames []string) (sql.Node, error) {
+	generated := make(map[int]*sql.Column)
+	var firstGenerated *sql.Column
+	for i, columnName := range columnNames {
+		for _, col := range destTbl.Schema() {
+			if col.Name == columnName && col.Generated != nil {
+				generated[i] = col
+				if firstGenerated == nil {
+					firstGenerated = col
+				}
+			}
+		}
+	}
+	if len(generated) == 0 {
+		return insertSource, nil
+	}
+
+	values, ok := insertSource.(*plan.Values)
+	if !ok {
+		return nil, sql.ErrGeneratedColumnValue.New(firstGenerated.Name, destTbl.Name())
+	}
+
+	tuples := make([][]sql.Expression, len(values.ExpressionTuples))
+	for i, tuple := range values.ExpressionTuples {
+		tuples[i] = make([]sql.Expression, len(tuple))
+		for j, e := range tuple {
+			col, isGenerated := generated[j]
+			if !isGenerated {
+				tuples[i][j] = e
+				continue
+			}
+			if _, isDefault := e.(*expression.DefaultColumn); !isDefault {
+				return nil, sql.ErrGeneratedColumnValue.New(col.Name, destTbl.Name())
+			}
+			tuples[i][j] = expression.NewLiteral(nil, sql.Null)
+		}
+	}
+
+	return plan.NewValues(tuples), nil
+}
+
 func validateColumns(columnNames []string, dstSchema sql.Schema) error {
 	dstColNames := make(map[string]struct{})
 	for _, dstCol := range dstSchema {
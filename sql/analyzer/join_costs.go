@@ -0,0 +1,208 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// opaqueTableRows is the number of rows assumed for nodes that can't tell how many rows they have, such as subqueries.
+const opaqueTableRows = 1000
+
+// accessPlan is an order to access some of the commutable nodes of a joinOrderNode in, as a left-deep tree of joins,
+// with the estimated cost of doing so, which is the number of rows read, and the estimated number of rows returned.
+type accessPlan struct {
+	order  []int
+	schema sql.Schema
+	cost   float64
+	rows   float64
+}
+
+// cheaperThan returns whether this plan has a lower estimated cost than the one given, which may be nil. Ties go to the
+// plan returning fewer rows, then to the plan whose order comes first, so that the order is stable.
+func (p *accessPlan) cheaperThan(other *accessPlan) bool {
+	if other == nil {
+		return true
+	}
+	if p.cost != other.cost {
+		return p.cost < other.cost
+	}
+	if p.rows != other.rows {
+		return p.rows < other.rows
+	}
+	for i := range p.order {
+		if p.order[i] != other.order[i] {
+			return p.order[i] < other.order[i]
+		}
+	}
+	return false
+}
+
+// extendAccessPlan returns the access plan given, followed by the commutable node with the index given. The first node
+// is read once. A node that has an index usable with the nodes before it is looked up once for every row of those,
+// and other nodes are read whole for every row of those.
+func (jo *joinOrderNode) extendAccessPlan(p *accessPlan, idx int, joinIndexes joinIndexesByTable) *accessPlan {
+	next := &jo.commutes[idx]
+	extended := &accessPlan{
+		order:  append(append(make([]int, 0, len(p.order)+1), p.order...), idx),
+		schema: append(append(make(sql.Schema, 0, len(p.schema)), p.schema...), next.schema()...),
+	}
+	if len(p.order) == 0 {
+		extended.cost, extended.rows = next.cost, next.rows
+		return extended
+	}
+
+	if next.node != nil {
+		_, isSubquery := next.node.(*plan.SubqueryAlias)
+		_, isValuesTable := next.node.(*plan.ValueDerivedTable)
+		_, isTableFunction := next.node.(*plan.ResolvedTableFunction)
+		indexes := joinIndexes[strings.ToLower(next.node.Name())]
+		if !isSubquery && !isValuesTable && !isTableFunction {
+			if ji := indexes.getUsableIndex(p.schema); ji != nil {
+				lookupRows := next.indexLookupRows(ji)
+				extended.cost = p.cost + p.rows*lookupRows
+				extended.rows = p.rows * lookupRows * next.filterSelectivity()
+				return extended
+			}
+		}
+	}
+
+	extended.cost = p.cost + p.rows*next.cost
+	extended.rows = p.rows * next.rows * jo.joinSelectivity(next, p.schema, joinIndexes)
+	return extended
+}
+
+// estimateTableCost sets the cost and rows of a node for a table, which are the rows of the table, reduced by the
+// estimated selectivity of the filters given on it when it has column statistics.
+func (jo *joinOrderNode) estimateTableCost(ctx *sql.Context, filters []sql.Expression) error {
+	jo.tableRows = opaqueTableRows
+	switch node := jo.node.(type) {
+	case *plan.SubqueryAlias, *plan.ResolvedTableFunction:
+	case *plan.ValueDerivedTable:
+		jo.tableRows = float64(len(node.ExpressionTuples))
+	default:
+		// An alias of a table function has no statistics either
+		if rt := getResolvedTable(jo.node); rt != nil {
+			if st, ok := rt.Table.(sql.StatisticsTable); ok {
+				numRows, err := st.NumRows(ctx)
+				if err != nil {
+					return err
+				}
+				jo.tableRows = float64(numRows)
+			}
+
+			var err error
+			jo.stats, err = tableStatistics(ctx, rt.Table)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	jo.cost = jo.tableRows
+	jo.rows = jo.tableRows * filterSelectivity(jo.stats, jo.node.Name(), filters)
+	return nil
+}
+
+// filterSelectivity returns the estimated fraction of the rows of the table of this node that pass the filters on it.
+func (jo *joinOrderNode) filterSelectivity() float64 {
+	if jo.tableRows == 0 {
+		return 1
+	}
+	return jo.rows / jo.tableRows
+}
+
+// indexLookupRows returns the estimated number of rows of the table of this node that a lookup of the join index
+// given returns. Lookups of unique indexes return a row, and so do those on columns without statistics.
+func (jo *joinOrderNode) indexLookupRows(ji *joinIndex) float64 {
+	if ji.disjunction[0] != nil {
+		return jo.indexLookupRows(ji.disjunction[0]) + jo.indexLookupRows(ji.disjunction[1])
+	}
+	if ji.index.IsUnique() && len(ji.cols) >= len(ji.index.Expressions()) {
+		return 1
+	}
+
+	rows := jo.tableRows
+	known := false
+	for _, col := range ji.cols {
+		if colStats := jo.stats.Column(col.Name()); colStats != nil && colStats.DistinctCount > 0 {
+			rows *= (1 - colStats.NullSelectivity()) / float64(colStats.DistinctCount)
+			known = true
+		}
+	}
+	if !known {
+		return 1
+	}
+	return math.Max(rows, 1)
+}
+
+// joinSelectivity returns the estimated fraction of the pairs of rows of the commutable node given and of the nodes
+// with the schema given that the equalities between them let through, which is one over the number of distinct values
+// of the columns compared. Equalities of columns without statistics don't reduce it.
+func (jo *joinOrderNode) joinSelectivity(next *joinOrderNode, schema sql.Schema, joinIndexes joinIndexesByTable) float64 {
+	if next.node == nil {
+		return 1
+	}
+
+	selectivity := 1.0
+	for _, ji := range joinIndexes[strings.ToLower(next.node.Name())] {
+		if ji.disjunction[0] != nil || !schemaContainsFields(schema, ji.comparandCols) {
+			continue
+		}
+		s := 1.0
+		for i, col := range ji.cols {
+			if distinct := math.Max(next.distinctValues(col), jo.distinctValues(ji.comparandCols[i])); distinct > 0 {
+				s /= distinct
+			}
+		}
+		selectivity = math.Min(selectivity, s)
+	}
+	return selectivity
+}
+
+// distinctValues returns the number of distinct values of the column given of the table of this node, or of its
+// commutable nodes, or 0 if it's not known.
+func (jo *joinOrderNode) distinctValues(col *expression.GetField) float64 {
+	if jo.node != nil {
+		if !strings.EqualFold(jo.node.Name(), col.Table()) {
+			return 0
+		}
+		if colStats := jo.stats.Column(col.Name()); colStats != nil {
+			return float64(colStats.DistinctCount)
+		}
+		return 0
+	}
+	for i := range jo.commutes {
+		if distinct := jo.commutes[i].distinctValues(col); distinct > 0 {
+			return distinct
+		}
+	}
+	return 0
+}
+
+// schemaContainsFields returns whether the schema given has all the fields given.
+func schemaContainsFields(schema sql.Schema, fields []*expression.GetField) bool {
+	for _, field := range fields {
+		if !schemaContainsField(schema, field) {
+			return false
+		}
+	}
+	return true
+}
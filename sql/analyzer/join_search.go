@@ -42,25 +42,6 @@ func buildJoinTree(
 	return found
 }
 
-// Generates all permutations of the slice given.
-func permutations(a []int) (res [][]int) {
-	var helper func(n int)
-	helper = func(n int) {
-		if n > len(a) {
-			res = append(res, append([]int(nil), a...))
-		} else {
-			helper(n + 1)
-			for i := n + 1; i < len(a); i++ {
-				a[n], a[i] = a[i], a[n]
-				helper(n + 1)
-				a[i], a[n] = a[n], a[i]
-			}
-		}
-	}
-	helper(0)
-	return res
-}
-
 // assignConditions attempts to assign the conditions in |conditions|
 // to the search tree in |root|, such that every condition is on an
 // internal node, and all of the trees referenced in the condition
@@ -129,7 +110,12 @@ type joinOrderNode struct {
 	left     *joinOrderNode
 	right    *joinOrderNode
 	order    []int
-	cost     uint64
+	// The estimated cost of reading the rows of this node, and the number of rows it returns
+	cost float64
+	rows float64
+	// For nodes of tables, the number of rows of the table, before any filters, and its column statistics if any
+	tableRows float64
+	stats     *sql.TableStatistics
 }
 
 func (jo *joinOrderNode) String() string {
@@ -266,96 +252,95 @@ func (jo *joinOrderNode) tables() []NameableNode {
 	}
 }
 
-// estimateCost sets `jo.cost` and `jo.order` for this
-// `joinOrderNode`, taking into account the cost of its children and
-// attempting to find the lowest cost assignment by varying
-// `jo.order` for commutable nodes.
-func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesByTable) error {
+// estimateCost sets `jo.cost` and `jo.rows` for this `joinOrderNode`, and `jo.order` for commutable nodes to the
+// access order with the lowest estimated cost. Filters given that reference a single table reduce its estimated rows.
+func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesByTable, filters []sql.Expression) error {
 	if jo.node != nil {
-		// Subqueries are considered opaque in this analysis, so give them the opaque table cost.
-		switch node := jo.node.(type) {
-		case *plan.SubqueryAlias:
-			jo.cost = uint64(1000)
-			return nil
-		case *plan.ValueDerivedTable:
-			jo.cost = uint64(len(node.ExpressionTuples))
-			return nil
-		}
-
-		rt := getResolvedTable(jo.node)
-		// TODO: also consider indexes which could be pushed down to this table, if it's the first one
-		if st, ok := rt.Table.(sql.StatisticsTable); ok {
-			numRows, err := st.NumRows(ctx)
-			if err != nil {
-				return err
-			}
-			jo.cost = numRows
-		} else {
-			jo.cost = uint64(1000)
-		}
+		return jo.estimateTableCost(ctx, filters)
 	} else if jo.left != nil {
-		err := jo.left.estimateCost(ctx, joinIndexes)
+		err := jo.left.estimateCost(ctx, joinIndexes, filters)
 		if err != nil {
 			return err
 		}
-		err = jo.right.estimateCost(ctx, joinIndexes)
+		err = jo.right.estimateCost(ctx, joinIndexes, filters)
 		if err != nil {
 			return err
 		}
-		jo.cost = jo.left.cost * jo.right.cost
-	} else {
-		for i := range jo.commutes {
-			err := jo.commutes[i].estimateCost(ctx, joinIndexes)
-			if err != nil {
-				return err
-			}
+		// The right side is read again for every row of the left one, and every row of the left one is returned
+		jo.cost = jo.left.cost + jo.left.rows*jo.right.cost
+		jo.rows = math.Max(jo.left.rows, jo.left.rows*jo.right.rows)
+		return nil
+	}
+
+	for i := range jo.commutes {
+		err := jo.commutes[i].estimateCost(ctx, joinIndexes, filters)
+		if err != nil {
+			return err
 		}
-		indexes := make([]int, len(jo.commutes))
-		for i := range jo.commutes {
-			indexes[i] = i
+	}
+
+	var best *accessPlan
+	if len(jo.commutes) <= maxExhaustiveJoinSearchTables {
+		best = jo.searchAccessOrders(joinIndexes)
+	} else {
+		best = jo.greedyAccessOrder(joinIndexes)
+	}
+	jo.order = best.order
+	jo.cost = best.cost
+	jo.rows = best.rows
+	return nil
+}
+
+// maxExhaustiveJoinSearchTables is the largest number of commutable nodes whose access orders are all considered.
+// Beyond it, the number of sets of nodes makes the search too slow, and the access order is built greedily instead.
+const maxExhaustiveJoinSearchTables = 12
+
+// searchAccessOrders returns the access order of the commutable nodes with the lowest estimated cost, found with
+// dynamic programming: the cheapest order of every set of nodes is the cheapest order of one of its subsets with one
+// node less, followed by that node.
+func (jo *joinOrderNode) searchAccessOrders(joinIndexes joinIndexesByTable) *accessPlan {
+	n := len(jo.commutes)
+	best := make([]*accessPlan, 1<<uint(n))
+	best[0] = &accessPlan{}
+	// Every set of nodes comes after all of its subsets
+	for set := range best {
+		if best[set] == nil {
+			continue
 		}
-		lowestCost := uint64(math.MaxUint64)
-		accessOrders := permutations(indexes)
-		lowestCostIdx := 0
-		for i, accessOrder := range accessOrders {
-			cost, err := jo.estimateAccessOrderCost(ctx, accessOrder, joinIndexes, lowestCost)
-			if err != nil {
-				return err
+		for i := 0; i < n; i++ {
+			if set&(1<<uint(i)) != 0 {
+				continue
 			}
-			if cost < lowestCost {
-				lowestCost = cost
-				lowestCostIdx = i
+			next := jo.extendAccessPlan(best[set], i, joinIndexes)
+			if next.cheaperThan(best[set|1<<uint(i)]) {
+				best[set|1<<uint(i)] = next
 			}
 		}
-		jo.order = accessOrders[lowestCostIdx]
-		jo.cost = lowestCost
 	}
-
-	return nil
+	return best[len(best)-1]
 }
 
-func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) (uint64, error) {
-	cost := uint64(1)
-	var availableSchemaForKeys sql.Schema
-	for i, idx := range accessOrder {
-		if cost >= lowestCost {
-			return cost, nil
-		}
-		availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[idx].schema()...)
-		if jo.commutes[idx].node != nil {
-			indexes := joinIndexes[strings.ToLower(jo.commutes[idx].node.Name())]
-			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
-			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
-			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
-				cost *= jo.commutes[idx].cost
-			} else {
-				cost += 1
+// greedyAccessOrder returns an access order of the commutable nodes built by repeatedly appending the node that leaves
+// the fewest estimated rows to join with the nodes after it, or that adds the least to the cost when tied.
+func (jo *joinOrderNode) greedyAccessOrder(joinIndexes joinIndexesByTable) *accessPlan {
+	access := &accessPlan{}
+	used := make([]bool, len(jo.commutes))
+	for range jo.commutes {
+		var cheapest *accessPlan
+		var cheapestIdx int
+		for i := range jo.commutes {
+			if used[i] {
+				continue
+			}
+			next := jo.extendAccessPlan(access, i, joinIndexes)
+			if cheapest == nil || next.rows < cheapest.rows || (next.rows == cheapest.rows && next.cheaperThan(cheapest)) {
+				cheapest, cheapestIdx = next, i
 			}
-		} else {
-			cost *= jo.commutes[idx].cost
 		}
+		access = cheapest
+		used[cheapestIdx] = true
 	}
-	return cost, nil
+	return access
 }
 
 func (jo *joinOrderNode) schema() sql.Schema {
@@ -421,7 +406,7 @@ func visitCommutableJoinSearchNodes(indexes []int, nodes []joinOrderNode, cb fun
 // being joined on the right.
 func newJoinOrderNode(node sql.Node) *joinOrderNode {
 	switch node := node.(type) {
-	case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable:
+	case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 		return &joinOrderNode{node: node.(NameableNode)}
 	case plan.JoinNode:
 		ljo := newJoinOrderNode(node.Left())
@@ -19,8 +19,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -644,3 +646,48 @@ func tableOrder(tables ...interface{}) *joinOrderNode {
 	}
 	return jo
 }
+
+func TestEstimateCost(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	table := func(name string, rows int) joinOrderNode {
+		return joinOrderNode{node: plan.NewResolvedTable(analyzedTable(t, ctx, name, rows, func(i int) sql.Row {
+			return sql.NewRow(int64(i), int64(i%10))
+		}), nil, nil)}
+	}
+	filter := func(table string, below int64) sql.Expression {
+		return expression.NewLessThan(
+			expression.NewGetFieldWithTable(0, sql.Int64, table, "i", false),
+			expression.NewLiteral(below, sql.Int64),
+		)
+	}
+
+	t.Run("smaller tables first without join conditions", func(t *testing.T) {
+		jo := &joinOrderNode{commutes: []joinOrderNode{table("a", 30), table("b", 10), table("c", 20)}}
+		require.NoError(t, jo.estimateCost(ctx, joinIndexesByTable{}, nil))
+		require.Equal(t, []int{1, 2, 0}, jo.order)
+		require.Equal(t, float64(10+10*20+10*20*30), jo.cost)
+	})
+
+	t.Run("filtered table first", func(t *testing.T) {
+		jo := &joinOrderNode{commutes: []joinOrderNode{table("a", 30), table("b", 10), table("c", 20)}}
+		require.NoError(t, jo.estimateCost(ctx, joinIndexesByTable{}, []sql.Expression{filter("a", 2)}))
+		require.Equal(t, []int{0, 1, 2}, jo.order)
+		require.InDelta(t, 2, jo.commutes[0].rows, 0.5)
+	})
+
+	t.Run("greedy order for many tables", func(t *testing.T) {
+		jo := &joinOrderNode{}
+		for i := 0; i < maxExhaustiveJoinSearchTables+4; i++ {
+			jo.commutes = append(jo.commutes, table(fmt.Sprintf("t%d", i), 100-i))
+		}
+		require.NoError(t, jo.estimateCost(ctx, joinIndexesByTable{}, []sql.Expression{filter("t3", 5)}))
+
+		expected := []int{3}
+		for i := len(jo.commutes) - 1; i >= 0; i-- {
+			if i != 3 {
+				expected = append(expected, i)
+			}
+		}
+		require.Equal(t, expected, jo.order)
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replaceJoinWithMergeJoin replaces an inner, left or right join between two tables on an equality of their columns
+// with a merge join, when both columns are the first column of an ordered index of their table. Both tables are then
+// read in the order of those indexes. It returns false if the join can't be merged.
+func replaceJoinWithMergeJoin(
+	ctx *sql.Context,
+	a *Analyzer,
+	j plan.JoinNode,
+	scope *Scope,
+	tableAliases TableAliases,
+) (sql.Node, bool, error) {
+	switch j.JoinType() {
+	case plan.JoinTypeInner, plan.JoinTypeLeft, plan.JoinTypeRight:
+	default:
+		return j, false, nil
+	}
+	if !isMergeJoinTable(j.Left()) || !isMergeJoinTable(j.Right()) {
+		return j, false, nil
+	}
+
+	ia, err := getIndexesForNode(ctx, a, j)
+	if err != nil {
+		return nil, false, err
+	}
+
+	scopeLen := len(scope.Schema())
+	leftLen := len(j.Left().Schema())
+	conds := splitConjunction(j.JoinCond())
+	for c, cond := range conds {
+		eq, ok := cond.(*expression.Equals)
+		if !ok || !plan.CanMergeJoin(eq, scopeLen, j.Left().Schema()) {
+			continue
+		}
+		leftCol, ok := eq.Left().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		rightCol, ok := eq.Right().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		if leftCol.Index() >= scopeLen+leftLen {
+			leftCol, rightCol = rightCol, leftCol
+		}
+
+		// Both sides must be sorted the way the merge join compares them
+		leftType, isLeftString := leftCol.Type().(sql.StringType)
+		rightType, isRightString := rightCol.Type().(sql.StringType)
+		if isLeftString && isRightString && !leftType.Collation().Equals(rightType.Collation()) {
+			continue
+		}
+
+		leftIdx := orderedIndexOn(ctx, ia, j.Left(), leftCol, tableAliases)
+		rightIdx := orderedIndexOn(ctx, ia, j.Right(), rightCol, tableAliases)
+		if leftIdx == nil || rightIdx == nil {
+			continue
+		}
+
+		left, err := withOrderedIndexLookup(j.Left(), leftIdx, leftCol.WithIndex(leftCol.Index()-scopeLen))
+		if err != nil {
+			return nil, false, err
+		}
+		right, err := withOrderedIndexLookup(j.Right(), rightIdx, rightCol.WithIndex(rightCol.Index()-scopeLen-leftLen))
+		if err != nil {
+			return nil, false, err
+		}
+		if scopeLen > 0 {
+			left = plan.NewStripRowNode(left, scopeLen)
+			right = plan.NewStripRowNode(right, scopeLen)
+		}
+
+		// The merge join merges on the first equality of its condition
+		mergeConds := append([]sql.Expression{eq}, conds[:c]...)
+		mergeConds = append(mergeConds, conds[c+1:]...)
+
+		a.Log("replacing %s on %s with a merge join on %s", j.JoinType(), j.JoinCond(), eq)
+		return plan.NewMergeJoin(left, right, j.JoinType(), expression.JoinAnd(mergeConds...), scopeLen), true, nil
+	}
+
+	return j, false, nil
+}
+
+// isMergeJoinTable returns whether the node given is a table, or an alias of one, that can be read through an index.
+func isMergeJoinTable(n sql.Node) bool {
+	if ta, ok := n.(*plan.TableAlias); ok {
+		n = ta.Child
+	}
+	rt, ok := n.(*plan.ResolvedTable)
+	if !ok {
+		return false
+	}
+	_, ok = rt.Table.(sql.IndexAddressableTable)
+	return ok
+}
+
+// orderedIndexOn returns an ordered index of the table given whose first expression is the column given, or nil if it
+// has none.
+func orderedIndexOn(ctx *sql.Context, ia *indexAnalyzer, table sql.Node, col *expression.GetField, tableAliases TableAliases) sql.Index {
+	colName := normalizeExpression(ctx, tableAliases, col).String()
+	for _, idx := range ia.indexesByTable[table.(sql.Nameable).Name()] {
+		_, ok := idx.(sql.OrderedIndex)
+		if ok && strings.EqualFold(idx.Expressions()[0], colName) {
+			return idx
+		}
+	}
+	return nil
+}
+
+// withOrderedIndexLookup returns the table given read in the order of the index given. The key expression is the
+// indexed column, which is only displayed.
+func withOrderedIndexLookup(table sql.Node, idx sql.Index, keyExpr sql.Expression) (sql.Node, error) {
+	lookup, err := idx.(sql.OrderedIndex).AscendAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(table, func(n sql.Node) (sql.Node, error) {
+		if rt, ok := n.(*plan.ResolvedTable); ok {
+			return plan.NewStaticIndexedTableAccess(rt, lookup, idx, []sql.Expression{keyExpr}), nil
+		}
+		return n, nil
+	})
+}
@@ -173,6 +173,22 @@ func filterPushdownChildSelector(parent sql.Node, child sql.Node, childNum int)
 			return childNum == 0
 		}
 		return true
+	case *plan.HashJoin:
+		switch n.JoinType() {
+		case plan.JoinTypeLeft:
+			return childNum == 0
+		case plan.JoinTypeRight:
+			return childNum == 1
+		}
+		return true
+	case *plan.MergeJoin:
+		switch n.JoinType() {
+		case plan.JoinTypeLeft:
+			return childNum == 0
+		case plan.JoinTypeRight:
+			return childNum == 1
+		}
+		return true
 	case *plan.LeftJoin:
 		return childNum == 0
 	case *plan.RightJoin:
@@ -196,7 +212,7 @@ func filterPushdownAboveTablesChildSelector(parent sql.Node, child sql.Node, chi
 		// Don't bother pushing filters down above tables if the direct child node is a table. At best this
 		// just splits the predicates into multiple filter nodes, and at worst it breaks other parts of the
 		// analyzer that don't expect this structure in the tree.
-		case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+		case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 			return false
 		}
 	}
@@ -214,7 +230,7 @@ func transformPushdownFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *
 					return nil, err
 				}
 				return FixFieldIndexesForExpressions(ctx, a, n, scope)
-			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 				table, err := pushdownFiltersToTable(ctx, a, node.(NameableNode), scope, filters, tableAliases)
 				if err != nil {
 					return nil, err
@@ -235,7 +251,7 @@ func transformPushdownFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *
 					return nil, err
 				}
 				return FixFieldIndexesForExpressions(ctx, a, n, scope)
-			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 				table, err := pushdownFiltersToAboveTable(ctx, a, node.(NameableNode), scope, filters)
 				if err != nil {
 					return nil, err
@@ -253,7 +269,7 @@ func transformPushdownFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *
 		case *plan.Filter:
 			// Find all col exprs and group them by the table they mention so that we can keep track of which ones
 			// have been pushed down and need to be removed from the parent filter
-			filtersByTable := getFiltersByTable(n)
+			filtersByTable := getFiltersByTable(ctx, n)
 			filters := newFilterSet(n.Expression, filtersByTable, tableAliases)
 
 			// Two passes: first push filters to any tables that implement sql.Filtered table directly
@@ -291,7 +307,7 @@ func transformPushdownSubqueryAliasFilters(ctx *sql.Context, a *Analyzer, n sql.
 		switch n := n.(type) {
 		case *plan.Filter:
 			// First step is to find all col exprs and group them by the table they mention.
-			filtersByTable := getFiltersByTable(n)
+			filtersByTable := getFiltersByTable(ctx, n)
 			filters = newFilterSet(n.Expression, filtersByTable, tableAliases)
 			return transformFilterNode(n)
 		default:
@@ -315,7 +331,7 @@ func convertFiltersToIndexedAccess(
 			return false
 		}
 
-		switch parent.(type) {
+		switch parent := parent.(type) {
 		// For IndexedJoins, if we are already using indexed access during query execution for the secondary table,
 		// replacing the secondary table with an indexed lookup will have no effect on the result of the join, but
 		// *will* inappropriately remove the filter from the predicate.
@@ -324,6 +340,20 @@ func convertFiltersToIndexedAccess(
 			// Left and right joins can push down indexes for the primary table, but not the secondary. See comment
 			// on transformPushdownFilters
 			return childNum == 0
+		case *plan.HashJoin:
+			switch parent.JoinType() {
+			case plan.JoinTypeLeft:
+				return childNum == 0
+			case plan.JoinTypeRight:
+				return childNum == 1
+			}
+		case *plan.MergeJoin:
+			switch parent.JoinType() {
+			case plan.JoinTypeLeft:
+				return childNum == 0
+			case plan.JoinTypeRight:
+				return childNum == 1
+			}
 		case *plan.LeftJoin:
 			return childNum == 0
 		case *plan.RightJoin:
@@ -400,8 +430,9 @@ func pushdownFiltersToTable(
 	// Push any filters for this table onto the table itself if it's a sql.FilteredTable
 	if ft, ok := table.(sql.FilteredTable); ok && len(filters.availableFiltersForTable(ctx, tableNode.Name())) > 0 {
 		tableFilters := filters.availableFiltersForTable(ctx, tableNode.Name())
-		handled := ft.HandledFilters(normalizeExpressions(ctx, tableAliases, tableFilters...))
-		filters.markFiltersHandled(handled...)
+		normalized, converted := utcTableFilters(ctx, normalizeExpressions(ctx, tableAliases, tableFilters...))
+		handled := ft.HandledFilters(converted)
+		filters.markFiltersHandled(originalTableFilters(normalized, converted, handled)...)
 
 		handled, err := FixFieldIndexesOnExpressions(ctx, scope, a, tableNode.Schema(), handled...)
 		if err != nil {
@@ -422,7 +453,7 @@ func pushdownFiltersToTable(
 	}
 
 	switch tableNode.(type) {
-	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 		return withTable(newTableNode, table)
 	default:
 		return nil, ErrInvalidNodeType.New("pushdownFiltersToTable", tableNode)
@@ -464,7 +495,7 @@ func pushdownFiltersToAboveTable(
 	}
 
 	switch tableNode.(type) {
-	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess, *plan.ValueDerivedTable:
+	case *plan.ResolvedTable, *plan.TableAlias, *plan.IndexedTableAccess, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 		node, err := withTable(tableNode, table)
 		if err != nil {
 			return nil, err
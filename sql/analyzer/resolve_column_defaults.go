@@ -433,24 +433,56 @@ var validColumnDefaultFuncs = map[string]struct{}{
 	"yearweek":                           {},
 }
 
+// nondeterministicGeneratedColumnFuncs are the functions that are valid in a default value, but not in the
+// expression of a generated column, as their results may differ between evaluations.
+var nondeterministicGeneratedColumnFuncs = map[string]struct{}{
+	"connection_id":     {},
+	"curdate":           {},
+	"current_role":      {},
+	"current_timestamp": {},
+	"curtime":           {},
+	"database":          {},
+	"found_rows":        {},
+	"last_insert_id":    {},
+	"localtimestamp":    {},
+	"now":               {},
+	"rand":              {},
+	"random_bytes":      {},
+	"row_count":         {},
+	"schema":            {},
+	"session_user":      {},
+	"sleep":             {},
+	"sysdate":           {},
+	"system_user":       {},
+	"unix_timestamp":    {},
+	"user":              {},
+	"utc_date":          {},
+	"utc_time":          {},
+	"utc_timestamp":     {},
+	"uuid":              {},
+	"uuid_short":        {},
+}
+
 func resolveColumnDefaults(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	span, _ := ctx.Span("resolveColumnDefaults")
 	defer span.Finish()
 
-	// This is kind of hacky: we rely on the fact that we know that CreateTable returns the default for every
-	// column in the table, and they get evaluated in order below
-	colIndex := 0
+	n, err := plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		if ct, ok := n.(*plan.CreateTable); ok {
+			return resolveCreateTableColumnDefaults(ctx, ct)
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return plan.TransformExpressionsUpWithNode(ctx, n, func(n sql.Node, e sql.Expression) (sql.Expression, error) {
 		eWrapper, ok := e.(*expression.Wrapper)
 		if !ok {
 			return e, nil
 		}
 		switch node := n.(type) {
-		case *plan.CreateTable:
-			sch := node.Schema()
-			col := sch[colIndex]
-			colIndex++
-			return resolveColumnDefaultsOnWrapper(ctx, col, eWrapper)
 		case *plan.AddColumn:
 			return resolveColumnDefaultsOnWrapper(ctx, node.Column(), eWrapper)
 		case *plan.ModifyColumn:
@@ -474,6 +506,28 @@ func resolveColumnDefaults(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 	})
 }
 
+// resolveCreateTableColumnDefaults resolves the default value and the generation expression of every column of the
+// CreateTable given. Its expressions hold the default value of the column at index i of its schema at index i, and
+// the generation expression of that column at index len(schema)+i.
+func resolveCreateTableColumnDefaults(ctx *sql.Context, ct *plan.CreateTable) (sql.Node, error) {
+	sch := ct.Schema()
+	exprs := ct.Expressions()
+	for i, col := range sch {
+		for _, exprIdx := range []int{i, len(sch) + i} {
+			eWrapper, ok := exprs[exprIdx].(*expression.Wrapper)
+			if !ok {
+				continue
+			}
+			resolved, err := resolveColumnDefaultsOnWrapper(ctx, col, eWrapper)
+			if err != nil {
+				return nil, err
+			}
+			exprs[exprIdx] = resolved
+		}
+	}
+	return ct.WithExpressions(exprs...)
+}
+
 func resolveColumnDefaultsOnWrapper(ctx *sql.Context, col *sql.Column, e *expression.Wrapper) (sql.Expression, error) {
 	newDefault, ok := e.Unwrap().(*sql.ColumnDefaultValue)
 	if !ok {
@@ -484,7 +538,9 @@ func resolveColumnDefaultsOnWrapper(ctx *sql.Context, col *sql.Column, e *expres
 		return e, nil
 	}
 
-	if sql.IsTextBlob(col.Type) && newDefault.IsLiteral() {
+	// A generated column may not have a default value, so any value on it is its generation expression
+	isGenerated := col.Generated != nil
+	if sql.IsTextBlob(col.Type) && newDefault.IsLiteral() && !isGenerated {
 		return nil, sql.ErrInvalidTextBlobColumnDefault.New()
 	}
 
@@ -506,6 +562,10 @@ func resolveColumnDefaultsOnWrapper(ctx *sql.Context, col *sql.Column, e *expres
 		switch expr := e.(type) {
 		case sql.FunctionExpression:
 			funcName := expr.FunctionName()
+			if _, isNondeterministic := nondeterministicGeneratedColumnFuncs[funcName]; isNondeterministic && isGenerated {
+				err = sql.ErrInvalidGeneratedColumnFunction.New(funcName, col.Name)
+				return false
+			}
 			if _, isValid := validColumnDefaultFuncs[funcName]; !isValid {
 				err = sql.ErrInvalidColumnDefaultFunction.New(funcName, col.Name)
 				return false
@@ -538,10 +598,28 @@ func resolveColumnDefaultsOnWrapper(ctx *sql.Context, col *sql.Column, e *expres
 			case float64:
 				newDefault.Expression = expression.NewLiteral(-val, sql.Float64)
 				isLiteral = true
+			case string:
+				if sql.IsDecimal(literalExpr.Type()) {
+					neg, err := unaryMinusExpr.Eval(ctx, nil)
+					if err != nil {
+						return nil, err
+					}
+					newDefault.Expression = expression.NewLiteral(neg, literalExpr.Type())
+					isLiteral = true
+				}
 			}
 		}
 	}
 
+	if isGenerated {
+		// Nullability of generated values is checked along with the rest of the row when it is written
+		newDefault, err = sql.NewColumnDefaultValue(newDefault.Expression, col.Type, false, true)
+		if err != nil {
+			return nil, err
+		}
+		return expression.WrapExpression(newDefault), nil
+	}
+
 	newDefault, err = sql.NewColumnDefaultValue(newDefault.Expression, col.Type, isLiteral, col.Nullable)
 	if err != nil {
 		return nil, err
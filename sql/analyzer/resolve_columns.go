@@ -19,13 +19,13 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/similartext"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 func checkUniqueTableNames(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
@@ -181,6 +181,7 @@ func dedupStrings(in []string) []string {
 
 // qualifyColumns assigns a table to any column expressions that don't have one already
 func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	query := n
 	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		if _, ok := n.(sql.Expressioner); !ok || n.Resolved() {
 			return n, nil
@@ -188,12 +189,57 @@ func qualifyColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sq
 
 		symbols := getNodeAvailableNames(n, scope)
 
+		if tf, ok := n.(*plan.ResolvedTableFunction); ok {
+			if err := checkTableFunctionArguments(tf, query, scope, symbols); err != nil {
+				return nil, err
+			}
+		}
+
 		return plan.TransformExpressions(ctx, n, func(e sql.Expression) (sql.Expression, error) {
 			return qualifyExpression(ctx, e, symbols)
 		})
 	})
 }
 
+// checkTableFunctionArguments returns an error if an argument of the table function given references a column that
+// is not available to it, but is available to the rest of the query given, such as the column of another table in the
+// same FROM clause. The arguments of a table function can only reference the columns of outer queries.
+func checkTableFunctionArguments(tf *plan.ResolvedTableFunction, query sql.Node, scope *Scope, symbols availableNames) error {
+	var queryNames availableNames
+	var err error
+	for _, arg := range tf.Arguments {
+		sql.Inspect(arg, func(e sql.Expression) bool {
+			col, ok := e.(column)
+			if !ok || col.Resolved() || err != nil || symbols.hasColumn(col) {
+				return err == nil
+			}
+			if queryNames == nil {
+				queryNames = getNodeAvailableNames(query, scope)
+			}
+			if queryNames.hasColumn(col) {
+				err = sql.ErrTableFunctionColumnReference.New(tf.Name(), col.String())
+			}
+			return err == nil
+		})
+	}
+	return err
+}
+
+// hasColumn returns whether the column given, or the table of the column given if it has one, is available at any
+// nesting level.
+func (a availableNames) hasColumn(col column) bool {
+	for _, level := range a {
+		if col.Table() != "" {
+			if _, ok := level.availableTables[strings.ToLower(col.Table())]; ok {
+				return true
+			}
+		} else if len(level.availableColumns[strings.ToLower(col.Name())]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // getNodeAvailableSymbols returns the set of table and column names accessible to the node given and using the scope
 // given. Table aliases overwrite table names: the original name is not considered accessible once aliased.
 // The value of the map is the same as the key, just used for existence checks.
@@ -212,13 +258,13 @@ func getNodeAvailableNames(n sql.Node, scope *Scope) availableNames {
 	for i, n := range append(append(([]sql.Node)(nil), n), scope.InnerToOuter()...) {
 		plan.Inspect(n, func(n sql.Node) bool {
 			switch n := n.(type) {
-			case *plan.SubqueryAlias, *plan.ResolvedTable, *plan.ValueDerivedTable:
+			case *plan.SubqueryAlias, *plan.ResolvedTable, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 				name := strings.ToLower(n.(sql.Nameable).Name())
 				names.indexTable(name, name, i)
 				return false
 			case *plan.TableAlias:
 				switch t := n.Child.(type) {
-				case *plan.ResolvedTable, *plan.UnresolvedTable, *plan.SubqueryAlias:
+				case *plan.ResolvedTable, *plan.UnresolvedTable, *plan.SubqueryAlias, *plan.ResolvedTableFunction:
 					name := strings.ToLower(t.(sql.Nameable).Name())
 					alias := strings.ToLower(n.Name())
 					names.indexTable(alias, name, i)
@@ -351,7 +397,7 @@ func getColumnsInNodes(nodes []sql.Node, names availableNames, nestingLevel int)
 
 	for _, node := range nodes {
 		switch n := node.(type) {
-		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable:
+		case *plan.TableAlias, *plan.ResolvedTable, *plan.SubqueryAlias, *plan.ValueDerivedTable, *plan.ResolvedTableFunction:
 			for _, col := range n.Schema() {
 				names.indexColumn(col.Source, col.Name, nestingLevel)
 			}
@@ -175,6 +175,12 @@ func schemaLength(node sql.Node) int {
 		case plan.JoinNode:
 			schemaLen = schemaLength(node.Left()) + schemaLength(node.Right())
 			return false
+		case *plan.HashJoin:
+			schemaLen = len(node.Schema())
+			return false
+		case *plan.MergeJoin:
+			schemaLen = len(node.Schema())
+			return false
 		default:
 			return true
 		}
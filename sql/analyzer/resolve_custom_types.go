@@ -0,0 +1,51 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveCustomTypes replaces the types of the columns declared with a type that is not built in with the custom
+// types registered in the catalog under their names.
+func resolveCustomTypes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		var sch sql.Schema
+		switch n := n.(type) {
+		case *plan.CreateTable:
+			sch = n.Schema()
+		case *plan.AddColumn:
+			sch = sql.Schema{n.Column()}
+		case *plan.ModifyColumn:
+			sch = sql.Schema{n.NewColumn()}
+		default:
+			return n, nil
+		}
+
+		for _, col := range sch {
+			ut, ok := col.Type.(sql.UnresolvedCustomType)
+			if !ok {
+				continue
+			}
+			typ, err := a.Catalog.CustomTypes.Type(ut.Name(), ut.Params()...)
+			if err != nil {
+				return nil, err
+			}
+			col.Type = typ
+		}
+		return n, nil
+	})
+}
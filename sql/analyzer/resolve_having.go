@@ -456,6 +456,13 @@ func aggregationEquals(ctx *sql.Context, a, b sql.Expression) bool {
 		}
 
 		return aggregationChildEquals(ctx, a.Child, b.Child)
+	case *aggregation.VarPop, *aggregation.VarSamp, *aggregation.StdDevPop, *aggregation.StdDevSamp,
+		*aggregation.BitAnd, *aggregation.BitOr, *aggregation.BitXor:
+		if reflect.TypeOf(a) != reflect.TypeOf(b) {
+			return false
+		}
+
+		return aggregationChildEquals(ctx, a.Children()[0], b.Children()[0])
 	default:
 		return false
 	}
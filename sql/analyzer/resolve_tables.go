@@ -40,6 +40,10 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			return n, nil
 		}
 
+		if tf, ok := n.(*plan.UnresolvedTableFunction); ok {
+			return resolveTableFunction(a, tf)
+		}
+
 		t, ok := n.(*plan.UnresolvedTable)
 		if !ok {
 			return n, nil
@@ -88,6 +92,18 @@ func resolveTables(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 	})
 }
 
+// resolveTableFunction replaces the call to a table function given with a call to the table function of the same name
+// in the Catalog. Its arguments are resolved later, as any other expression.
+func resolveTableFunction(a *Analyzer, tf *plan.UnresolvedTableFunction) (sql.Node, error) {
+	fn, err := a.Catalog.TableFunctions.TableFunction(tf.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	a.Log("table function resolved: %s", tf.Name())
+	return plan.NewResolvedTableFunction(fn, tf.Name(), tf.Arguments)
+}
+
 func handleTableLookupFailure(err error, tableName string, dbName string, a *Analyzer, t *plan.UnresolvedTable) (sql.Node, error) {
 	if sql.ErrDatabaseNotFound.Is(err) {
 		if tableName == dualTableName {
@@ -33,6 +33,7 @@ var OnceBeforeDefault = []Rule{
 	{"resolve_set_variables", resolveSetVariables},
 	{"resolve_create_like", resolveCreateLike},
 	{"resolve_create_select", resolveCreateSelect},
+	{"resolve_custom_types", resolveCustomTypes},
 	{"resolve_subqueries", resolveSubqueries},
 	{"resolve_unions", resolveUnions},
 	{"resolve_describe_query", resolveDescribeQuery},
@@ -63,6 +64,7 @@ var DefaultRules = []Rule{
 	{"merge_union_schemas", mergeUnionSchemas},
 	{"flatten_aggregation_exprs", flattenAggregationExpressions},
 	{"reorder_projection", reorderProjection},
+	{"decorrelate_subqueries", decorrelateSubqueries},
 	{"resolve_subquery_exprs", resolveSubqueryExpressions},
 	{"move_join_conds_to_filter", moveJoinConditionsToFilter},
 	{"eval_filter", evalFilter},
@@ -77,17 +79,21 @@ var OnceAfterDefault = []Rule{
 	{"load_triggers", loadTriggers},
 	{"process_truncate", processTruncate},
 	{"resolve_column_defaults", resolveColumnDefaults},
+	{"validate_generated_column_writes", validateGeneratedColumnWrites},
 	{"resolve_generators", resolveGenerators},
+	{"resolve_full_text_match", resolveFullTextMatch},
 	{"remove_unnecessary_converts", removeUnnecessaryConverts},
 	{"assign_catalog", assignCatalog},
 	{"prune_columns", pruneColumns},
 	{"optimize_joins", constructJoinPlan},
 	{"pushdown_filters", pushdownFilters},
+	{"apply_vector_indexes", applyVectorIndexes},
 	{"subquery_indexes", applyIndexesFromOuterScope},
 	{"in_subquery_indexes", applyIndexesForSubqueryComparisons},
 	{"pushdown_projections", pushdownProjections},
 	{"set_join_scope_len", setJoinScopeLen},
 	{"erase_projection", eraseProjection},
+	{"apply_top_n", applyTopN},
 	// One final pass at analyzing subqueries to handle rewriting field indexes after changes to outer scope by
 	// previous rules.
 	{"resolve_subquery_exprs", resolveSubqueryExpressions},
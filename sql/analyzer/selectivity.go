@@ -0,0 +1,194 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// tableStatistics returns the column statistics ANALYZE TABLE stored for the table given, or nil if there are none.
+func tableStatistics(ctx *sql.Context, table sql.Table) (*sql.TableStatistics, error) {
+	for {
+		switch t := table.(type) {
+		case sql.AnalyzableTable:
+			return t.ColumnStatistics(ctx)
+		case sql.TableWrapper:
+			table = t.Underlying()
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// filterSelectivity returns the estimated fraction of the rows of the table with the name given that pass the filters
+// given, of which it only considers the ones that reference no other table. Filters it can't estimate don't reduce it.
+func filterSelectivity(stats *sql.TableStatistics, table string, filters []sql.Expression) float64 {
+	selectivity := 1.0
+	if stats == nil {
+		return selectivity
+	}
+	for _, filter := range filters {
+		sources := expressionSources(filter)
+		if len(sources) != 1 || !strings.EqualFold(sources[0], table) {
+			continue
+		}
+		if s, ok := predicateSelectivity(stats, filter); ok {
+			selectivity *= s
+		}
+	}
+	return selectivity
+}
+
+// predicateSelectivity returns the estimated fraction of the rows of a table that pass the predicate given, which only
+// references columns of that table, and false if the statistics can't tell.
+func predicateSelectivity(stats *sql.TableStatistics, e sql.Expression) (float64, bool) {
+	switch e := e.(type) {
+	case *expression.And:
+		left, leftOk := predicateSelectivity(stats, e.Left)
+		right, rightOk := predicateSelectivity(stats, e.Right)
+		switch {
+		case leftOk && rightOk:
+			return left * right, true
+		case leftOk:
+			return left, true
+		case rightOk:
+			return right, true
+		}
+	case *expression.Or:
+		left, leftOk := predicateSelectivity(stats, e.Left)
+		right, rightOk := predicateSelectivity(stats, e.Right)
+		if leftOk && rightOk {
+			return left + right - left*right, true
+		}
+	case *expression.Not:
+		if s, ok := predicateSelectivity(stats, e.Child); ok {
+			return 1 - s, true
+		}
+	case *expression.IsNull:
+		if col := columnStatistics(stats, e.Child); col != nil {
+			return col.NullSelectivity(), true
+		}
+	case *expression.Equals:
+		if col, v, _, ok := columnComparison(stats, e); ok {
+			return equalsSelectivity(col, v)
+		}
+	case *expression.NullSafeEquals:
+		if col, v, _, ok := columnComparison(stats, e); ok {
+			if v == nil {
+				return col.NullSelectivity(), true
+			}
+			return equalsSelectivity(col, v)
+		}
+	case *expression.LessThan, *expression.LessThanOrEqual, *expression.GreaterThan, *expression.GreaterThanOrEqual:
+		col, v, columnLeft, ok := columnComparison(stats, e.(expression.Comparer))
+		if !ok {
+			break
+		}
+		if v == nil {
+			return 0, true
+		}
+
+		var inclusive, below bool
+		switch e.(type) {
+		case *expression.LessThan:
+			below = true
+		case *expression.LessThanOrEqual:
+			below, inclusive = true, true
+		case *expression.GreaterThanOrEqual:
+			inclusive = true
+		}
+		if !columnLeft {
+			below = !below
+		}
+
+		if below {
+			return rangeSelectivity(col, nil, v, false, inclusive)
+		}
+		return rangeSelectivity(col, v, nil, inclusive, false)
+	case *expression.Between:
+		col := columnStatistics(stats, e.Val)
+		lower, lowerOk := e.Lower.(*expression.Literal)
+		upper, upperOk := e.Upper.(*expression.Literal)
+		if col == nil || !lowerOk || !upperOk {
+			break
+		}
+		if lower.Value() == nil || upper.Value() == nil {
+			return 0, true
+		}
+		return rangeSelectivity(col, lower.Value(), upper.Value(), true, true)
+	case *expression.InTuple:
+		col := columnStatistics(stats, e.Left())
+		tuple, ok := e.Right().(expression.Tuple)
+		if col == nil || !ok {
+			break
+		}
+		var selectivity float64
+		for _, el := range tuple {
+			lit, ok := el.(*expression.Literal)
+			if !ok {
+				return 0, false
+			}
+			s, ok := equalsSelectivity(col, lit.Value())
+			if !ok {
+				return 0, false
+			}
+			selectivity += s
+		}
+		if selectivity > 1 {
+			selectivity = 1
+		}
+		return selectivity, true
+	}
+	return 0, false
+}
+
+// columnStatistics returns the statistics of the column the expression given is, or nil if it's not a column or has no
+// statistics.
+func columnStatistics(stats *sql.TableStatistics, e sql.Expression) *sql.ColumnStatistics {
+	gf, ok := e.(*expression.GetField)
+	if !ok {
+		return nil
+	}
+	return stats.Column(gf.Name())
+}
+
+// columnComparison returns the statistics of the column and the literal value of the comparison given between them,
+// and whether the column is on its left side.
+func columnComparison(stats *sql.TableStatistics, c expression.Comparer) (*sql.ColumnStatistics, interface{}, bool, bool) {
+	if lit, ok := c.Right().(*expression.Literal); ok {
+		if col := columnStatistics(stats, c.Left()); col != nil {
+			return col, lit.Value(), true, true
+		}
+	}
+	if lit, ok := c.Left().(*expression.Literal); ok {
+		if col := columnStatistics(stats, c.Right()); col != nil {
+			return col, lit.Value(), false, true
+		}
+	}
+	return nil, nil, false, false
+}
+
+func equalsSelectivity(col *sql.ColumnStatistics, v interface{}) (float64, bool) {
+	s, err := col.EqualsSelectivity(v)
+	return s, err == nil
+}
+
+func rangeSelectivity(col *sql.ColumnStatistics, lower, upper interface{}, lowerInclusive, upperInclusive bool) (float64, bool) {
+	s, err := col.RangeSelectivity(lower, upper, lowerInclusive, upperInclusive)
+	return s, err == nil
+}
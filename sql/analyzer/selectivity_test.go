@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestPredicateSelectivity(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	table := analyzedTable(t, ctx, "t", 100, func(i int) sql.Row {
+		if i%10 == 0 {
+			return sql.NewRow(int64(i), nil)
+		}
+		return sql.NewRow(int64(i), int64(i%3))
+	})
+	stats, err := tableStatistics(ctx, table)
+	require.NoError(t, err)
+
+	i := expression.NewGetFieldWithTable(0, sql.Int64, "t", "i", false)
+	k := expression.NewGetFieldWithTable(1, sql.Int64, "t", "k", true)
+	lit := func(v interface{}) sql.Expression {
+		return expression.NewLiteral(v, sql.Int64)
+	}
+
+	testCases := []struct {
+		name     string
+		filter   sql.Expression
+		expected float64
+		ok       bool
+	}{
+		{"equals", expression.NewEquals(k, lit(int64(1))), 0.3, true},
+		{"equals flipped", expression.NewEquals(lit(int64(1)), k), 0.3, true},
+		{"equals null", expression.NewEquals(k, lit(nil)), 0, true},
+		{"null safe equals null", expression.NewNullSafeEquals(k, lit(nil)), 0.1, true},
+		{"is null", expression.NewIsNull(k), 0.1, true},
+		{"not", expression.NewNot(expression.NewIsNull(k)), 0.9, true},
+		{"less than", expression.NewLessThan(i, lit(int64(25))), 0.25, true},
+		{"greater than flipped", expression.NewGreaterThan(lit(int64(25)), i), 0.25, true},
+		{"greater than or equal", expression.NewGreaterThanOrEqual(i, lit(int64(90))), 0.1, true},
+		{"between", expression.NewBetween(i, lit(int64(10)), lit(int64(19))), 0.1, true},
+		{"in", expression.NewInTuple(k, expression.NewTuple(lit(int64(0)), lit(int64(2)))), 0.6, true},
+		{"and", expression.NewAnd(expression.NewLessThan(i, lit(int64(50))), expression.NewEquals(k, lit(int64(1)))), 0.15, true},
+		{"or", expression.NewOr(expression.NewLessThan(i, lit(int64(50))), expression.NewLessThan(i, lit(int64(20)))), 0.6, true},
+		{"column comparison", expression.NewEquals(i, k), 0, false},
+		{"and with unknown side", expression.NewAnd(expression.NewEquals(i, k), expression.NewIsNull(k)), 0.1, true},
+		{"or with unknown side", expression.NewOr(expression.NewEquals(i, k), expression.NewIsNull(k)), 0, false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := predicateSelectivity(stats, tt.filter)
+			require.Equal(t, tt.ok, ok)
+			require.InDelta(t, tt.expected, s, 0.02)
+		})
+	}
+}
+
+func TestHashJoinBuildsOnFilteredSide(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	big := analyzedTable(t, ctx, "big", 1000, func(i int) sql.Row {
+		return sql.NewRow(int64(i), int64(i%100))
+	})
+	small := analyzedTable(t, ctx, "small", 100, func(i int) sql.Row {
+		return sql.NewRow(int64(i), int64(i))
+	})
+	left, right := plan.NewResolvedTable(big, nil, nil), plan.NewResolvedTable(small, nil, nil)
+
+	buildLeft, err := hashJoinBuildsLeft(ctx, plan.JoinTypeInner, left, right, nil)
+	require.NoError(err)
+	require.False(buildLeft)
+
+	// Only about 10 rows of big have an i under 10
+	filters := []sql.Expression{
+		expression.NewLessThan(
+			expression.NewGetFieldWithTable(0, sql.Int64, "big", "i", false),
+			expression.NewLiteral(int64(10), sql.Int64),
+		),
+	}
+	buildLeft, err = hashJoinBuildsLeft(ctx, plan.JoinTypeInner, left, right, filters)
+	require.NoError(err)
+	require.True(buildLeft)
+
+	// The filter doesn't apply to the alias of big
+	buildLeft, err = hashJoinBuildsLeft(ctx, plan.JoinTypeInner, plan.NewTableAlias("b", left), right, filters)
+	require.NoError(err)
+	require.False(buildLeft)
+}
+
+// analyzedTable returns a memory table with the number of rows given, made by the function given, of columns i and k,
+// after running ANALYZE TABLE on it.
+func analyzedTable(t *testing.T, ctx *sql.Context, name string, rows int, row func(i int) sql.Row) *memory.Table {
+	table := memory.NewTable(name, sql.Schema{
+		{Name: "i", Type: sql.Int64, Source: name},
+		{Name: "k", Type: sql.Int64, Source: name, Nullable: true},
+	})
+	for i := 0; i < rows; i++ {
+		require.NoError(t, table.Insert(ctx, row(i)))
+	}
+
+	iter, err := plan.NewAnalyzeTable([]sql.Node{plan.NewResolvedTable(table, nil, nil)}).RowIter(ctx, nil)
+	require.NoError(t, err)
+	_, err = sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	return table
+}
@@ -0,0 +1,135 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// replanSemiOrAntiJoin plans the joins on both sides of the semi or anti join given, and then the join itself. Its
+// right side is looked up in an index for every row of its left side when it's a single table with an index on the
+// join condition, and otherwise the join becomes a hash join if the condition has an equality between both sides.
+// The tables of the right side are never joined before the left side, since only the rows of the left side are
+// returned.
+func replanSemiOrAntiJoin(ctx *sql.Context, a *Analyzer, j plan.JoinNode, scope *Scope) (sql.Node, error) {
+	left, err := replaceJoinPlans(ctx, a, j.Left(), scope)
+	if err != nil {
+		return nil, err
+	}
+	right, err := replaceJoinPlans(ctx, a, j.Right(), scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tables of the left side might have been reordered
+	cond, err := FixFieldIndexes(ctx, scope, a, append(left.Schema(), right.Schema()...), j.JoinCond())
+	if err != nil {
+		return nil, err
+	}
+
+	indexedJoin, ok, err := semiOrAntiIndexedJoin(ctx, a, j.JoinType(), left, right, cond, scope)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return indexedJoin, nil
+	}
+
+	scopeLen := len(scope.Schema())
+	if plan.CanHashJoin(cond, scopeLen, left.Schema()) {
+		buildLeft, err := hashJoinBuildsLeft(ctx, j.JoinType(), left, right, filtersOutsideSubqueries(right))
+		if err != nil {
+			return nil, err
+		}
+		if scopeLen > 0 {
+			left = plan.NewStripRowNode(left, scopeLen)
+			right = plan.NewStripRowNode(right, scopeLen)
+		}
+
+		a.Log("replacing %s on %s with a hash join", j.JoinType(), cond)
+		return plan.NewHashJoin(left, right, j.JoinType(), cond, buildLeft, scopeLen), nil
+	}
+
+	nj, err := j.WithChildren(left, right)
+	if err != nil {
+		return nil, err
+	}
+	return nj.(sql.Expressioner).WithExpressions(cond)
+}
+
+// semiOrAntiIndexedJoin returns an indexed semi or anti join between the nodes given, and true, if the right one is a
+// single table, possibly filtered, with an index that the join condition can look up with the columns of the left
+// one.
+func semiOrAntiIndexedJoin(
+	ctx *sql.Context,
+	a *Analyzer,
+	joinType plan.JoinType,
+	left, right sql.Node,
+	cond sql.Expression,
+	scope *Scope,
+) (sql.Node, bool, error) {
+	ok := true
+	plan.Inspect(right, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Filter, *plan.TableAlias, *plan.ResolvedTable, nil:
+			return true
+		default:
+			ok = false
+			return false
+		}
+	})
+	if !ok {
+		return nil, false, nil
+	}
+
+	rightTable := getTableName(right)
+	if rightTable == "" {
+		return nil, false, nil
+	}
+
+	tableAliases, err := getTableAliases(right, scope)
+	if err != nil {
+		return nil, false, err
+	}
+	ia, err := getIndexesForNode(ctx, a, right)
+	if err != nil {
+		return nil, false, err
+	}
+	defer ia.releaseUsedIndexes()
+
+	joinIndexes := getJoinIndexes(ctx, a, ia, joinCond{cond: cond, joinType: joinType, rightHandTable: strings.ToLower(rightTable)}, tableAliases)
+	if joinIndexes[rightTable].getUsableIndex(left.Schema()) == nil {
+		return nil, false, nil
+	}
+
+	right, replaced, err := replaceTableAccessWithIndexedAccess(ctx, right, a, left.Schema(), scope, joinIndexes, tableAliases)
+	if err != nil {
+		return nil, false, err
+	}
+	if !replaced {
+		return nil, false, nil
+	}
+
+	if scope != nil {
+		left = plan.NewStripRowNode(left, len(scope.Schema()))
+		right = plan.NewStripRowNode(right, len(scope.Schema()))
+	}
+
+	a.Log("replacing %s on %s with an indexed join", joinType, cond)
+	return plan.NewIndexedJoin(left, right, joinType, cond, len(scope.Schema())), true, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyTopN replaces a Limit, with an optional Offset, over a Sort with a TopN node, which keeps only the rows it
+// returns in memory instead of sorting all of the rows of its child. A projection between the Limit and the Sort is
+// kept above the TopN.
+func applyTopN(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		limit, ok := n.(*plan.Limit)
+		if !ok {
+			return n, nil
+		}
+
+		child := limit.Child
+		var offset sql.Expression
+		if o, ok := child.(*plan.Offset); ok {
+			offset = o.Offset
+			child = o.Child
+		}
+		project, ok := child.(*plan.Project)
+		if ok {
+			child = project.Child
+		}
+		sort, ok := child.(*plan.Sort)
+		if !ok {
+			return n, nil
+		}
+
+		topN := plan.NewTopN(sort.SortFields, limit.Limit, offset, sort.Child)
+		topN.CalcFoundRows = limit.CalcFoundRows
+		if project == nil {
+			return topN, nil
+		}
+		return project.WithChildren(topN)
+	})
+}
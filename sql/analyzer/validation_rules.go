@@ -497,6 +497,11 @@ func validateSubqueryColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *S
 	// First validate that every subquery expression returns a single column
 	valid := true
 	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		// EXISTS only checks whether its subquery returns a row, whatever its columns
+		if _, ok := e.(*plan.ExistsSubquery); ok {
+			return false
+		}
+
 		s, ok := e.(*plan.Subquery)
 		if ok && len(s.Query.Schema()) != 1 {
 			valid = false
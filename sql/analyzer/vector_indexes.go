@@ -0,0 +1,184 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyVectorIndexes replaces the table of a query ordered by the distance of a VECTOR column to a constant vector
+// with a LIMIT, such as SELECT * FROM t ORDER BY VEC_DISTANCE_L2(v, '[1,2]') LIMIT 10, with a lookup of the rows
+// nearest to the vector in a sql.VectorIndex on the column. The rows of the lookup are still sorted, as lookups may be
+// approximate. Only columns that are NOT NULL are looked up, as rows without a vector are never among the nearest
+// rows of an index, while they sort first by distance.
+func applyVectorIndexes(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		limit, ok := n.(*plan.Limit)
+		if !ok {
+			return n, nil
+		}
+
+		k, ok := constantRowCount(ctx, limit.Limit)
+		if !ok {
+			return n, nil
+		}
+		child := limit.Child
+		if offset, ok := child.(*plan.Offset); ok {
+			skipped, ok := constantRowCount(ctx, offset.Offset)
+			if !ok {
+				return n, nil
+			}
+			k += skipped
+			child = offset.Child
+		}
+
+		var aliases map[string]sql.Expression
+		if project, ok := child.(*plan.Project); ok {
+			child = project.Child
+		}
+		sort, ok := child.(*plan.Sort)
+		if !ok || len(sort.SortFields) != 1 || sort.SortFields[0].Order != sql.Ascending {
+			return n, nil
+		}
+		child = sort.Child
+		if project, ok := child.(*plan.Project); ok {
+			// The distance may be computed below the sort, as in ORDER BY an alias of it
+			aliases = make(map[string]sql.Expression)
+			for _, e := range project.Projections {
+				if alias, ok := e.(*expression.Alias); ok {
+					aliases[strings.ToLower(alias.Name())] = alias.Child
+				}
+			}
+			child = project.Child
+		}
+
+		var rt *plan.ResolvedTable
+		switch child := child.(type) {
+		case *plan.ResolvedTable:
+			rt = child
+		case *plan.TableAlias:
+			rt, _ = child.Child.(*plan.ResolvedTable)
+		}
+		if rt == nil {
+			return n, nil
+		}
+		it, ok := rt.Table.(sql.IndexedTable)
+		if !ok {
+			return n, nil
+		}
+
+		sortExpr := sort.SortFields[0].Column
+		if gf, ok := sortExpr.(*expression.GetField); ok && aliases != nil {
+			sortExpr = aliases[strings.ToLower(gf.Name())]
+		}
+		distance, ok := sortExpr.(*function.VecDistance)
+		if !ok {
+			return n, nil
+		}
+		column, vecExpr := vectorDistanceOperands(distance)
+		if column == nil || column.IsNullable() {
+			return n, nil
+		}
+		val, err := vecExpr.Eval(ctx, nil)
+		if err != nil || val == nil {
+			return n, nil
+		}
+		vec, err := sql.Vector.Convert(val)
+		if err != nil {
+			return n, nil
+		}
+
+		indexes, err := it.GetIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, idx := range indexes {
+			vi, ok := idx.(sql.VectorIndex)
+			if !ok || len(vi.Expressions()) != 1 ||
+				!strings.EqualFold(vi.Expressions()[0], vi.Table()+"."+column.Name()) {
+				continue
+			}
+
+			lookup, err := vi.Nearest(ctx, distance.Distance(), vec.([]float32), int(k))
+			if err != nil {
+				return nil, err
+			}
+			a.Log("using vector index %s for the %d rows nearest to %s", vi.ID(), k, vecExpr)
+			ita := plan.NewStaticIndexedTableAccess(rt, lookup, vi, []sql.Expression{vecExpr})
+			return plan.TransformUp(limit, func(n sql.Node) (sql.Node, error) {
+				if n == rt {
+					return ita, nil
+				}
+				return n, nil
+			})
+		}
+		return n, nil
+	})
+}
+
+// constantRowCount returns the number of rows given by a constant LIMIT or OFFSET expression.
+func constantRowCount(ctx *sql.Context, e sql.Expression) (int64, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	val, err := lit.Eval(ctx, nil)
+	if err != nil {
+		return 0, false
+	}
+	count, err := sql.Int64.Convert(val)
+	if err != nil || count.(int64) < 0 {
+		return 0, false
+	}
+	return count.(int64), true
+}
+
+// vectorDistanceOperands returns the column and the constant vector expression that are the arguments of the
+// distance function given, in either order, or nils if its arguments are not a column and a constant.
+func vectorDistanceOperands(distance *function.VecDistance) (*expression.GetField, sql.Expression) {
+	for _, pair := range [][2]sql.Expression{{distance.Left, distance.Right}, {distance.Right, distance.Left}} {
+		column, ok := pair[0].(*expression.GetField)
+		if ok && isConstantExpression(pair[1]) {
+			return column, pair[1]
+		}
+	}
+	return nil, nil
+}
+
+// isConstantExpression returns whether the expression given evaluates to the same value for every row.
+func isConstantExpression(e sql.Expression) bool {
+	constant := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField, *plan.Subquery, *expression.BindVar, *expression.UserVar, *expression.SystemVar:
+			constant = false
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				constant = false
+			}
+		}
+		return constant
+	})
+	return constant
+}
@@ -36,6 +36,41 @@ func HashOf(v Row) (uint64, error) {
 	return hash.Sum64(), nil
 }
 
+// HashOfSchema returns a hash of the given row like HashOf, but with every value first replaced by its CollationKey in
+// the type of its column in the schema given, so that rows that compare as equal have the same hash.
+func HashOfSchema(v Row, schema Schema) (uint64, error) {
+	keys := make(Row, len(v))
+	for i, x := range v {
+		if i < len(schema) {
+			keys[i] = CollationKey(schema[i].Type, x)
+		} else {
+			keys[i] = x
+		}
+	}
+	return HashOf(keys)
+}
+
+// CollationKey returns a value to use in place of the value given, of the type given, in hash keys. For strings, this
+// is their key in the collation of the type, so that strings that compare as equal have the same key. Other values are
+// returned unchanged.
+func CollationKey(t Type, v interface{}) interface{} {
+	switch t := t.(type) {
+	case StringType:
+		if s, ok := v.(string); ok {
+			return t.Collation().Key(s)
+		}
+	case tupleType:
+		if vals, ok := v.([]interface{}); ok && len(vals) == len(t) {
+			keys := make([]interface{}, len(vals))
+			for i, val := range vals {
+				keys[i] = CollationKey(t[i], val)
+			}
+			return keys
+		}
+	}
+	return v
+}
+
 // ErrKeyNotFound is returned when the key could not be found in the cache.
 var ErrKeyNotFound = errors.NewKind("memory: key %d not found in cache")
 
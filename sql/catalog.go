@@ -43,6 +43,11 @@ type Catalog struct {
 	*ProcessList
 	*MemoryManager
 
+	// TableFunctions holds the functions that can be used as tables in the FROM clause of queries.
+	TableFunctions TableFunctionRegistry
+	// CustomTypes holds the types that are not built in, which columns may be declared with.
+	CustomTypes *TypeRegistry
+
 	mu       sync.RWMutex
 	provider MutableDatabaseProvider
 	locks    sessionLocks
@@ -61,8 +66,13 @@ func NewCatalog() *Catalog {
 
 // NewCatalogWithDbProvider returns a new empty Catalog.
 func NewCatalogWithDbProvider(provider MutableDatabaseProvider) *Catalog {
+	customTypes := NewTypeRegistry()
+	customTypes.MustRegister("vector", newVectorType)
+
 	return &Catalog{
 		FunctionRegistry: NewFunctionRegistry(),
+		TableFunctions:   NewTableFunctionRegistry(),
+		CustomTypes:      customTypes,
 		MemoryManager:    NewMemoryManager(ProcessMemory),
 		ProcessList:      NewProcessList(),
 		provider:         provider,
@@ -16,7 +16,6 @@ package sql
 
 import (
 	"fmt"
-	"strings"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -26,34 +25,15 @@ import (
 // CharacterSet represents the character set of a string.
 type CharacterSet string
 
-type insensitiveMatcher struct {
+// foldingMatcher matches strings after folding them by the rules of a collation, in the same way as the pattern it
+// was created with.
+type foldingMatcher struct {
 	regex.DisposableMatcher
+	fold func(string) string
 }
 
-func (im *insensitiveMatcher) Match(matchStr string) bool {
-	lower := strings.ToLower(matchStr)
-	return im.DisposableMatcher.Match(lower)
-}
-
-func insensitiveLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
-	lower := strings.ToLower(likeStr)
-	dm, err := regex.NewDisposableMatcher("go", lower)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return &insensitiveMatcher{dm}, nil
-}
-
-func sensitiveLikeMatcher(likeStr string) (regex.DisposableMatcher, error) {
-	return regex.NewDisposableMatcher("go", likeStr)
-}
-
-func insensitiveCompare(a, b string) int {
-	lowerA := strings.ToLower(a)
-	lowerB := strings.ToLower(b)
-	return strings.Compare(lowerA, lowerB)
+func (fm *foldingMatcher) Match(matchStr string) bool {
+	return fm.DisposableMatcher.Match(fm.fold(matchStr))
 }
 
 // Collation represents the collation of a string.
@@ -62,18 +42,27 @@ type Collation struct {
 	CharSet     CharacterSet
 	Compare     func(as, bs string) int
 	LikeMatcher func(likeStr string) (regex.DisposableMatcher, error)
+	rules       collationRules
 }
 
 var Collations = map[string]Collation{}
 
+// newCollation creates and registers a Collation, which compares strings by the rules its name specifies.
 func newCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: insensitiveCompare, LikeMatcher: insensitiveLikeMatcher}
-	Collations[name] = c
-	return c
-}
-
-func newCSCollation(name string, cs CharacterSet) Collation {
-	c := Collation{Name: name, CharSet: cs, Compare: strings.Compare, LikeMatcher: sensitiveLikeMatcher}
+	rules := collationRulesForName(name)
+	c := Collation{
+		Name:    name,
+		CharSet: cs,
+		Compare: rules.compare,
+		LikeMatcher: func(likeStr string) (regex.DisposableMatcher, error) {
+			dm, err := regex.NewDisposableMatcher("go", rules.fold(likeStr))
+			if err != nil {
+				return nil, err
+			}
+			return &foldingMatcher{dm, rules.fold}, nil
+		},
+		rules: rules,
+	}
 	Collations[name] = c
 	return c
 }
@@ -128,10 +117,8 @@ const (
 )
 
 var (
-	// case sensitive colations
-	Collation_binary = newCSCollation("binary", CharacterSet_binary)
+	Collation_binary = newCollation("binary", CharacterSet_binary)
 
-	// case insensitive collations
 	Collation_armscii8_general_ci         = newCollation("armscii8_general_ci", CharacterSet_armscii8)
 	Collation_armscii8_bin                = newCollation("armscii8_bin", CharacterSet_armscii8)
 	Collation_ascii_general_ci            = newCollation("ascii_general_ci", CharacterSet_ascii)
@@ -705,16 +692,27 @@ type mysqlCollationRow struct {
 	IsDefault  string
 	IsCompiled string
 	SortLen    int64
-	PadSpace   string
 }
 
 var CollationToMySQLVals = map[string]mysqlCollationRow{
-	Collation_binary.Name:             {63, Y, Y, 0, NoPad},
-	Collation_utf8_general_ci.Name:    {33, Y, Y, 1, PadSpace},
-	Collation_utf8mb4_0900_ai_ci.Name: {255, Y, Y, 0, NoPad},
+	Collation_ascii_general_ci.Name:   {11, Y, Y, 1},
+	Collation_binary.Name:             {63, Y, Y, 0},
+	Collation_latin1_swedish_ci.Name:  {8, Y, Y, 1},
+	Collation_ucs2_general_ci.Name:    {35, Y, Y, 1},
+	Collation_utf16_general_ci.Name:   {54, Y, Y, 1},
+	Collation_utf16le_general_ci.Name: {56, Y, Y, 1},
+	Collation_utf32_general_ci.Name:   {60, Y, Y, 1},
+	Collation_utf8_general_ci.Name:    {33, Y, Y, 1},
+	Collation_utf8mb4_0900_ai_ci.Name: {255, Y, Y, 0},
 }
 
 var SupportedCharsets = []CharacterSet{
+	CharacterSet_ascii,
+	CharacterSet_latin1,
+	CharacterSet_ucs2,
+	CharacterSet_utf16,
+	CharacterSet_utf16le,
+	CharacterSet_utf32,
 	CharacterSet_utf8mb4,
 }
 
@@ -860,11 +858,21 @@ func (c Collation) SortLen() int64 {
 
 // PadSpace returns pad space of the collation.
 func (c Collation) PadSpace() string {
-	s, ok := CollationToMySQLVals[c.Name]
-	if !ok {
+	if c.rules.padSpace {
 		return PadSpace
 	}
-	return s.PadSpace
+	return NoPad
+}
+
+// Key returns a string that is the same for any two strings that this collation compares as equal, and different for
+// any two strings that it does not, for use in place of the strings in hash keys.
+func (c Collation) Key(s string) string {
+	return c.rules.key(s)
+}
+
+// IsCaseSensitive returns whether this collation compares letters of different cases as different.
+func (c Collation) IsCaseSensitive() bool {
+	return c.rules.strength != collationStrength_Primary && c.rules.strength != collationStrength_Secondary
 }
 
 // Equals returns true if two collations are equal, false otherwise
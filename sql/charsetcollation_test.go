@@ -69,3 +69,77 @@ func testParseCollation(t *testing.T, charset *string, collation *string, binary
 		}
 	})
 }
+
+func TestCollationCompare(t *testing.T) {
+	tests := []struct {
+		collation Collation
+		a         string
+		b         string
+		expected  int
+	}{
+		{Collation_utf8mb4_0900_ai_ci, "résumé", "RESUME", 0},
+		{Collation_utf8mb4_0900_ai_ci, "straße", "STRASSE", 0},
+		{Collation_utf8mb4_0900_ai_ci, "ñ", "o", -1},
+		{Collation_utf8mb4_0900_ai_ci, "a", "a ", -1},
+		{Collation_utf8mb4_0900_ai_ci, "9", "a", -1},
+		{Collation_utf8mb4_0900_ai_ci, " ", "!", -1},
+		{Collation_utf8mb4_0900_ai_ci, "Ωμέγα", "ωμεγα", 0},
+		{Collation_utf8mb4_0900_ai_ci, "ＭｙＳＱＬ", "mysql", 0},
+		{Collation_utf8mb4_0900_ai_ci, "ǆ", "dž", 0},
+		{Collation_utf8mb4_0900_ai_ci, "б", "a", 1},
+		{Collation_utf8mb4_0900_as_ci, "Ёлка", "елка", 1},
+		{Collation_utf8mb4_0900_as_ci, "resume", "RESUME", 0},
+		{Collation_utf8mb4_0900_as_ci, "résumé", "resume", 1},
+		{Collation_utf8mb4_0900_as_cs, "a", "A", -1},
+		{Collation_utf8mb4_0900_as_cs, "A", "á", -1},
+		{Collation_utf8mb4_0900_as_cs, "Ab", "ac", -1},
+		{Collation_utf8mb4_0900_bin, "a", "a ", -1},
+		{Collation_utf8mb4_0900_bin, "B", "a", -1},
+		{Collation_utf8mb4_bin, "a", "a  ", 0},
+		{Collation_utf8mb4_bin, "é", "e", 1},
+		{Collation_utf8mb4_general_ci, "Abc", "abc   ", 0},
+		{Collation_latin1_general_cs, "a", "A", -1},
+		{Collation_binary, "a", "a ", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %q %q", test.collation, test.a, test.b), func(t *testing.T) {
+			assert.Equal(t, test.expected, test.collation.Compare(test.a, test.b))
+			assert.Equal(t, -test.expected, test.collation.Compare(test.b, test.a))
+			assert.Equal(t, test.expected == 0, test.collation.Key(test.a) == test.collation.Key(test.b))
+		})
+	}
+}
+
+func TestCollationLikeMatcher(t *testing.T) {
+	tests := []struct {
+		collation Collation
+		pattern   string
+		value     string
+		expected  bool
+	}{
+		{Collation_utf8mb4_0900_ai_ci, "^caf.*$", "CAFÉ", true},
+		{Collation_utf8mb4_0900_ai_ci, "^.*é$", "cafe", true},
+		{Collation_utf8mb4_0900_as_ci, "^.*é$", "CAFÉ", true},
+		{Collation_utf8mb4_0900_as_ci, "^.*é$", "cafe", false},
+		{Collation_utf8mb4_0900_as_cs, "^caf.*$", "Café", false},
+		{Collation_utf8mb4_bin, "^A$", "a", false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %q %q", test.collation, test.pattern, test.value), func(t *testing.T) {
+			matcher, err := test.collation.LikeMatcher(test.pattern)
+			require.NoError(t, err)
+			defer matcher.Dispose()
+			assert.Equal(t, test.expected, matcher.Match(test.value))
+		})
+	}
+}
+
+func TestCollationPadSpace(t *testing.T) {
+	assert.Equal(t, NoPad, Collation_utf8mb4_0900_ai_ci.PadSpace())
+	assert.Equal(t, NoPad, Collation_utf8mb4_0900_bin.PadSpace())
+	assert.Equal(t, NoPad, Collation_binary.PadSpace())
+	assert.Equal(t, PadSpace, Collation_utf8mb4_bin.PadSpace())
+	assert.Equal(t, PadSpace, Collation_latin1_swedish_ci.PadSpace())
+}
@@ -0,0 +1,279 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// characterSetEncoding converts the characters of a character set between the bytes that encode them in the character
+// set and the runes that they are in UTF-8, which is how the engine holds strings of every character set other than
+// binary.
+type characterSetEncoding struct {
+	// encodeRune appends the encoding of the rune given to the bytes given, returning false if the character set
+	// cannot represent it.
+	encodeRune func(b []byte, r rune) ([]byte, bool)
+	// decodeRune returns the first rune encoded in the bytes given and the number of bytes encoding it, returning false
+	// if the bytes are not a valid encoding.
+	decodeRune func(b []byte) (rune, int, bool)
+}
+
+// characterSetEncodings are the encodings of the character sets that have one. Strings of binary character set hold
+// their bytes as is, and strings of any other character set are held, and sent to clients, as UTF-8.
+var characterSetEncodings = map[CharacterSet]characterSetEncoding{
+	CharacterSet_ascii:   {encodeRune: encodeByteRune(0x7F), decodeRune: decodeByteRune(0x7F)},
+	CharacterSet_latin1:  {encodeRune: encodeLatin1Rune, decodeRune: decodeLatin1Rune},
+	CharacterSet_ucs2:    {encodeRune: encodeUTF16Rune(binary.BigEndian, false), decodeRune: decodeUTF16Rune(binary.BigEndian, false)},
+	CharacterSet_utf16:   {encodeRune: encodeUTF16Rune(binary.BigEndian, true), decodeRune: decodeUTF16Rune(binary.BigEndian, true)},
+	CharacterSet_utf16le: {encodeRune: encodeUTF16Rune(binary.LittleEndian, true), decodeRune: decodeUTF16Rune(binary.LittleEndian, true)},
+	CharacterSet_utf32:   {encodeRune: encodeUTF32Rune, decodeRune: decodeUTF32Rune},
+	CharacterSet_utf8:    {encodeRune: encodeUTF8Rune(0xFFFF), decodeRune: decodeUTF8Rune(0xFFFF)},
+	CharacterSet_utf8mb3: {encodeRune: encodeUTF8Rune(0xFFFF), decodeRune: decodeUTF8Rune(0xFFFF)},
+	CharacterSet_utf8mb4: {encodeRune: encodeUTF8Rune(utf8.MaxRune), decodeRune: decodeUTF8Rune(utf8.MaxRune)},
+}
+
+// Encode returns the UTF-8 string given encoded in this CharacterSet, and whether every character of the string could
+// be represented in it. Characters that cannot be represented are encoded as '?', as in MySQL.
+func (cs CharacterSet) Encode(s string) ([]byte, bool) {
+	encoding, ok := characterSetEncodings[cs]
+	if !ok {
+		return []byte(s), true
+	}
+	if cs == CharacterSet_utf8mb4 && utf8.ValidString(s) {
+		return []byte(s), true
+	}
+
+	b := make([]byte, 0, len(s))
+	valid := true
+	for _, r := range s {
+		var encoded bool
+		if b, encoded = encoding.encodeRune(b, r); !encoded {
+			b, _ = encoding.encodeRune(b, '?')
+			valid = false
+		}
+	}
+	return b, valid
+}
+
+// Decode returns the bytes given, encoded in this CharacterSet, as a UTF-8 string, and whether they were a valid
+// encoding. Invalid sequences are decoded as '?'.
+func (cs CharacterSet) Decode(b []byte) (string, bool) {
+	encoding, ok := characterSetEncodings[cs]
+	if !ok {
+		return string(b), true
+	}
+	if cs == CharacterSet_utf8mb4 && utf8.Valid(b) {
+		return string(b), true
+	}
+
+	var sb strings.Builder
+	valid := true
+	for len(b) > 0 {
+		r, size, decoded := encoding.decodeRune(b)
+		if !decoded {
+			r = '?'
+			valid = false
+		}
+		sb.WriteRune(r)
+		b = b[size:]
+	}
+	return sb.String(), valid
+}
+
+// CanRepresent returns whether every character of the UTF-8 string given can be represented in this CharacterSet.
+func (cs CharacterSet) CanRepresent(s string) bool {
+	_, ok := cs.unrepresentableRune(s)
+	return !ok
+}
+
+// unrepresentableRune returns the first character of the UTF-8 string given that cannot be represented in this
+// CharacterSet, if there is one.
+func (cs CharacterSet) unrepresentableRune(s string) (rune, bool) {
+	encoding, ok := characterSetEncodings[cs]
+	if !ok || cs == CharacterSet_utf8mb4 {
+		return 0, false
+	}
+
+	var buf [4]byte
+	for _, r := range s {
+		if _, ok := encoding.encodeRune(buf[:0], r); !ok {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+// escapeRune returns the UTF-8 bytes of the rune given as escaped hexadecimal, as MySQL shows characters in errors.
+func escapeRune(r rune) string {
+	var sb strings.Builder
+	for _, b := range []byte(string(r)) {
+		fmt.Fprintf(&sb, "\\x%02X", b)
+	}
+	return sb.String()
+}
+
+// EncodedLength returns the number of bytes of the UTF-8 string given when encoded in this CharacterSet.
+func (cs CharacterSet) EncodedLength(s string) int {
+	if _, ok := characterSetEncodings[cs]; !ok || cs == CharacterSet_utf8mb4 {
+		return len(s)
+	}
+	b, _ := cs.Encode(s)
+	return len(b)
+}
+
+// encodeByteRune returns a function that encodes runes up to the maximum given as a single byte.
+func encodeByteRune(maxRune rune) func(b []byte, r rune) ([]byte, bool) {
+	return func(b []byte, r rune) ([]byte, bool) {
+		if r < 0 || r > maxRune {
+			return b, false
+		}
+		return append(b, byte(r)), true
+	}
+}
+
+// decodeByteRune returns a function that decodes single bytes up to the maximum given as a rune.
+func decodeByteRune(maxRune rune) func(b []byte) (rune, int, bool) {
+	return func(b []byte) (rune, int, bool) {
+		if rune(b[0]) > maxRune {
+			return utf8.RuneError, 1, false
+		}
+		return rune(b[0]), 1, true
+	}
+}
+
+// latin1Runes are the characters of the bytes 0x80 to 0x9F in MySQL's latin1, which is cp1252 with the five bytes
+// undefined in cp1252 mapped to the matching control characters.
+var latin1Runes = [32]rune{
+	'€', 0x81, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0x8D, 'Ž', 0x8F,
+	0x90, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0x9D, 'ž', 'Ÿ',
+}
+
+// latin1Bytes maps the characters of latin1Runes back to their bytes.
+var latin1Bytes = func() map[rune]byte {
+	bytes := make(map[rune]byte, len(latin1Runes))
+	for i, r := range latin1Runes {
+		bytes[r] = byte(0x80 + i)
+	}
+	return bytes
+}()
+
+func encodeLatin1Rune(b []byte, r rune) ([]byte, bool) {
+	if c, ok := latin1Bytes[r]; ok {
+		return append(b, c), true
+	}
+	if r < 0 || r > 0xFF || (r >= 0x80 && r <= 0x9F) {
+		return b, false
+	}
+	return append(b, byte(r)), true
+}
+
+func decodeLatin1Rune(b []byte) (rune, int, bool) {
+	if b[0] >= 0x80 && b[0] <= 0x9F {
+		return latin1Runes[b[0]-0x80], 1, true
+	}
+	return rune(b[0]), 1, true
+}
+
+// encodeUTF8Rune returns a function that encodes runes up to the maximum given in UTF-8.
+func encodeUTF8Rune(maxRune rune) func(b []byte, r rune) ([]byte, bool) {
+	return func(b []byte, r rune) ([]byte, bool) {
+		if r > maxRune || !utf8.ValidRune(r) {
+			return b, false
+		}
+		var buf [utf8.UTFMax]byte
+		n := utf8.EncodeRune(buf[:], r)
+		return append(b, buf[:n]...), true
+	}
+}
+
+// decodeUTF8Rune returns a function that decodes runes up to the maximum given from UTF-8.
+func decodeUTF8Rune(maxRune rune) func(b []byte) (rune, int, bool) {
+	return func(b []byte) (rune, int, bool) {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size <= 1 {
+			return r, 1, false
+		}
+		return r, size, r <= maxRune
+	}
+}
+
+// encodeUTF16Rune returns a function that encodes runes in UTF-16 with the byte order given, or in UCS-2 if surrogate
+// pairs are not allowed.
+func encodeUTF16Rune(order binary.ByteOrder, surrogates bool) func(b []byte, r rune) ([]byte, bool) {
+	return func(b []byte, r rune) ([]byte, bool) {
+		var units []uint16
+		switch {
+		case r >= 0x10000 && r <= utf8.MaxRune && surrogates:
+			r1, r2 := utf16.EncodeRune(r)
+			units = []uint16{uint16(r1), uint16(r2)}
+		case r >= 0 && r < 0x10000 && utf8.ValidRune(r):
+			units = []uint16{uint16(r)}
+		default:
+			return b, false
+		}
+		var buf [2]byte
+		for _, unit := range units {
+			order.PutUint16(buf[:], unit)
+			b = append(b, buf[:]...)
+		}
+		return b, true
+	}
+}
+
+// decodeUTF16Rune returns a function that decodes runes from UTF-16 with the byte order given, or from UCS-2 if
+// surrogate pairs are not allowed.
+func decodeUTF16Rune(order binary.ByteOrder, surrogates bool) func(b []byte) (rune, int, bool) {
+	return func(b []byte) (rune, int, bool) {
+		if len(b) < 2 {
+			return utf8.RuneError, len(b), false
+		}
+		r := rune(order.Uint16(b))
+		if !utf16.IsSurrogate(r) {
+			return r, 2, true
+		}
+		if !surrogates || len(b) < 4 {
+			return utf8.RuneError, 2, false
+		}
+		r = utf16.DecodeRune(r, rune(order.Uint16(b[2:])))
+		if r == utf8.RuneError {
+			return r, 2, false
+		}
+		return r, 4, true
+	}
+}
+
+func encodeUTF32Rune(b []byte, r rune) ([]byte, bool) {
+	if !utf8.ValidRune(r) {
+		return b, false
+	}
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(r))
+	return append(b, buf[:]...), true
+}
+
+func decodeUTF32Rune(b []byte) (rune, int, bool) {
+	if len(b) < 4 {
+		return utf8.RuneError, len(b), false
+	}
+	r := rune(binary.BigEndian.Uint32(b))
+	if !utf8.ValidRune(r) {
+		return utf8.RuneError, 4, false
+	}
+	return r, 4, true
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacterSetEncode(t *testing.T) {
+	tests := []struct {
+		charset  CharacterSet
+		str      string
+		expected []byte
+		valid    bool
+	}{
+		{CharacterSet_utf8mb4, "héllo😀", []byte("héllo😀"), true},
+		{CharacterSet_latin1, "héllo", []byte{'h', 0xE9, 'l', 'l', 'o'}, true},
+		{CharacterSet_latin1, "€5", []byte{0x80, '5'}, true},
+		{CharacterSet_latin1, "日本", []byte("??"), false},
+		{CharacterSet_ascii, "naïve", []byte("na?ve"), false},
+		{CharacterSet_utf8mb3, "a😀", []byte("a?"), false},
+		{CharacterSet_ucs2, "aé", []byte{0, 'a', 0, 0xE9}, true},
+		{CharacterSet_ucs2, "😀", []byte{0, '?'}, false},
+		{CharacterSet_utf16, "😀", []byte{0xD8, 0x3D, 0xDE, 0x00}, true},
+		{CharacterSet_utf16le, "a", []byte{'a', 0}, true},
+		{CharacterSet_utf32, "é", []byte{0, 0, 0, 0xE9}, true},
+		{CharacterSet_binary, "\xff\x00", []byte{0xFF, 0}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.charset)+" "+test.str, func(t *testing.T) {
+			encoded, valid := test.charset.Encode(test.str)
+			assert.Equal(t, test.expected, encoded)
+			assert.Equal(t, test.valid, valid)
+			assert.Equal(t, len(test.expected), test.charset.EncodedLength(test.str))
+			assert.Equal(t, test.valid, test.charset.CanRepresent(test.str))
+		})
+	}
+}
+
+func TestCharacterSetDecode(t *testing.T) {
+	tests := []struct {
+		charset  CharacterSet
+		bytes    []byte
+		expected string
+		valid    bool
+	}{
+		{CharacterSet_utf8mb4, []byte("héllo"), "héllo", true},
+		{CharacterSet_utf8mb4, []byte{'a', 0xFF}, "a?", false},
+		{CharacterSet_latin1, []byte{'h', 0xE9, 0x80}, "hé€", true},
+		{CharacterSet_ascii, []byte{'a', 0xE9}, "a?", false},
+		{CharacterSet_ucs2, []byte{0, 'a', 0, 0xE9}, "aé", true},
+		{CharacterSet_utf16, []byte{0xD8, 0x3D, 0xDE, 0x00}, "😀", true},
+		{CharacterSet_utf16, []byte{0, 'a', 0}, "a?", false},
+		{CharacterSet_utf32, []byte{0, 0, 0, 0xE9}, "é", true},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.charset)+" "+test.expected, func(t *testing.T) {
+			decoded, valid := test.charset.Decode(test.bytes)
+			assert.Equal(t, test.expected, decoded)
+			assert.Equal(t, test.valid, valid)
+		})
+	}
+}
+
+func TestStringConvertCharacterSet(t *testing.T) {
+	latin1 := MustCreateString(sqltypes.VarChar, 3, Collation_latin1_swedish_ci)
+	val, err := latin1.Convert("né€")
+	require.NoError(t, err)
+	assert.Equal(t, "né€", val)
+
+	_, err = latin1.Convert("日本")
+	require.Error(t, err)
+	assert.True(t, ErrIncorrectStringValue.Is(err))
+
+	_, err = latin1.Convert("abcd")
+	require.Error(t, err)
+	assert.True(t, ErrLengthBeyondLimit.Is(err))
+
+	utf8mb4 := MustCreateString(sqltypes.VarChar, 3, Collation_Default)
+	val, err = utf8mb4.Convert("日本語")
+	require.NoError(t, err)
+	assert.Equal(t, "日本語", val)
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// collationStrength is how finely a collation tells strings apart.
+type collationStrength byte
+
+const (
+	// collationStrength_Binary compares the bytes of strings.
+	collationStrength_Binary collationStrength = iota
+	// collationStrength_CodePoint compares the code points of strings, which for UTF-8 is the same as their bytes.
+	collationStrength_CodePoint
+	// collationStrength_Primary compares base letters only, ignoring accents and case.
+	collationStrength_Primary
+	// collationStrength_Secondary compares base letters and accents, ignoring case.
+	collationStrength_Secondary
+	// collationStrength_Tertiary compares base letters, accents and case.
+	collationStrength_Tertiary
+)
+
+// collationRules are the rules by which a collation compares strings.
+type collationRules struct {
+	strength collationStrength
+	// padSpace is whether trailing spaces are ignored, as with PAD SPACE collations. NO PAD collations compare
+	// trailing spaces like any other character.
+	padSpace bool
+	// collators holds the *collate.Collator of the Unicode Collation Algorithm at the strength of these rules. A
+	// Collator can only be used by one goroutine at a time, so each use takes one from the pool.
+	collators *sync.Pool
+}
+
+// collationRulesForName returns the rules of the collation with the name given, which MySQL derives from the suffixes
+// of the name: _bin collations compare code points, _ai_ci collations ignore accents and case, _as_ci collations
+// ignore case only, and _cs collations ignore neither. Collations based on UCA 9.0.0 (_0900_) are NO PAD, while all
+// older collations are PAD SPACE. Language-specific tailorings are not implemented, so every collation sorts strings
+// by the root collation of the Unicode Collation Algorithm, with the Default Unicode Collation Element Table.
+func collationRulesForName(name string) collationRules {
+	if name == "binary" {
+		return collationRules{strength: collationStrength_Binary}
+	}
+
+	rules := collationRules{padSpace: !strings.Contains(name, "_0900_")}
+	var options []collate.Option
+	switch {
+	case strings.HasSuffix(name, "_bin"):
+		rules.strength = collationStrength_CodePoint
+		return rules
+	case strings.Contains(name, "_as_cs"), strings.HasSuffix(name, "_cs"):
+		rules.strength = collationStrength_Tertiary
+	case strings.Contains(name, "_as_ci"):
+		rules.strength = collationStrength_Secondary
+		options = []collate.Option{collate.IgnoreCase}
+	default:
+		rules.strength = collationStrength_Primary
+		options = []collate.Option{collate.Loose}
+	}
+	rules.collators = &sync.Pool{
+		New: func() interface{} {
+			return collate.New(language.Und, options...)
+		},
+	}
+	return rules
+}
+
+// compare returns -1, 0 or 1 as the first string sorts before, the same as or after the second one.
+func (r collationRules) compare(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if r.padSpace {
+		a, b = trimPadding(a), trimPadding(b)
+	}
+	if r.strength <= collationStrength_CodePoint {
+		return strings.Compare(a, b)
+	}
+
+	collator := r.collators.Get().(*collate.Collator)
+	defer r.collators.Put(collator)
+	return collator.CompareString(a, b)
+}
+
+// key returns a string that is the same for any two strings that compare equal, and different for any two strings
+// that do not.
+func (r collationRules) key(s string) string {
+	if r.padSpace {
+		s = trimPadding(s)
+	}
+	if r.strength <= collationStrength_CodePoint {
+		return s
+	}
+
+	collator := r.collators.Get().(*collate.Collator)
+	defer r.collators.Put(collator)
+	var buf collate.Buffer
+	return string(collator.KeyFromString(&buf, s))
+}
+
+// fold returns the string given with its case, and at the primary strength its accents, removed, for matching against
+// patterns that have been folded the same way. Accents are removed by decomposing every character and dropping the
+// combining marks that result.
+func (r collationRules) fold(s string) string {
+	switch r.strength {
+	case collationStrength_Primary:
+		var sb strings.Builder
+		for _, c := range norm.NFD.String(s) {
+			if !unicode.Is(unicode.Mn, c) {
+				sb.WriteRune(unicode.ToLower(c))
+			}
+		}
+		return sb.String()
+	case collationStrength_Secondary:
+		return strings.ToLower(s)
+	default:
+		return s
+	}
+}
+
+// trimPadding removes the trailing spaces of a string, which PAD SPACE collations ignore.
+func trimPadding(s string) string {
+	return strings.TrimRight(s, " ")
+}
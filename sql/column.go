@@ -44,6 +44,11 @@ type Column struct {
 	Comment string
 	// Extra contains any additional information to put in the `extra` column under `information_schema.columns`.
 	Extra string
+	// Generated contains the generation expression of the column, or nil if the column is not a generated column.
+	Generated *ColumnDefaultValue
+	// Virtual is true if the column is a VIRTUAL generated column, and false if it is a STORED generated column. Only
+	// meaningful when Generated is non-nil.
+	Virtual bool
 }
 
 // Check ensures the value is correct for this column.
@@ -62,9 +67,25 @@ func (c *Column) Equals(c2 *Column) bool {
 		c.Source == c2.Source &&
 		c.Nullable == c2.Nullable &&
 		reflect.DeepEqual(c.Default, c2.Default) &&
+		reflect.DeepEqual(c.Generated, c2.Generated) &&
+		c.Virtual == c2.Virtual &&
 		reflect.DeepEqual(c.Type, c2.Type)
 }
 
+// IsGenerated returns whether this column's values are computed from a generation expression.
+func (c *Column) IsGenerated() bool {
+	return c.Generated != nil
+}
+
+// GenerationExpressionString returns the generation expression of the column as shown in SHOW CREATE TABLE and
+// information_schema.columns, or an empty string if the column is not generated.
+func (c *Column) GenerationExpressionString() string {
+	if c.Generated == nil {
+		return ""
+	}
+	return c.Generated.Expression.String()
+}
+
 func (c *Column) DebugString() string {
 	sb := strings.Builder{}
 	sb.WriteString("Name: ")
@@ -93,6 +114,14 @@ func (c *Column) DebugString() string {
 	sb.WriteString(", ")
 	sb.WriteString("Extra: ")
 	sb.WriteString(c.Extra)
+	if c.Generated != nil {
+		sb.WriteString(", ")
+		sb.WriteString("Generated: ")
+		sb.WriteString(c.Generated.String())
+		sb.WriteString(", ")
+		sb.WriteString("Virtual: ")
+		sb.WriteString(fmt.Sprintf("%v", c.Virtual))
+	}
 
 	return sb.String()
 }
@@ -94,10 +94,22 @@ type Aggregation interface {
 	NewBuffer() Row
 	// Update updates the given buffer with the given row.
 	Update(ctx *Context, buffer, row Row) error
-	// Merge merges a partial buffer into a global one.
+	// Merge merges a partial buffer into a global one. The partial buffer holds the rows that follow those of the
+	// global one.
 	Merge(ctx *Context, buffer, partial Row) error
 }
 
+// SpillableAggregation is an Aggregation whose buffers can be written to disk and read back, so that aggregations of
+// more groups than fit in memory can be computed a partition of the groups at a time, merging the partial buffers of
+// each group.
+type SpillableAggregation interface {
+	Aggregation
+	// EncodeBuffer appends the binary encoding of the buffer given to buf.
+	EncodeBuffer(buf []byte, buffer Row) ([]byte, error)
+	// DecodeBuffer returns the buffer encoded by EncodeBuffer in the data given.
+	DecodeBuffer(data []byte) (Row, error)
+}
+
 // WindowAggregation implements a window aggregation expression. A WindowAggregation is similar to an Aggregation,
 // except that it returns a result row for every input row, as opposed to as single for the entire result set. Every
 // WindowAggregation is expected to track its input rows in the order received, and to return the value for the row
@@ -426,6 +438,43 @@ type AutoIncrementTable interface {
 
 var ErrNoAutoIncrementCol = fmt.Errorf("this table has no AUTO_INCREMENT columns")
 
+// AutoIncrementStep returns the session's auto_increment_increment and auto_increment_offset, which together
+// define the series of values generated for AUTO_INCREMENT columns. Implementations of AutoIncrementTable should
+// use them, through NextAutoIncrementValue, to choose the values they generate.
+func AutoIncrementStep(ctx *Context) (increment, offset uint64) {
+	increment, offset = 1, 1
+	if ctx == nil || ctx.Session == nil {
+		return increment, offset
+	}
+	if val, err := ctx.GetSessionVariable(ctx, "auto_increment_increment"); err == nil {
+		if v, err := Uint64.Convert(val); err == nil {
+			increment = v.(uint64)
+		}
+	}
+	if val, err := ctx.GetSessionVariable(ctx, "auto_increment_offset"); err == nil {
+		if v, err := Uint64.Convert(val); err == nil {
+			offset = v.(uint64)
+		}
+	}
+	return increment, offset
+}
+
+// NextAutoIncrementValue returns the smallest value of the series offset + N * increment that is not less than
+// val. As in MySQL, the offset is ignored when it is greater than the increment.
+func NextAutoIncrementValue(val, increment, offset uint64) uint64 {
+	if increment <= 1 {
+		return val
+	}
+	if offset > increment {
+		offset = 1
+	}
+	if val <= offset {
+		return offset
+	}
+	steps := (val - offset + increment - 1) / increment
+	return offset + steps*increment
+}
+
 // AutoIncrementSetter provides support for altering a table's
 // AUTO_INCREMENT sequence, eg 'ALTER TABLE t AUTO_INCREMENT = 10;'
 type AutoIncrementSetter interface {
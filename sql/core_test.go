@@ -62,3 +62,27 @@ func TestEvaluateCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestNextAutoIncrementValue(t *testing.T) {
+	tests := []struct {
+		val, increment, offset uint64
+		expected               uint64
+	}{
+		{1, 1, 1, 1},
+		{7, 1, 1, 7},
+		{1, 10, 5, 5},
+		{5, 10, 5, 5},
+		{6, 10, 5, 15},
+		{101, 10, 5, 105},
+		{106, 10, 5, 115},
+		{1, 10, 1, 1},
+		{2, 10, 1, 11},
+		{2, 10, 20, 11},
+		{3, 2, 2, 4},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d,%d,%d", tt.val, tt.increment, tt.offset), func(t *testing.T) {
+			require.Equal(t, tt.expected, sql.NextAutoIncrementValue(tt.val, tt.increment, tt.offset))
+		})
+	}
+}
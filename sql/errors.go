@@ -119,6 +119,30 @@ var (
 	// ErrDropColumnReferencedInDefault is returned when a column cannot be dropped as it is referenced by another column's default value.
 	ErrDropColumnReferencedInDefault = errors.NewKind(`cannot drop column "%s" as default value of column "%s" references it`)
 
+	// ErrGeneratedColumnValue is returned when an INSERT or UPDATE attempts to write a value to a generated column.
+	ErrGeneratedColumnValue = errors.NewKind("The value specified for generated column '%s' in table '%s' is not allowed.")
+
+	// ErrGeneratedColumnWithDefault is returned when a generated column also declares a default value or AUTO_INCREMENT.
+	ErrGeneratedColumnWithDefault = errors.NewKind("generated column '%s' cannot have a default value or be AUTO_INCREMENT")
+
+	// ErrGeneratedColumnRefersToLater is returned when a generated column references a generated column defined after it.
+	ErrGeneratedColumnRefersToLater = errors.NewKind("generated column '%s' cannot refer to a generated column defined after it")
+
+	// ErrInvalidGeneratedColumnFunction is returned when a nondeterministic function is used in a generated column.
+	ErrInvalidGeneratedColumnFunction = errors.NewKind("function `%s` on generated column `%s` is not allowed as its result is nondeterministic")
+
+	// ErrVirtualColumnPrimaryKey is returned when a VIRTUAL generated column is declared as part of the primary key.
+	ErrVirtualColumnPrimaryKey = errors.NewKind("defining a virtual generated column '%s' as primary key is not supported")
+
+	// ErrDropColumnReferencedInGenerated is returned when a column cannot be dropped as it is referenced by a generated column.
+	ErrDropColumnReferencedInGenerated = errors.NewKind(`cannot drop column "%s" as generated column "%s" references it`)
+
+	// ErrFullTextIndexNotFound is returned when the columns of a MATCH expression do not match any FULLTEXT index.
+	ErrFullTextIndexNotFound = errors.NewKind("Can't find FULLTEXT index matching the column list")
+
+	// ErrFullTextColumnType is returned when a FULLTEXT index is created on a column that is not a string type.
+	ErrFullTextColumnType = errors.NewKind("Column '%s' cannot be part of FULLTEXT index")
+
 	// ErrTriggersNotSupported is returned when attempting to create a trigger on a database that doesn't support them
 	ErrTriggersNotSupported = errors.NewKind(`database "%s" doesn't support triggers`)
 
@@ -331,6 +355,14 @@ func CastSQLError(err error) (*mysql.SQLError, bool) {
 		code = mysql.ERDupEntry
 	case ErrInvalidJSONText.Is(err):
 		code = 3141 // TODO: Needs to be added to vitess
+	case ErrGeneratedColumnValue.Is(err):
+		code = 3105 // TODO: Needs to be added to vitess
+	case ErrFullTextIndexNotFound.Is(err):
+		code = 1191 // TODO: Needs to be added to vitess
+	case ErrIncorrectStringValue.Is(err):
+		code = mysql.ERTruncatedWrongValueForField
+	case ErrUnknownTimeZone.Is(err):
+		code = mysql.ERUnknownTimeZone
 	default:
 		code = mysql.ERUnknownError
 	}
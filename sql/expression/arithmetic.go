@@ -16,14 +16,16 @@ package expression
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"time"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 var (
@@ -34,15 +36,42 @@ var (
 	errUnableToEval = errors.NewKind("Unable to evaluate an expression: %v %s %v")
 )
 
+// DefaultDivPrecisionIncrement is the default number of digits by which division increases the scale of its dividend,
+// as set by the div_precision_increment system variable.
+const DefaultDivPrecisionIncrement = 4
+
+// DivPrecisionIncrement returns the session's div_precision_increment, which is the number of digits by which the
+// division of exact numbers increases their scale.
+func DivPrecisionIncrement(ctx *sql.Context) uint8 {
+	if ctx == nil || ctx.Session == nil {
+		return DefaultDivPrecisionIncrement
+	}
+	val, err := ctx.GetSessionVariable(ctx, "div_precision_increment")
+	if err != nil {
+		return DefaultDivPrecisionIncrement
+	}
+	increment, err := sql.Uint8.Convert(val)
+	if err != nil {
+		return DefaultDivPrecisionIncrement
+	}
+	return increment.(uint8)
+}
+
 // Arithmetic expressions (+, -, *, /, ...)
 type Arithmetic struct {
 	BinaryExpression
 	Op string
+	// divPrecisionIncrement is the value of div_precision_increment used to derive the scale of a decimal division
+	divPrecisionIncrement uint8
 }
 
 // NewArithmetic creates a new Arithmetic sql.Expression.
 func NewArithmetic(left, right sql.Expression, op string) *Arithmetic {
-	return &Arithmetic{BinaryExpression{Left: left, Right: right}, op}
+	return &Arithmetic{
+		BinaryExpression:      BinaryExpression{Left: left, Right: right},
+		Op:                    op,
+		divPrecisionIncrement: DefaultDivPrecisionIncrement,
+	}
 }
 
 // NewPlus creates a new Arithmetic + sql.Expression.
@@ -70,6 +99,14 @@ func NewDiv(left, right sql.Expression) *Arithmetic {
 	return NewArithmetic(left, right, sqlparser.DivStr)
 }
 
+// WithDivPrecisionIncrement returns a copy of this expression that increases the scale of a decimal division by the
+// number of digits given, rather than by DefaultDivPrecisionIncrement.
+func (a *Arithmetic) WithDivPrecisionIncrement(increment uint8) *Arithmetic {
+	na := *a
+	na.divPrecisionIncrement = increment
+	return &na
+}
+
 // NewShiftLeft creates a new Arithmetic << sql.Expression.
 func NewShiftLeft(left, right sql.Expression) *Arithmetic {
 	return NewArithmetic(left, right, sqlparser.ShiftLeftStr)
@@ -134,19 +171,35 @@ func (a *Arithmetic) Type() sql.Type {
 			return sql.Int64
 		}
 
-		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) {
+		if sql.IsInteger(a.Left.Type()) && sql.IsInteger(a.Right.Type()) && a.Op != sqlparser.DivStr {
 			if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
 				return sql.Uint64
 			}
 			return sql.Int64
 		}
 
+		if isExactNumber(a.Left.Type()) && isExactNumber(a.Right.Type()) {
+			return a.decimalType()
+		}
+
 		return sql.Float64
 
 	case sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
 		return sql.Uint64
 
-	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr, sqlparser.ModStr:
+	case sqlparser.ModStr:
+		if sql.IsDecimal(a.Left.Type()) || sql.IsDecimal(a.Right.Type()) {
+			return modDecimalType(a.Left.Type(), a.Right.Type())
+		}
+		if !sql.IsInteger(a.Left.Type()) || !sql.IsInteger(a.Right.Type()) {
+			return sql.Float64
+		}
+		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
+			return sql.Uint64
+		}
+		return sql.Int64
+
+	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.IntDivStr:
 		if sql.IsUnsigned(a.Left.Type()) && sql.IsUnsigned(a.Right.Type()) {
 			return sql.Uint64
 		}
@@ -156,6 +209,91 @@ func (a *Arithmetic) Type() sql.Type {
 	return sql.Float64
 }
 
+// isExactNumber returns whether values of the type given are exact numbers, that is integers or decimals.
+func isExactNumber(t sql.Type) bool {
+	return sql.IsInteger(t) || sql.IsDecimal(t)
+}
+
+// DecimalPrecisionAndScale returns the precision and scale of the exact number type given. Integers have as many
+// digits as their largest value.
+func DecimalPrecisionAndScale(t sql.Type) (precision, scale int) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return int(dt.Precision()), int(dt.Scale())
+	}
+	switch t {
+	case sql.Int8, sql.Uint8:
+		return 3, 0
+	case sql.Int16, sql.Uint16:
+		return 5, 0
+	case sql.Int24, sql.Uint24:
+		return 8, 0
+	case sql.Int32, sql.Uint32:
+		return 10, 0
+	case sql.Int64:
+		return 19, 0
+	default:
+		return 20, 0
+	}
+}
+
+// decimalType returns the type of the result of +, -, * or / on two exact numbers, following MySQL's rules: the sum
+// and difference have the largest scale of both numbers and one more integer digit, the product adds up the precisions
+// and scales of both numbers, and the quotient has the scale of the dividend increased by div_precision_increment.
+func (a *Arithmetic) decimalType() sql.Type {
+	p1, s1 := DecimalPrecisionAndScale(a.Left.Type())
+	p2, s2 := DecimalPrecisionAndScale(a.Right.Type())
+
+	var precision, scale int
+	switch a.Op {
+	case sqlparser.PlusStr, sqlparser.MinusStr:
+		scale = s1
+		if s2 > scale {
+			scale = s2
+		}
+		intDigits := p1 - s1
+		if p2-s2 > intDigits {
+			intDigits = p2 - s2
+		}
+		precision = intDigits + 1 + scale
+	case sqlparser.MultStr:
+		precision, scale = p1+p2, s1+s2
+	case sqlparser.DivStr:
+		scale = s1 + int(a.divPrecisionIncrement)
+		precision = p1 + s2 + int(a.divPrecisionIncrement)
+	}
+
+	if scale > sql.DecimalTypeMaxScale {
+		scale = sql.DecimalTypeMaxScale
+	}
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	if precision < scale {
+		precision = scale
+	}
+	return sql.MustCreateDecimalType(uint8(precision), uint8(scale))
+}
+
+// modDecimalType returns the type of the remainder of a division in which either number is a decimal. The remainder
+// has the largest scale of both numbers, and no more integer digits than either of them.
+func modDecimalType(left, right sql.Type) sql.Type {
+	var intDigits, scale uint8
+	for _, t := range []sql.Type{left, right} {
+		if dt, ok := t.(sql.DecimalType); ok {
+			if digits := dt.Precision() - dt.Scale(); intDigits == 0 || digits < intDigits {
+				intDigits = digits
+			}
+			if dt.Scale() > scale {
+				scale = dt.Scale()
+			}
+		}
+	}
+	if intDigits == 0 {
+		intDigits = 1
+	}
+	return sql.MustCreateDecimalType(intDigits+scale, scale)
+}
+
 func isInterval(expr sql.Expression) bool {
 	_, ok := expr.(*Interval)
 	return ok
@@ -166,7 +304,9 @@ func (a *Arithmetic) WithChildren(ctx *sql.Context, children ...sql.Expression)
 	if len(children) != 2 {
 		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
 	}
-	return NewArithmetic(children[0], children[1], a.Op), nil
+	na := *a
+	na.BinaryExpression = BinaryExpression{Left: children[0], Right: children[1]}
+	return &na, nil
 }
 
 // Eval implements the Expression interface.
@@ -180,6 +320,15 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if dt, ok := a.Type().(sql.DecimalType); ok {
+		switch strings.ToLower(a.Op) {
+		case sqlparser.ModStr:
+			return modDecimal(dt, lval, rval)
+		case sqlparser.PlusStr, sqlparser.MinusStr, sqlparser.MultStr, sqlparser.DivStr:
+			return decimalArithmetic(dt, a.Op, lval, rval)
+		}
+	}
+
 	lval, rval, err = a.convertLeftRight(lval, rval)
 	if err != nil {
 		return nil, err
@@ -366,7 +515,7 @@ func div(lval, rval interface{}) (interface{}, error) {
 		switch r := rval.(type) {
 		case uint64:
 			if r == 0 {
-				return sql.Null, nil
+				return nil, nil
 			}
 			return l / r, nil
 		}
@@ -375,7 +524,7 @@ func div(lval, rval interface{}) (interface{}, error) {
 		switch r := rval.(type) {
 		case int64:
 			if r == 0 {
-				return sql.Null, nil
+				return nil, nil
 			}
 			return l / r, nil
 		}
@@ -384,7 +533,7 @@ func div(lval, rval interface{}) (interface{}, error) {
 		switch r := rval.(type) {
 		case float64:
 			if r == 0 {
-				return sql.Null, nil
+				return nil, nil
 			}
 			return l / r, nil
 		}
@@ -477,7 +626,7 @@ func intDiv(lval, rval interface{}) (interface{}, error) {
 		switch r := rval.(type) {
 		case uint64:
 			if r == 0 {
-				return sql.Null, nil
+				return nil, nil
 			}
 			return uint64(l / r), nil
 		}
@@ -486,7 +635,7 @@ func intDiv(lval, rval interface{}) (interface{}, error) {
 		switch r := rval.(type) {
 		case int64:
 			if r == 0 {
-				return sql.Null, nil
+				return nil, nil
 			}
 			return int64(l / r), nil
 		}
@@ -495,24 +644,106 @@ func intDiv(lval, rval interface{}) (interface{}, error) {
 	return nil, errUnableToCast.New(lval, rval)
 }
 
+// mod returns the remainder of the division of two numbers, which has the sign of the dividend, or NULL if the divisor
+// is 0.
 func mod(lval, rval interface{}) (interface{}, error) {
 	switch l := lval.(type) {
 	case uint64:
 		switch r := rval.(type) {
 		case uint64:
+			if r == 0 {
+				return nil, nil
+			}
 			return l % r, nil
 		}
 
 	case int64:
 		switch r := rval.(type) {
 		case int64:
+			if r == 0 {
+				return nil, nil
+			}
 			return l % r, nil
 		}
+
+	case float64:
+		switch r := rval.(type) {
+		case float64:
+			if r == 0 {
+				return nil, nil
+			}
+			return math.Mod(l, r), nil
+		}
 	}
 
 	return nil, errUnableToCast.New(lval, rval)
 }
 
+// modDecimal returns the exact remainder of the division of two numbers as a decimal of the type given, or NULL if the
+// divisor is 0.
+func modDecimal(typ sql.DecimalType, lval, rval interface{}) (interface{}, error) {
+	// The numbers are converted without any bound on their integer digits, which only the remainder has
+	operandType := sql.MustCreateDecimalType(sql.DecimalTypeMaxPrecision, typ.Scale())
+	l, err := operandType.ConvertToDecimal(lval)
+	if err != nil {
+		return nil, err
+	}
+	r, err := operandType.ConvertToDecimal(rval)
+	if err != nil {
+		return nil, err
+	}
+	if r.Decimal.IsZero() {
+		return nil, nil
+	}
+	return typ.Convert(l.Decimal.Mod(r.Decimal))
+}
+
+// decimalOperandType is used to convert the operands of decimal arithmetic without losing any digits.
+var decimalOperandType = sql.MustCreateDecimalType(sql.DecimalTypeMaxPrecision, sql.DecimalTypeMaxScale)
+
+// convertToDecimal converts the value given to an exact decimal.
+func convertToDecimal(v interface{}) (decimal.Decimal, error) {
+	if s, ok := v.(string); ok {
+		if d, err := decimal.NewFromString(s); err == nil {
+			return d, nil
+		}
+	}
+	d, err := decimalOperandType.ConvertToDecimal(v)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return d.Decimal, nil
+}
+
+// decimalArithmetic returns the exact result of +, -, * or / on two numbers as a decimal of the type given. Division
+// is rounded to the scale of the type, and returns NULL if the divisor is 0.
+func decimalArithmetic(typ sql.DecimalType, op string, lval, rval interface{}) (interface{}, error) {
+	l, err := convertToDecimal(lval)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convertToDecimal(rval)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case sqlparser.PlusStr:
+		return typ.Convert(l.Add(r))
+	case sqlparser.MinusStr:
+		return typ.Convert(l.Sub(r))
+	case sqlparser.MultStr:
+		return typ.Convert(l.Mul(r))
+	case sqlparser.DivStr:
+		if r.IsZero() {
+			return nil, nil
+		}
+		return typ.Convert(l.DivRound(r, int32(typ.Scale())))
+	}
+
+	return nil, errUnableToEval.New(lval, op, rval)
+}
+
 // UnaryMinus is an unary minus operator.
 type UnaryMinus struct {
 	UnaryExpression
@@ -534,6 +765,14 @@ func (e *UnaryMinus) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if dt, ok := e.Child.Type().(sql.DecimalType); ok {
+		d, err := convertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return dt.Convert(d.Neg())
+	}
+
 	if !sql.IsNumber(e.Child.Type()) {
 		child, err = sql.Float64.Convert(child)
 		if err != nil {
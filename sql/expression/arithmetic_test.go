@@ -178,7 +178,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -188,14 +188,16 @@ func TestDiv(t *testing.T) {
 	var intTestCases = []struct {
 		name        string
 		left, right int64
-		expected    int64
+		expected    string
 		null        bool
 	}{
-		{"1 / 1", 1, 1, 1, false},
-		{"-1 / 1", -1, 1, -1, false},
-		{"0 / 1234567890", 0, 12345677890, 0, false},
-		{"1/0", 1, 0, 0, true},
-		{"0/0", 1, 0, 0, true},
+		{"1 / 1", 1, 1, "1.0000", false},
+		{"-1 / 1", -1, 1, "-1.0000", false},
+		{"0 / 1234567890", 0, 12345677890, "0.0000", false},
+		{"7 / 2", 7, 2, "3.5000", false},
+		{"2 / 3", 2, 3, "0.6667", false},
+		{"1/0", 1, 0, "", true},
+		{"0/0", 1, 0, "", true},
 	}
 	for _, tt := range intTestCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -205,7 +207,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -215,13 +217,13 @@ func TestDiv(t *testing.T) {
 	var uintTestCases = []struct {
 		name        string
 		left, right uint64
-		expected    uint64
+		expected    string
 		null        bool
 	}{
-		{"1 / 1", 1, 1, 1, false},
-		{"0 / 1234567890", 0, 12345677890, 0, false},
-		{"1/0", 1, 0, 0, true},
-		{"0/0", 1, 0, 0, true},
+		{"1 / 1", 1, 1, "1.0000", false},
+		{"0 / 1234567890", 0, 12345677890, "0.0000", false},
+		{"1/0", 1, 0, "", true},
+		{"0/0", 1, 0, "", true},
 	}
 	for _, tt := range uintTestCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -231,7 +233,7 @@ func TestDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(t, err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -392,7 +394,7 @@ func TestIntDiv(t *testing.T) {
 			).Eval(sql.NewEmptyContext(), sql.NewRow())
 			require.NoError(err)
 			if tt.null {
-				assert.Equal(t, sql.Null, result)
+				assert.Nil(t, result)
 			} else {
 				assert.Equal(t, tt.expected, result)
 			}
@@ -425,6 +427,72 @@ func TestMod(t *testing.T) {
 	}
 }
 
+func TestModNonIntegers(t *testing.T) {
+	decimalType := sql.MustCreateDecimalType(10, 3)
+	var testCases = []struct {
+		name         string
+		left, right  *Literal
+		expected     interface{}
+		expectedType sql.Type
+	}{
+		{"float", NewLiteral(5.5, sql.Float64), NewLiteral(int64(2), sql.Int64), 1.5, sql.Float64},
+		{"negative float", NewLiteral(-5.5, sql.Float64), NewLiteral(int64(2), sql.Int64), -1.5, sql.Float64},
+		{"string", NewLiteral("7", sql.LongText), NewLiteral(int64(4), sql.Int64), float64(3), sql.Float64},
+		{"int by zero", NewLiteral(int64(5), sql.Int64), NewLiteral(int64(0), sql.Int64), nil, sql.Int64},
+		{"unsigned by zero", NewLiteral(uint64(5), sql.Uint64), NewLiteral(uint64(0), sql.Uint64), nil, sql.Uint64},
+		{"float by zero", NewLiteral(5.5, sql.Float64), NewLiteral(0.0, sql.Float64), nil, sql.Float64},
+		{"decimal", NewLiteral("10.750", decimalType), NewLiteral(int64(3), sql.Int64), "1.750", decimalType},
+		{"negative decimal", NewLiteral("-10.750", decimalType), NewLiteral("0.300", decimalType), "-0.250", decimalType},
+		{"decimal by float", NewLiteral("0.300", decimalType), NewLiteral(0.1, sql.Float64), "0.000", decimalType},
+		{"decimal by zero", NewLiteral("10.750", decimalType), NewLiteral(int64(0), sql.Int64), nil, decimalType},
+		{"decimals of different scales", NewLiteral("10.750", decimalType), NewLiteral("0.25", sql.MustCreateDecimalType(4, 2)), "0.000", sql.MustCreateDecimalType(5, 3)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			m := NewMod(tt.left, tt.right)
+			require.Equal(tt.expectedType, m.Type())
+			result, err := m.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	dec := func(v string, precision, scale uint8) *Literal {
+		return NewLiteral(v, sql.MustCreateDecimalType(precision, scale))
+	}
+	var testCases = []struct {
+		name         string
+		arithmetic   *Arithmetic
+		expected     interface{}
+		expectedType sql.Type
+	}{
+		{"sum of decimals", NewPlus(dec("0.1", 2, 1), dec("0.2", 2, 1)), "0.3", sql.MustCreateDecimalType(3, 1)},
+		{"difference of decimals of different scales", NewMinus(dec("1", 1, 0), dec("0.75", 3, 2)), "0.25", sql.MustCreateDecimalType(4, 2)},
+		{"sum of decimal and integer", NewPlus(dec("1.5", 2, 1), NewLiteral(int8(2), sql.Int8)), "3.5", sql.MustCreateDecimalType(5, 1)},
+		{"product of decimals", NewMult(dec("1.5", 2, 1), dec("-1.25", 3, 2)), "-1.875", sql.MustCreateDecimalType(5, 3)},
+		{"quotient of integers", NewDiv(NewLiteral(int64(1), sql.Int64), NewLiteral(int64(3), sql.Int64)), "0.3333", sql.MustCreateDecimalType(23, 4)},
+		{"quotient of decimals", NewDiv(dec("2.50", 3, 2), dec("0.3", 2, 1)), "8.333333", sql.MustCreateDecimalType(8, 6)},
+		{"quotient by zero", NewDiv(dec("2.50", 3, 2), NewLiteral(int8(0), sql.Int8)), nil, sql.MustCreateDecimalType(7, 6)},
+		{"quotient with precision increment", NewDiv(NewLiteral(int8(2), sql.Int8), NewLiteral(int8(3), sql.Int8)).WithDivPrecisionIncrement(0), "1", sql.MustCreateDecimalType(3, 0)},
+		{"sum of decimal and float", NewPlus(dec("0.5", 2, 1), NewLiteral(0.25, sql.Float64)), 0.75, sql.Float64},
+		{"sum of decimal and string", NewPlus(dec("0.5", 2, 1), NewLiteral("2", sql.LongText)), 2.5, sql.Float64},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.expectedType, tt.arithmetic.Type())
+			result, err := tt.arithmetic.Eval(sql.NewEmptyContext(), sql.NewRow())
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
 func TestAllFloat64(t *testing.T) {
 	var testCases = []struct {
 		op       string
@@ -497,6 +565,8 @@ func TestUnaryMinus(t *testing.T) {
 		{"float64", float64(1), sql.Float64, float64(-1)},
 		{"int text", "1", sql.LongText, float64(-1)},
 		{"float text", "1.2", sql.LongText, float64(-1.2)},
+		{"decimal", "1.20", sql.MustCreateDecimalType(3, 2), "-1.20"},
+		{"negative decimal", "-0.5", sql.MustCreateDecimalType(2, 1), "0.5"},
 		{"nil", nil, sql.LongText, nil},
 	}
 
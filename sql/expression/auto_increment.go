@@ -34,6 +34,21 @@ type AutoIncrement struct {
 	UnaryExpression
 	autoTbl sql.AutoIncrementTable
 	autoCol *sql.Column
+	// rows is the number of rows inserted by the statement, when it is known before the insert begins, or 0.
+	rows int
+	// block holds the values reserved for the statement's rows.
+	block *autoIncrementBlock
+}
+
+// autoIncrementBlock is a block of consecutive values of the AUTO_INCREMENT series, reserved for a multi-row insert
+// whose row count is known in advance.
+type autoIncrementBlock struct {
+	// evaluated is the number of rows of the statement evaluated so far.
+	evaluated int
+	// next is the next value of the block.
+	next uint64
+	// increment and offset define the AUTO_INCREMENT series the values of the block belong to.
+	increment, offset uint64
 }
 
 // NewAutoIncrement creates a new AutoIncrement expression.
@@ -55,12 +70,35 @@ func NewAutoIncrement(ctx *sql.Context, table sql.Table, given sql.Expression) (
 	}
 
 	return &AutoIncrement{
-		UnaryExpression{Child: given},
-		autoTbl,
-		autoCol,
+		UnaryExpression: UnaryExpression{Child: given},
+		autoTbl:         autoTbl,
+		autoCol:         autoCol,
 	}, nil
 }
 
+// WithRowCount returns a copy of this expression for an insert of the given number of rows. Unless
+// innodb_autoinc_lock_mode is 0 ("traditional"), a multi-row insert reserves a block of one value per row when it
+// begins, as InnoDB does, even for the rows that give their own value. The values left unused by these rows are lost.
+func (i *AutoIncrement) WithRowCount(rows int) *AutoIncrement {
+	ni := *i
+	ni.rows = rows
+	ni.block = &autoIncrementBlock{}
+	return &ni
+}
+
+// autoIncrementLockMode returns the value of innodb_autoinc_lock_mode.
+func autoIncrementLockMode() int64 {
+	_, val, ok := sql.SystemVariables.GetGlobal("innodb_autoinc_lock_mode")
+	if !ok {
+		return 0
+	}
+	mode, err := sql.Int64.Convert(val)
+	if err != nil {
+		return 0
+	}
+	return mode.(int64)
+}
+
 // IsNullable implements the Expression interface.
 func (i *AutoIncrement) IsNullable() bool {
 	return false
@@ -90,6 +128,10 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		given = nil
 	}
 
+	if i.rows > 1 && i.block != nil && autoIncrementLockMode() != 0 {
+		return i.evalReserved(ctx, given)
+	}
+
 	// Integrator answer
 	// TODO: This being in Eval could potentially be a problem. If Eval is called multiple times on one row we could
 	// skip keys unexpectedly.
@@ -101,6 +143,62 @@ func (i *AutoIncrement) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	return next, nil
 }
 
+// evalReserved returns the AUTO_INCREMENT value of a row of a multi-row insert, taking the generated values from the
+// block reserved when the first row of the statement is evaluated.
+func (i *AutoIncrement) evalReserved(ctx *sql.Context, given interface{}) (interface{}, error) {
+	if i.block.evaluated == 0 {
+		if err := i.reserveBlock(ctx); err != nil {
+			return nil, err
+		}
+	}
+	i.block.evaluated++
+	if i.block.evaluated == i.rows {
+		i.block.evaluated = 0
+	}
+
+	if given != nil {
+		// A given value past the next value of the block moves the following generated values past it, as in MySQL
+		val, err := sql.Uint64.Convert(given)
+		if err == nil && val.(uint64) >= i.block.next {
+			i.block.next = sql.NextAutoIncrementValue(val.(uint64)+1, i.block.increment, i.block.offset)
+		}
+		return i.autoTbl.GetNextAutoIncrementValue(ctx, given)
+	}
+
+	next, err := i.Type().Convert(i.block.next)
+	if err != nil {
+		return nil, err
+	}
+	i.block.next += i.block.increment
+	return next, nil
+}
+
+// reserveBlock reserves one value per row of the statement, and moves the table's sequence past them.
+func (i *AutoIncrement) reserveBlock(ctx *sql.Context) error {
+	first, err := i.autoTbl.GetNextAutoIncrementValue(ctx, nil)
+	if err != nil {
+		return err
+	}
+	start, err := sql.Uint64.Convert(first)
+	if err != nil {
+		return err
+	}
+
+	i.block.next = start.(uint64)
+	i.block.increment, i.block.offset = sql.AutoIncrementStep(ctx)
+
+	end, err := i.Type().Convert(i.block.next + uint64(i.rows)*i.block.increment)
+	if err != nil {
+		// The end of the block is out of the column's range, which only matters if the rows use all of it.
+		return nil
+	}
+	setter := i.autoTbl.AutoIncrementSetter(ctx)
+	if err := setter.SetAutoIncrementValue(ctx, end); err != nil {
+		return err
+	}
+	return setter.Close(ctx)
+}
+
 func (i *AutoIncrement) String() string {
 	return fmt.Sprintf("AutoIncrement(%s)", i.Child.String())
 }
@@ -110,11 +208,9 @@ func (i *AutoIncrement) WithChildren(ctx *sql.Context, children ...sql.Expressio
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
 	}
-	return &AutoIncrement{
-		UnaryExpression{Child: children[0]},
-		i.autoTbl,
-		i.autoCol,
-	}, nil
+	ni := *i
+	ni.UnaryExpression = UnaryExpression{Child: children[0]}
+	return &ni, nil
 }
 
 // Children implements the Expression interface.
@@ -92,22 +92,43 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return left, right, c.Left().Type(), nil
 	}
 
-	if sql.IsNumber(leftType) || sql.IsNumber(rightType) {
-		if sql.IsDecimal(leftType) || sql.IsDecimal(rightType) {
-			//TODO: We need to set to the actual DECIMAL type
-			l, r, err := convertLeftAndRight(left, right, ConvertToDecimal)
+	// Custom types define their own comparison, so the other operand is converted to the custom type
+	for _, typ := range []sql.Type{leftType, rightType} {
+		if sql.IsCustomType(typ) {
+			l, err := typ.Convert(left)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			r, err := typ.Convert(right)
 			if err != nil {
 				return nil, nil, nil, err
 			}
+			return l, r, typ, nil
+		}
+	}
 
-			if sql.IsDecimal(leftType) {
-				return l, r, leftType, nil
-			} else {
-				return l, r, rightType, nil
+	if sql.IsNumber(leftType) || sql.IsNumber(rightType) {
+		if (sql.IsDecimal(leftType) || sql.IsDecimal(rightType)) && isExactNumber(leftType) && isExactNumber(rightType) {
+			// Decimals are compared exactly with integers and other decimals, and as doubles with anything else
+			_, leftScale := DecimalPrecisionAndScale(leftType)
+			_, rightScale := DecimalPrecisionAndScale(rightType)
+			if rightScale > leftScale {
+				leftScale = rightScale
+			}
+			typ := sql.MustCreateDecimalType(sql.DecimalTypeMaxPrecision, uint8(leftScale))
+			l, err := typ.Convert(left)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			r, err := typ.Convert(right)
+			if err != nil {
+				return nil, nil, nil, err
 			}
+
+			return l, r, typ, nil
 		}
 
-		if sql.IsFloat(leftType) || sql.IsFloat(rightType) {
+		if sql.IsFloat(leftType) || sql.IsFloat(rightType) || sql.IsDecimal(leftType) || sql.IsDecimal(rightType) {
 			l, r, err := convertLeftAndRight(left, right, ConvertToDouble)
 			if err != nil {
 				return nil, nil, nil, err
@@ -147,7 +168,43 @@ func (c *comparison) castLeftAndRight(left, right interface{}) (interface{}, int
 		return nil, nil, nil, err
 	}
 
-	return left, right, sql.LongText, nil
+	return left, right, c.stringCompareType(), nil
+}
+
+// stringCompareType returns the type with the collation that strings are compared with.
+func (c *comparison) stringCompareType() sql.Type {
+	collation := CollationOf(c.Left(), c.Right())
+	switch {
+	case collation.CharacterSet() == sql.CharacterSet_binary:
+		return sql.LongBlob
+	case collation.Equals(sql.Collation_Default):
+		return sql.LongText
+	default:
+		return sql.CreateLongText(collation)
+	}
+}
+
+// CollationOf returns the collation that strings from the expressions given are compared with. Binary strings are
+// compared byte by byte. Otherwise, the collation of a column takes precedence over that of any other string, as in
+// MySQL. Expressions that are not strings have the default collation.
+func CollationOf(exprs ...sql.Expression) sql.Collation {
+	collation := sql.Collation_Default
+	found, fromColumn := false, false
+	for _, e := range exprs {
+		st, ok := e.Type().(sql.StringType)
+		if !ok {
+			continue
+		}
+		coll := st.Collation()
+		if coll.CharacterSet() == sql.CharacterSet_binary {
+			return sql.Collation_binary
+		}
+		_, isColumn := e.(*GetField)
+		if !found || (isColumn && !fromColumn) {
+			collation, found, fromColumn = coll, true, isColumn
+		}
+	}
+	return collation
 }
 
 func convertLeftAndRight(left, right interface{}, convertTo string) (interface{}, interface{}, error) {
@@ -354,7 +411,7 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 		if rerr != nil || right == nil {
 			return right, rerr
 		}
-		matcher, err = regex.NewDisposableMatcher(regex.Default(), *right)
+		matcher, err = regex.NewRegex(regex.Default(), *right, re.options())
 	} else {
 		re.once.Do(func() {
 			right, err := re.evalRight(ctx, row)
@@ -363,7 +420,7 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 					if err != nil || right == nil {
 						return matcherErrTuple{nil, err}
 					}
-					m, e := regex.NewDisposableMatcher(regex.Default(), *right)
+					m, e := regex.NewRegex(regex.Default(), *right, re.options())
 					return matcherErrTuple{m, e}
 				},
 			}
@@ -388,6 +445,12 @@ func (re *Regexp) compareRegexp(ctx *sql.Context, row sql.Row) (interface{}, err
 	return ok, nil
 }
 
+// options returns the options the pattern is compiled with, which are case-insensitive when the strings are compared
+// with a case-insensitive collation.
+func (re *Regexp) options() regex.Options {
+	return regex.Options{CaseInsensitive: !CollationOf(re.Left(), re.Right()).IsCaseSensitive()}
+}
+
 func (re *Regexp) evalRight(ctx *sql.Context, row sql.Row) (*string, error) {
 	right, err := re.Right().Eval(ctx, row)
 	if err != nil {
@@ -218,6 +218,26 @@ func TestGreaterThan(t *testing.T) {
 	}
 }
 
+func TestDecimalComparison(t *testing.T) {
+	testCases := []struct {
+		name        string
+		left, right sql.Expression
+		expected    interface{}
+	}{
+		{"decimals of different scales", expression.NewLiteral("0.3", sql.MustCreateDecimalType(2, 1)), expression.NewLiteral("0.30", sql.MustCreateDecimalType(3, 2)), true},
+		{"decimal and integer", expression.NewLiteral("2.00", sql.MustCreateDecimalType(3, 2)), expression.NewLiteral(int8(2), sql.Int8), true},
+		{"decimals differing in the last digit", expression.NewLiteral("0.31", sql.MustCreateDecimalType(3, 2)), expression.NewLiteral("0.3", sql.MustCreateDecimalType(2, 1)), false},
+		{"decimal and double", expression.NewLiteral("0.3", sql.MustCreateDecimalType(2, 1)), expression.NewLiteral(0.30000000000000004, sql.Float64), false},
+		{"decimal and string", expression.NewLiteral("1.5", sql.MustCreateDecimalType(2, 1)), expression.NewLiteral("1.50", sql.LongText), true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, eval(t, expression.NewEquals(tt.left, tt.right), nil))
+		})
+	}
+}
+
 func TestRegexp(t *testing.T) {
 	for _, engine := range regex.Engines() {
 		regex.SetDefault(engine)
@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ConvertUsing represents a CONVERT(x USING charset) operation, which converts the string x to the character set
+// given. Characters that the character set cannot represent are converted to '?', and binary strings that are not a
+// valid encoding in the character set are converted to NULL.
+type ConvertUsing struct {
+	UnaryExpression
+	Charset sql.CharacterSet
+}
+
+var _ sql.Expression = (*ConvertUsing)(nil)
+
+// NewConvertUsing creates a new ConvertUsing expression.
+func NewConvertUsing(expr sql.Expression, charset sql.CharacterSet) *ConvertUsing {
+	return &ConvertUsing{
+		UnaryExpression: UnaryExpression{Child: expr},
+		Charset:         charset,
+	}
+}
+
+// IsNullable implements the Expression interface.
+func (c *ConvertUsing) IsNullable() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (c *ConvertUsing) Type() sql.Type {
+	if c.Charset == sql.CharacterSet_binary {
+		return sql.LongBlob
+	}
+	return sql.CreateLongText(c.Charset.DefaultCollation())
+}
+
+func (c *ConvertUsing) String() string {
+	return fmt.Sprintf("convert(%v using %v)", c.Child, c.Charset)
+}
+
+// WithChildren implements the Expression interface.
+func (c *ConvertUsing) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewConvertUsing(children[0], c.Charset), nil
+}
+
+// Eval implements the Expression interface.
+func (c *ConvertUsing) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := c.Child.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	s := val.(string)
+
+	from := sql.Collation_Default.CharacterSet()
+	if st, ok := c.Child.Type().(sql.StringType); ok {
+		from = st.CharacterSet()
+	}
+
+	switch {
+	case from == sql.CharacterSet_binary && c.Charset == sql.CharacterSet_binary:
+		return s, nil
+	case from == sql.CharacterSet_binary:
+		decoded, ok := c.Charset.Decode([]byte(s))
+		if !ok {
+			ctx.Warn(mysql.ERInvalidCharacterString, "Invalid %s character string: '%s'", c.Charset, strings.ToUpper(fmt.Sprintf("%x", s)))
+			return nil, nil
+		}
+		return decoded, nil
+	case c.Charset == sql.CharacterSet_binary:
+		encoded, _ := from.Encode(s)
+		return string(encoded), nil
+	default:
+		encoded, _ := c.Charset.Encode(s)
+		converted, _ := c.Charset.Decode(encoded)
+		return converted, nil
+	}
+}
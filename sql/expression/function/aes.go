@@ -0,0 +1,323 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrAESInvalidIV is returned when the initialization vector given to AES_ENCRYPT or AES_DECRYPT is too short.
+var ErrAESInvalidIV = errors.NewKind("The initialization vector supplied to %s is too short. Must be at least 16 bytes long")
+
+// aesMode is the key size and block mode used by AES_ENCRYPT and AES_DECRYPT, as given by the block_encryption_mode
+// system variable, e.g. aes-256-cbc.
+type aesMode struct {
+	keySize int
+	block   string
+}
+
+// sessionAESMode returns the mode given by the block_encryption_mode system variable of the session.
+func sessionAESMode(ctx *sql.Context) (aesMode, error) {
+	val, err := ctx.GetSessionVariable(ctx, "block_encryption_mode")
+	if err != nil {
+		return aesMode{}, err
+	}
+	parts := strings.Split(strings.ToLower(val.(string)), "-")
+	if len(parts) != 3 {
+		return aesMode{}, sql.ErrInvalidSystemVariableValue.New("block_encryption_mode", val)
+	}
+	bits, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return aesMode{}, sql.ErrInvalidSystemVariableValue.New("block_encryption_mode", val)
+	}
+	return aesMode{keySize: bits / 8, block: parts[2]}, nil
+}
+
+// needsIV returns whether the block mode uses an initialization vector, which ECB does not.
+func (m aesMode) needsIV() bool {
+	return m.block != "ecb"
+}
+
+// padded returns whether the block mode pads the text to a multiple of the block size, with PKCS#7 padding, as ECB and
+// CBC do. The other modes turn AES into a stream cipher.
+func (m aesMode) padded() bool {
+	return m.block == "ecb" || m.block == "cbc"
+}
+
+// foldKey returns the key of the size of the mode from the key given, as MySQL makes it: the bytes of the key given are
+// XORed into a buffer of zeroes, starting over at the beginning of the buffer when its end is reached.
+func (m aesMode) foldKey(key string) []byte {
+	folded := make([]byte, m.keySize)
+	for i := 0; i < len(key); i++ {
+		folded[i%m.keySize] ^= key[i]
+	}
+	return folded
+}
+
+// encrypt returns the text encrypted with the key and initialization vector given.
+func (m aesMode) encrypt(text, key, iv string) string {
+	block, _ := aes.NewCipher(m.foldKey(key))
+	src := []byte(text)
+	if m.padded() {
+		padding := aes.BlockSize - len(src)%aes.BlockSize
+		for i := 0; i < padding; i++ {
+			src = append(src, byte(padding))
+		}
+	}
+	return string(m.crypt(block, []byte(iv), src, false))
+}
+
+// decrypt returns the text decrypted with the key and initialization vector given, or false if it could not have been
+// encrypted with them.
+func (m aesMode) decrypt(text, key, iv string) (string, bool) {
+	block, _ := aes.NewCipher(m.foldKey(key))
+	src := []byte(text)
+	if m.padded() && (len(src) == 0 || len(src)%aes.BlockSize != 0) {
+		return "", false
+	}
+
+	dst := m.crypt(block, []byte(iv), src, true)
+	if m.padded() {
+		padding := int(dst[len(dst)-1])
+		if padding == 0 || padding > aes.BlockSize {
+			return "", false
+		}
+		for _, b := range dst[len(dst)-padding:] {
+			if int(b) != padding {
+				return "", false
+			}
+		}
+		dst = dst[:len(dst)-padding]
+	}
+	return string(dst), true
+}
+
+func (m aesMode) crypt(block cipher.Block, iv, src []byte, decrypt bool) []byte {
+	dst := make([]byte, len(src))
+	if m.needsIV() {
+		iv = iv[:aes.BlockSize]
+	}
+
+	switch m.block {
+	case "ecb":
+		for i := 0; i < len(src); i += aes.BlockSize {
+			if decrypt {
+				block.Decrypt(dst[i:], src[i:])
+			} else {
+				block.Encrypt(dst[i:], src[i:])
+			}
+		}
+	case "cbc":
+		if decrypt {
+			cipher.NewCBCDecrypter(block, iv).CryptBlocks(dst, src)
+		} else {
+			cipher.NewCBCEncrypter(block, iv).CryptBlocks(dst, src)
+		}
+	case "cfb128":
+		if decrypt {
+			cipher.NewCFBDecrypter(block, iv).XORKeyStream(dst, src)
+		} else {
+			cipher.NewCFBEncrypter(block, iv).XORKeyStream(dst, src)
+		}
+	case "ofb":
+		cipher.NewOFB(block, iv).XORKeyStream(dst, src)
+	case "cfb8":
+		cfbBits(block, iv, dst, src, 8, decrypt)
+	case "cfb1":
+		cfbBits(block, iv, dst, src, 1, decrypt)
+	}
+	return dst
+}
+
+// cfbBits encrypts or decrypts in CFB mode with segments of 8 bits or 1 bit, which the cipher package does not
+// support. Each segment of the text is XORed with the first bits of the encrypted shift register, and the resulting
+// ciphertext segment is then shifted into the register.
+func cfbBits(block cipher.Block, iv, dst, src []byte, segmentBits int, decrypt bool) {
+	register := make([]byte, aes.BlockSize)
+	copy(register, iv)
+	stream := make([]byte, aes.BlockSize)
+
+	for i, in := range src {
+		var out byte
+		for shift := 8 - segmentBits; shift >= 0; shift -= segmentBits {
+			mask := byte(1<<uint(segmentBits) - 1)
+			block.Encrypt(stream, register)
+			segment := (in >> uint(shift)) & mask
+			result := segment ^ (stream[0] >> uint(8-segmentBits))
+			out |= result << uint(shift)
+
+			feedback := result
+			if decrypt {
+				feedback = segment
+			}
+			shiftIn(register, feedback, segmentBits)
+		}
+		dst[i] = out
+	}
+}
+
+// shiftIn shifts the register left by the number of bits given, which is at most 8, with the bits given coming in at
+// its end.
+func shiftIn(register []byte, bits byte, n int) {
+	for i := 0; i < len(register)-1; i++ {
+		register[i] = register[i]<<uint(n) | register[i+1]>>uint(8-n)
+	}
+	register[len(register)-1] = register[len(register)-1]<<uint(n) | bits
+}
+
+// aesFunction holds the arguments of AES_ENCRYPT and AES_DECRYPT, which are the text, the key, and the initialization
+// vector for the modes that need one.
+type aesFunction struct {
+	name string
+	args []sql.Expression
+}
+
+func newAESFunction(name string, args []sql.Expression) (aesFunction, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return aesFunction{}, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(name), "2 or 3", len(args))
+	}
+	return aesFunction{name: name, args: args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f aesFunction) FunctionName() string {
+	return f.name
+}
+
+// Type implements the Expression interface.
+func (f aesFunction) Type() sql.Type { return sql.LongBlob }
+
+// IsNullable implements the Expression interface.
+func (f aesFunction) IsNullable() bool { return true }
+
+func (f aesFunction) String() string {
+	return fmt.Sprintf("%s(%s)", strings.ToUpper(f.name), joinExpressions(f.args))
+}
+
+// Resolved implements the Expression interface.
+func (f aesFunction) Resolved() bool {
+	return resolvedExpressions(f.args)
+}
+
+// Children implements the Expression interface.
+func (f aesFunction) Children() []sql.Expression { return f.args }
+
+// evalArgs returns the text, the key and the initialization vector, along with the mode of the session, or false if
+// any of them is NULL. As in MySQL, the initialization vector is ignored with a warning by ECB, and is otherwise
+// required.
+func (f aesFunction) evalArgs(ctx *sql.Context, row sql.Row) (mode aesMode, text, key, iv string, ok bool, err error) {
+	mode, err = sessionAESMode(ctx)
+	if err != nil {
+		return
+	}
+	if mode.needsIV() && len(f.args) < 3 {
+		err = sql.ErrInvalidArgumentNumber.New(strings.ToUpper(f.name), 3, len(f.args))
+		return
+	}
+
+	vals := make([]string, 3)
+	for i, arg := range f.args {
+		if i == 2 && !mode.needsIV() {
+			ctx.Warn(1618, "<IV> option ignored")
+			break
+		}
+		vals[i], ok, err = evalLongText(ctx, arg, row)
+		if err != nil || !ok {
+			return
+		}
+	}
+	text, key, iv = vals[0], vals[1], vals[2]
+
+	if mode.needsIV() && len(iv) < aes.BlockSize {
+		err = ErrAESInvalidIV.New(f.name)
+		return
+	}
+	return mode, text, key, iv, true, nil
+}
+
+// AESEncrypt encrypts a string with the AES algorithm, in the mode given by the block_encryption_mode system variable.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-encrypt
+type AESEncrypt struct {
+	aesFunction
+}
+
+var _ sql.FunctionExpression = (*AESEncrypt)(nil)
+
+// NewAESEncrypt creates a new AES_ENCRYPT function.
+func NewAESEncrypt(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunction("aes_encrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESEncrypt{f}, nil
+}
+
+// WithChildren implements the Expression interface.
+func (a *AESEncrypt) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewAESEncrypt(ctx, children...)
+}
+
+// Eval implements the Expression interface.
+func (a *AESEncrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	mode, text, key, iv, ok, err := a.evalArgs(ctx, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return mode.encrypt(text, key, iv), nil
+}
+
+// AESDecrypt decrypts a string encrypted by AES_ENCRYPT with the same key, initialization vector and mode. The result
+// is NULL if the string could not have been encrypted with them.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-decrypt
+type AESDecrypt struct {
+	aesFunction
+}
+
+var _ sql.FunctionExpression = (*AESDecrypt)(nil)
+
+// NewAESDecrypt creates a new AES_DECRYPT function.
+func NewAESDecrypt(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunction("aes_decrypt", args)
+	if err != nil {
+		return nil, err
+	}
+	return &AESDecrypt{f}, nil
+}
+
+// WithChildren implements the Expression interface.
+func (a *AESDecrypt) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewAESDecrypt(ctx, children...)
+}
+
+// Eval implements the Expression interface.
+func (a *AESDecrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	mode, text, key, iv, ok, err := a.evalArgs(ctx, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	decrypted, ok := mode.decrypt(text, key, iv)
+	if !ok {
+		return nil, nil
+	}
+	return decrypted, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestAESEncrypt(t *testing.T) {
+	const text = "Hello, world! This is a test."
+	const iv = "1234567890123456"
+
+	// The expected results come from OpenSSL, with the keys folded as MySQL does
+	testCases := []struct {
+		mode     string
+		text     string
+		key      string
+		iv       interface{}
+		expected string
+	}{
+		{"aes-128-ecb", "text", "password", nil, "f6bd0fa8dcb7f8cd4a2faabc54668044"},
+		{"aes-128-ecb", "text", "a long key of more than 16 bytes", nil, "93c44b686a653867dafe3fcb28b9a63b"},
+		{"aes-128-ecb", "", "password", nil, "c6b4234e1d0709c945113e4f2a9607f7"},
+		{"aes-256-ecb", text, "secret", nil, "d0b615d101d7224a4693d8e2472db8f8136589cf4485d01be6974022eed7e5f1"},
+		{"aes-128-cbc", text, "secret", iv, "45bbba18809d64598fe7352c8343ff0b28a7acee0381a69cb3ee8511f3433322"},
+		{"aes-192-cbc", text, "secret", iv, "cca2339f8c0b0d080ee6ee9467cbe6ffe9fa299f2e3c01c0abf7f7718fc90fba"},
+		{"aes-256-cbc", text, "secret", iv + "ignored", "e25e213d66af3b42d0872b83aa576d90eee550563b423eddc9d5d6491bd25ca9"},
+		{"aes-128-cfb1", text, "secret", iv, "d6ca3cf38e691553560c96f804ee8cfeed2c6336bfc48eb2fdb049e00b"},
+		{"aes-256-cfb1", text, "secret", iv, "bef9c67688a07c303b92f5ebaa4e5d6169ec29d8870d2bedf8f6ee3959"},
+		{"aes-128-cfb8", text, "secret", iv, "c55a9f573fa8ddc12aef672d0327683d94c5ed71a72a758e993c009992"},
+		{"aes-256-cfb8", text, "secret", iv, "d0d8509b39307f21f8bf45242de56f605a7e4786bd6afc491cf4c5b91b"},
+		{"aes-128-cfb128", text, "secret", iv, "c5a88986919277ca65af8338b185ae02631a64a9e2176bbf09a11f7229"},
+		{"aes-128-ofb", text, "secret", iv, "c5a88986919277ca65af8338b185ae028880bff64417cabfb796d7af19"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.mode+" "+tt.text, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", strings.ToUpper(tt.mode)))
+
+			args := []sql.Expression{
+				expression.NewLiteral(tt.text, sql.LongText),
+				expression.NewLiteral(tt.key, sql.LongText),
+			}
+			if tt.iv != nil {
+				args = append(args, expression.NewLiteral(tt.iv, sql.LongText))
+			}
+
+			encrypt, err := NewAESEncrypt(ctx, args...)
+			require.NoError(err)
+			encrypted, err := encrypt.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.expected, hex.EncodeToString([]byte(encrypted.(string))))
+
+			args[0] = expression.NewLiteral(encrypted, sql.LongBlob)
+			decrypt, err := NewAESDecrypt(ctx, args...)
+			require.NoError(err)
+			decrypted, err := decrypt.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(tt.text, decrypted)
+		})
+	}
+}
+
+func TestAESDecryptInvalid(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	decrypt := func(text, key string) interface{} {
+		f, err := NewAESDecrypt(ctx, expression.NewLiteral(text, sql.LongBlob), expression.NewLiteral(key, sql.LongText))
+		require.NoError(err)
+		v, err := f.Eval(ctx, nil)
+		require.NoError(err)
+		return v
+	}
+
+	encrypted, err := hex.DecodeString("f6bd0fa8dcb7f8cd4a2faabc54668044")
+	require.NoError(err)
+	require.Equal("text", decrypt(string(encrypted), "password"))
+	require.Nil(decrypt(string(encrypted), "wrong key"))
+	require.Nil(decrypt(string(encrypted[:15]), "password"))
+	require.Nil(decrypt("", "password"))
+}
+
+func TestAESArguments(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	text := expression.NewLiteral("text", sql.LongText)
+	key := expression.NewLiteral("key", sql.LongText)
+
+	_, err := NewAESEncrypt(ctx, text)
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err := NewAESEncrypt(ctx, text, expression.NewLiteral(nil, sql.Null))
+	require.NoError(err)
+	v, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	// ECB ignores the initialization vector, with a warning
+	f, err = NewAESEncrypt(ctx, text, key, expression.NewLiteral("1234567890123456", sql.LongText))
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Len(ctx.Warnings(), 1)
+	require.Equal(1618, ctx.Warnings()[0].Code)
+
+	require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", "aes-256-cbc"))
+	f, err = NewAESEncrypt(ctx, text, key)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(sql.ErrInvalidArgumentNumber.Is(err))
+
+	f, err = NewAESEncrypt(ctx, text, key, expression.NewLiteral("short", sql.LongText))
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrAESInvalidIV.Is(err))
+
+	require.Error(ctx.SetSessionVariable(ctx, "block_encryption_mode", "aes-512-cbc"))
+}
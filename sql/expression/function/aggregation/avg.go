@@ -17,6 +17,8 @@ package aggregation
 import (
 	"fmt"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -24,13 +26,19 @@ import (
 // Avg node to calculate the average from numeric column
 type Avg struct {
 	expression.UnaryExpression
+	// divPrecisionIncrement is the value of div_precision_increment used to derive the scale of a decimal average
+	divPrecisionIncrement uint8
 }
 
 var _ sql.FunctionExpression = (*Avg)(nil)
+var _ sql.SpillableAggregation = (*Avg)(nil)
 
 // NewAvg creates a new Avg node.
 func NewAvg(ctx *sql.Context, e sql.Expression) *Avg {
-	return &Avg{expression.UnaryExpression{Child: e}}
+	return &Avg{
+		UnaryExpression:       expression.UnaryExpression{Child: e},
+		divPrecisionIncrement: expression.DivPrecisionIncrement(ctx),
+	}
 }
 
 // FunctionName implements sql.FunctionExpression
@@ -43,7 +51,20 @@ func (a *Avg) String() string {
 }
 
 // Type implements AggregationExpression interface. (AggregationExpression[Expression]])
+// The average of decimals or integers is an exact decimal whose scale is increased by div_precision_increment, as in MySQL, and
+// the average of anything else is a double.
 func (a *Avg) Type() sql.Type {
+	if dt, ok := exactDecimalType(a.Child.Type()); ok {
+		precision := int(dt.Precision()) + int(a.divPrecisionIncrement)
+		if precision > sql.DecimalTypeMaxPrecision {
+			precision = sql.DecimalTypeMaxPrecision
+		}
+		scale := int(dt.Scale()) + int(a.divPrecisionIncrement)
+		if scale > sql.DecimalTypeMaxScale {
+			scale = sql.DecimalTypeMaxScale
+		}
+		return sql.MustCreateDecimalType(uint8(precision), uint8(scale))
+	}
 	return sql.Float64
 }
 
@@ -55,10 +76,15 @@ func (a *Avg) IsNullable() bool {
 // Eval implements AggregationExpression interface. (AggregationExpression[Expression]])
 func (a *Avg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	// This case is triggered when no rows exist.
-	if buffer[0] == float64(0) && buffer[1] == int64(0) {
+	if buffer[1] == int64(0) {
 		return nil, nil
 	}
 
+	if dec, ok := buffer[0].(decimal.Decimal); ok {
+		typ := a.Type().(sql.DecimalType)
+		return typ.Convert(dec.DivRound(decimal.NewFromInt(buffer[1].(int64)), int32(typ.Scale())))
+	}
+
 	sum := buffer[0].(float64)
 	rows := buffer[1].(int64)
 
@@ -74,11 +100,17 @@ func (a *Avg) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Ex
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
 	}
-	return NewAvg(ctx, children[0]), nil
+	na := *a
+	na.UnaryExpression = expression.UnaryExpression{Child: children[0]}
+	return &na, nil
 }
 
 // NewBuffer implements AggregationExpression interface. (AggregationExpression)
 func (a *Avg) NewBuffer() sql.Row {
+	if _, ok := exactDecimalType(a.Child.Type()); ok {
+		return sql.NewRow(decimal.Zero, int64(0))
+	}
+
 	const (
 		sum  = float64(0)
 		rows = int64(0)
@@ -98,6 +130,16 @@ func (a *Avg) Update(ctx *sql.Context, buffer, row sql.Row) error {
 		return nil
 	}
 
+	if dt, ok := exactDecimalType(a.Child.Type()); ok {
+		dec, err := dt.ConvertToDecimal(v)
+		if err != nil {
+			return err
+		}
+		buffer[0] = buffer[0].(decimal.Decimal).Add(dec.Decimal)
+		buffer[1] = buffer[1].(int64) + 1
+		return nil
+	}
+
 	v, err = sql.Float64.Convert(v)
 	if err != nil {
 		v = float64(0)
@@ -111,6 +153,12 @@ func (a *Avg) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements AggregationExpression interface. (AggregationExpression)
 func (a *Avg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	if bsum, ok := buffer[0].(decimal.Decimal); ok {
+		buffer[0] = bsum.Add(partial[0].(decimal.Decimal))
+		buffer[1] = buffer[1].(int64) + partial[1].(int64)
+		return nil
+	}
+
 	bsum := buffer[0].(float64)
 	brows := buffer[1].(int64)
 
@@ -122,3 +170,13 @@ func (a *Avg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
 
 	return nil
 }
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (a *Avg) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (a *Avg) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
+}
@@ -46,14 +46,15 @@ func TestAvg_Eval_INT32(t *testing.T) {
 	ctx := sql.NewEmptyContext()
 
 	avgNode := NewAvg(sql.NewEmptyContext(), expression.NewGetField(0, sql.Int32, "col1", true))
+	require.Equal(sql.MustCreateDecimalType(14, 4), avgNode.Type())
 	buffer := avgNode.NewBuffer()
 	require.Equal(nil, eval(t, avgNode, buffer))
 
 	avgNode.Update(ctx, buffer, sql.NewRow(int32(1)))
-	require.Equal(float64(1), eval(t, avgNode, buffer))
+	require.Equal("1.0000", eval(t, avgNode, buffer))
 
 	avgNode.Update(ctx, buffer, sql.NewRow(int32(2)))
-	require.Equal(float64(1.5), eval(t, avgNode, buffer))
+	require.Equal("1.5000", eval(t, avgNode, buffer))
 }
 
 func TestAvg_Eval_UINT64(t *testing.T) {
@@ -66,11 +67,11 @@ func TestAvg_Eval_UINT64(t *testing.T) {
 
 	err := avgNode.Update(ctx, buffer, sql.NewRow(uint64(1)))
 	require.NoError(err)
-	require.Equal(float64(1), eval(t, avgNode, buffer))
+	require.Equal("1.0000", eval(t, avgNode, buffer))
 
 	err = avgNode.Update(ctx, buffer, sql.NewRow(uint64(2)))
 	require.NoError(err)
-	require.Equal(float64(1.5), eval(t, avgNode, buffer))
+	require.Equal("1.5000", eval(t, avgNode, buffer))
 }
 
 func TestAvg_Eval_String(t *testing.T) {
@@ -120,6 +121,28 @@ func TestAvg_Merge(t *testing.T) {
 	require.Equal(float64(5.2), eval(t, avgNode, buffer1))
 }
 
+func TestAvg_Decimal(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	avgNode := NewAvg(ctx, expression.NewGetField(0, sql.MustCreateDecimalType(10, 2), "col1", true))
+	require.Equal(sql.MustCreateDecimalType(14, 6), avgNode.Type())
+
+	buffer1 := avgNode.NewBuffer()
+	require.Nil(eval(t, avgNode, buffer1))
+	require.NoError(avgNode.Update(ctx, buffer1, sql.NewRow("0.10")))
+	require.NoError(avgNode.Update(ctx, buffer1, sql.NewRow("0.20")))
+	require.Equal("0.150000", eval(t, avgNode, buffer1))
+
+	buffer2 := avgNode.NewBuffer()
+	require.NoError(avgNode.Update(ctx, buffer2, sql.NewRow(nil)))
+	require.NoError(avgNode.Update(ctx, buffer2, sql.NewRow("1.00")))
+	require.Equal("1.000000", eval(t, avgNode, buffer2))
+
+	require.NoError(avgNode.Merge(ctx, buffer1, buffer2))
+	require.Equal("0.433333", eval(t, avgNode, buffer1))
+}
+
 func TestAvg_NULL(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -147,12 +170,12 @@ func TestAvg_NUMS_AND_NULLS(t *testing.T) {
 		{
 			"float values with nil",
 			[]sql.Row{{2.0}, {2.0}, {3.}, {4.}, {nil}},
-			float64(2.75),
+			"2.7500",
 		},
 		{
 			"float values with nil",
 			[]sql.Row{{1}, {2}, {3}, {nil}, {nil}},
-			float64(2.0),
+			"2.0000",
 		},
 		{
 			"no rows",
@@ -0,0 +1,232 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+type bitOperator byte
+
+const (
+	bitAndOperator bitOperator = iota
+	bitOrOperator
+	bitXorOperator
+)
+
+// bitAggregation combines the bits of the values of its child, as 64-bit unsigned integers, with a bitwise operator.
+// NULLs are left out, and the result has all its bits set for BIT_AND, and none for the others, if there are no other
+// values.
+type bitAggregation struct {
+	expression.UnaryExpression
+	op bitOperator
+}
+
+// Type implements the Expression interface.
+func (b *bitAggregation) Type() sql.Type {
+	return sql.Uint64
+}
+
+// IsNullable implements the Expression interface.
+func (b *bitAggregation) IsNullable() bool {
+	return false
+}
+
+// NewBuffer implements the Aggregation interface.
+func (b *bitAggregation) NewBuffer() sql.Row {
+	if b.op == bitAndOperator {
+		return sql.NewRow(uint64(math.MaxUint64))
+	}
+	return sql.NewRow(uint64(0))
+}
+
+// Update implements the Aggregation interface.
+func (b *bitAggregation) Update(ctx *sql.Context, buffer, row sql.Row) error {
+	v, err := b.Child.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	buffer[0] = b.combine(buffer[0].(uint64), toBits(v))
+	return nil
+}
+
+// Merge implements the Aggregation interface.
+func (b *bitAggregation) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	buffer[0] = b.combine(buffer[0].(uint64), partial[0].(uint64))
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (b *bitAggregation) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (b *bitAggregation) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
+}
+
+func (b *bitAggregation) combine(x, y uint64) uint64 {
+	switch b.op {
+	case bitAndOperator:
+		return x & y
+	case bitOrOperator:
+		return x | y
+	default:
+		return x ^ y
+	}
+}
+
+// Eval implements the Aggregation interface.
+func (b *bitAggregation) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return buffer[0], nil
+}
+
+// toBits returns the bits of a value as an unsigned integer, so that negative numbers have their two's complement
+// bits. Numbers with a fractional part are rounded half away from zero, and values that are not numbers count as 0.
+func toBits(v interface{}) uint64 {
+	switch n := v.(type) {
+	case float32:
+		v = math.Round(float64(n))
+	case float64:
+		v = math.Round(n)
+	case decimal.Decimal:
+		v = n.Round(0).String()
+	case string:
+		if d, err := decimal.NewFromString(n); err == nil {
+			v = d.Round(0).String()
+		}
+	}
+	if n, err := sql.Int64.Convert(v); err == nil {
+		return uint64(n.(int64))
+	}
+	if n, err := sql.Uint64.Convert(v); err == nil {
+		return n.(uint64)
+	}
+	if f, err := sql.Float64.Convert(v); err == nil {
+		return uint64(int64(math.Round(f.(float64))))
+	}
+	return 0
+}
+
+// BitAnd aggregation returns the bitwise AND of the values of its child.
+type BitAnd struct {
+	bitAggregation
+}
+
+var _ sql.FunctionExpression = (*BitAnd)(nil)
+var _ sql.SpillableAggregation = (*BitAnd)(nil)
+
+// NewBitAnd creates a new BitAnd node.
+func NewBitAnd(ctx *sql.Context, e sql.Expression) *BitAnd {
+	return &BitAnd{bitAggregation{
+		UnaryExpression: expression.UnaryExpression{Child: e},
+		op:              bitAndOperator,
+	}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitAnd) FunctionName() string {
+	return "bit_and"
+}
+
+func (b *BitAnd) String() string {
+	return fmt.Sprintf("BIT_AND(%s)", b.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitAnd) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitAnd(ctx, children[0]), nil
+}
+
+// BitOr aggregation returns the bitwise OR of the values of its child.
+type BitOr struct {
+	bitAggregation
+}
+
+var _ sql.FunctionExpression = (*BitOr)(nil)
+var _ sql.SpillableAggregation = (*BitOr)(nil)
+
+// NewBitOr creates a new BitOr node.
+func NewBitOr(ctx *sql.Context, e sql.Expression) *BitOr {
+	return &BitOr{bitAggregation{
+		UnaryExpression: expression.UnaryExpression{Child: e},
+		op:              bitOrOperator,
+	}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitOr) FunctionName() string {
+	return "bit_or"
+}
+
+func (b *BitOr) String() string {
+	return fmt.Sprintf("BIT_OR(%s)", b.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitOr) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitOr(ctx, children[0]), nil
+}
+
+// BitXor aggregation returns the bitwise XOR of the values of its child.
+type BitXor struct {
+	bitAggregation
+}
+
+var _ sql.FunctionExpression = (*BitXor)(nil)
+var _ sql.SpillableAggregation = (*BitXor)(nil)
+
+// NewBitXor creates a new BitXor node.
+func NewBitXor(ctx *sql.Context, e sql.Expression) *BitXor {
+	return &BitXor{bitAggregation{
+		UnaryExpression: expression.UnaryExpression{Child: e},
+		op:              bitXorOperator,
+	}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *BitXor) FunctionName() string {
+	return "bit_xor"
+}
+
+func (b *BitXor) String() string {
+	return fmt.Sprintf("BIT_XOR(%s)", b.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (b *BitXor) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 1)
+	}
+	return NewBitXor(ctx, children[0]), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestBitAggregations(t *testing.T) {
+	col := expression.NewGetField(0, sql.Int64, "col1", true)
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		name string
+		rows []sql.Row
+		and  uint64
+		or   uint64
+		xor  uint64
+	}{
+		{
+			"no rows",
+			nil,
+			math.MaxUint64, 0, 0,
+		},
+		{
+			"only nulls",
+			[]sql.Row{{nil}},
+			math.MaxUint64, 0, 0,
+		},
+		{
+			"values",
+			[]sql.Row{{int64(7)}, {nil}, {int64(13)}, {int64(5)}},
+			5, 15, 15,
+		},
+		{
+			"negative values",
+			[]sql.Row{{int64(-1)}, {int64(-2)}},
+			math.MaxUint64 - 1, math.MaxUint64, 1,
+		},
+		{
+			"strings",
+			[]sql.Row{{"6"}, {"3"}},
+			2, 7, 5,
+		},
+		{
+			"fractions",
+			[]sql.Row{{1.6}, {decimal.RequireFromString("2.5")}, {"2.5"}},
+			2, 3, 2,
+		},
+		{
+			"negative fractions",
+			[]sql.Row{{-1.5}, {decimal.RequireFromString("-1.5")}},
+			math.MaxUint64 - 1, math.MaxUint64 - 1, 0,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.and, aggregate(t, NewBitAnd(ctx, col), tt.rows...))
+			require.Equal(tt.or, aggregate(t, NewBitOr(ctx, col), tt.rows...))
+			require.Equal(tt.xor, aggregate(t, NewBitXor(ctx, col), tt.rows...))
+		})
+	}
+}
+
+func TestBitAggregationMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	b := NewBitAnd(ctx, expression.NewGetField(0, sql.Int64, "col1", true))
+	require.Equal("BIT_AND(col1)", b.String())
+
+	buffer1 := b.NewBuffer()
+	require.NoError(b.Update(ctx, buffer1, sql.NewRow(int64(7))))
+	buffer2 := b.NewBuffer()
+	require.NoError(b.Merge(ctx, buffer1, buffer2))
+	require.Equal(uint64(7), eval(t, b, buffer1))
+
+	require.NoError(b.Update(ctx, buffer2, sql.NewRow(int64(12))))
+	require.NoError(b.Merge(ctx, buffer1, buffer2))
+	require.Equal(uint64(4), eval(t, b, buffer1))
+}
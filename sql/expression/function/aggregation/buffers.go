@@ -0,0 +1,41 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// encodeBuffer appends the encoding of an aggregation buffer to buf. Values of the buffer that aren't of built-in Go
+// types must be of the types given, one per value.
+func encodeBuffer(buf []byte, buffer sql.Row, types ...sql.Type) ([]byte, error) {
+	return sql.EncodeRow(buf, bufferSchema(types), buffer)
+}
+
+// decodeBuffer returns the aggregation buffer encoded by encodeBuffer with the types given.
+func decodeBuffer(data []byte, types ...sql.Type) (sql.Row, error) {
+	return sql.DecodeRow(data, bufferSchema(types))
+}
+
+func bufferSchema(types []sql.Type) sql.Schema {
+	if len(types) == 0 {
+		return nil
+	}
+	sch := make(sql.Schema, len(types))
+	for i, typ := range types {
+		sch[i] = &sql.Column{Type: typ, Nullable: true}
+	}
+	return sch
+}
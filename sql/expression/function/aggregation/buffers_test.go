@@ -0,0 +1,110 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestSpillableAggregations(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	decType := sql.MustCreateDecimalType(10, 2)
+	i := expression.NewGetField(0, sql.Int64, "i", true)
+	s := expression.NewGetField(1, sql.LongText, "s", true)
+	d := expression.NewGetField(2, decType, "d", true)
+
+	var rows []sql.Row
+	for j := 0; j < 30; j++ {
+		var v interface{} = int64((j * 7) % 11)
+		if j%5 == 0 {
+			v = nil
+		}
+		rows = append(rows, sql.NewRow(v, fmt.Sprintf("s%d", j%4), decimal.New(int64(j*25), -2)))
+	}
+
+	groupConcat, err := NewGroupConcat(ctx, "", nil, ",", []sql.Expression{s}, 1024)
+	require.NoError(t, err)
+	distinctConcat, err := NewGroupConcat(ctx, "distinct", nil, ",", []sql.Expression{s}, 1024)
+	require.NoError(t, err)
+
+	aggregations := []sql.SpillableAggregation{
+		NewAvg(ctx, i),
+		NewAvg(ctx, d),
+		NewBitAnd(ctx, i),
+		NewBitOr(ctx, i),
+		NewBitXor(ctx, i),
+		NewCount(ctx, i),
+		NewCount(ctx, expression.NewStar()),
+		NewCountDistinct(i),
+		NewFirst(ctx, i),
+		NewLast(ctx, i),
+		NewMax(ctx, i),
+		NewMax(ctx, s),
+		NewMin(ctx, i),
+		NewMin(ctx, d),
+		NewSum(ctx, i),
+		NewSum(ctx, d),
+		NewVarPop(ctx, i),
+		NewVarSamp(ctx, i),
+		NewStdDevPop(ctx, i),
+		NewStdDevSamp(ctx, i),
+		groupConcat,
+		distinctConcat,
+		NewJSONArrayAgg(ctx, i),
+		NewJSONObjectAgg(ctx, s, i).(sql.SpillableAggregation),
+	}
+
+	for _, agg := range aggregations {
+		t.Run(agg.String(), func(t *testing.T) {
+			require := require.New(t)
+			expected := aggregate(t, agg, rows...)
+
+			// Aggregate the rows in three partial buffers, write them out and read them back, and merge them in order
+			var merged sql.Row
+			for start := 0; start < len(rows); start += 10 {
+				partial := agg.NewBuffer()
+				for _, row := range rows[start : start+10] {
+					require.NoError(agg.Update(ctx, partial, row))
+				}
+
+				encoded, err := agg.EncodeBuffer(nil, partial)
+				require.NoError(err)
+				decoded, err := agg.DecodeBuffer(encoded)
+				require.NoError(err)
+
+				if merged == nil {
+					merged = decoded
+				} else {
+					require.NoError(agg.Merge(ctx, merged, decoded))
+				}
+			}
+
+			actual, err := agg.Eval(ctx, merged)
+			require.NoError(err)
+			if f, ok := expected.(float64); ok {
+				require.InDelta(f, actual, 1e-9)
+			} else {
+				require.Equal(expected, actual)
+			}
+		})
+	}
+}
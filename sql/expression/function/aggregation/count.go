@@ -16,6 +16,7 @@ package aggregation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mitchellh/hashstructure"
 
@@ -29,6 +30,7 @@ type Count struct {
 }
 
 var _ sql.FunctionExpression = (*Count)(nil)
+var _ sql.SpillableAggregation = (*Count)(nil)
 
 // NewCount creates a new Count node.
 func NewCount(ctx *sql.Context, e sql.Expression) *Count {
@@ -105,13 +107,24 @@ func (c *Count) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
 	return nil
 }
 
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (c *Count) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (c *Count) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
+}
+
 // Eval implements the Aggregation interface.
 func (c *Count) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	count := buffer[0]
 	return count, nil
 }
 
-// CountDistinct node to count how many rows are in the result set.
+// CountDistinct node to count how many distinct values are in the result set. COUNT(DISTINCT a, b) counts the
+// distinct tuples of its expressions, which are given as an expression.Tuple, and leaves out those with a NULL.
 type CountDistinct struct {
 	expression.UnaryExpression
 }
@@ -146,6 +159,13 @@ func (c *CountDistinct) Resolved() bool {
 }
 
 func (c *CountDistinct) String() string {
+	if t, ok := c.Child.(expression.Tuple); ok && len(t) > 1 {
+		exprs := make([]string, len(t))
+		for i, e := range t {
+			exprs[i] = e.String()
+		}
+		return fmt.Sprintf("COUNT(DISTINCT %s)", strings.Join(exprs, ", "))
+	}
 	return fmt.Sprintf("COUNT(DISTINCT %s)", c.Child)
 }
 
@@ -173,7 +193,15 @@ func (c *CountDistinct) Update(ctx *sql.Context, buffer, row sql.Row) error {
 			return err
 		}
 
-		value = v
+		if vals, ok := v.([]interface{}); ok {
+			for _, val := range vals {
+				if val == nil {
+					return nil
+				}
+			}
+		}
+
+		value = sql.CollationKey(c.Child.Type(), v)
 	}
 
 	hash, err := hashstructure.Hash(value, nil)
@@ -195,6 +223,30 @@ func (c *CountDistinct) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
 	return nil
 }
 
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (c *CountDistinct) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	seen := buffer[0].(map[uint64]struct{})
+	hashes := make([]interface{}, 0, len(seen))
+	for k := range seen {
+		hashes = append(hashes, k)
+	}
+	return encodeBuffer(buf, sql.NewRow(hashes))
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (c *CountDistinct) DecodeBuffer(data []byte) (sql.Row, error) {
+	buffer, err := decodeBuffer(data)
+	if err != nil {
+		return nil, err
+	}
+	hashes := buffer[0].([]interface{})
+	seen := make(map[uint64]struct{}, len(hashes))
+	for _, k := range hashes {
+		seen[k.(uint64)] = struct{}{}
+	}
+	return sql.NewRow(seen), nil
+}
+
 // Eval implements the Aggregation interface.
 func (c *CountDistinct) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	seen := buffer[0].(map[uint64]struct{})
@@ -138,3 +138,31 @@ func TestCountDistinctEvalString(t *testing.T) {
 	require.NoError(c.Update(ctx, b, sql.NewRow("bar")))
 	require.Equal(int64(2), eval(t, c, b))
 }
+
+func TestCountDistinctEvalTuple(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	c := NewCountDistinct(expression.NewTuple(
+		expression.NewGetField(0, sql.Int64, "a", true),
+		expression.NewGetField(1, sql.Text, "b", true),
+	))
+	require.Equal("COUNT(DISTINCT a, b)", c.String())
+
+	b := c.NewBuffer()
+	require.Equal(int64(0), eval(t, c, b))
+
+	require.NoError(c.Update(ctx, b, sql.NewRow(int64(1), "foo")))
+	require.NoError(c.Update(ctx, b, sql.NewRow(int64(1), "bar")))
+	require.NoError(c.Update(ctx, b, sql.NewRow(int64(2), "foo")))
+	require.NoError(c.Update(ctx, b, sql.NewRow(int64(1), "foo")))
+	require.NoError(c.Update(ctx, b, sql.NewRow(int64(1), nil)))
+	require.NoError(c.Update(ctx, b, sql.NewRow(nil, "foo")))
+	require.Equal(int64(3), eval(t, c, b))
+
+	b2 := c.NewBuffer()
+	require.NoError(c.Update(ctx, b2, sql.NewRow(int64(2), "foo")))
+	require.NoError(c.Update(ctx, b2, sql.NewRow(int64(3), "foo")))
+	require.NoError(c.Merge(ctx, b, b2))
+	require.Equal(int64(4), eval(t, c, b))
+}
@@ -28,6 +28,7 @@ type First struct {
 }
 
 var _ sql.FunctionExpression = (*First)(nil)
+var _ sql.SpillableAggregation = (*First)(nil)
 
 // NewFirst returns a new First node.
 func NewFirst(ctx *sql.Context, e sql.Expression) *First {
@@ -83,9 +84,22 @@ func (f *First) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (f *First) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	if buffer[0] == nil {
+		buffer[0] = partial[0]
+	}
 	return nil
 }
 
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (f *First) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer, f.Child.Type())
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (f *First) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data, f.Child.Type())
+}
+
 // Eval implements the Aggregation interface.
 func (f *First) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	return buffer[0], nil
@@ -35,7 +35,7 @@ type GroupConcat struct {
 }
 
 var _ sql.FunctionExpression = &GroupConcat{}
-var _ sql.Aggregation = &GroupConcat{}
+var _ sql.SpillableAggregation = &GroupConcat{}
 
 func NewEmptyGroupConcat(ctx *sql.Context) sql.Expression {
 	return &GroupConcat{}
@@ -110,7 +110,57 @@ func (g *GroupConcat) Update(ctx *sql.Context, buffer, originalRow sql.Row) erro
 
 // Merge implements the Aggregation interface.
 func (g *GroupConcat) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	return g.Update(ctx, buffer, partial)
+	rows := buffer[0].([]sql.Row)
+	distinctSet := buffer[1].(map[string]bool)
+
+	for _, row := range partial[0].([]sql.Row) {
+		if g.distinct != "" {
+			vs := row[len(row)-1].(string)
+			if distinctSet[vs] {
+				continue
+			}
+			distinctSet[vs] = true
+		}
+		rows = append(rows, row)
+	}
+
+	buffer[0] = rows
+	buffer[1] = distinctSet
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (g *GroupConcat) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	rows := buffer[0].([]sql.Row)
+	encodedRows := make([]interface{}, len(rows))
+	for i, row := range rows {
+		encodedRows[i] = []interface{}(row)
+	}
+	distinctSet := buffer[1].(map[string]bool)
+	values := make([]interface{}, 0, len(distinctSet))
+	for v := range distinctSet {
+		values = append(values, v)
+	}
+	return encodeBuffer(buf, sql.NewRow(encodedRows, values))
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (g *GroupConcat) DecodeBuffer(data []byte) (sql.Row, error) {
+	buffer, err := decodeBuffer(data)
+	if err != nil {
+		return nil, err
+	}
+	encodedRows := buffer[0].([]interface{})
+	rows := make([]sql.Row, len(encodedRows))
+	for i, row := range encodedRows {
+		rows[i] = row.([]interface{})
+	}
+	values := buffer[1].([]interface{})
+	distinctSet := make(map[string]bool, len(values))
+	for _, v := range values {
+		distinctSet[v.(string)] = true
+	}
+	return sql.NewRow(rows, distinctSet), nil
 }
 
 // cc: https://dev.mysql.com/doc/refman/8.0/en/aggregate-functions.html#function_group-concat
@@ -40,6 +40,7 @@ type JSONArrayAgg struct {
 }
 
 var _ sql.FunctionExpression = &JSONArrayAgg{}
+var _ sql.SpillableAggregation = &JSONArrayAgg{}
 
 // NewJSONArrayAgg creates a new JSONArrayAgg function.
 func NewJSONArrayAgg(ctx *sql.Context, arg sql.Expression) *JSONArrayAgg {
@@ -119,6 +120,16 @@ func (j *JSONArrayAgg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
 	return nil
 }
 
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (j *JSONArrayAgg) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (j *JSONArrayAgg) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
+}
+
 // Eval implements the Aggregation interface.
 func (j *JSONArrayAgg) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	return sql.JSONDocument{Val: buffer[0]}, nil
@@ -139,6 +150,7 @@ type JSONObjectAgg struct {
 }
 
 var _ sql.FunctionExpression = JSONObjectAgg{}
+var _ sql.SpillableAggregation = JSONObjectAgg{}
 
 // NewJSONObjectAgg creates a new JSONArrayAgg function.
 func NewJSONObjectAgg(ctx *sql.Context, key, value sql.Expression) sql.Expression {
@@ -224,7 +236,21 @@ func (j JSONObjectAgg) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (j JSONObjectAgg) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	return j.Update(ctx, buffer, partial)
+	mp := buffer[0].(map[string]interface{})
+	for k, v := range partial[0].(map[string]interface{}) {
+		mp[k] = v
+	}
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (j JSONObjectAgg) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (j JSONObjectAgg) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
 }
 
 // Eval implements the Aggregation interface.
@@ -28,6 +28,7 @@ type Last struct {
 }
 
 var _ sql.FunctionExpression = (*Last)(nil)
+var _ sql.SpillableAggregation = (*Last)(nil)
 
 // NewLast returns a new Last node.
 func NewLast(ctx *sql.Context, e sql.Expression) *Last {
@@ -79,10 +80,22 @@ func (l *Last) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (l *Last) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	buffer[0] = partial[0]
+	if partial[0] != nil {
+		buffer[0] = partial[0]
+	}
 	return nil
 }
 
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (l *Last) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer, l.Child.Type())
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (l *Last) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data, l.Child.Type())
+}
+
 // Eval implements the Aggregation interface.
 func (l *Last) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	return buffer[0], nil
@@ -29,6 +29,7 @@ type Max struct {
 }
 
 var _ sql.FunctionExpression = (*Max)(nil)
+var _ sql.SpillableAggregation = (*Max)(nil)
 
 // NewMax returns a new Max node.
 func NewMax(ctx *sql.Context, e sql.Expression) *Max {
@@ -99,7 +100,32 @@ func (m *Max) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (m *Max) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	return m.Update(ctx, buffer, partial)
+	if partial[0] == nil {
+		return nil
+	}
+	if buffer[0] == nil {
+		buffer[0] = partial[0]
+		return nil
+	}
+
+	cmp, err := m.Child.Type().Compare(partial[0], buffer[0])
+	if err != nil {
+		return err
+	}
+	if cmp == 1 {
+		buffer[0] = partial[0]
+	}
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Max) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer, m.Child.Type())
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Max) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data, m.Child.Type())
 }
 
 // Eval implements the Aggregation interface.
@@ -29,6 +29,7 @@ type Min struct {
 }
 
 var _ sql.FunctionExpression = (*Min)(nil)
+var _ sql.SpillableAggregation = (*Min)(nil)
 
 // NewMin creates a new Min node.
 func NewMin(ctx *sql.Context, e sql.Expression) *Min {
@@ -95,7 +96,32 @@ func (m *Min) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (m *Min) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	return m.Update(ctx, buffer, partial)
+	if partial[0] == nil {
+		return nil
+	}
+	if buffer[0] == nil {
+		buffer[0] = partial[0]
+		return nil
+	}
+
+	cmp, err := m.Child.Type().Compare(partial[0], buffer[0])
+	if err != nil {
+		return err
+	}
+	if cmp == -1 {
+		buffer[0] = partial[0]
+	}
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Min) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer, m.Child.Type())
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Min) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data, m.Child.Type())
 }
 
 // Eval implements the Aggregation interface
@@ -17,6 +17,8 @@ package aggregation
 import (
 	"fmt"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -28,6 +30,7 @@ type Sum struct {
 }
 
 var _ sql.FunctionExpression = (*Sum)(nil)
+var _ sql.SpillableAggregation = (*Sum)(nil)
 
 // NewSum returns a new Sum node.
 func NewSum(ctx *sql.Context, e sql.Expression) *Sum {
@@ -39,11 +42,33 @@ func (m *Sum) FunctionName() string {
 	return "sum"
 }
 
-// Type returns the resultant type of the aggregation.
+// Type returns the resultant type of the aggregation. The sum of decimals or integers is an exact decimal with 22 more
+// integer digits, as in MySQL, and the sum of anything else is a double.
 func (m *Sum) Type() sql.Type {
+	if dt, ok := exactDecimalType(m.Child.Type()); ok {
+		precision := dt.Precision() + 22
+		if precision > sql.DecimalTypeMaxPrecision {
+			precision = sql.DecimalTypeMaxPrecision
+		}
+		return sql.MustCreateDecimalType(precision, dt.Scale())
+	}
 	return sql.Float64
 }
 
+// exactDecimalType returns the decimal type that holds every value of the type given, if it is a decimal or an integer
+// type, whose values SUM and AVG add up exactly.
+func exactDecimalType(t sql.Type) (sql.DecimalType, bool) {
+	if dt, ok := t.(sql.DecimalType); ok {
+		return dt, true
+	}
+	if !sql.IsInteger(t) {
+		return nil, false
+	}
+
+	precision, _ := expression.DecimalPrecisionAndScale(t)
+	return sql.MustCreateDecimalType(uint8(precision), 0), true
+}
+
 func (m *Sum) String() string {
 	return fmt.Sprintf("SUM(%s)", m.Child)
 }
@@ -72,6 +97,18 @@ func (m *Sum) Update(ctx *sql.Context, buffer, row sql.Row) error {
 		return nil
 	}
 
+	if dt, ok := exactDecimalType(m.Child.Type()); ok {
+		dec, err := dt.ConvertToDecimal(v)
+		if err != nil {
+			return err
+		}
+		if buffer[0] == nil {
+			buffer[0] = decimal.Zero
+		}
+		buffer[0] = buffer[0].(decimal.Decimal).Add(dec.Decimal)
+		return nil
+	}
+
 	val, err := sql.Float64.Convert(v)
 	if err != nil {
 		val = float64(0)
@@ -88,12 +125,38 @@ func (m *Sum) Update(ctx *sql.Context, buffer, row sql.Row) error {
 
 // Merge implements the Aggregation interface.
 func (m *Sum) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
-	return m.Update(ctx, buffer, partial)
+	if partial[0] == nil {
+		return nil
+	}
+	if buffer[0] == nil {
+		buffer[0] = partial[0]
+		return nil
+	}
+
+	if sum, ok := buffer[0].(decimal.Decimal); ok {
+		buffer[0] = sum.Add(partial[0].(decimal.Decimal))
+		return nil
+	}
+	buffer[0] = buffer[0].(float64) + partial[0].(float64)
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Sum) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (m *Sum) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
 }
 
 // Eval implements the Aggregation interface.
 func (m *Sum) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
 	sum := buffer[0]
+	if dec, ok := sum.(decimal.Decimal); ok {
+		return m.Type().Convert(dec)
+	}
 
 	return sum, nil
 }
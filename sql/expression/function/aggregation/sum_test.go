@@ -89,6 +89,42 @@ func TestSum(t *testing.T) {
 	}
 }
 
+func TestSumDecimal(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	sum := NewSum(ctx, expression.NewGetField(0, sql.MustCreateDecimalType(10, 2), "col1", true))
+	require.Equal(sql.MustCreateDecimalType(32, 2), sum.Type())
+
+	buf := sum.NewBuffer()
+	result, err := sum.Eval(ctx, buf)
+	require.NoError(err)
+	require.Nil(result)
+
+	for _, row := range []sql.Row{{"0.10"}, {"0.20"}, {nil}, {"-0.05"}} {
+		require.NoError(sum.Update(ctx, buf, row))
+	}
+	result, err = sum.Eval(ctx, buf)
+	require.NoError(err)
+	require.Equal("0.25", result)
+}
+
+func TestSumInteger(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	sum := NewSum(ctx, expression.NewGetField(0, sql.Int32, "col1", true))
+	require.Equal(sql.MustCreateDecimalType(32, 0), sum.Type())
+
+	buf := sum.NewBuffer()
+	for _, row := range []sql.Row{{int32(10)}, {nil}, {int32(20)}} {
+		require.NoError(sum.Update(ctx, buf, row))
+	}
+	result, err := sum.Eval(ctx, buf)
+	require.NoError(err)
+	require.Equal("30", result)
+}
+
 func TestSumWithDistinct(t *testing.T) {
 	require := require.New(t)
 
@@ -0,0 +1,278 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// varianceAggregation computes the variance of the values of its child with Welford's algorithm, which keeps the
+// count, the mean and the sum of the squared differences to the mean of the values seen so far, and so does not lose
+// precision as a sum of squares would. Its buffer is made of these three values.
+type varianceAggregation struct {
+	expression.UnaryExpression
+}
+
+// Type implements the Expression interface.
+func (v *varianceAggregation) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (v *varianceAggregation) IsNullable() bool {
+	return true
+}
+
+// NewBuffer implements the Aggregation interface.
+func (v *varianceAggregation) NewBuffer() sql.Row {
+	const (
+		count = int64(0)
+		mean  = float64(0)
+		m2    = float64(0)
+	)
+
+	return sql.NewRow(count, mean, m2)
+}
+
+// Update implements the Aggregation interface.
+func (v *varianceAggregation) Update(ctx *sql.Context, buffer, row sql.Row) error {
+	val, err := v.Child.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		return nil
+	}
+
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		val = float64(0)
+	}
+	x := val.(float64)
+
+	count := buffer[0].(int64) + 1
+	mean := buffer[1].(float64)
+	delta := x - mean
+	mean += delta / float64(count)
+
+	buffer[0] = count
+	buffer[1] = mean
+	buffer[2] = buffer[2].(float64) + delta*(x-mean)
+
+	return nil
+}
+
+// Merge implements the Aggregation interface.
+func (v *varianceAggregation) Merge(ctx *sql.Context, buffer, partial sql.Row) error {
+	bcount, bmean, bm2 := buffer[0].(int64), buffer[1].(float64), buffer[2].(float64)
+	pcount, pmean, pm2 := partial[0].(int64), partial[1].(float64), partial[2].(float64)
+
+	if pcount == 0 {
+		return nil
+	}
+
+	count := bcount + pcount
+	delta := pmean - bmean
+
+	buffer[0] = count
+	buffer[1] = bmean + delta*float64(pcount)/float64(count)
+	buffer[2] = bm2 + pm2 + delta*delta*float64(bcount)*float64(pcount)/float64(count)
+
+	return nil
+}
+
+// EncodeBuffer implements the sql.SpillableAggregation interface.
+func (v *varianceAggregation) EncodeBuffer(buf []byte, buffer sql.Row) ([]byte, error) {
+	return encodeBuffer(buf, buffer)
+}
+
+// DecodeBuffer implements the sql.SpillableAggregation interface.
+func (v *varianceAggregation) DecodeBuffer(data []byte) (sql.Row, error) {
+	return decodeBuffer(data)
+}
+
+// variance returns the variance of the values of the buffer given, which is the population variance or the sample
+// variance. It is NULL if there are no values, or a single one for the sample variance.
+func (v *varianceAggregation) variance(buffer sql.Row, sample bool) interface{} {
+	count := buffer[0].(int64)
+	m2 := buffer[2].(float64)
+
+	if sample {
+		if count < 2 {
+			return nil
+		}
+		return m2 / float64(count-1)
+	}
+
+	if count == 0 {
+		return nil
+	}
+	return m2 / float64(count)
+}
+
+// standardDeviation returns the square root of the variance of the values of the buffer given.
+func (v *varianceAggregation) standardDeviation(buffer sql.Row, sample bool) interface{} {
+	variance := v.variance(buffer, sample)
+	if variance == nil {
+		return nil
+	}
+	return math.Sqrt(variance.(float64))
+}
+
+// VarPop aggregation returns the population variance of the values of its child. VARIANCE is a synonym for it.
+type VarPop struct {
+	varianceAggregation
+}
+
+var _ sql.FunctionExpression = (*VarPop)(nil)
+var _ sql.SpillableAggregation = (*VarPop)(nil)
+
+// NewVarPop creates a new VarPop node.
+func NewVarPop(ctx *sql.Context, e sql.Expression) *VarPop {
+	return &VarPop{varianceAggregation{expression.UnaryExpression{Child: e}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (v *VarPop) FunctionName() string {
+	return "var_pop"
+}
+
+func (v *VarPop) String() string {
+	return fmt.Sprintf("VAR_POP(%s)", v.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (v *VarPop) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVarPop(ctx, children[0]), nil
+}
+
+// Eval implements the Aggregation interface.
+func (v *VarPop) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return v.variance(buffer, false), nil
+}
+
+// VarSamp aggregation returns the sample variance of the values of its child.
+type VarSamp struct {
+	varianceAggregation
+}
+
+var _ sql.FunctionExpression = (*VarSamp)(nil)
+var _ sql.SpillableAggregation = (*VarSamp)(nil)
+
+// NewVarSamp creates a new VarSamp node.
+func NewVarSamp(ctx *sql.Context, e sql.Expression) *VarSamp {
+	return &VarSamp{varianceAggregation{expression.UnaryExpression{Child: e}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (v *VarSamp) FunctionName() string {
+	return "var_samp"
+}
+
+func (v *VarSamp) String() string {
+	return fmt.Sprintf("VAR_SAMP(%s)", v.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (v *VarSamp) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVarSamp(ctx, children[0]), nil
+}
+
+// Eval implements the Aggregation interface.
+func (v *VarSamp) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return v.variance(buffer, true), nil
+}
+
+// StdDevPop aggregation returns the population standard deviation of the values of its child. STD and STDDEV are
+// synonyms for it.
+type StdDevPop struct {
+	varianceAggregation
+}
+
+var _ sql.FunctionExpression = (*StdDevPop)(nil)
+var _ sql.SpillableAggregation = (*StdDevPop)(nil)
+
+// NewStdDevPop creates a new StdDevPop node.
+func NewStdDevPop(ctx *sql.Context, e sql.Expression) *StdDevPop {
+	return &StdDevPop{varianceAggregation{expression.UnaryExpression{Child: e}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *StdDevPop) FunctionName() string {
+	return "stddev_pop"
+}
+
+func (s *StdDevPop) String() string {
+	return fmt.Sprintf("STDDEV_POP(%s)", s.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (s *StdDevPop) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewStdDevPop(ctx, children[0]), nil
+}
+
+// Eval implements the Aggregation interface.
+func (s *StdDevPop) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return s.standardDeviation(buffer, false), nil
+}
+
+// StdDevSamp aggregation returns the sample standard deviation of the values of its child.
+type StdDevSamp struct {
+	varianceAggregation
+}
+
+var _ sql.FunctionExpression = (*StdDevSamp)(nil)
+var _ sql.SpillableAggregation = (*StdDevSamp)(nil)
+
+// NewStdDevSamp creates a new StdDevSamp node.
+func NewStdDevSamp(ctx *sql.Context, e sql.Expression) *StdDevSamp {
+	return &StdDevSamp{varianceAggregation{expression.UnaryExpression{Child: e}}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *StdDevSamp) FunctionName() string {
+	return "stddev_samp"
+}
+
+func (s *StdDevSamp) String() string {
+	return fmt.Sprintf("STDDEV_SAMP(%s)", s.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (s *StdDevSamp) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewStdDevSamp(ctx, children[0]), nil
+}
+
+// Eval implements the Aggregation interface.
+func (s *StdDevSamp) Eval(ctx *sql.Context, buffer sql.Row) (interface{}, error) {
+	return s.standardDeviation(buffer, true), nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestVariance(t *testing.T) {
+	col := expression.NewGetField(0, sql.Float64, "col1", true)
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		name       string
+		rows       []sql.Row
+		varPop     interface{}
+		varSamp    interface{}
+		stddevPop  interface{}
+		stddevSamp interface{}
+	}{
+		{
+			"no rows",
+			nil,
+			nil, nil, nil, nil,
+		},
+		{
+			"only nulls",
+			[]sql.Row{{nil}, {nil}},
+			nil, nil, nil, nil,
+		},
+		{
+			"one value",
+			[]sql.Row{{5}, {nil}},
+			float64(0), nil, float64(0), nil,
+		},
+		{
+			"values",
+			[]sql.Row{{2}, {4}, {4}, {4}, {nil}, {5}, {5}, {7}, {9}},
+			float64(4), float64(32) / 7, float64(2), math.Sqrt(float64(32) / 7),
+		},
+		{
+			"strings",
+			[]sql.Row{{"1"}, {"3"}},
+			float64(1), float64(2), float64(1), math.Sqrt(2),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.varPop, aggregate(t, NewVarPop(ctx, col), tt.rows...))
+			require.Equal(tt.varSamp, aggregate(t, NewVarSamp(ctx, col), tt.rows...))
+			require.Equal(tt.stddevPop, aggregate(t, NewStdDevPop(ctx, col), tt.rows...))
+			require.Equal(tt.stddevSamp, aggregate(t, NewStdDevSamp(ctx, col), tt.rows...))
+		})
+	}
+}
+
+func TestVarianceIsStable(t *testing.T) {
+	require := require.New(t)
+
+	// A sum of squares loses every significant digit of the variance of these values
+	var rows []sql.Row
+	for _, v := range []float64{4, 7, 13, 16} {
+		rows = append(rows, sql.NewRow(1e9+v))
+	}
+	v := aggregate(t, NewVarSamp(sql.NewEmptyContext(), expression.NewGetField(0, sql.Float64, "col1", true)), rows...)
+	require.InDelta(float64(30), v, 1e-6)
+}
+
+func TestVarianceMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	v := NewVarSamp(ctx, expression.NewGetField(0, sql.Float64, "col1", true))
+	require.Equal("VAR_SAMP(col1)", v.String())
+
+	buffer1 := v.NewBuffer()
+	require.NoError(v.Update(ctx, buffer1, sql.NewRow(2)))
+	require.NoError(v.Update(ctx, buffer1, sql.NewRow(4)))
+	require.NoError(v.Update(ctx, buffer1, sql.NewRow(4)))
+
+	buffer2 := v.NewBuffer()
+	require.NoError(v.Merge(ctx, buffer1, buffer2))
+	require.Equal(float64(4)/3, eval(t, v, buffer1))
+
+	for _, n := range []int{4, 5, 5, 7, 9} {
+		require.NoError(v.Update(ctx, buffer2, sql.NewRow(n)))
+	}
+	require.NoError(v.Merge(ctx, buffer1, buffer2))
+	require.InDelta(float64(32)/7, eval(t, v, buffer1), 1e-12)
+
+	empty := v.NewBuffer()
+	require.NoError(v.Merge(ctx, empty, buffer1))
+	require.InDelta(float64(32)/7, eval(t, v, empty), 1e-12)
+}
@@ -19,6 +19,8 @@ import (
 	"math"
 	"reflect"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -43,6 +45,9 @@ func (c *Ceil) FunctionName() string {
 // Type implements the Expression interface.
 func (c *Ceil) Type() sql.Type {
 	childType := c.Child.Type()
+	if dt, ok := childType.(sql.DecimalType); ok {
+		return integerDecimalType(dt)
+	}
 	if sql.IsNumber(childType) {
 		return childType
 	}
@@ -82,6 +87,14 @@ func (c *Ceil) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Ceil(child.(float64))), nil
 	}
 
+	if sql.IsDecimal(c.Child.Type()) {
+		dec, err := c.Child.Type().(sql.DecimalType).ConvertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return c.Type().Convert(dec.Decimal.Ceil())
+	}
+
 	if !sql.IsFloat(c.Child.Type()) {
 		return child, err
 	}
@@ -96,6 +109,16 @@ func (c *Ceil) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// integerDecimalType returns the type of a decimal of the type given rounded to an integer, which may need one more
+// integer digit.
+func integerDecimalType(dt sql.DecimalType) sql.Type {
+	precision := dt.Precision() - dt.Scale() + 1
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	return sql.MustCreateDecimalType(precision, 0)
+}
+
 // Floor returns the biggest integer value not less than X.
 type Floor struct {
 	expression.UnaryExpression
@@ -116,6 +139,9 @@ func (f *Floor) FunctionName() string {
 // Type implements the Expression interface.
 func (f *Floor) Type() sql.Type {
 	childType := f.Child.Type()
+	if dt, ok := childType.(sql.DecimalType); ok {
+		return integerDecimalType(dt)
+	}
 	if sql.IsNumber(childType) {
 		return childType
 	}
@@ -155,6 +181,14 @@ func (f *Floor) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return int32(math.Floor(child.(float64))), nil
 	}
 
+	if sql.IsDecimal(f.Child.Type()) {
+		dec, err := f.Child.Type().(sql.DecimalType).ConvertToDecimal(child)
+		if err != nil {
+			return nil, err
+		}
+		return f.Type().Convert(dec.Decimal.Floor())
+	}
+
 	if !sql.IsFloat(f.Child.Type()) {
 		return child, err
 	}
@@ -261,6 +295,14 @@ func (r *Round) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
+	if dt, ok := r.Left.Type().(sql.DecimalType); ok {
+		dec, err := dt.ConvertToDecimal(xVal)
+		if err != nil {
+			return nil, err
+		}
+		return r.Type().Convert(roundDecimal(dec.Decimal, int64(dVal)))
+	}
+
 	if !sql.IsNumber(r.Left.Type()) {
 		xVal, err = sql.Float64.Convert(xVal)
 		if err != nil {
@@ -299,6 +341,20 @@ func (r *Round) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 }
 
+// roundDecimal returns a decimal rounded half away from zero to the number of decimal places given, which may be
+// negative.
+func roundDecimal(dec decimal.Decimal, places int64) decimal.Decimal {
+	if places > maxTruncateDigits {
+		places = maxTruncateDigits
+	} else if places < -maxTruncateDigits {
+		places = -maxTruncateDigits
+	}
+	if places >= 0 {
+		return dec.Round(int32(places))
+	}
+	return dec.Shift(int32(places)).Round(0).Shift(int32(-places))
+}
+
 // IsNullable implements the Expression interface.
 func (r *Round) IsNullable() bool {
 	return r.Left.IsNullable()
@@ -320,12 +376,43 @@ func (r *Round) Resolved() bool {
 // Type implements the Expression interface.
 func (r *Round) Type() sql.Type {
 	leftChildType := r.Left.Type()
+	if dt, ok := leftChildType.(sql.DecimalType); ok {
+		return r.decimalType(dt)
+	}
 	if sql.IsNumber(leftChildType) {
 		return leftChildType
 	}
 	return sql.Int32
 }
 
+// decimalType returns the type of a decimal of the type given rounded by this expression. When the number of decimal
+// places is a constant, the scale is reduced to it, and one more integer digit is kept for the carry.
+func (r *Round) decimalType(dt sql.DecimalType) sql.Type {
+	scale := uint8(0)
+	if r.Right != nil {
+		lit, ok := r.Right.(*expression.Literal)
+		if !ok {
+			return dt
+		}
+		d, err := sql.Int64.Convert(lit.Value())
+		if err != nil || d == nil {
+			return dt
+		}
+		scale = dt.Scale()
+		if d.(int64) < int64(scale) {
+			scale = 0
+			if d.(int64) > 0 {
+				scale = uint8(d.(int64))
+			}
+		}
+	}
+	precision := dt.Precision() - dt.Scale() + scale + 1
+	if precision > sql.DecimalTypeMaxPrecision {
+		precision = sql.DecimalTypeMaxPrecision
+	}
+	return sql.MustCreateDecimalType(precision, scale)
+}
+
 // WithChildren implements the Expression interface.
 func (r *Round) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	return NewRound(ctx, children...)
@@ -79,6 +79,42 @@ func TestCeil(t *testing.T) {
 	}
 }
 
+func TestCeilFloorRoundDecimal(t *testing.T) {
+	decimalType := sql.MustCreateDecimalType(5, 2)
+	testCases := []struct {
+		name         string
+		f            sql.Expression
+		expected     interface{}
+		expectedType sql.Type
+	}{
+		{"ceil", NewCeil(sql.NewEmptyContext(), expression.NewLiteral("-2.25", decimalType)), "-2", sql.MustCreateDecimalType(4, 0)},
+		{"floor", NewFloor(sql.NewEmptyContext(), expression.NewLiteral("-2.25", decimalType)), "-3", sql.MustCreateDecimalType(4, 0)},
+		{"round", mustRound(expression.NewLiteral("999.50", decimalType)), "1000", sql.MustCreateDecimalType(4, 0)},
+		{"round with d", mustRound(expression.NewLiteral("-2.25", decimalType), expression.NewLiteral(int8(1), sql.Int8)), "-2.3", sql.MustCreateDecimalType(5, 1)},
+		{"round with negative d", mustRound(expression.NewLiteral("155.00", decimalType), expression.NewLiteral(int8(-1), sql.Int8)), "160", sql.MustCreateDecimalType(4, 0)},
+		{"round with larger d", mustRound(expression.NewLiteral("1.25", decimalType), expression.NewLiteral(int8(5), sql.Int8)), "1.25", sql.MustCreateDecimalType(6, 2)},
+		{"round is nil", mustRound(expression.NewLiteral(nil, decimalType)), nil, sql.MustCreateDecimalType(4, 0)},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			require.Equal(tt.expectedType, tt.f.Type())
+			result, err := tt.f.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(tt.expected, result)
+		})
+	}
+}
+
+func mustRound(args ...sql.Expression) sql.Expression {
+	r, err := NewRound(sql.NewEmptyContext(), args...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
 func TestFloor(t *testing.T) {
 	testCases := []struct {
 		name     string
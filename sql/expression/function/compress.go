@@ -0,0 +1,175 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+
+	"github.com/dolthub/go-mysql-server/internal/deflate"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// compressedLengthMask is the mask of the bits of the header of a compressed string that hold the length of the
+// uncompressed string.
+const compressedLengthMask = 0x3FFFFFFF
+
+// Compress compresses a string with zlib, in the format of MySQL: the length of the string as a 4-byte little-endian
+// integer, followed by the zlib stream, and by a period if the stream ends with a space, so that it is not trimmed.
+// The empty string stays empty.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_compress
+type Compress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Compress)(nil)
+
+// NewCompress creates a new COMPRESS function.
+func NewCompress(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Compress{NewUnaryFunc(arg, "COMPRESS", sql.LongBlob)}
+}
+
+// Eval implements the Expression interface.
+func (c *Compress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, c.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if s == "" {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	var header [4]byte
+	binary.LittleEndian.PutUint32(header[:], uint32(len(s))&compressedLengthMask)
+	buf.Write(header[:])
+
+	buf.Write(deflate.Compress([]byte(s)))
+	if buf.Bytes()[buf.Len()-1] == ' ' {
+		buf.WriteByte('.')
+	}
+	return buf.String(), nil
+}
+
+// WithChildren implements the Expression interface.
+func (c *Compress) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCompress(ctx, children[0]), nil
+}
+
+// Uncompress uncompresses a string compressed by COMPRESS. As in MySQL, the result is NULL with a warning if the string
+// was not compressed by COMPRESS, or if it is larger than max_allowed_packet once uncompressed.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompress
+type Uncompress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Uncompress)(nil)
+
+// NewUncompress creates a new UNCOMPRESS function.
+func NewUncompress(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Uncompress{NewUnaryFunc(arg, "UNCOMPRESS", sql.LongBlob)}
+}
+
+// Eval implements the Expression interface.
+func (u *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, u.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if s == "" {
+		return "", nil
+	}
+	if len(s) <= 4 {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+
+	length := int64(binary.LittleEndian.Uint32([]byte(s[:4])) & compressedLengthMask)
+	maxLength, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return nil, err
+	}
+	if length > maxLength.(int64) {
+		ctx.Warn(1256, "Uncompressed data size too large; the maximum size is %d (probably, length of uncompressed data was corrupted)", maxLength)
+		return nil, nil
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader([]byte(s[4:])))
+	if err != nil {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+	uncompressed, err := ioutil.ReadAll(io.LimitReader(r, length+1))
+	if err != nil {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+	if int64(len(uncompressed)) > length {
+		ctx.Warn(1258, "ZLIB: Not enough room in the output buffer (probably, length of uncompressed data was corrupted)")
+		return nil, nil
+	}
+	return string(uncompressed), nil
+}
+
+// WithChildren implements the Expression interface.
+func (u *Uncompress) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
+	}
+	return NewUncompress(ctx, children[0]), nil
+}
+
+// UncompressedLength returns the length of a string compressed by COMPRESS once uncompressed, as given by its header.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompressed-length
+type UncompressedLength struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*UncompressedLength)(nil)
+
+// NewUncompressedLength creates a new UNCOMPRESSED_LENGTH function.
+func NewUncompressedLength(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &UncompressedLength{NewUnaryFunc(arg, "UNCOMPRESSED_LENGTH", sql.Uint32)}
+}
+
+// Eval implements the Expression interface.
+func (u *UncompressedLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, u.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if s == "" {
+		return uint32(0), nil
+	}
+	if len(s) <= 4 {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return uint32(0), nil
+	}
+	return binary.LittleEndian.Uint32([]byte(s[:4])) & compressedLengthMask, nil
+}
+
+// WithChildren implements the Expression interface.
+func (u *UncompressedLength) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 1)
+	}
+	return NewUncompressedLength(ctx, children[0]), nil
+}
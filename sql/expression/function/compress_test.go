@@ -0,0 +1,84 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestCompress(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	for _, s := range []string{"a", "any string", strings.Repeat("a", 1000), "ends with spaces   "} {
+		t.Run(s, func(t *testing.T) {
+			require := require.New(t)
+
+			compressed, err := NewCompress(ctx, expression.NewLiteral(s, sql.LongText)).Eval(ctx, nil)
+			require.NoError(err)
+			c := compressed.(string)
+			require.Equal(string([]byte{byte(len(s)), byte(len(s) >> 8), 0, 0}), c[:4])
+			require.NotEqual(byte(' '), c[len(c)-1])
+
+			length, err := NewUncompressedLength(ctx, expression.NewLiteral(c, sql.LongBlob)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(uint32(len(s)), length)
+
+			uncompressed, err := NewUncompress(ctx, expression.NewLiteral(c, sql.LongBlob)).Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(s, uncompressed)
+		})
+	}
+
+	// The same bytes as MySQL gives
+	compressed, err := NewCompress(ctx, expression.NewLiteral("any string", sql.LongText)).Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, "0A000000789C4BCCAB54282E29CACC4B070015960400", strings.ToUpper(hex.EncodeToString([]byte(compressed.(string)))))
+}
+
+func TestUncompress(t *testing.T) {
+	f := sql.Function1{Name: "uncompress", Fn: NewUncompress}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("", "")
+	tf.AddSucceeding(nil, "abc")
+	tf.AddSucceeding(nil, "not compressed")
+
+	// Compressed with the C zlib library, as MySQL does
+	mysqlCompressed, err := hex.DecodeString("0A000000789C4BCCAB54282E29CACC4B070015960400")
+	require.NoError(t, err)
+	tf.AddSucceeding("any string", string(mysqlCompressed))
+
+	// A header with a length shorter than the uncompressed string
+	wrongLength := append([]byte{4, 0, 0, 0}, mysqlCompressed[4:]...)
+	tf.AddSucceeding(nil, string(wrongLength))
+	tf.Test(t, sql.NewEmptyContext(), nil)
+}
+
+func TestUncompressedLength(t *testing.T) {
+	f := sql.Function1{Name: "uncompressed_length", Fn: NewUncompressedLength}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(uint32(0), "")
+	tf.AddSucceeding(uint32(0), "abc")
+	tf.AddSucceeding(uint32(30), "\x1e\x00\x00\x00x")
+	tf.Test(t, sql.NewEmptyContext(), nil)
+}
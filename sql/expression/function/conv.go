@@ -0,0 +1,176 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Conv converts a number, given as a string of digits, from one base to another. Bases go from 2 to 36, and a
+// negative base means that the number is signed, while it is unsigned otherwise.
+type Conv struct {
+	num      sql.Expression
+	fromBase sql.Expression
+	toBase   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Conv)(nil)
+
+// NewConv creates a new CONV function.
+func NewConv(ctx *sql.Context, num, fromBase, toBase sql.Expression) sql.Expression {
+	return &Conv{num, fromBase, toBase}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *Conv) FunctionName() string {
+	return "conv"
+}
+
+// Type implements the Expression interface.
+func (c *Conv) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (c *Conv) IsNullable() bool { return true }
+
+func (c *Conv) String() string {
+	return fmt.Sprintf("CONV(%s, %s, %s)", c.num, c.fromBase, c.toBase)
+}
+
+// WithChildren implements the Expression interface.
+func (c *Conv) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 3)
+	}
+	return NewConv(ctx, children[0], children[1], children[2]), nil
+}
+
+// Resolved implements the Expression interface.
+func (c *Conv) Resolved() bool {
+	return c.num.Resolved() && c.fromBase.Resolved() && c.toBase.Resolved()
+}
+
+// Children implements the Expression interface.
+func (c *Conv) Children() []sql.Expression {
+	return []sql.Expression{c.num, c.fromBase, c.toBase}
+}
+
+// Eval implements the Expression interface. The result is NULL if a base is not valid.
+func (c *Conv) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	num, ok, err := evalLongText(ctx, c.num, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	fromBase, ok, err := evalInt64(ctx, c.fromBase, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	toBase, ok, err := evalInt64(ctx, c.toBase, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if !validConvBase(fromBase) || !validConvBase(toBase) {
+		return nil, nil
+	}
+	return convertBase(num, fromBase, toBase), nil
+}
+
+func validConvBase(base int64) bool {
+	return (base >= 2 && base <= 36) || (base <= -2 && base >= -36)
+}
+
+// convertBase converts the number given as a string from one base to another, as CONV does. As in MySQL, the number
+// is read up to its first character that is not a digit of its base, and numbers out of range are clamped.
+func convertBase(num string, fromBase, toBase int64) string {
+	num = strings.TrimLeft(num, " \t\n\r")
+	negative := strings.HasPrefix(num, "-")
+	if negative {
+		num = num[1:]
+	}
+
+	base := uint64(fromBase)
+	if fromBase < 0 {
+		base = uint64(-fromBase)
+	}
+	var val uint64
+	overflow := false
+	for _, c := range strings.ToLower(num) {
+		digit := uint64(strings.IndexRune("0123456789abcdefghijklmnopqrstuvwxyz", c))
+		if digit >= base {
+			break
+		}
+		if val > (math.MaxUint64-digit)/base {
+			overflow = true
+		}
+		val = val*base + digit
+	}
+	if overflow {
+		val = math.MaxUint64
+	}
+
+	if fromBase < 0 {
+		// Signed numbers are clamped to the range of a signed integer
+		switch {
+		case negative && val > 1<<63:
+			val = 1 << 63
+		case !negative && val > math.MaxInt64:
+			val = math.MaxInt64
+		}
+	}
+	if negative {
+		val = -val
+	}
+
+	if toBase < 0 && int64(val) < 0 {
+		return "-" + strings.ToUpper(strconv.FormatUint(-val, int(-toBase)))
+	}
+	if toBase < 0 {
+		toBase = -toBase
+	}
+	return strings.ToUpper(strconv.FormatUint(val, int(toBase)))
+}
+
+// Oct returns the octal representation of a number, as CONV(N, 10, 8) does.
+type Oct struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Oct)(nil)
+
+// NewOct creates a new OCT function.
+func NewOct(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Oct{NewUnaryFunc(arg, "OCT", sql.LongText)}
+}
+
+// Eval implements the Expression interface.
+func (o *Oct) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	num, ok, err := evalLongText(ctx, o.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return convertBase(num, 10, 8), nil
+}
+
+// WithChildren implements the Expression interface.
+func (o *Oct) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 1)
+	}
+	return NewOct(ctx, children[0]), nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestConv(t *testing.T) {
+	f := sql.Function3{Name: "conv", Fn: NewConv}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 16, 2)
+	tf.AddSucceeding(nil, "a", nil, 2)
+	tf.AddSucceeding(nil, "a", 16, nil)
+	tf.AddSucceeding(nil, "a", 1, 2)
+	tf.AddSucceeding(nil, "a", 16, 37)
+	tf.AddSucceeding("1010", "a", 16, 2)
+	tf.AddSucceeding("172", "6E", 18, 8)
+	tf.AddSucceeding("-H", "-17", 10, -18)
+	tf.AddSucceeding("FFFFFFFFFFFFFFFF", "-1", 10, 16)
+	tf.AddSucceeding("-1", "-1", 10, -10)
+	tf.AddSucceeding("12", "12xyz", 10, 10)
+	tf.AddSucceeding("0", "xyz", 10, 10)
+	tf.AddSucceeding("FFFFFFFFFFFFFFFF", "99999999999999999999", 10, 16)
+	tf.AddSucceeding("7FFFFFFFFFFFFFFF", "99999999999999999999", -10, 16)
+	tf.Test(t, nil, nil)
+}
+
+func TestOct(t *testing.T) {
+	f := sql.Function1{Name: "oct", Fn: NewOct}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("14", 12)
+	tf.AddSucceeding("0", 0)
+	tf.AddSucceeding("1777777777777777777777", -1)
+	tf.AddSucceeding("14", "12")
+	tf.Test(t, nil, nil)
+}
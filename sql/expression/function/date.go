@@ -16,6 +16,7 @@ package function
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -365,7 +366,8 @@ func (ut *UnixTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error
 		return nil, err
 	}
 
-	return toUnixTimestamp(date.(time.Time))
+	// Dates are wall clock times in the session's time zone
+	return toUnixTimestamp(ctx.FromSessionTime(date.(time.Time)))
 }
 
 func toUnixTimestamp(t time.Time) (interface{}, error) {
@@ -406,7 +408,7 @@ func (r *FromUnixtime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 		return nil, err
 	}
 
-	return time.Unix(n.(int64), 0), nil
+	return ctx.ToSessionTime(time.Unix(n.(int64), 0)), nil
 }
 
 func (r *FromUnixtime) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
@@ -435,7 +437,7 @@ func NewCurrentDate(ctx *sql.Context) sql.Expression {
 }
 
 func currDateLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := ctx.ToSessionTime(ctx.QueryTime())
 	return fmt.Sprintf("%d-%02d-%02d", t.Year(), t.Month(), t.Day()), nil
 }
 
@@ -448,3 +450,464 @@ func (c CurrDate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func (c CurrDate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(ctx, c, children)
 }
+
+// evalDatetime evaluates the expression given as a datetime. As in MySQL, values that are not valid datetimes, such
+// as '2021-02-30' or the zero date, are NULL with a warning. The result is false if the value is NULL.
+func evalDatetime(ctx *sql.Context, expr sql.Expression, row sql.Row) (time.Time, bool, error) {
+	val, err := expr.Eval(ctx, row)
+	if err != nil || val == nil {
+		return time.Time{}, false, err
+	}
+
+	t, err := sql.Datetime.Convert(val)
+	if err != nil || t.(time.Time).Equal(sql.Datetime.Zero().(time.Time)) {
+		ctx.Warn(1292, "Incorrect datetime value: '%v'", val)
+		return time.Time{}, false, nil
+	}
+	return t.(time.Time), true, nil
+}
+
+// evalInt64 evaluates the expression given as an integer. The result is false if the value is NULL.
+func evalInt64(ctx *sql.Context, expr sql.Expression, row sql.Row) (int64, bool, error) {
+	val, err := expr.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, false, err
+	}
+
+	n, err := sql.Int64.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return n.(int64), true, nil
+}
+
+// lastDayOfMonth returns the number of days of the month given.
+func lastDayOfMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DateDiff returns the number of days from the date of the second datetime to the date of the first one.
+type DateDiff struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*DateDiff)(nil)
+
+// NewDateDiff creates a new DateDiff function.
+func NewDateDiff(ctx *sql.Context, date1, date2 sql.Expression) sql.Expression {
+	return &DateDiff{
+		expression.BinaryExpression{
+			Left:  date1,
+			Right: date2,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (d *DateDiff) FunctionName() string {
+	return "datediff"
+}
+
+// Type implements the Expression interface.
+func (d *DateDiff) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the Expression interface.
+func (d *DateDiff) IsNullable() bool { return true }
+
+func (d *DateDiff) String() string {
+	return fmt.Sprintf("DATEDIFF(%s, %s)", d.Left, d.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (d *DateDiff) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 2)
+	}
+	return NewDateDiff(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface.
+func (d *DateDiff) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	date1, ok, err := evalDatetime(ctx, d.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	date2, ok, err := evalDatetime(ctx, d.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return (date1.Truncate(24*time.Hour).Unix() - date2.Truncate(24*time.Hour).Unix()) / (24 * 3600), nil
+}
+
+// TimestampDiff returns the difference from the first datetime to the second one, in whole units of the unit given.
+type TimestampDiff struct {
+	unit  sql.Expression
+	start sql.Expression
+	end   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*TimestampDiff)(nil)
+
+// NewTimestampDiff creates a new TimestampDiff function.
+func NewTimestampDiff(ctx *sql.Context, unit, start, end sql.Expression) sql.Expression {
+	return &TimestampDiff{unit, start, end}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *TimestampDiff) FunctionName() string {
+	return "timestampdiff"
+}
+
+// Children implements the Expression interface.
+func (t *TimestampDiff) Children() []sql.Expression {
+	return []sql.Expression{t.unit, t.start, t.end}
+}
+
+// Resolved implements the Expression interface.
+func (t *TimestampDiff) Resolved() bool {
+	return t.unit.Resolved() && t.start.Resolved() && t.end.Resolved()
+}
+
+// IsNullable implements the Expression interface.
+func (t *TimestampDiff) IsNullable() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (t *TimestampDiff) Type() sql.Type { return sql.Int64 }
+
+func (t *TimestampDiff) String() string {
+	return fmt.Sprintf("TIMESTAMPDIFF(%s, %s, %s)", t.unit, t.start, t.end)
+}
+
+// WithChildren implements the Expression interface.
+func (t *TimestampDiff) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 3)
+	}
+	return NewTimestampDiff(ctx, children[0], children[1], children[2]), nil
+}
+
+// Eval implements the Expression interface.
+func (t *TimestampDiff) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	unit, err := evalUnit(ctx, "TIMESTAMPDIFF", t.unit, row)
+	if err != nil {
+		return nil, err
+	}
+	start, ok, err := evalDatetime(ctx, t.start, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	end, ok, err := evalDatetime(ctx, t.end, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	// Datetimes span more than the 292 years that a time.Duration can hold
+	micros := (end.Unix()-start.Unix())*1000000 + int64(end.Nanosecond()-start.Nanosecond())/1000
+	switch unit {
+	case "MICROSECOND":
+		return micros, nil
+	case "SECOND":
+		return micros / 1000000, nil
+	case "MINUTE":
+		return micros / (60 * 1000000), nil
+	case "HOUR":
+		return micros / (3600 * 1000000), nil
+	case "DAY":
+		return micros / (24 * 3600 * 1000000), nil
+	case "WEEK":
+		return micros / (7 * 24 * 3600 * 1000000), nil
+	case "MONTH":
+		return monthsBetween(start, end), nil
+	case "QUARTER":
+		return monthsBetween(start, end) / 3, nil
+	case "YEAR":
+		return monthsBetween(start, end) / 12, nil
+	default:
+		return nil, ErrInvalidArgument.New("TIMESTAMPDIFF", "invalid unit "+unit)
+	}
+}
+
+// monthsBetween returns the number of whole months from the first datetime to the second one. As in MySQL, a month
+// is whole once the day of the month and the time of the first datetime are reached, so there is no whole month
+// between 2021-01-31 and 2021-02-28.
+func monthsBetween(start, end time.Time) int64 {
+	sign := int64(1)
+	if end.Before(start) {
+		start, end = end, start
+		sign = -1
+	}
+
+	months := int64(end.Year()-start.Year())*12 + int64(end.Month()-start.Month())
+	startTime := start.Sub(start.Truncate(24 * time.Hour))
+	endTime := end.Sub(end.Truncate(24 * time.Hour))
+	if end.Day() < start.Day() || (end.Day() == start.Day() && endTime < startTime) {
+		months--
+	}
+	return sign * months
+}
+
+// timestampAddUnits are the units of TIMESTAMPADD.
+var timestampAddUnits = map[string]bool{
+	"MICROSECOND": true,
+	"SECOND":      true,
+	"MINUTE":      true,
+	"HOUR":        true,
+	"DAY":         true,
+	"WEEK":        true,
+	"MONTH":       true,
+	"QUARTER":     true,
+	"YEAR":        true,
+}
+
+// TimestampAdd adds a number of units of the unit given to a datetime.
+type TimestampAdd struct {
+	unit     sql.Expression
+	interval sql.Expression
+	date     sql.Expression
+}
+
+var _ sql.FunctionExpression = (*TimestampAdd)(nil)
+
+// NewTimestampAdd creates a new TimestampAdd function.
+func NewTimestampAdd(ctx *sql.Context, unit, interval, date sql.Expression) sql.Expression {
+	return &TimestampAdd{unit, interval, date}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *TimestampAdd) FunctionName() string {
+	return "timestampadd"
+}
+
+// Children implements the Expression interface.
+func (t *TimestampAdd) Children() []sql.Expression {
+	return []sql.Expression{t.unit, t.interval, t.date}
+}
+
+// Resolved implements the Expression interface.
+func (t *TimestampAdd) Resolved() bool {
+	return t.unit.Resolved() && t.interval.Resolved() && t.date.Resolved()
+}
+
+// IsNullable implements the Expression interface.
+func (t *TimestampAdd) IsNullable() bool {
+	return true
+}
+
+// Type implements the Expression interface.
+func (t *TimestampAdd) Type() sql.Type { return sql.Datetime }
+
+func (t *TimestampAdd) String() string {
+	return fmt.Sprintf("TIMESTAMPADD(%s, %s, %s)", t.unit, t.interval, t.date)
+}
+
+// WithChildren implements the Expression interface.
+func (t *TimestampAdd) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 3)
+	}
+	return NewTimestampAdd(ctx, children[0], children[1], children[2]), nil
+}
+
+// Eval implements the Expression interface.
+func (t *TimestampAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	unit, err := evalUnit(ctx, "TIMESTAMPADD", t.unit, row)
+	if err != nil {
+		return nil, err
+	}
+	if !timestampAddUnits[unit] {
+		return nil, ErrInvalidArgument.New("TIMESTAMPADD", "invalid unit "+unit)
+	}
+
+	date, ok, err := evalDatetime(ctx, t.date, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	delta, err := expression.NewInterval(t.interval, unit).EvalDelta(ctx, row)
+	if err != nil || delta == nil {
+		return nil, err
+	}
+
+	return sql.ValidateTime(delta.Add(date)), nil
+}
+
+// evalUnit evaluates the expression given as the unit of a time interval, such as DAY or YEAR_MONTH.
+func evalUnit(ctx *sql.Context, funcName string, expr sql.Expression, row sql.Row) (string, error) {
+	unit, err := expr.Eval(ctx, row)
+	if err != nil {
+		return "", err
+	}
+	s, ok := unit.(string)
+	if !ok {
+		return "", ErrInvalidArgument.New(funcName, fmt.Sprintf("invalid unit %v", unit))
+	}
+	return strings.ToUpper(s), nil
+}
+
+// LastDay returns the date of the last day of the month of a datetime.
+type LastDay struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*LastDay)(nil)
+
+// NewLastDay creates a new LastDay function.
+func NewLastDay(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &LastDay{NewUnaryFunc(arg, "LAST_DAY", sql.Date)}
+}
+
+// Eval implements the Expression interface.
+func (l *LastDay) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	date, ok, err := evalDatetime(ctx, l.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return time.Date(date.Year(), date.Month(), lastDayOfMonth(date.Year(), date.Month()), 0, 0, 0, 0, time.UTC), nil
+}
+
+// WithChildren implements the Expression interface.
+func (l *LastDay) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	return NewLastDay(ctx, children[0]), nil
+}
+
+// MakeDate returns the date of a day of the year of a year.
+type MakeDate struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*MakeDate)(nil)
+
+// NewMakeDate creates a new MakeDate function.
+func NewMakeDate(ctx *sql.Context, year, dayOfYear sql.Expression) sql.Expression {
+	return &MakeDate{
+		expression.BinaryExpression{
+			Left:  year,
+			Right: dayOfYear,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MakeDate) FunctionName() string {
+	return "makedate"
+}
+
+// Type implements the Expression interface.
+func (m *MakeDate) Type() sql.Type { return sql.Date }
+
+// IsNullable implements the Expression interface.
+func (m *MakeDate) IsNullable() bool { return true }
+
+func (m *MakeDate) String() string {
+	return fmt.Sprintf("MAKEDATE(%s, %s)", m.Left, m.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (m *MakeDate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return NewMakeDate(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, years below 100 are two-digit years, and the result is NULL
+// if the day of the year is not positive or the date is after 9999-12-31.
+func (m *MakeDate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	year, ok, err := evalInt64(ctx, m.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	dayOfYear, ok, err := evalInt64(ctx, m.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if year < 0 || year > 9999 || dayOfYear <= 0 || dayOfYear > 366*10000 {
+		return nil, nil
+	}
+	if year < 100 {
+		year = int64(twoDigitYear(int(year)))
+	}
+
+	date := time.Date(int(year), time.January, int(dayOfYear), 0, 0, 0, 0, time.UTC)
+	if date.Year() > 9999 {
+		return nil, nil
+	}
+	return date, nil
+}
+
+// PeriodAdd adds a number of months to a period, which is a month given as YYMM or YYYYMM, and returns the resulting
+// period as YYYYMM.
+type PeriodAdd struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*PeriodAdd)(nil)
+
+// NewPeriodAdd creates a new PeriodAdd function.
+func NewPeriodAdd(ctx *sql.Context, period, months sql.Expression) sql.Expression {
+	return &PeriodAdd{
+		expression.BinaryExpression{
+			Left:  period,
+			Right: months,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (p *PeriodAdd) FunctionName() string {
+	return "period_add"
+}
+
+// Type implements the Expression interface.
+func (p *PeriodAdd) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the Expression interface.
+func (p *PeriodAdd) IsNullable() bool { return p.Left.IsNullable() || p.Right.IsNullable() }
+
+func (p *PeriodAdd) String() string {
+	return fmt.Sprintf("PERIOD_ADD(%s, %s)", p.Left, p.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (p *PeriodAdd) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 2)
+	}
+	return NewPeriodAdd(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, the period 0 stays 0, and periods whose month is not valid
+// are an error.
+func (p *PeriodAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	period, ok, err := evalInt64(ctx, p.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	months, ok, err := evalInt64(ctx, p.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if period == 0 {
+		return int64(0), nil
+	}
+	if period < 0 || period%100 < 1 || period%100 > 12 {
+		return nil, sql.ErrInvalidArgument.New("period_add")
+	}
+
+	year := period / 100
+	if year < 100 {
+		year = int64(twoDigitYear(int(year)))
+	}
+	total := year*12 + period%100 - 1 + months
+	if total <= 0 {
+		return int64(0), nil
+	}
+	return total/12*100 + total%12 + 1, nil
+}
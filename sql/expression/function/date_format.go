@@ -181,50 +181,65 @@ func (af AppendFuncWrapper) Append(bytes []byte, t time.Time) []byte {
 }
 
 var mysqlDateFormatSpec = strftime.NewSpecificationSet()
-var specifierToFunc = map[byte]func(time.Time) string{
-	'a': nil,
-	'b': nil,
-	'c': monthNum,
-	'D': dayWithSuffix,
-	'd': nil,
-	'e': dayOfMonth,
-	'f': microsecondsStr,
-	'H': nil,
-	'h': twelveHourPadded,
-	'I': twelveHourPadded,
-	'i': minutesStr,
-	'j': nil,
-	'k': twentyFourHourNoPadding,
-	'l': twelveHourNoPadding,
-	'M': fullMonthName,
-	'm': nil,
-	'p': nil,
-	'r': ampmClockStr,
-	'S': nil,
-	's': secondsStr,
-	'T': nil,
-	'U': weekMode0,
-	'u': weekMode1,
-	'V': weekMode2,
-	'v': weekMode3,
-	'W': dayName,
-	'w': nil,
-	'X': yearMode0,
-	'x': yearMode1,
-	'Y': nil,
-	'y': yearTwoDigit,
+
+// dateSpecifier is a specifier of the formats of DATE_FORMAT and STR_TO_DATE.
+type dateSpecifier struct {
+	// format returns the text of the specifier for the time given. It is nil for the specifiers that strftime
+	// already formats as MySQL does.
+	format func(time.Time) string
+	// parse reads the value of the specifier from the start of the string given, and returns the rest of the string.
+	// It is nil for the specifiers that STR_TO_DATE cannot parse.
+	parse func(d *parsedDate, str string) (string, bool)
+	// expansion is the format the specifier is short for, which STR_TO_DATE parses in its place.
+	expansion string
+	// time is whether the specifier is a part of the time of a datetime rather than of its date.
+	time bool
+}
+
+var dateSpecifiers = map[byte]dateSpecifier{
+	'a': {parse: (*parsedDate).parseShortWeekdayName},
+	'b': {parse: (*parsedDate).parseShortMonthName},
+	'c': {format: monthNum, parse: (*parsedDate).parseMonth},
+	'D': {format: dayWithSuffix, parse: (*parsedDate).parseDayWithSuffix},
+	'd': {parse: (*parsedDate).parseDay},
+	'e': {format: dayOfMonth, parse: (*parsedDate).parseDay},
+	'f': {format: microsecondsStr, parse: (*parsedDate).parseMicrosecond, time: true},
+	'H': {parse: (*parsedDate).parseHour, time: true},
+	'h': {format: twelveHourPadded, parse: (*parsedDate).parseTwelveHour, time: true},
+	'I': {format: twelveHourPadded, parse: (*parsedDate).parseTwelveHour, time: true},
+	'i': {format: minutesStr, parse: (*parsedDate).parseMinute, time: true},
+	'j': {parse: (*parsedDate).parseYearDay},
+	'k': {format: twentyFourHourNoPadding, parse: (*parsedDate).parseHour, time: true},
+	'l': {format: twelveHourNoPadding, parse: (*parsedDate).parseTwelveHour, time: true},
+	'M': {format: fullMonthName, parse: (*parsedDate).parseMonthName},
+	'm': {parse: (*parsedDate).parseMonth},
+	'p': {parse: (*parsedDate).parseAMPM, time: true},
+	'r': {format: ampmClockStr, expansion: "%I:%i:%S %p", time: true},
+	'S': {parse: (*parsedDate).parseSecond, time: true},
+	's': {format: secondsStr, parse: (*parsedDate).parseSecond, time: true},
+	'T': {expansion: "%H:%i:%S", time: true},
+	'U': {format: weekMode0},
+	'u': {format: weekMode1},
+	'V': {format: weekMode2},
+	'v': {format: weekMode3},
+	'W': {format: dayName, parse: (*parsedDate).parseWeekdayName},
+	'w': {parse: (*parsedDate).parseWeekday},
+	'X': {format: yearMode0},
+	'x': {format: yearMode1},
+	'Y': {parse: (*parsedDate).parseYear},
+	'y': {format: yearTwoDigit, parse: (*parsedDate).parseTwoDigitYear},
 }
 
 func init() {
-	for specifier, fn := range specifierToFunc {
-		if fn != nil {
-			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(fn)))
+	for specifier, spec := range dateSpecifiers {
+		if spec.format != nil {
+			panicIfErr(mysqlDateFormatSpec.Set(specifier, wrap(spec.format)))
 		}
 	}
 
 	// replace any strftime specifiers that aren't supported
 	fn := func(b byte) {
-		if _, ok := specifierToFunc[b]; !ok {
+		if _, ok := dateSpecifiers[b]; !ok {
 			panicIfErr(mysqlDateFormatSpec.Set(b, wrap(func(time.Time) string {
 				return string(b)
 			})))
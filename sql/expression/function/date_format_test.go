@@ -83,7 +83,7 @@ func TestDateFormatting(t *testing.T) {
 
 func TestUnsupportedSpecifiers(t *testing.T) {
 	testFunc := func(t *testing.T, b byte) {
-		if _, ok := specifierToFunc[b]; !ok {
+		if _, ok := dateSpecifiers[b]; !ok {
 			name := fmt.Sprintf("%%%s", string(b))
 			t.Run(name, func(t *testing.T) {
 				result, err := formatDate(name, time.Now())
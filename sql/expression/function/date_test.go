@@ -15,6 +15,7 @@
 package function
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -163,3 +164,199 @@ func TestFromUnixtime(t *testing.T) {
 	_, err = NewUnixTimestamp(ctx, expression.NewLiteral(1447430881, sql.Int64))
 	require.NoError(err)
 }
+
+func TestDateDiff(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	f := NewDateDiff(ctx, expression.NewGetField(0, sql.LongText, "a", true), expression.NewGetField(1, sql.LongText, "b", true))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"later date", sql.Row{"2021-03-01 23:59:59", "2021-02-28 00:00:00"}, int64(1)},
+		{"earlier date", sql.Row{"2020-02-28", "2021-02-28"}, int64(-366)},
+		{"centuries apart", sql.Row{"9999-12-31", "1000-01-01"}, int64(3287181)},
+		{"null", sql.Row{nil, "2021-02-28"}, nil},
+		{"invalid date", sql.Row{"2021-02-30", "2021-02-28"}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := f.Eval(ctx, tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestTimestampDiff(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		unit     string
+		start    interface{}
+		end      interface{}
+		expected interface{}
+	}{
+		{"MICROSECOND", "2021-01-01 00:00:00", "2021-01-01 00:00:01.5", int64(1500000)},
+		{"second", "2021-01-01 00:00:00", "2020-12-31 23:59:58.5", int64(-1)},
+		{"MINUTE", "2021-01-01 00:00:00", "2021-01-01 01:30:59", int64(90)},
+		{"HOUR", "1000-01-01 00:00:00", "9999-12-31 00:00:00", int64(78892344)},
+		{"DAY", "2021-01-01 12:00:00", "2021-01-03 11:59:59", int64(1)},
+		{"WEEK", "2021-01-01", "2021-01-15", int64(2)},
+		{"MONTH", "2021-01-31", "2021-02-28", int64(0)},
+		{"MONTH", "2021-01-31", "2021-03-31", int64(2)},
+		{"MONTH", "2021-03-31 12:00:00", "2021-01-31 13:00:00", int64(-1)},
+		{"QUARTER", "2021-01-01", "2021-12-31", int64(3)},
+		{"YEAR", "2000-02-29", "2021-02-28", int64(20)},
+		{"YEAR", "2021-02-28", nil, nil},
+		{"YEAR", "2021-02-28", "not a date", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.unit, func(t *testing.T) {
+			f := NewTimestampDiff(ctx,
+				expression.NewLiteral(tt.unit, sql.LongText),
+				expression.NewLiteral(tt.start, sql.LongText),
+				expression.NewLiteral(tt.end, sql.LongText),
+			)
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+
+	f := NewTimestampDiff(ctx,
+		expression.NewLiteral("DAY_HOUR", sql.LongText),
+		expression.NewLiteral("2021-01-01", sql.LongText),
+		expression.NewLiteral("2021-01-02", sql.LongText),
+	)
+	_, err := f.Eval(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestTimestampAdd(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		unit     string
+		interval interface{}
+		date     interface{}
+		expected interface{}
+	}{
+		{"DAY", 1, "2021-02-28", time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"MONTH", 1, "2021-01-31 10:00:00", time.Date(2021, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{"quarter", -1, "2021-05-31", time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"MICROSECOND", 1500000, "2021-01-01", time.Date(2021, 1, 1, 0, 0, 1, 500000000, time.UTC)},
+		{"YEAR", 1, "9999-01-01", nil},
+		{"DAY", nil, "2021-01-01", nil},
+		{"DAY", 1, "2021-02-30", nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.unit, func(t *testing.T) {
+			f := NewTimestampAdd(ctx,
+				expression.NewLiteral(tt.unit, sql.LongText),
+				expression.NewLiteral(tt.interval, sql.Int64),
+				expression.NewLiteral(tt.date, sql.LongText),
+			)
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+
+	f := NewTimestampAdd(ctx,
+		expression.NewLiteral("YEAR_MONTH", sql.LongText),
+		expression.NewLiteral(1, sql.Int64),
+		expression.NewLiteral("2021-01-01", sql.LongText),
+	)
+	_, err := f.Eval(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestLastDay(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	f := NewLastDay(ctx, expression.NewGetField(0, sql.LongText, "foo", true))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"leap year", sql.Row{"2020-02-10 10:00:00"}, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"december", sql.Row{"2021-12-01"}, time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"null", sql.Row{nil}, nil},
+		{"invalid date", sql.Row{"2021-02-30"}, nil},
+		{"zero date", sql.Row{"0000-00-00"}, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			val, err := f.Eval(ctx, tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestMakeDate(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		year      interface{}
+		dayOfYear interface{}
+		expected  interface{}
+	}{
+		{2021, 32, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{2021, 366, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{21, 1, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{99, 1, time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{2021, 0, nil},
+		{9999, 366, nil},
+		{nil, 1, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%v %v", tt.year, tt.dayOfYear), func(t *testing.T) {
+			f := NewMakeDate(ctx, expression.NewLiteral(tt.year, sql.Int64), expression.NewLiteral(tt.dayOfYear, sql.Int64))
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestPeriodAdd(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		period   interface{}
+		months   interface{}
+		expected interface{}
+		err      bool
+	}{
+		{202101, 2, int64(202103), false},
+		{202112, 1, int64(202201), false},
+		{2101, -1, int64(202012), false},
+		{9901, 12, int64(200001), false},
+		{0, 5, int64(0), false},
+		{nil, 5, nil, false},
+		{202113, 1, nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%v %v", tt.period, tt.months), func(t *testing.T) {
+			f := NewPeriodAdd(ctx, expression.NewLiteral(tt.period, sql.Int64), expression.NewLiteral(tt.months, sql.Int64))
+			val, err := f.Eval(ctx, nil)
+			if tt.err {
+				require.Error(t, err)
+				require.True(t, sql.ErrInvalidArgument.Is(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
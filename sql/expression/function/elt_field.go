@@ -0,0 +1,182 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Elt returns the string of a list at the given index, counted from 1.
+type Elt struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Elt)(nil)
+
+// NewElt creates a new ELT function.
+func NewElt(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("ELT", "2 or more", len(args))
+	}
+	return &Elt{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Elt) FunctionName() string {
+	return "elt"
+}
+
+// Type implements the Expression interface.
+func (e *Elt) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (e *Elt) IsNullable() bool { return true }
+
+func (e *Elt) String() string {
+	return fmt.Sprintf("ELT(%s)", joinExpressions(e.args))
+}
+
+// WithChildren implements the Expression interface.
+func (e *Elt) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewElt(ctx, children...)
+}
+
+// Resolved implements the Expression interface.
+func (e *Elt) Resolved() bool {
+	return resolvedExpressions(e.args)
+}
+
+// Children implements the Expression interface.
+func (e *Elt) Children() []sql.Expression { return e.args }
+
+// Eval implements the Expression interface. The result is NULL if the index is not in the list.
+func (e *Elt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, ok, err := evalInt64(ctx, e.args[0], row)
+	if err != nil || !ok || n < 1 || n >= int64(len(e.args)) {
+		return nil, err
+	}
+
+	val, err := e.args[n].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return sql.LongText.Convert(val)
+}
+
+// Field returns the index, counted from 1, of the first argument in the list of the other arguments, or 0 if it is not
+// in the list. The arguments are compared as strings, by the rules of their collation, when they are all strings, and
+// as numbers otherwise.
+type Field struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Field)(nil)
+
+// NewField creates a new FIELD function.
+func NewField(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("FIELD", "2 or more", len(args))
+	}
+	return &Field{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Field) FunctionName() string {
+	return "field"
+}
+
+// Type implements the Expression interface.
+func (f *Field) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the Expression interface.
+func (f *Field) IsNullable() bool { return false }
+
+func (f *Field) String() string {
+	return fmt.Sprintf("FIELD(%s)", joinExpressions(f.args))
+}
+
+// WithChildren implements the Expression interface.
+func (f *Field) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewField(ctx, children...)
+}
+
+// Resolved implements the Expression interface.
+func (f *Field) Resolved() bool {
+	return resolvedExpressions(f.args)
+}
+
+// Children implements the Expression interface.
+func (f *Field) Children() []sql.Expression { return f.args }
+
+// Eval implements the Expression interface. A NULL is never found in the list.
+func (f *Field) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	compareType := sql.Type(sql.Float64)
+	if allStrings(f.args) {
+		compareType = sql.CreateLongText(expression.CollationOf(f.args...))
+	}
+
+	val, err := f.evalArg(ctx, 0, compareType, row)
+	if err != nil || val == nil {
+		return int64(0), err
+	}
+
+	for i := 1; i < len(f.args); i++ {
+		other, err := f.evalArg(ctx, i, compareType, row)
+		if err != nil {
+			return nil, err
+		}
+		if other == nil {
+			continue
+		}
+		cmp, err := compareType.Compare(val, other)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			return int64(i), nil
+		}
+	}
+	return int64(0), nil
+}
+
+func (f *Field) evalArg(ctx *sql.Context, i int, typ sql.Type, row sql.Row) (interface{}, error) {
+	val, err := f.args[i].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return typ.Convert(val)
+}
+
+// allStrings returns whether all the expressions given are strings, leaving out NULLs.
+func allStrings(exprs []sql.Expression) bool {
+	for _, e := range exprs {
+		if !sql.IsText(e.Type()) && e.Type() != sql.Null {
+			return false
+		}
+	}
+	return true
+}
+
+func joinExpressions(exprs []sql.Expression) string {
+	var args []string
+	for _, e := range exprs {
+		args = append(args, e.String())
+	}
+	return strings.Join(args, ", ")
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestElt(t *testing.T) {
+	f := sql.FunctionN{Name: "elt", Fn: NewElt}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "a", "b")
+	tf.AddSucceeding("a", 1, "a", "b")
+	tf.AddSucceeding("b", 2, "a", "b")
+	tf.AddSucceeding("2", "2", "a", 2)
+	tf.AddSucceeding(nil, 0, "a", "b")
+	tf.AddSucceeding(nil, 3, "a", "b")
+	tf.AddSucceeding(nil, 2, "a", nil)
+	tf.Test(t, nil, nil)
+
+	_, err := NewElt(sql.NewEmptyContext(), expression.NewLiteral(1, sql.Int64))
+	require.Error(t, err)
+}
+
+func TestField(t *testing.T) {
+	f := sql.FunctionN{Name: "field", Fn: NewField}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(int64(2), "b", "a", "b", "c")
+	tf.AddSucceeding(int64(2), "B", "a", "b", "c")
+	tf.AddSucceeding(int64(0), "d", "a", "b", "c")
+	tf.AddSucceeding(int64(0), nil, "a", nil, "c")
+	tf.AddSucceeding(int64(3), "c", "a", nil, "c")
+	tf.AddSucceeding(int64(2), 2, 1, 2.0, 3)
+	tf.AddSucceeding(int64(1), "2", 2, "b")
+	tf.Test(t, nil, nil)
+
+	_, err := NewField(sql.NewEmptyContext(), expression.NewLiteral("a", sql.LongText))
+	require.Error(t, err)
+}
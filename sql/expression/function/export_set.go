@@ -0,0 +1,199 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ExportSet returns a string with an "on" string for every bit set in a number, and an "off" string for every other
+// bit, from the lowest bit to the highest, separated by a separator that is a comma by default.
+type ExportSet struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*ExportSet)(nil)
+
+// NewExportSet creates a new EXPORT_SET function.
+func NewExportSet(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("EXPORT_SET", "3 to 5", len(args))
+	}
+	return &ExportSet{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *ExportSet) FunctionName() string {
+	return "export_set"
+}
+
+// Type implements the Expression interface.
+func (e *ExportSet) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (e *ExportSet) IsNullable() bool { return true }
+
+func (e *ExportSet) String() string {
+	return fmt.Sprintf("EXPORT_SET(%s)", joinExpressions(e.args))
+}
+
+// WithChildren implements the Expression interface.
+func (e *ExportSet) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(e.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), len(e.args))
+	}
+	return NewExportSet(ctx, children...)
+}
+
+// Resolved implements the Expression interface.
+func (e *ExportSet) Resolved() bool {
+	return resolvedExpressions(e.args)
+}
+
+// Children implements the Expression interface.
+func (e *ExportSet) Children() []sql.Expression { return e.args }
+
+// Eval implements the Expression interface. The number of bits shown is 64 by default, which is also its maximum.
+func (e *ExportSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bits, ok, err := evalBits(ctx, e.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	strs := []string{"", "", ","}
+	for i := 1; i < len(e.args) && i < 4; i++ {
+		s, ok, err := evalLongText(ctx, e.args[i], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+		strs[i-1] = s
+	}
+	on, off, separator := strs[0], strs[1], strs[2]
+
+	numBits := int64(64)
+	if len(e.args) == 5 {
+		numBits, ok, err = evalInt64(ctx, e.args[4], row)
+		if err != nil || !ok {
+			return nil, err
+		}
+		if numBits < 0 || numBits > 64 {
+			numBits = 64
+		}
+	}
+
+	var sb strings.Builder
+	for i := int64(0); i < numBits; i++ {
+		if i > 0 {
+			sb.WriteString(separator)
+		}
+		if bits&(1<<uint(i)) != 0 {
+			sb.WriteString(on)
+		} else {
+			sb.WriteString(off)
+		}
+	}
+	return sb.String(), nil
+}
+
+// MakeSet returns the comma-separated list of the strings whose bit is set in a number, the first string being the one
+// of the lowest bit.
+type MakeSet struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*MakeSet)(nil)
+
+// NewMakeSet creates a new MAKE_SET function.
+func NewMakeSet(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("MAKE_SET", "2 or more", len(args))
+	}
+	return &MakeSet{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MakeSet) FunctionName() string {
+	return "make_set"
+}
+
+// Type implements the Expression interface.
+func (m *MakeSet) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (m *MakeSet) IsNullable() bool { return true }
+
+func (m *MakeSet) String() string {
+	return fmt.Sprintf("MAKE_SET(%s)", joinExpressions(m.args))
+}
+
+// WithChildren implements the Expression interface.
+func (m *MakeSet) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewMakeSet(ctx, children...)
+}
+
+// Resolved implements the Expression interface.
+func (m *MakeSet) Resolved() bool {
+	return resolvedExpressions(m.args)
+}
+
+// Children implements the Expression interface.
+func (m *MakeSet) Children() []sql.Expression { return m.args }
+
+// Eval implements the Expression interface. NULL strings are left out of the list.
+func (m *MakeSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	bits, ok, err := evalBits(ctx, m.args[0], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var strs []string
+	for i, arg := range m.args[1:] {
+		if i >= 64 || bits&(1<<uint(i)) == 0 {
+			continue
+		}
+		s, ok, err := evalLongText(ctx, arg, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			strs = append(strs, s)
+		}
+	}
+	return strings.Join(strs, ","), nil
+}
+
+// evalBits evaluates a number as the 64 bits of an unsigned integer, so that negative numbers have their two's
+// complement bits. The result is false if the number is NULL.
+func evalBits(ctx *sql.Context, e sql.Expression, row sql.Row) (uint64, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, false, err
+	}
+	if n, err := sql.Int64.Convert(val); err == nil {
+		return uint64(n.(int64)), true, nil
+	}
+	if n, err := sql.Uint64.Convert(val); err == nil {
+		return n.(uint64), true, nil
+	}
+	f, err := sql.Float64.Convert(val)
+	if err != nil {
+		return 0, false, err
+	}
+	return uint64(int64(math.Round(f.(float64)))), true, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestExportSet(t *testing.T) {
+	f := sql.FunctionN{Name: "export_set", Fn: NewExportSet}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "Y", "N")
+	tf.AddSucceeding(nil, 5, nil, "N")
+	tf.AddSucceeding(nil, 5, "Y", "N", ",", nil)
+	tf.AddSucceeding("Y,N,Y,N", 5, "Y", "N", ",", 4)
+	tf.AddSucceeding("0,1,1,0,0,0,0,0,0,0", 6, "1", "0", ",", 10)
+	tf.AddSucceeding("YNY", 5, "Y", "N", "", 3)
+	tf.AddSucceeding("Y|Y", -1, "Y", "N", "|", 2)
+	tf.AddSucceeding("N,N", 0, "Y", "N", ",", 2)
+	tf.Test(t, nil, nil)
+}
+
+func TestExportSetDefaultBits(t *testing.T) {
+	f := sql.FunctionN{Name: "export_set", Fn: NewExportSet}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding("1000000000000000000000000000000000000000000000000000000000000000", 1, "1", "0", "")
+	tf.AddSucceeding("1000000000000000000000000000000000000000000000000000000000000000", 1, "1", "0", "", 100)
+	tf.Test(t, nil, nil)
+}
+
+func TestMakeSet(t *testing.T) {
+	f := sql.FunctionN{Name: "make_set", Fn: NewMakeSet}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "a", "b")
+	tf.AddSucceeding("a", 1, "a", "b", "c")
+	tf.AddSucceeding("a,c", 5, "a", "b", "c")
+	tf.AddSucceeding("hello", 1|4, "hello", "nice", nil, "world")
+	tf.AddSucceeding("", 0, "a", "b", "c")
+	tf.AddSucceeding("a,b,c", -1, "a", "b", "c")
+	tf.Test(t, nil, nil)
+}
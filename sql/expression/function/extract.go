@@ -0,0 +1,265 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Extract returns a part of a datetime, given by a unit such as YEAR or DAY_MINUTE. The parts of compound units are
+// concatenated, so EXTRACT(DAY_MINUTE FROM '2021-01-02 03:04:05') is 20304.
+type Extract struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Extract)(nil)
+
+// NewExtract creates a new Extract function.
+func NewExtract(ctx *sql.Context, unit, date sql.Expression) sql.Expression {
+	return &Extract{
+		expression.BinaryExpression{
+			Left:  unit,
+			Right: date,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (e *Extract) FunctionName() string {
+	return "extract"
+}
+
+// Type implements the Expression interface.
+func (e *Extract) Type() sql.Type { return sql.Int64 }
+
+// IsNullable implements the Expression interface.
+func (e *Extract) IsNullable() bool { return true }
+
+func (e *Extract) String() string {
+	return fmt.Sprintf("EXTRACT(%s FROM %s)", e.Left, e.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (e *Extract) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 2)
+	}
+	return NewExtract(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. Units that only have parts of a time may also be extracted from a TIME,
+// whose hours are not limited to a day and which may be negative.
+func (e *Extract) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	unit, err := evalUnit(ctx, "EXTRACT", e.Left, row)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := extractUnits[unit]; !ok {
+		return nil, ErrInvalidArgument.New("EXTRACT", "invalid unit "+unit)
+	}
+
+	val, err := e.Right.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	if t, err := sql.Datetime.Convert(val); err == nil && !t.(time.Time).Equal(sql.Datetime.Zero().(time.Time)) {
+		return extractUnits[unit](datetimeParts(t.(time.Time))), nil
+	}
+	if isTimeUnit(unit) {
+		if d, err := sql.Time.ConvertToTimeDuration(val); err == nil {
+			return extractUnits[unit](durationParts(d)), nil
+		}
+	}
+
+	ctx.Warn(1292, "Incorrect datetime value: '%v'", val)
+	return nil, nil
+}
+
+// dateParts are the parts of a datetime or a time that EXTRACT returns. The parts of negative times are negative.
+type dateParts struct {
+	year, month, day, hour, minute, second, microsecond int64
+	week                                                int64
+}
+
+func datetimeParts(t time.Time) dateParts {
+	yearForWeek, week := calcWeek(int32(t.Year()), int32(t.Month()), int32(t.Day()), weekMode(0)|weekBehaviourYear)
+	if yearForWeek < int32(t.Year()) {
+		week = 0
+	} else if yearForWeek > int32(t.Year()) {
+		week = 53
+	}
+
+	return dateParts{
+		year:        int64(t.Year()),
+		month:       int64(t.Month()),
+		day:         int64(t.Day()),
+		hour:        int64(t.Hour()),
+		minute:      int64(t.Minute()),
+		second:      int64(t.Second()),
+		microsecond: int64(t.Nanosecond() / int(time.Microsecond)),
+		week:        int64(week),
+	}
+}
+
+func durationParts(d time.Duration) dateParts {
+	micros := d.Microseconds()
+	sign := int64(1)
+	if micros < 0 {
+		sign, micros = -1, -micros
+	}
+	return dateParts{
+		hour:        sign * (micros / 3600000000),
+		minute:      sign * (micros / 60000000 % 60),
+		second:      sign * (micros / 1000000 % 60),
+		microsecond: sign * (micros % 1000000),
+	}
+}
+
+// isTimeUnit returns whether the unit given only has parts of a time.
+func isTimeUnit(unit string) bool {
+	return strings.HasPrefix(unit, "HOUR") || strings.HasPrefix(unit, "MINUTE") ||
+		strings.HasPrefix(unit, "SECOND") || unit == "MICROSECOND"
+}
+
+var extractUnits = map[string]func(p dateParts) int64{
+	"YEAR":        func(p dateParts) int64 { return p.year },
+	"QUARTER":     func(p dateParts) int64 { return (p.month + 2) / 3 },
+	"MONTH":       func(p dateParts) int64 { return p.month },
+	"WEEK":        func(p dateParts) int64 { return p.week },
+	"DAY":         func(p dateParts) int64 { return p.day },
+	"HOUR":        func(p dateParts) int64 { return p.hour },
+	"MINUTE":      func(p dateParts) int64 { return p.minute },
+	"SECOND":      func(p dateParts) int64 { return p.second },
+	"MICROSECOND": func(p dateParts) int64 { return p.microsecond },
+	"YEAR_MONTH":  func(p dateParts) int64 { return p.year*100 + p.month },
+	"DAY_HOUR":    func(p dateParts) int64 { return p.day*100 + p.hour },
+	"DAY_MINUTE":  func(p dateParts) int64 { return p.day*10000 + p.hour*100 + p.minute },
+	"DAY_SECOND": func(p dateParts) int64 {
+		return p.day*1000000 + p.hour*10000 + p.minute*100 + p.second
+	},
+	"DAY_MICROSECOND": func(p dateParts) int64 {
+		return (p.day*1000000+p.hour*10000+p.minute*100+p.second)*1000000 + p.microsecond
+	},
+	"HOUR_MINUTE": func(p dateParts) int64 { return p.hour*100 + p.minute },
+	"HOUR_SECOND": func(p dateParts) int64 { return p.hour*10000 + p.minute*100 + p.second },
+	"HOUR_MICROSECOND": func(p dateParts) int64 {
+		return (p.hour*10000+p.minute*100+p.second)*1000000 + p.microsecond
+	},
+	"MINUTE_SECOND":      func(p dateParts) int64 { return p.minute*100 + p.second },
+	"MINUTE_MICROSECOND": func(p dateParts) int64 { return (p.minute*100+p.second)*1000000 + p.microsecond },
+	"SECOND_MICROSECOND": func(p dateParts) int64 { return p.second*1000000 + p.microsecond },
+}
+
+// getFormats are the formats returned by GET_FORMAT, by type and then by standard.
+var getFormats = map[string]map[string]string{
+	"DATE": {
+		"USA":      "%m.%d.%Y",
+		"JIS":      "%Y-%m-%d",
+		"ISO":      "%Y-%m-%d",
+		"EUR":      "%d.%m.%Y",
+		"INTERNAL": "%Y%m%d",
+	},
+	"DATETIME": {
+		"USA":      "%Y-%m-%d %H.%i.%s",
+		"JIS":      "%Y-%m-%d %H:%i:%s",
+		"ISO":      "%Y-%m-%d %H:%i:%s",
+		"EUR":      "%Y-%m-%d %H.%i.%s",
+		"INTERNAL": "%Y%m%d%H%i%s",
+	},
+	"TIME": {
+		"USA":      "%h:%i:%s %p",
+		"JIS":      "%H:%i:%s",
+		"ISO":      "%H:%i:%s",
+		"EUR":      "%H.%i.%s",
+		"INTERNAL": "%H%i%s",
+	},
+}
+
+// GetFormat returns the DATE_FORMAT format of a type, DATE, TIME, DATETIME or TIMESTAMP, in a standard, such as
+// 'ISO' or 'USA'.
+type GetFormat struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*GetFormat)(nil)
+
+// NewGetFormat creates a new GetFormat function.
+func NewGetFormat(ctx *sql.Context, typ, standard sql.Expression) sql.Expression {
+	return &GetFormat{
+		expression.BinaryExpression{
+			Left:  typ,
+			Right: standard,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (g *GetFormat) FunctionName() string {
+	return "get_format"
+}
+
+// Type implements the Expression interface.
+func (g *GetFormat) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (g *GetFormat) IsNullable() bool { return true }
+
+func (g *GetFormat) String() string {
+	return fmt.Sprintf("GET_FORMAT(%s, %s)", g.Left, g.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (g *GetFormat) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 2)
+	}
+	return NewGetFormat(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. Standards that are not known are NULL.
+func (g *GetFormat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	typ, err := evalUnit(ctx, "GET_FORMAT", g.Left, row)
+	if err != nil {
+		return nil, err
+	}
+	if typ == "TIMESTAMP" {
+		typ = "DATETIME"
+	}
+	formats, ok := getFormats[typ]
+	if !ok {
+		return nil, ErrInvalidArgument.New("GET_FORMAT", "invalid type "+typ)
+	}
+
+	standard, err := g.Right.Eval(ctx, row)
+	if err != nil || standard == nil {
+		return nil, err
+	}
+	standard, err = sql.LongText.Convert(standard)
+	if err != nil {
+		return nil, err
+	}
+
+	format, ok := formats[strings.ToUpper(standard.(string))]
+	if !ok {
+		return nil, nil
+	}
+	return format, nil
+}
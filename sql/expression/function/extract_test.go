@@ -0,0 +1,102 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestExtract(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	const datetime = "2021-01-02 03:04:05.000006"
+
+	testCases := []struct {
+		unit     string
+		date     interface{}
+		expected interface{}
+	}{
+		{"YEAR", datetime, int64(2021)},
+		{"QUARTER", datetime, int64(1)},
+		{"MONTH", datetime, int64(1)},
+		{"WEEK", datetime, int64(0)},
+		{"WEEK", "2021-01-03", int64(1)},
+		{"DAY", datetime, int64(2)},
+		{"hour", datetime, int64(3)},
+		{"MINUTE", datetime, int64(4)},
+		{"SECOND", datetime, int64(5)},
+		{"MICROSECOND", datetime, int64(6)},
+		{"YEAR_MONTH", datetime, int64(202101)},
+		{"DAY_HOUR", datetime, int64(203)},
+		{"DAY_MINUTE", datetime, int64(20304)},
+		{"DAY_SECOND", datetime, int64(2030405)},
+		{"DAY_MICROSECOND", datetime, int64(2030405000006)},
+		{"HOUR_MINUTE", datetime, int64(304)},
+		{"HOUR_SECOND", datetime, int64(30405)},
+		{"HOUR_MICROSECOND", datetime, int64(30405000006)},
+		{"MINUTE_SECOND", datetime, int64(405)},
+		{"MINUTE_MICROSECOND", datetime, int64(405000006)},
+		{"SECOND_MICROSECOND", datetime, int64(5000006)},
+		{"HOUR", "-100:30:00", int64(-100)},
+		{"HOUR_MINUTE", "100:30:00", int64(10030)},
+		{"DAY", "100:30:00", nil},
+		{"YEAR", "2021-02-30", nil},
+		{"YEAR", nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.unit, func(t *testing.T) {
+			f := NewExtract(ctx, expression.NewLiteral(tt.unit, sql.LongText), expression.NewLiteral(tt.date, sql.LongText))
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+
+	f := NewExtract(ctx, expression.NewLiteral("FORTNIGHT", sql.LongText), expression.NewLiteral(datetime, sql.LongText))
+	_, err := f.Eval(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestGetFormat(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+
+	testCases := []struct {
+		typ      string
+		standard interface{}
+		expected interface{}
+	}{
+		{"DATE", "USA", "%m.%d.%Y"},
+		{"date", "eur", "%d.%m.%Y"},
+		{"DATETIME", "ISO", "%Y-%m-%d %H:%i:%s"},
+		{"TIMESTAMP", "INTERNAL", "%Y%m%d%H%i%s"},
+		{"TIME", "USA", "%h:%i:%s %p"},
+		{"TIME", "MARS", nil},
+		{"TIME", nil, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.typ, func(t *testing.T) {
+			f := NewGetFormat(ctx, expression.NewLiteral(tt.typ, sql.LongText), expression.NewLiteral(tt.standard, sql.LongText))
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// FindInSet returns the index, counted from 1, of a string in a list of comma-separated strings, or 0 if it is not in
+// the list. The strings are compared by the rules of their collation.
+type FindInSet struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*FindInSet)(nil)
+
+// NewFindInSet creates a new FIND_IN_SET function.
+func NewFindInSet(ctx *sql.Context, str, strList sql.Expression) sql.Expression {
+	return &FindInSet{
+		expression.BinaryExpression{
+			Left:  str,
+			Right: strList,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *FindInSet) FunctionName() string {
+	return "find_in_set"
+}
+
+// Type implements the Expression interface.
+func (f *FindInSet) Type() sql.Type { return sql.Int64 }
+
+func (f *FindInSet) String() string {
+	return fmt.Sprintf("FIND_IN_SET(%s, %s)", f.Left, f.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (f *FindInSet) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+	return NewFindInSet(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, a string that contains a comma is never found.
+func (f *FindInSet) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, ok, err := evalLongText(ctx, f.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	strList, ok, err := evalLongText(ctx, f.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if strList == "" || strings.Contains(str, ",") {
+		return int64(0), nil
+	}
+	collation := expression.CollationOf(f.Left, f.Right)
+	for i, s := range strings.Split(strList, ",") {
+		if collation.Compare(str, s) == 0 {
+			return int64(i + 1), nil
+		}
+	}
+	return int64(0), nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestFindInSet(t *testing.T) {
+	f := sql.Function2{Name: "find_in_set", Fn: NewFindInSet}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "a,b,c")
+	tf.AddSucceeding(nil, "b", nil)
+	tf.AddSucceeding(int64(2), "b", "a,b,c")
+	tf.AddSucceeding(int64(2), "B", "a,b,c")
+	tf.AddSucceeding(int64(0), "d", "a,b,c")
+	tf.AddSucceeding(int64(0), "b", "")
+	tf.AddSucceeding(int64(0), "a,b", "a,b,c")
+	tf.AddSucceeding(int64(2), "", "a,,c")
+	tf.Test(t, nil, nil)
+}
@@ -0,0 +1,307 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// numberLocale holds how numbers are formatted in a locale.
+type numberLocale struct {
+	decimalPoint string
+	thousandsSep string
+	// grouping is the number of digits of every group, from the one right before the decimal point. The last number
+	// repeats for all the remaining groups. Numbers are not grouped if it's empty.
+	grouping []int
+}
+
+var (
+	enNumberLocale = numberLocale{".", ",", []int{3}}
+	deNumberLocale = numberLocale{",", ".", []int{3}}
+	ruNumberLocale = numberLocale{",", " ", []int{3}}
+	chNumberLocale = numberLocale{",", "'", []int{3}}
+	inNumberLocale = numberLocale{".", ",", []int{3, 2}}
+	// The locales of frNumberLocale and saNumberLocale have no thousands separator in MySQL
+	frNumberLocale = numberLocale{",", "", nil}
+	saNumberLocale = numberLocale{".", "", nil}
+)
+
+// numberLocales are the locales that FORMAT supports, by name, as defined by MySQL.
+var numberLocales = map[string]numberLocale{
+	"ar_AE": enNumberLocale,
+	"ar_BH": enNumberLocale,
+	"ar_DZ": enNumberLocale,
+	"ar_EG": enNumberLocale,
+	"ar_IN": enNumberLocale,
+	"ar_IQ": enNumberLocale,
+	"ar_JO": enNumberLocale,
+	"ar_KW": enNumberLocale,
+	"ar_LB": enNumberLocale,
+	"ar_LY": enNumberLocale,
+	"ar_MA": enNumberLocale,
+	"ar_OM": enNumberLocale,
+	"ar_QA": enNumberLocale,
+	"ar_SA": saNumberLocale,
+	"ar_SD": enNumberLocale,
+	"ar_SY": enNumberLocale,
+	"ar_TN": enNumberLocale,
+	"ar_YE": enNumberLocale,
+	"be_BY": deNumberLocale,
+	"bg_BG": frNumberLocale,
+	"ca_ES": frNumberLocale,
+	"cs_CZ": ruNumberLocale,
+	"da_DK": deNumberLocale,
+	"de_AT": frNumberLocale,
+	"de_BE": deNumberLocale,
+	"de_CH": {".", "'", []int{3}},
+	"de_DE": deNumberLocale,
+	"de_LU": deNumberLocale,
+	"el_GR": frNumberLocale,
+	"en_AU": enNumberLocale,
+	"en_CA": enNumberLocale,
+	"en_GB": enNumberLocale,
+	"en_IN": inNumberLocale,
+	"en_NZ": enNumberLocale,
+	"en_PH": enNumberLocale,
+	"en_US": enNumberLocale,
+	"en_ZA": enNumberLocale,
+	"en_ZW": enNumberLocale,
+	"es_AR": deNumberLocale,
+	"es_BO": frNumberLocale,
+	"es_CL": frNumberLocale,
+	"es_CO": frNumberLocale,
+	"es_CR": saNumberLocale,
+	"es_DO": saNumberLocale,
+	"es_EC": frNumberLocale,
+	"es_ES": deNumberLocale,
+	"es_GT": enNumberLocale,
+	"es_HN": enNumberLocale,
+	"es_MX": enNumberLocale,
+	"es_NI": enNumberLocale,
+	"es_PA": enNumberLocale,
+	"es_PE": frNumberLocale,
+	"es_PR": enNumberLocale,
+	"es_PY": frNumberLocale,
+	"es_SV": enNumberLocale,
+	"es_US": enNumberLocale,
+	"es_UY": frNumberLocale,
+	"es_VE": frNumberLocale,
+	"et_EE": ruNumberLocale,
+	"eu_ES": frNumberLocale,
+	"fi_FI": ruNumberLocale,
+	"fo_FO": deNumberLocale,
+	"fr_BE": frNumberLocale,
+	"fr_CA": frNumberLocale,
+	"fr_CH": frNumberLocale,
+	"fr_FR": frNumberLocale,
+	"fr_LU": frNumberLocale,
+	"gl_ES": frNumberLocale,
+	"gu_IN": enNumberLocale,
+	"he_IL": enNumberLocale,
+	"hi_IN": enNumberLocale,
+	"hr_HR": frNumberLocale,
+	"hu_HU": deNumberLocale,
+	"id_ID": deNumberLocale,
+	"is_IS": deNumberLocale,
+	"it_CH": chNumberLocale,
+	"it_IT": frNumberLocale,
+	"ja_JP": enNumberLocale,
+	"ko_KR": enNumberLocale,
+	"lt_LT": deNumberLocale,
+	"lv_LV": ruNumberLocale,
+	"mk_MK": ruNumberLocale,
+	"mn_MN": deNumberLocale,
+	"ms_MY": enNumberLocale,
+	"nb_NO": deNumberLocale,
+	"nl_BE": frNumberLocale,
+	"nl_NL": frNumberLocale,
+	"no_NO": deNumberLocale,
+	"pl_PL": frNumberLocale,
+	"pt_BR": frNumberLocale,
+	"pt_PT": frNumberLocale,
+	"rm_CH": chNumberLocale,
+	"ro_RO": deNumberLocale,
+	"ru_RU": ruNumberLocale,
+	"ru_UA": deNumberLocale,
+	"sk_SK": ruNumberLocale,
+	"sl_SI": frNumberLocale,
+	"sq_AL": deNumberLocale,
+	"sr_RS": saNumberLocale,
+	"sv_FI": ruNumberLocale,
+	"sv_SE": ruNumberLocale,
+	"ta_IN": inNumberLocale,
+	"te_IN": inNumberLocale,
+	"th_TH": enNumberLocale,
+	"tr_TR": deNumberLocale,
+	"uk_UA": deNumberLocale,
+	"ur_PK": enNumberLocale,
+	"vi_VN": deNumberLocale,
+	"zh_CN": enNumberLocale,
+	"zh_HK": enNumberLocale,
+	"zh_TW": enNumberLocale,
+}
+
+// maxFormatDecimals is the maximum number of decimals FORMAT rounds numbers to.
+const maxFormatDecimals = 30
+
+// Format formats a number with a number of decimals and groups of thousands, in the manner of a locale that is en_US
+// by default.
+type Format struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Format)(nil)
+
+// NewFormat creates a new FORMAT function.
+func NewFormat(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, sql.ErrInvalidArgumentNumber.New("FORMAT", "2 or 3", len(args))
+	}
+	return &Format{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *Format) FunctionName() string {
+	return "format"
+}
+
+// Type implements the Expression interface.
+func (f *Format) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (f *Format) IsNullable() bool { return true }
+
+func (f *Format) String() string {
+	return fmt.Sprintf("FORMAT(%s)", joinExpressions(f.args))
+}
+
+// WithChildren implements the Expression interface.
+func (f *Format) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.args))
+	}
+	return NewFormat(ctx, children...)
+}
+
+// Resolved implements the Expression interface.
+func (f *Format) Resolved() bool {
+	return resolvedExpressions(f.args)
+}
+
+// Children implements the Expression interface.
+func (f *Format) Children() []sql.Expression { return f.args }
+
+// Eval implements the Expression interface. As in MySQL, locales that are not known are replaced by en_US with a
+// warning, and the number is rounded half away from zero.
+func (f *Format) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.args[0].Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	decimals, ok, err := evalInt64(ctx, f.args[1], row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if decimals < 0 {
+		decimals = 0
+	} else if decimals > maxFormatDecimals {
+		decimals = maxFormatDecimals
+	}
+
+	locale := enNumberLocale
+	if len(f.args) == 3 {
+		name, ok, err := evalLongText(ctx, f.args[2], row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			if locale, ok = numberLocales[name]; !ok {
+				ctx.Warn(1649, "Unknown locale: '%s'", name)
+				locale = enNumberLocale
+			}
+		}
+	}
+
+	d, err := formatNumber(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return locale.format(d.StringFixed(int32(decimals))), nil
+}
+
+// formatNumber returns the number to format for the value given. Decimals, which are given as strings, and integers
+// are kept exact, and other values are converted to float64.
+func formatNumber(val interface{}) (decimal.Decimal, error) {
+	switch val := val.(type) {
+	case decimal.Decimal:
+		return val, nil
+	case int8, int16, int32, int64, uint8, uint16, uint32:
+		n, _ := sql.Int64.Convert(val)
+		return decimal.NewFromInt(n.(int64)), nil
+	case uint64:
+		return decimal.NewFromBigInt(new(big.Int).SetUint64(val), 0), nil
+	case string:
+		if d, err := decimal.NewFromString(val); err == nil {
+			return d, nil
+		}
+	}
+
+	n, err := sql.Float64.Convert(val)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return decimal.NewFromFloat(n.(float64)), nil
+}
+
+// format returns the number given, formatted with a decimal point, as formatted in this locale.
+func (l numberLocale) format(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	intPart, fracPart := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		intPart, fracPart = number[:i], number[i+1:]
+	}
+
+	var groups []string
+	if len(l.grouping) == 0 {
+		groups = []string{intPart}
+	}
+	for i := 0; len(l.grouping) > 0 && len(intPart) > 0; i++ {
+		size := l.grouping[len(l.grouping)-1]
+		if i < len(l.grouping) {
+			size = l.grouping[i]
+		}
+		if size >= len(intPart) {
+			size = len(intPart)
+		}
+		groups = append([]string{intPart[len(intPart)-size:]}, groups...)
+		intPart = intPart[:len(intPart)-size]
+	}
+
+	result := sign + strings.Join(groups, l.thousandsSep)
+	if fracPart != "" {
+		result += l.decimalPoint + fracPart
+	}
+	return result
+}
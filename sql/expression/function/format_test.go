@@ -0,0 +1,70 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestFormat(t *testing.T) {
+	f := sql.FunctionN{Name: "format", Fn: NewFormat}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 2)
+	tf.AddSucceeding(nil, 12332.2, nil)
+	tf.AddSucceeding("12,332.1235", 12332.123456, 4)
+	tf.AddSucceeding("12,332.1000", 12332.1, 4)
+	tf.AddSucceeding("12,332", 12332.2, 0)
+	tf.AddSucceeding("12,333", 12332.5, 0)
+	tf.AddSucceeding("12,332", 12332.2, -1)
+	tf.AddSucceeding("-1,234,567.00", -1234567, 2)
+	tf.AddSucceeding("123.00", "123", 2)
+	tf.AddSucceeding("1,234.57", decimal.RequireFromString("1234.567"), 2)
+	tf.AddSucceeding("12,345,678,901,234,567.89", "12345678901234567.89", 2)
+	tf.AddSucceeding("18,446,744,073,709,551,615.0", uint64(18446744073709551615), 1)
+	tf.AddSucceeding("12.332,20", 12332.2, 2, "de_DE")
+	tf.AddSucceeding("12'332.20", 12332.2, 2, "de_CH")
+	tf.AddSucceeding("1,23,45,678.00", 12345678, 2, "en_IN")
+	tf.AddSucceeding("1234567,89", 1234567.891, 2, "fr_FR")
+	tf.AddSucceeding("1.234.567,89", 1234567.891, 2, "es_ES")
+	tf.AddSucceeding("1234567,89", 1234567.891, 2, "it_IT")
+	tf.AddSucceeding("1 234 567,89", 1234567.891, 2, "ru_RU")
+	tf.AddSucceeding("1234567.9", 1234567.891, 1, "sr_RS")
+	tf.AddSucceeding("12,332.20", 12332.2, 2, nil)
+	tf.Test(t, nil, nil)
+}
+
+func TestFormatUnknownLocale(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	f, err := NewFormat(ctx,
+		expression.NewLiteral(12332.2, sql.Float64),
+		expression.NewLiteral(2, sql.Int64),
+		expression.NewLiteral("xx_XX", sql.LongText),
+	)
+	require.NoError(err)
+
+	v, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("12,332.20", v)
+	require.Len(ctx.Warnings(), 1)
+	require.Equal(1649, ctx.Warnings()[0].Code)
+}
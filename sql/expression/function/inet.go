@@ -0,0 +1,493 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// inet6AddressType is the type of the binary addresses returned by INET6_ATON, which are 4 bytes long for IPv4
+// addresses and 16 bytes long for IPv6 addresses.
+var inet6AddressType = sql.MustCreateBinary(sqltypes.VarBinary, net.IPv6len)
+
+// InetAton returns the numeric value of an IPv4 address given in dotted-quad notation. As in MySQL, the address may
+// be in short form, in which case its last part stands for all of the remaining bytes: '127.1' is '127.0.0.1'. The
+// result is NULL if the address is malformed.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_inet-aton
+type InetAton struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*InetAton)(nil)
+
+// NewInetAton creates a new INET_ATON function.
+func NewInetAton(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &InetAton{NewUnaryFunc(arg, "INET_ATON", sql.Uint64)}
+}
+
+// Eval implements the Expression interface.
+func (i *InetAton) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, i.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	var result, part uint64
+	dots := 0
+	last := byte('.')
+	for j := 0; j < len(s); j++ {
+		prev := last
+		last = s[j]
+		switch {
+		case isDigit(last):
+			if part = part*10 + uint64(last-'0'); part > math.MaxUint8 {
+				return nil, nil
+			}
+		case last == '.':
+			// Every part of the address must have digits
+			if dots++; dots > 3 || prev == '.' {
+				return nil, nil
+			}
+			result = result<<8 + part
+			part = 0
+		default:
+			return nil, nil
+		}
+	}
+	// An address may not be empty, nor end with a dot
+	if last == '.' {
+		return nil, nil
+	}
+	// The parts before the last one are the leading bytes of the address, and the last one fills the rest of it
+	return result<<(8*uint(4-dots)) + part, nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *InetAton) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewInetAton(ctx, children[0]), nil
+}
+
+// InetNtoa returns the dotted-quad notation of an IPv4 address given as a number. The result is NULL if the number is
+// not that of an IPv4 address.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_inet-ntoa
+type InetNtoa struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*InetNtoa)(nil)
+
+// NewInetNtoa creates a new INET_NTOA function.
+func NewInetNtoa(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &InetNtoa{NewUnaryFunc(arg, "INET_NTOA", sql.LongText)}
+}
+
+// Eval implements the Expression interface.
+func (i *InetNtoa) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := i.EvalChild(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	// As in MySQL, a string that is not a number stands for 0
+	f, err := sql.Float64.Convert(val)
+	if err != nil {
+		ctx.Warn(1292, "Truncated incorrect INTEGER value: '%v'", val)
+		f = float64(0)
+	}
+	n := math.Round(f.(float64))
+	if n < 0 || n > math.MaxUint32 {
+		return nil, nil
+	}
+
+	var ip [net.IPv4len]byte
+	binary.BigEndian.PutUint32(ip[:], uint32(n))
+	return ipv4String(ip[:]), nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *InetNtoa) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewInetNtoa(ctx, children[0]), nil
+}
+
+// Inet6Aton returns the binary value of an IPv4 or IPv6 address, which is 4 bytes long for IPv4 addresses and 16 bytes
+// long for IPv6 addresses. Unlike INET_ATON, IPv4 addresses must have all of their 4 parts. The result is NULL if the
+// address is malformed.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_inet6-aton
+type Inet6Aton struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Inet6Aton)(nil)
+
+// NewInet6Aton creates a new INET6_ATON function.
+func NewInet6Aton(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Inet6Aton{NewUnaryFunc(arg, "INET6_ATON", inet6AddressType)}
+}
+
+// Eval implements the Expression interface.
+func (i *Inet6Aton) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, i.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if ip := parseIPv4(s); ip != nil {
+		return string(ip), nil
+	}
+	if ip := parseIPv6(s); ip != nil {
+		return string(ip), nil
+	}
+	return nil, nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *Inet6Aton) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewInet6Aton(ctx, children[0]), nil
+}
+
+// Inet6Ntoa returns the text of an IPv4 or IPv6 address given as a binary string, as returned by INET6_ATON. IPv6
+// addresses are written in lowercase, with their longest run of zeros shortened, and IPv4-compatible and IPv4-mapped
+// addresses end with the IPv4 address in dotted-quad notation. The result is NULL if the argument is not a binary
+// string of 4 or 16 bytes.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_inet6-ntoa
+type Inet6Ntoa struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Inet6Ntoa)(nil)
+
+// NewInet6Ntoa creates a new INET6_NTOA function.
+func NewInet6Ntoa(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Inet6Ntoa{NewUnaryFunc(arg, "INET6_NTOA", sql.LongText)}
+}
+
+// Eval implements the Expression interface.
+func (i *Inet6Ntoa) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	ip, ok, err := evalBinaryAddress(ctx, i.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	switch len(ip) {
+	case net.IPv4len:
+		return ipv4String(ip), nil
+	case net.IPv6len:
+		return ipv6String(ip), nil
+	default:
+		return nil, nil
+	}
+}
+
+// WithChildren implements the Expression interface.
+func (i *Inet6Ntoa) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewInet6Ntoa(ctx, children[0]), nil
+}
+
+// IsIPv4 returns whether a string is a valid IPv4 address, in the notation accepted by INET6_ATON.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_is-ipv4
+type IsIPv4 struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*IsIPv4)(nil)
+
+// NewIsIPv4 creates a new IS_IPV4 function.
+func NewIsIPv4(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &IsIPv4{NewUnaryFunc(arg, "IS_IPV4", sql.Boolean)}
+}
+
+// IsNullable implements the Expression interface.
+func (i *IsIPv4) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (i *IsIPv4) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, i.Child, row)
+	if err != nil || !ok {
+		return false, err
+	}
+	return parseIPv4(s) != nil, nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *IsIPv4) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewIsIPv4(ctx, children[0]), nil
+}
+
+// IsIPv6 returns whether a string is a valid IPv6 address, in the notation accepted by INET6_ATON.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_is-ipv6
+type IsIPv6 struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*IsIPv6)(nil)
+
+// NewIsIPv6 creates a new IS_IPV6 function.
+func NewIsIPv6(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &IsIPv6{NewUnaryFunc(arg, "IS_IPV6", sql.Boolean)}
+}
+
+// IsNullable implements the Expression interface.
+func (i *IsIPv6) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (i *IsIPv6) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, i.Child, row)
+	if err != nil || !ok {
+		return false, err
+	}
+	return parseIPv6(s) != nil, nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *IsIPv6) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewIsIPv6(ctx, children[0]), nil
+}
+
+// IsIPv4Compat returns whether a binary string, as returned by INET6_ATON, is an IPv4-compatible IPv6 address, which
+// is an IPv4 address other than 0.0.0.0 and 0.0.0.1 preceded by 12 zero bytes.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_is-ipv4-compat
+type IsIPv4Compat struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*IsIPv4Compat)(nil)
+
+// NewIsIPv4Compat creates a new IS_IPV4_COMPAT function.
+func NewIsIPv4Compat(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &IsIPv4Compat{NewUnaryFunc(arg, "IS_IPV4_COMPAT", sql.Boolean)}
+}
+
+// IsNullable implements the Expression interface.
+func (i *IsIPv4Compat) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (i *IsIPv4Compat) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	ip, ok, err := evalBinaryAddress(ctx, i.Child, row)
+	if err != nil || !ok {
+		return false, err
+	}
+	return isIPv4Compat(ip), nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *IsIPv4Compat) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewIsIPv4Compat(ctx, children[0]), nil
+}
+
+// IsIPv4Mapped returns whether a binary string, as returned by INET6_ATON, is an IPv4-mapped IPv6 address, which is
+// an IPv4 address preceded by 10 zero bytes and 2 0xFF bytes.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_is-ipv4-mapped
+type IsIPv4Mapped struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*IsIPv4Mapped)(nil)
+
+// NewIsIPv4Mapped creates a new IS_IPV4_MAPPED function.
+func NewIsIPv4Mapped(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &IsIPv4Mapped{NewUnaryFunc(arg, "IS_IPV4_MAPPED", sql.Boolean)}
+}
+
+// IsNullable implements the Expression interface.
+func (i *IsIPv4Mapped) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (i *IsIPv4Mapped) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	ip, ok, err := evalBinaryAddress(ctx, i.Child, row)
+	if err != nil || !ok {
+		return false, err
+	}
+	return isIPv4Mapped(ip), nil
+}
+
+// WithChildren implements the Expression interface.
+func (i *IsIPv4Mapped) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 1)
+	}
+	return NewIsIPv4Mapped(ctx, children[0]), nil
+}
+
+// evalBinaryAddress returns the value of an expression that holds a binary address, or false if it is NULL or, as in
+// MySQL, if it is not a binary string.
+func evalBinaryAddress(ctx *sql.Context, e sql.Expression, row sql.Row) ([]byte, bool, error) {
+	if !sql.IsBlob(e.Type()) {
+		return nil, false, nil
+	}
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, false, err
+	}
+	val, err = sql.LongBlob.Convert(val)
+	if err != nil {
+		return nil, false, err
+	}
+	return []byte(val.(string)), true, nil
+}
+
+// parseIPv4 returns the 4 bytes of an IPv4 address in dotted-quad notation, or nil if the address is malformed. Each
+// of its parts is made of 1 to 3 digits.
+func parseIPv4(s string) []byte {
+	parts := strings.Split(s, ".")
+	if len(parts) != net.IPv4len {
+		return nil
+	}
+	ip := make([]byte, net.IPv4len)
+	for i, part := range parts {
+		if len(part) == 0 || len(part) > 3 {
+			return nil
+		}
+		for j := 0; j < len(part); j++ {
+			if !isDigit(part[j]) {
+				return nil
+			}
+		}
+		n, _ := strconv.Atoi(part)
+		if n > math.MaxUint8 {
+			return nil
+		}
+		ip[i] = byte(n)
+	}
+	return ip
+}
+
+// parseIPv6 returns the 16 bytes of an IPv6 address, which may end with an IPv4 address in dotted-quad notation, or
+// nil if the address is malformed. Zone identifiers are not allowed.
+func parseIPv6(s string) []byte {
+	if !strings.Contains(s, ":") {
+		return nil
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil
+	}
+	return ip.To16()
+}
+
+// ipv4String returns the dotted-quad notation of an IPv4 address.
+func ipv4String(ip []byte) string {
+	return fmt.Sprintf("%d.%d.%d.%d", ip[0], ip[1], ip[2], ip[3])
+}
+
+// ipv6String returns the text of an IPv6 address as MySQL writes it: its longest run of at least 2 zero words, or the
+// first of them, is replaced by "::", and IPv4-compatible and IPv4-mapped addresses end with an IPv4 address.
+func ipv6String(ip []byte) string {
+	var words [net.IPv6len / 2]uint16
+	for i := range words {
+		words[i] = binary.BigEndian.Uint16(ip[2*i:])
+	}
+
+	gapStart, gapLength := -1, 0
+	for i := 0; i < len(words); {
+		if words[i] != 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(words) && words[j] == 0 {
+			j++
+		}
+		if j-i > gapLength {
+			gapStart, gapLength = i, j-i
+		}
+		i = j
+	}
+	if gapLength < 2 {
+		gapStart, gapLength = -1, 0
+	}
+
+	if gapStart == 0 && (gapLength == 6 || (gapLength == 5 && words[5] == 0xFFFF)) {
+		if gapLength == 6 {
+			return "::" + ipv4String(ip[12:])
+		}
+		return "::ffff:" + ipv4String(ip[12:])
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(words); i++ {
+		if i == gapStart {
+			sb.WriteString("::")
+			i += gapLength - 1
+			continue
+		}
+		if i > 0 && i != gapStart+gapLength {
+			sb.WriteByte(':')
+		}
+		sb.WriteString(strconv.FormatUint(uint64(words[i]), 16))
+	}
+	return sb.String()
+}
+
+// isIPv4Compat returns whether a binary address is an IPv4-compatible IPv6 address.
+func isIPv4Compat(ip []byte) bool {
+	if len(ip) != net.IPv6len {
+		return false
+	}
+	for _, b := range ip[:12] {
+		if b != 0 {
+			return false
+		}
+	}
+	return binary.BigEndian.Uint32(ip[12:]) > 1
+}
+
+// isIPv4Mapped returns whether a binary address is an IPv4-mapped IPv6 address.
+func isIPv4Mapped(ip []byte) bool {
+	if len(ip) != net.IPv6len {
+		return false
+	}
+	for _, b := range ip[:10] {
+		if b != 0 {
+			return false
+		}
+	}
+	return ip[10] == 0xFF && ip[11] == 0xFF
+}
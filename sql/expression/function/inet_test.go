@@ -0,0 +1,153 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestInetAton(t *testing.T) {
+	f := sql.Function1{Name: "inet_aton", Fn: NewInetAton}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(uint64(167773449), "10.0.5.9")
+	tf.AddSucceeding(uint64(4294967295), "255.255.255.255")
+	tf.AddSucceeding(uint64(0), "0.0.0.0")
+	tf.AddSucceeding(uint64(2130706433), "127.1")
+	tf.AddSucceeding(uint64(167837701), "10.1.5")
+	tf.AddSucceeding(uint64(127), "127")
+	tf.AddSucceeding(uint64(127), 127)
+	tf.AddSucceeding(nil, "")
+	tf.AddSucceeding(nil, "10.0.5.")
+	tf.AddSucceeding(nil, "1..2")
+	tf.AddSucceeding(nil, ".1.2")
+	tf.AddSucceeding(nil, "10.0.5.256")
+	tf.AddSucceeding(nil, "1.2.3.4.5")
+	tf.AddSucceeding(nil, "10.0.5.9 ")
+	tf.AddSucceeding(nil, "::1")
+	tf.Test(t, nil, nil)
+}
+
+func TestInetNtoa(t *testing.T) {
+	f := sql.Function1{Name: "inet_ntoa", Fn: NewInetNtoa}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("10.0.5.9", 167773449)
+	tf.AddSucceeding("10.0.5.9", "167773449")
+	tf.AddSucceeding("0.0.0.0", 0)
+	tf.AddSucceeding("255.255.255.255", uint64(4294967295))
+	tf.AddSucceeding("0.0.0.2", 1.5)
+	tf.AddSucceeding("0.0.0.0", "not a number")
+	tf.AddSucceeding(nil, -1)
+	tf.AddSucceeding(nil, uint64(4294967296))
+	tf.Test(t, sql.NewEmptyContext(), nil)
+}
+
+func TestInet6Aton(t *testing.T) {
+	f := sql.Function1{Name: "inet6_aton", Fn: NewInet6Aton}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("\x0a\x00\x05\x09", "10.0.5.9")
+	tf.AddSucceeding("\x0a\x00\x05\x09", "010.000.005.009")
+	tf.AddSucceeding(unhexString(t, "FDFE0000000000005A55CAFFFEFA9089"), "fdfe::5a55:caff:fefa:9089")
+	tf.AddSucceeding(unhexString(t, "00000000000000000000FFFF0A000509"), "::ffff:10.0.5.9")
+	tf.AddSucceeding(unhexString(t, "00000000000000000000000000000001"), "::1")
+	tf.AddSucceeding(nil, "")
+	tf.AddSucceeding(nil, "127.1")
+	tf.AddSucceeding(nil, "10.0.5.256")
+	tf.AddSucceeding(nil, "0010.0.5.9")
+	tf.AddSucceeding(nil, "fe80::1%eth0")
+	tf.AddSucceeding(nil, "1:2:3:4:5:6:7:8:9")
+	tf.AddSucceeding(nil, 167773449)
+	tf.Test(t, nil, nil)
+}
+
+func TestInet6Ntoa(t *testing.T) {
+	f := sql.Function1{Name: "inet6_ntoa", Fn: NewInet6Ntoa}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("10.0.5.9", []byte{10, 0, 5, 9})
+	tf.AddSucceeding("fdfe::5a55:caff:fefa:9089", unhexBytes(t, "FDFE0000000000005A55CAFFFEFA9089"))
+	tf.AddSucceeding("::ffff:10.0.5.9", unhexBytes(t, "00000000000000000000FFFF0A000509"))
+	tf.AddSucceeding("::10.0.5.9", unhexBytes(t, "0000000000000000000000000A000509"))
+	tf.AddSucceeding("::1", unhexBytes(t, "00000000000000000000000000000001"))
+	tf.AddSucceeding("::", unhexBytes(t, "00000000000000000000000000000000"))
+	tf.AddSucceeding("1:0:0:2::3", unhexBytes(t, "00010000000000020000000000000003"))
+	tf.AddSucceeding("1:0:2:3:4:5:6:7", unhexBytes(t, "00010000000200030004000500060007"))
+	tf.AddSucceeding("1::", unhexBytes(t, "00010000000000000000000000000000"))
+	tf.AddSucceeding(nil, []byte{10, 0, 5})
+	tf.AddSucceeding(nil, "abcd")
+	tf.AddSucceeding(nil, 167773449)
+	tf.Test(t, nil, nil)
+}
+
+func TestIsIPv4AndIPv6(t *testing.T) {
+	isIPv4 := sql.Function1{Name: "is_ipv4", Fn: NewIsIPv4}
+	tf := NewTestFactory(isIPv4.Fn)
+	tf.AddSucceeding(false, nil)
+	tf.AddSucceeding(true, "10.0.5.9")
+	tf.AddSucceeding(false, "10.0.5.256")
+	tf.AddSucceeding(false, "127.1")
+	tf.AddSucceeding(false, "::1")
+	tf.Test(t, nil, nil)
+
+	isIPv6 := sql.Function1{Name: "is_ipv6", Fn: NewIsIPv6}
+	tf = NewTestFactory(isIPv6.Fn)
+	tf.AddSucceeding(false, nil)
+	tf.AddSucceeding(true, "::1")
+	tf.AddSucceeding(true, "::ffff:10.0.5.9")
+	tf.AddSucceeding(false, "10.0.5.9")
+	tf.AddSucceeding(false, "::g")
+	tf.Test(t, nil, nil)
+}
+
+func TestIsIPv4CompatAndMapped(t *testing.T) {
+	compat := unhexBytes(t, "0000000000000000000000000A000509")
+	mapped := unhexBytes(t, "00000000000000000000FFFF0A000509")
+
+	isIPv4Compat := sql.Function1{Name: "is_ipv4_compat", Fn: NewIsIPv4Compat}
+	tf := NewTestFactory(isIPv4Compat.Fn)
+	tf.AddSucceeding(false, nil)
+	tf.AddSucceeding(true, compat)
+	tf.AddSucceeding(false, mapped)
+	tf.AddSucceeding(false, unhexBytes(t, "00000000000000000000000000000001"))
+	tf.AddSucceeding(false, []byte{10, 0, 5, 9})
+	tf.AddSucceeding(false, string(compat))
+	tf.Test(t, nil, nil)
+
+	isIPv4Mapped := sql.Function1{Name: "is_ipv4_mapped", Fn: NewIsIPv4Mapped}
+	tf = NewTestFactory(isIPv4Mapped.Fn)
+	tf.AddSucceeding(false, nil)
+	tf.AddSucceeding(true, mapped)
+	tf.AddSucceeding(false, compat)
+	tf.AddSucceeding(false, []byte{10, 0, 5, 9})
+	tf.AddSucceeding(false, string(mapped))
+	tf.Test(t, nil, nil)
+}
+
+func unhexBytes(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func unhexString(t *testing.T, s string) string {
+	return string(unhexBytes(t, s))
+}
@@ -99,27 +99,23 @@ func (l *Length) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	var content string
-	switch l.Child.Type() {
-	case sql.TinyBlob, sql.Blob, sql.MediumBlob, sql.LongBlob:
-		val, err = sql.LongBlob.Convert(val)
-		if err != nil {
-			return nil, err
-		}
-
-		content = val.(string)
-	default:
-		val, err = sql.LongText.Convert(val)
-		if err != nil {
-			return nil, err
-		}
-
-		content = val.(string)
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	content := val.(string)
+
+	// Strings are counted in the character set of their type, in which binary strings are made of bytes
+	charset := sql.Collation_Default.CharacterSet()
+	if st, ok := l.Child.Type().(sql.StringType); ok {
+		charset = st.CharacterSet()
 	}
 
 	if l.CountType == NumBytes {
+		return int32(charset.EncodedLength(content)), nil
+	}
+	if charset == sql.CharacterSet_binary {
 		return int32(len(content)), nil
 	}
-
 	return int32(utf8.RuneCountInString(content)), nil
 }
@@ -22,17 +22,30 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Rand returns a random float 0 <= x < 1. If it has an argument, that argument will be used to seed the random number
-// generator, effectively turning it into a hash on that value.
+// generator. As in MySQL, a constant seed gives the same sequence of numbers to every query, as the generator is only
+// seeded once, while a seed that depends on the row seeds the generator again for every row, effectively turning it
+// into a hash on that value.
 type Rand struct {
 	Child sql.Expression
+
+	seeded *seededRand
+}
+
+// seededRand is the random number generator of a RAND function with a constant seed, which is seeded when the first
+// number is generated.
+type seededRand struct {
+	mu  sync.Mutex
+	rng *rand.Rand
 }
 
 var _ sql.Expression = (*Rand)(nil)
@@ -45,7 +58,7 @@ func NewRand(ctx *sql.Context, exprs ...sql.Expression) (sql.Expression, error)
 		return nil, sql.ErrInvalidArgumentNumber.New("rand", "0 or 1", len(exprs))
 	}
 	if len(exprs) > 0 {
-		return &Rand{Child: exprs[0]}, nil
+		return &Rand{Child: exprs[0], seeded: &seededRand{}}, nil
 	}
 	return &Rand{}, nil
 }
@@ -108,12 +121,33 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return rand.Float64(), nil
 	}
 
-	// For child expressions, the mysql semantics are to seed the PRNG with an int64 value of the expression given. For
-	// non-numeric types, the seed will always be 0, which means that rand() will always return the same result for all
-	// non-numeric seed arguments.
+	if !canBeCached(r.Child) {
+		seed, err := r.evalSeed(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		return rand.New(rand.NewSource(seed)).Float64(), nil
+	}
+
+	r.seeded.mu.Lock()
+	defer r.seeded.mu.Unlock()
+	if r.seeded.rng == nil {
+		seed, err := r.evalSeed(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		r.seeded.rng = rand.New(rand.NewSource(seed))
+	}
+	return r.seeded.rng.Float64(), nil
+}
+
+// evalSeed returns the seed given to the function. For child expressions, the mysql semantics are to seed the PRNG
+// with an int64 value of the expression given. For non-numeric types, the seed will always be 0, which means that
+// rand() will always return the same results for all non-numeric seed arguments.
+func (r *Rand) evalSeed(ctx *sql.Context, row sql.Row) (int64, error) {
 	e, err := r.Child.Eval(ctx, row)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	var seed int64
@@ -123,8 +157,7 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			seed = e.(int64)
 		}
 	}
-
-	return rand.New(rand.NewSource(seed)).Float64(), nil
+	return seed, nil
 }
 
 // Sin is the SIN function
@@ -356,6 +389,76 @@ func (a *Atan) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.E
 	return NewAtan(ctx, children[0]), nil
 }
 
+// Atan2 is the ATAN2 function, which returns the arc tangent of y / x, using the signs of both arguments to find the
+// quadrant of the result. It is also the ATAN function with two arguments.
+type Atan2 struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Atan2)(nil)
+
+// NewAtan2 returns a new ATAN2 function expression
+func NewAtan2(ctx *sql.Context, y, x sql.Expression) sql.Expression {
+	return &Atan2{expression.BinaryExpression{Left: y, Right: x}}
+}
+
+// newAtanOrAtan2 returns a new ATAN function expression with one argument, or a new ATAN2 function expression with two.
+func newAtanOrAtan2(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 1:
+		return NewAtan(ctx, args[0]), nil
+	case 2:
+		return NewAtan2(ctx, args[0], args[1]), nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("ATAN", "1 or 2", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *Atan2) FunctionName() string {
+	return "atan2"
+}
+
+// Type implements sql.Expression
+func (a *Atan2) Type() sql.Type {
+	return sql.Float64
+}
+
+func (a *Atan2) String() string {
+	return fmt.Sprintf("ATAN2(%s, %s)", a.Left, a.Right)
+}
+
+// Eval implements sql.Expression
+func (a *Atan2) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	y, err := a.Left.Eval(ctx, row)
+	if err != nil || y == nil {
+		return nil, err
+	}
+	x, err := a.Right.Eval(ctx, row)
+	if err != nil || x == nil {
+		return nil, err
+	}
+
+	y, err = sql.Float64.Convert(y)
+	if err != nil {
+		return nil, err
+	}
+	x, err = sql.Float64.Convert(x)
+	if err != nil {
+		return nil, err
+	}
+
+	return math.Atan2(y.(float64), x.(float64)), nil
+}
+
+// WithChildren implements sql.Expression
+func (a *Atan2) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
+	}
+	return NewAtan2(ctx, children[0], children[1]), nil
+}
+
 type Cot struct {
 	*UnaryFunc
 }
@@ -492,6 +595,64 @@ func (c *Crc32) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	bytes, err := crc32Bytes(arg, "crc32")
+	if err != nil {
+		return nil, err
+	}
+	return crc32.ChecksumIEEE(bytes), nil
+}
+
+// WithChildren implements sql.Expression
+func (c *Crc32) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCrc32(ctx, children[0]), nil
+}
+
+// Crc32c is the CRC32C function, which computes the checksum of a value with the Castagnoli polynomial rather than
+// the IEEE one used by CRC32.
+type Crc32c struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Crc32c)(nil)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// NewCrc32c returns a new CRC32C function expression
+func NewCrc32c(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Crc32c{NewUnaryFunc(arg, "CRC32C", sql.Uint32)}
+}
+
+// Eval implements sql.Expression
+func (c *Crc32c) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := c.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if arg == nil {
+		return nil, nil
+	}
+
+	bytes, err := crc32Bytes(arg, "crc32c")
+	if err != nil {
+		return nil, err
+	}
+	return crc32.Checksum(bytes, castagnoliTable), nil
+}
+
+// WithChildren implements sql.Expression
+func (c *Crc32c) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewCrc32c(ctx, children[0]), nil
+}
+
+// crc32Bytes returns the bytes of the value of which a checksum is computed.
+func crc32Bytes(arg interface{}, funcName string) ([]byte, error) {
 	var bytes []byte
 	switch val := arg.(type) {
 	case string:
@@ -525,18 +686,10 @@ func (c *Crc32) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			bytes = []byte{0}
 		}
 	default:
-		return nil, ErrInvalidArgument.New("crc32", fmt.Sprint(arg))
+		return nil, ErrInvalidArgument.New(funcName, fmt.Sprint(arg))
 	}
 
-	return crc32.ChecksumIEEE(bytes), nil
-}
-
-// WithChildren implements sql.Expression
-func (c *Crc32) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
-	if len(children) != 1 {
-		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
-	}
-	return NewCrc32(ctx, children[0]), nil
+	return bytes, nil
 }
 
 func floatToString(f float64) string {
@@ -636,3 +789,206 @@ func (s *Sign) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.E
 	}
 	return NewSign(ctx, children[0]), nil
 }
+
+// NewMod returns a new MOD function expression, which is the % operator.
+func NewMod(ctx *sql.Context, n, m sql.Expression) sql.Expression {
+	return expression.NewMod(n, m)
+}
+
+// Pi is the PI function, which returns the value of π.
+type Pi struct {
+	NoArgFunc
+}
+
+var _ sql.FunctionExpression = Pi{}
+
+// NewPi returns a new PI function expression
+func NewPi(ctx *sql.Context) sql.Expression {
+	return Pi{NoArgFunc{"pi", sql.Float64}}
+}
+
+// Eval implements sql.Expression
+func (p Pi) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return math.Pi, nil
+}
+
+// WithChildren implements sql.Expression
+func (p Pi) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(ctx, p, children)
+}
+
+// Exp is the EXP function, which returns e raised to the power of its argument.
+type Exp struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Exp)(nil)
+
+// NewExp returns a new EXP function expression
+func NewExp(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Exp{NewUnaryFunc(arg, "EXP", sql.Float64)}
+}
+
+// Eval implements sql.Expression
+func (e *Exp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := e.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == nil {
+		return nil, nil
+	}
+
+	n, err := sql.Float64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+
+	result := math.Exp(n.(float64))
+	if math.IsInf(result, 0) {
+		return nil, sql.ErrOutOfRange.New(e, "DOUBLE")
+	}
+	return result, nil
+}
+
+// WithChildren implements sql.Expression
+func (e *Exp) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	return NewExp(ctx, children[0]), nil
+}
+
+// maxTruncateDigits is the number of decimal places beyond which TRUNCATE has nothing left to truncate, or truncates
+// every digit of a number.
+const maxTruncateDigits = 100
+
+// Truncate is the TRUNCATE function, which returns a number truncated to a number of decimal places. If that number is
+// negative, as many digits of the integer part of the number are set to 0. Decimals are truncated exactly, and integers
+// stay integers.
+type Truncate struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Truncate)(nil)
+
+// NewTruncate returns a new TRUNCATE function expression
+func NewTruncate(ctx *sql.Context, x, d sql.Expression) sql.Expression {
+	return &Truncate{expression.BinaryExpression{Left: x, Right: d}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *Truncate) FunctionName() string {
+	return "truncate"
+}
+
+// Type implements sql.Expression. The scale of a decimal is reduced to the number of decimal places kept, if that
+// number is a literal.
+func (t *Truncate) Type() sql.Type {
+	typ := t.Left.Type()
+	if dt, ok := typ.(sql.DecimalType); ok {
+		lit, ok := t.Right.(*expression.Literal)
+		if !ok {
+			return typ
+		}
+		d, err := sql.Int64.Convert(lit.Value())
+		if err != nil || d == nil {
+			return typ
+		}
+		scale := dt.Scale()
+		if d.(int64) < int64(scale) {
+			scale = 0
+			if d.(int64) > 0 {
+				scale = uint8(d.(int64))
+			}
+		}
+		return sql.MustCreateDecimalType(dt.Precision()-dt.Scale()+scale, scale)
+	}
+	if sql.IsInteger(typ) {
+		return typ
+	}
+	return sql.Float64
+}
+
+func (t *Truncate) String() string {
+	return fmt.Sprintf("TRUNCATE(%s, %s)", t.Left, t.Right)
+}
+
+// Eval implements sql.Expression
+func (t *Truncate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	x, err := t.Left.Eval(ctx, row)
+	if err != nil || x == nil {
+		return nil, err
+	}
+	d, ok, err := evalInt64(ctx, t.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if d > maxTruncateDigits {
+		d = maxTruncateDigits
+	} else if d < -maxTruncateDigits {
+		d = -maxTruncateDigits
+	}
+
+	typ := t.Type()
+	switch {
+	case sql.IsDecimal(typ):
+		dec, err := t.Left.Type().(sql.DecimalType).ConvertToDecimal(x)
+		if err != nil {
+			return nil, err
+		}
+		return typ.Convert(truncateDecimal(dec.Decimal, d))
+	case sql.IsUnsigned(typ):
+		n, err := sql.Uint64.Convert(x)
+		if err != nil {
+			return nil, err
+		}
+		u := n.(uint64)
+		if d < -19 {
+			u = 0
+		} else if d < 0 {
+			u -= u % uint64(math.Pow10(int(-d)))
+		}
+		return typ.Convert(u)
+	case sql.IsInteger(typ):
+		n, err := sql.Int64.Convert(x)
+		if err != nil {
+			return nil, err
+		}
+		i := n.(int64)
+		if d < -18 {
+			i = 0
+		} else if d < 0 {
+			i -= i % int64(math.Pow10(int(-d)))
+		}
+		return typ.Convert(i)
+	default:
+		f, err := sql.Float64.Convert(x)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsInf(f.(float64), 0) || math.IsNaN(f.(float64)) {
+			return f, nil
+		}
+		// The float is truncated as the shortest decimal that stands for it, so that 1.1 is not truncated to 1.09
+		result, _ := truncateDecimal(decimal.NewFromFloat(f.(float64)), d).Float64()
+		return result, nil
+	}
+}
+
+// truncateDecimal returns a decimal truncated to the number of decimal places given, which may be negative.
+func truncateDecimal(dec decimal.Decimal, places int64) decimal.Decimal {
+	if places >= 0 {
+		return dec.Truncate(int32(places))
+	}
+	return dec.Shift(int32(places)).Truncate(0).Shift(int32(-places))
+}
+
+// WithChildren implements sql.Expression
+func (t *Truncate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 2)
+	}
+	return NewTruncate(ctx, children[0], children[1]), nil
+}
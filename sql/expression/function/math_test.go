@@ -15,6 +15,7 @@
 package function
 
 import (
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -66,7 +67,16 @@ func TestRandWithSeed(t *testing.T) {
 	require.NoError(t, err)
 	f642 := f.(float64)
 
-	assert.Equal(t, f64, f642)
+	assert.NotEqual(t, f64, f642)
+
+	// Another query with the same seed gets the same sequence
+	r, _ = NewRand(sql.NewEmptyContext(), expression.NewLiteral(10, sql.Int8))
+	f, err = r.Eval(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, f64, f)
+	f, err = r.Eval(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, f642, f)
 
 	r, _ = NewRand(sql.NewEmptyContext(), expression.NewLiteral("not a number", sql.LongText))
 	assert.Equal(t, `RAND("not a number")`, r.String())
@@ -82,7 +92,19 @@ func TestRandWithSeed(t *testing.T) {
 	require.NoError(t, err)
 	f642 = f.(float64)
 
-	assert.Equal(t, f64, f642)
+	assert.NotEqual(t, f64, f642)
+
+	// A seed that depends on the row seeds the generator again for every row
+	r, _ = NewRand(sql.NewEmptyContext(), expression.NewGetField(0, sql.Int64, "seed", false))
+	f, err = r.Eval(nil, sql.NewRow(int64(10)))
+	require.NoError(t, err)
+	f64 = f.(float64)
+	f, err = r.Eval(nil, sql.NewRow(int64(10)))
+	require.NoError(t, err)
+	assert.Equal(t, f64, f)
+	f, err = r.Eval(nil, sql.NewRow(int64(11)))
+	require.NoError(t, err)
+	assert.NotEqual(t, f64, f)
 }
 
 func TestRadians(t *testing.T) {
@@ -169,6 +191,17 @@ func TestCRC32(t *testing.T) {
 	assert.Equal(t, nil, res)
 }
 
+func TestCRC32C(t *testing.T) {
+	f := sql.Function1{Name: "crc32c", Fn: NewCrc32c}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(uint32(3808858755), "123456789")
+	tf.AddSucceeding(uint32(1398257063), "MySQL")
+	tf.AddSucceeding(uint32(1145044232), "6")
+	tf.AddSucceeding(uint32(1145044232), 6)
+	tf.Test(t, nil, nil)
+}
+
 func TestTrigFunctions(t *testing.T) {
 	asin := sql.Function1{Name: "asin", Fn: NewAsin}
 	acos := sql.Function1{Name: "acos", Fn: NewAcos}
@@ -251,3 +284,103 @@ func TestSignFunc(t *testing.T) {
 
 	tf.Test(t, nil, nil)
 }
+
+func TestAtan2(t *testing.T) {
+	f := sql.Function2{Name: "atan2", Fn: NewAtan2}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 1)
+	tf.AddSucceeding(nil, 1, nil)
+	tf.AddSucceeding(math.Pi/4, 1, 1)
+	tf.AddSucceeding(-3*math.Pi/4, -2, -2)
+	tf.AddSucceeding(math.Pi/2, "1", 0)
+	tf.AddSucceeding(float64(0), 0, 1)
+	tf.Test(t, nil, nil)
+
+	atan, err := newAtanOrAtan2(sql.NewEmptyContext(), expression.NewLiteral(1, sql.Int32), expression.NewLiteral(-1, sql.Int32))
+	require.NoError(t, err)
+	assert.Equal(t, "ATAN2(1, -1)", atan.String())
+	res, err := atan.Eval(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3*math.Pi/4, res)
+
+	_, err = newAtanOrAtan2(sql.NewEmptyContext())
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestPi(t *testing.T) {
+	pi := NewPi(sql.NewEmptyContext())
+	assert.Equal(t, "PI()", pi.String())
+	res, err := pi.Eval(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, math.Pi, res)
+}
+
+func TestExp(t *testing.T) {
+	f := sql.Function1{Name: "exp", Fn: NewExp}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(float64(1), 0)
+	tf.AddSucceeding(math.E, 1)
+	tf.AddSucceeding(math.Exp(-2.5), "-2.5")
+	tf.AddFailing(1000)
+	tf.Test(t, nil, nil)
+}
+
+func TestTruncate(t *testing.T) {
+	f := sql.Function2{Name: "truncate", Fn: NewTruncate}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 1)
+	tf.AddSucceeding(nil, 1.5, nil)
+	tf.AddSucceeding(1.2, 1.223, 1)
+	tf.AddSucceeding(1.9, 1.999, 1)
+	tf.AddSucceeding(float64(1), 1.999, 0)
+	tf.AddSucceeding(-1.9, -1.999, 1)
+	tf.AddSucceeding(1.1, 1.1, 1)
+	tf.AddSucceeding(4.28, 4.28, 5)
+	tf.AddSucceeding(float64(120), 122.0, -1)
+	tf.AddSucceeding(float64(0), 122.0, -3)
+	tf.AddSucceeding(float64(10), "10.28", 0)
+	tf.AddSucceeding(int64(10), int64(10), 2)
+	tf.AddSucceeding(int64(120), int64(128), -1)
+	tf.AddSucceeding(int64(-100), int64(-128), -2)
+	tf.AddSucceeding(int64(0), int64(math.MaxInt64), -19)
+	tf.AddSucceeding(uint64(18000000000000000000), uint64(math.MaxUint64), -18)
+	tf.AddSucceeding(int32(1200), int32(1234), -2)
+	tf.Test(t, nil, nil)
+}
+
+func TestTruncateDecimal(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	decimalType := sql.MustCreateDecimalType(10, 4)
+
+	tests := []struct {
+		x            string
+		d            int64
+		expected     string
+		expectedType sql.Type
+	}{
+		{"1.2345", 2, "1.23", sql.MustCreateDecimalType(8, 2)},
+		{"-1.2399", 2, "-1.23", sql.MustCreateDecimalType(8, 2)},
+		{"1.2345", 6, "1.2345", decimalType},
+		{"123456.7891", 0, "123456", sql.MustCreateDecimalType(6, 0)},
+		{"123456.7891", -3, "123000", sql.MustCreateDecimalType(6, 0)},
+		{"0.1000", 1, "0.1", sql.MustCreateDecimalType(7, 1)},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("TRUNCATE(%s, %d)", test.x, test.d), func(t *testing.T) {
+			truncate := NewTruncate(ctx, expression.NewLiteral(test.x, decimalType), expression.NewLiteral(test.d, sql.Int64))
+			assert.Equal(t, test.expectedType, truncate.Type())
+			res, err := truncate.Eval(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+
+	// The scale is kept when the number of decimal places is not known in advance
+	truncate := NewTruncate(ctx, expression.NewLiteral("9.8765", decimalType), expression.NewGetField(0, sql.Int64, "d", false))
+	assert.Equal(t, decimalType, truncate.Type())
+	res, err := truncate.Eval(ctx, sql.NewRow(int64(3)))
+	require.NoError(t, err)
+	assert.Equal(t, "9.8760", res)
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"crypto/rand"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrRandomBytesLength is returned when the length given to RANDOM_BYTES is not between 1 and 1024.
+var ErrRandomBytesLength = errors.NewKind("length value is out of range in 'random_bytes'")
+
+// maxRandomBytes is the largest number of bytes RANDOM_BYTES returns.
+const maxRandomBytes = 1024
+
+// RandomBytes returns a string of the given number of random bytes, from a cryptographically secure generator.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_random-bytes
+type RandomBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*RandomBytes)(nil)
+var _ sql.NonDeterministicExpression = (*RandomBytes)(nil)
+
+// NewRandomBytes creates a new RANDOM_BYTES function.
+func NewRandomBytes(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &RandomBytes{NewUnaryFunc(arg, "RANDOM_BYTES", sql.LongBlob)}
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (r *RandomBytes) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements the Expression interface.
+func (r *RandomBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, ok, err := evalInt64(ctx, r.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if n < 1 || n > maxRandomBytes {
+		return nil, ErrRandomBytesLength.New()
+	}
+
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// WithChildren implements the Expression interface.
+func (r *RandomBytes) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 1)
+	}
+	return NewRandomBytes(ctx, children[0]), nil
+}
+
+// The requirements of the MEDIUM policy of the validate_password component, at their default values.
+const (
+	passwordLength           = 8
+	passwordMixedCaseCount   = 1
+	passwordNumberCount      = 1
+	passwordSpecialCharCount = 1
+)
+
+// ValidatePasswordStrength returns the strength of a password, from 0 to 100, as the validate_password component does
+// with its default settings and no dictionary file: 0 for less than 4 characters, 25 for less than 8, 50 for a
+// password that does not have lowercase, uppercase, numeric and special characters, and 100 otherwise.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_validate-password-strength
+type ValidatePasswordStrength struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*ValidatePasswordStrength)(nil)
+
+// NewValidatePasswordStrength creates a new VALIDATE_PASSWORD_STRENGTH function.
+func NewValidatePasswordStrength(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &ValidatePasswordStrength{NewUnaryFunc(arg, "VALIDATE_PASSWORD_STRENGTH", sql.Int32)}
+}
+
+// Eval implements the Expression interface.
+func (v *ValidatePasswordStrength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	password, ok, err := evalLongText(ctx, v.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	length := utf8.RuneCountInString(password)
+	switch {
+	case length < 4:
+		return int32(0), nil
+	case length < passwordLength:
+		return int32(25), nil
+	}
+
+	var lower, upper, numbers, special int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsDigit(r):
+			numbers++
+		default:
+			special++
+		}
+	}
+	if lower < passwordMixedCaseCount || upper < passwordMixedCaseCount ||
+		numbers < passwordNumberCount || special < passwordSpecialCharCount {
+		return int32(50), nil
+	}
+	// Without a dictionary file, the STRONG policy has no more requirements than the MEDIUM one
+	return int32(100), nil
+}
+
+// WithChildren implements the Expression interface.
+func (v *ValidatePasswordStrength) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewValidatePasswordStrength(ctx, children[0]), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestRandomBytes(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	f := NewRandomBytes(ctx, expression.NewLiteral(16, sql.Int32))
+	require.True(f.(sql.NonDeterministicExpression).IsNonDeterministic())
+
+	a, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Len(a, 16)
+	b, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.NotEqual(a, b)
+
+	v, err := NewRandomBytes(ctx, expression.NewLiteral(nil, sql.Null)).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(v)
+
+	for _, n := range []int{0, -1, 1025} {
+		_, err = NewRandomBytes(ctx, expression.NewLiteral(n, sql.Int32)).Eval(ctx, nil)
+		require.True(ErrRandomBytesLength.Is(err))
+	}
+}
+
+func TestValidatePasswordStrength(t *testing.T) {
+	f := sql.Function1{Name: "validate_password_strength", Fn: NewValidatePasswordStrength}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(int32(0), "")
+	tf.AddSucceeding(int32(0), "abc")
+	tf.AddSucceeding(int32(25), "abcd")
+	tf.AddSucceeding(int32(25), "Ab1!xyz")
+	tf.AddSucceeding(int32(50), "password")
+	tf.AddSucceeding(int32(50), "Password1")
+	tf.AddSucceeding(int32(50), "password1!")
+	tf.AddSucceeding(int32(100), "Password1!")
+	tf.AddSucceeding(int32(100), "Pàsswörd 1")
+	tf.Test(t, nil, nil)
+}
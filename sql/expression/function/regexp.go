@@ -0,0 +1,210 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/internal/regex"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrRegexpIndexOutOfBounds is returned when the position given to a regular expression function is not in the text.
+var ErrRegexpIndexOutOfBounds = errors.NewKind("Index out of bounds in regular expression search.")
+
+// regexpMatcher compiles the pattern of a regular expression function, with the options given by its match type and
+// by the collation of its arguments. A pattern that does not depend on the row is only compiled once, and the compiled
+// expressions are then pooled, as not every regex engine can be used concurrently.
+type regexpMatcher struct {
+	once sync.Once
+	pool *sync.Pool
+}
+
+type regexErrTuple struct {
+	re  regex.Regex
+	err error
+}
+
+// get returns the compiled pattern for the row given, or nil if the pattern or the match type is NULL, along with a
+// function to call once it is no longer used.
+func (m *regexpMatcher) get(ctx *sql.Context, funcName string, text, pattern, matchType sql.Expression, row sql.Row) (regex.Regex, func(), error) {
+	if !canBeCached(pattern) || (matchType != nil && !canBeCached(matchType)) {
+		re, err := compileRegexp(ctx, funcName, text, pattern, matchType, row)
+		if err != nil || re == nil {
+			return nil, nil, err
+		}
+		return re, re.Dispose, nil
+	}
+
+	m.once.Do(func() {
+		m.pool = &sync.Pool{
+			New: func() interface{} {
+				re, err := compileRegexp(ctx, funcName, text, pattern, matchType, row)
+				return regexErrTuple{re, err}
+			},
+		}
+	})
+	t := m.pool.Get().(regexErrTuple)
+	if t.err != nil || t.re == nil {
+		return nil, nil, t.err
+	}
+	return t.re, func() { m.pool.Put(t) }, nil
+}
+
+// compileRegexp compiles the pattern of a regular expression function. Unless its match type says otherwise, the
+// pattern is case-insensitive when the text and the pattern are compared with a case-insensitive collation.
+func compileRegexp(ctx *sql.Context, funcName string, text, pattern, matchType sql.Expression, row sql.Row) (regex.Regex, error) {
+	patternVal, err := pattern.Eval(ctx, row)
+	if err != nil || patternVal == nil {
+		return nil, err
+	}
+	patternVal, err = sql.LongText.Convert(patternVal)
+	if err != nil {
+		return nil, err
+	}
+
+	options := regex.Options{CaseInsensitive: !expression.CollationOf(text, pattern).IsCaseSensitive()}
+	if matchType != nil {
+		m, err := matchType.Eval(ctx, row)
+		if err != nil || m == nil {
+			return nil, err
+		}
+		m, err = sql.LongText.Convert(m)
+		if err != nil {
+			return nil, err
+		}
+		options, err = applyMatchType(options, m.(string), funcName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	re, err := regex.NewRegex(regex.Default(), patternVal.(string), options)
+	if err != nil {
+		return nil, expression.ErrInvalidRegexp.New(err.Error())
+	}
+	return re, nil
+}
+
+// applyMatchType sets the options of a regular expression from the match type argument of a function. The match type
+// is made of the characters c (case-sensitive), i (case-insensitive), m (^ and $ match at line boundaries), n (.
+// matches line terminators) and u (only newlines are line terminators, which is always the case). Later characters
+// take precedence over earlier ones.
+func applyMatchType(options regex.Options, matchType, funcName string) (regex.Options, error) {
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			options.CaseInsensitive = false
+		case 'i':
+			options.CaseInsensitive = true
+		case 'm':
+			options.Multiline = true
+		case 'n':
+			options.DotAll = true
+		case 'u':
+		default:
+			return options, sql.ErrInvalidArgument.New(funcName)
+		}
+	}
+	return options, nil
+}
+
+// regexpSearchStart returns the byte offset of the character of the text at the position given, counted from 1. As
+// in MySQL, the position may be right after the last character.
+func regexpSearchStart(text string, pos int64) (int, error) {
+	if pos < 1 {
+		return 0, ErrRegexpIndexOutOfBounds.New()
+	}
+	offset := 0
+	for i := int64(1); i < pos; i++ {
+		if offset >= len(text) {
+			return 0, ErrRegexpIndexOutOfBounds.New()
+		}
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+	}
+	return offset, nil
+}
+
+// evalLongText returns the value of an expression as a string, or false if it is NULL.
+func evalLongText(ctx *sql.Context, text sql.Expression, row sql.Row) (string, bool, error) {
+	val, err := text.Eval(ctx, row)
+	if err != nil || val == nil {
+		return "", false, err
+	}
+	val, err = sql.LongText.Convert(val)
+	if err != nil {
+		return "", false, err
+	}
+	return val.(string), true, nil
+}
+
+// evalRegexpInts evaluates the optional integer arguments of a regular expression function into the values given,
+// which keep their defaults for the arguments that are nil. The result is false if any argument is NULL.
+func evalRegexpInts(ctx *sql.Context, row sql.Row, args []sql.Expression, vals ...*int64) (bool, error) {
+	for i, arg := range args {
+		if arg == nil {
+			continue
+		}
+		n, ok, err := evalInt64(ctx, arg, row)
+		if err != nil || !ok {
+			return false, err
+		}
+		*vals[i] = n
+	}
+	return true, nil
+}
+
+// nonNilExpressions returns the expressions given that are not nil, which are the arguments given to functions with
+// optional arguments.
+func nonNilExpressions(exprs ...sql.Expression) []sql.Expression {
+	var result []sql.Expression
+	for _, e := range exprs {
+		if e != nil {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func regexpString(funcName string, args []sql.Expression) string {
+	return fmt.Sprintf("%s(%s)", funcName, joinExpressions(args))
+}
+
+func resolvedExpressions(exprs []sql.Expression) bool {
+	for _, e := range exprs {
+		if !e.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+func canBeCached(e sql.Expression) bool {
+	hasCols := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *expression.GetField, *expression.UserVar, *expression.SystemVar, *expression.ProcedureParam:
+			hasCols = true
+		}
+		return true
+	})
+	return !hasCols
+}
@@ -0,0 +1,127 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"unicode/utf8"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RegexpInstr implements the REGEXP_INSTR function, which returns the position of a match of a regular expression.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-instr
+type RegexpInstr struct {
+	Text         sql.Expression
+	Pattern      sql.Expression
+	Position     sql.Expression
+	Occurrence   sql.Expression
+	ReturnOption sql.Expression
+	MatchType    sql.Expression
+
+	matcher regexpMatcher
+}
+
+var _ sql.FunctionExpression = (*RegexpInstr)(nil)
+
+// NewRegexpInstr creates a new RegexpInstr expression.
+func NewRegexpInstr(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 6 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_instr", "2 to 6", len(args))
+	}
+	args = append(args, make([]sql.Expression, 6-len(args))...)
+	return &RegexpInstr{
+		Text:         args[0],
+		Pattern:      args[1],
+		Position:     args[2],
+		Occurrence:   args[3],
+		ReturnOption: args[4],
+		MatchType:    args[5],
+	}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpInstr) FunctionName() string {
+	return "regexp_instr"
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpInstr) Type() sql.Type { return sql.Int32 }
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpInstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpInstr) Children() []sql.Expression {
+	return nonNilExpressions(r.Text, r.Pattern, r.Position, r.Occurrence, r.ReturnOption, r.MatchType)
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpInstr) Resolved() bool {
+	return resolvedExpressions(r.Children())
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpInstr) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	return NewRegexpInstr(ctx, children...)
+}
+
+func (r *RegexpInstr) String() string {
+	return regexpString(r.FunctionName(), r.Children())
+}
+
+// Eval implements the sql.Expression interface. The result is the position of the character at which the match
+// starts, or of the character after the match if the return option is 1, and 0 if there is no such match.
+func (r *RegexpInstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.RegexpInstr")
+	defer span.Finish()
+
+	text, ok, err := evalLongText(ctx, r.Text, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	pos, occurrence, returnOption := int64(1), int64(1), int64(0)
+	ok, err = evalRegexpInts(ctx, row, []sql.Expression{r.Position, r.Occurrence, r.ReturnOption}, &pos, &occurrence, &returnOption)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if returnOption != 0 && returnOption != 1 {
+		return nil, sql.ErrInvalidArgument.New(r.FunctionName())
+	}
+	if occurrence < 1 {
+		occurrence = 1
+	}
+
+	re, release, err := r.matcher.get(ctx, r.FunctionName(), r.Text, r.Pattern, r.MatchType, row)
+	if err != nil || re == nil {
+		return nil, err
+	}
+	defer release()
+
+	start, err := regexpSearchStart(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := re.FindAllSubmatchIndex(text[start:], int(occurrence))
+	if int64(len(matches)) < occurrence {
+		return int32(0), nil
+	}
+	offset := start + matches[occurrence-1][returnOption]
+	return int32(utf8.RuneCountInString(text[:offset]) + 1), nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// regexpArgs returns literals for the arguments of a regular expression function, which are strings or integers.
+func regexpArgs(args ...interface{}) []sql.Expression {
+	var exprs []sql.Expression
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case string:
+			exprs = append(exprs, expression.NewLiteral(arg, sql.LongText))
+		case int:
+			exprs = append(exprs, expression.NewLiteral(int64(arg), sql.Int64))
+		default:
+			exprs = append(exprs, expression.NewLiteral(nil, sql.Null))
+		}
+	}
+	return exprs
+}
+
+func TestRegexpInstr(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+		err      bool
+	}{
+		{[]interface{}{"dog cat dog", "dog"}, int32(1), false},
+		{[]interface{}{"dog cat dog", "dog", 2}, int32(9), false},
+		{[]interface{}{"dog cat dog", "dog", 1, 2}, int32(9), false},
+		{[]interface{}{"dog cat dog", "dog", 1, 3}, int32(0), false},
+		{[]interface{}{"dog cat dog", "dog", 1, 1, 1}, int32(4), false},
+		{[]interface{}{"aa aaa aaaa", "a{4}"}, int32(8), false},
+		{[]interface{}{"ñandú Ñu", "ñu"}, int32(7), false},
+		{[]interface{}{"ñandú Ñu", "ñu", 1, 1, 0, "c"}, int32(0), false},
+		{[]interface{}{"abc", "^b", 2}, int32(2), false},
+		{[]interface{}{"abc", "x", 4}, int32(0), false},
+		{[]interface{}{"abc", "b", 5}, nil, true},
+		{[]interface{}{"abc", "b", 0}, nil, true},
+		{[]interface{}{"abc", "b", 1, 1, 2}, nil, true},
+		{[]interface{}{"abc", "b", 1, 1, 0, "x"}, nil, true},
+		{[]interface{}{"abc", "("}, nil, true},
+		{[]interface{}{nil, "b"}, nil, false},
+		{[]interface{}{"abc", nil}, nil, false},
+		{[]interface{}{"abc", "b", nil}, nil, false},
+	}
+
+	for _, test := range testCases {
+		t.Run(fmt.Sprint(test.args...), func(t *testing.T) {
+			f, err := NewRegexpInstr(sql.NewEmptyContext(), regexpArgs(test.args...)...)
+			require.NoError(t, err)
+			res, err := f.Eval(sql.NewEmptyContext(), nil)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+
+	_, err := NewRegexpInstr(sql.NewEmptyContext(), regexpArgs("abc")...)
+	require.True(t, sql.ErrInvalidArgumentNumber.Is(err))
+}
+
+func TestRegexpCollation(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	binText := expression.NewGetField(0, sql.CreateLongText(sql.Collation_utf8mb4_0900_bin), "t", true)
+	ciText := expression.NewGetField(0, sql.LongText, "t", true)
+	row := sql.NewRow("Dog")
+
+	f, err := NewRegexpInstr(ctx, binText, expression.NewLiteral("dog", sql.LongText))
+	require.NoError(t, err)
+	res, err := f.Eval(ctx, row)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), res)
+
+	f, err = NewRegexpInstr(ctx, binText, expression.NewLiteral("dog", sql.LongText), expression.NewLiteral(1, sql.Int64),
+		expression.NewLiteral(1, sql.Int64), expression.NewLiteral(0, sql.Int64), expression.NewLiteral("i", sql.LongText))
+	require.NoError(t, err)
+	res, err = f.Eval(ctx, row)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res)
+
+	f, err = NewRegexpInstr(ctx, ciText, expression.NewLiteral("dog", sql.LongText))
+	require.NoError(t, err)
+	res, err = f.Eval(ctx, row)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), res)
+}
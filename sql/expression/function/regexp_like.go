@@ -15,14 +15,9 @@
 package function
 
 import (
-	"fmt"
-	"regexp"
-	"strings"
-	"sync"
 	"sync/atomic"
 
 	"github.com/dolthub/go-mysql-server/sql"
-	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // RegexpLike implements the REGEXP_LIKE function.
@@ -32,10 +27,8 @@ type RegexpLike struct {
 	Pattern sql.Expression
 	Flags   sql.Expression
 
-	cachedVal   atomic.Value
-	re          *regexp.Regexp
-	compileOnce sync.Once
-	compileErr  error
+	cachedVal atomic.Value
+	matcher   regexpMatcher
 }
 
 var _ sql.FunctionExpression = (*RegexpLike)(nil)
@@ -74,42 +67,24 @@ func (r *RegexpLike) IsNullable() bool { return true }
 
 // Children implements the sql.Expression interface.
 func (r *RegexpLike) Children() []sql.Expression {
-	var result = []sql.Expression{r.Text, r.Pattern}
-	if r.Flags != nil {
-		result = append(result, r.Flags)
-	}
-	return result
+	return nonNilExpressions(r.Text, r.Pattern, r.Flags)
 }
 
 // Resolved implements the sql.Expression interface.
 func (r *RegexpLike) Resolved() bool {
-	return r.Text.Resolved() && r.Pattern.Resolved() && (r.Flags == nil || r.Flags.Resolved())
+	return resolvedExpressions(r.Children())
 }
 
 // WithChildren implements the sql.Expression interface.
 func (r *RegexpLike) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
-	required := 2
-	if r.Flags != nil {
-		required = 3
-	}
-	if len(children) != required {
-		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), required)
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
 	}
 	return NewRegexpLike(ctx, children...)
 }
 
 func (r *RegexpLike) String() string {
-	var args []string
-	for _, e := range r.Children() {
-		args = append(args, e.String())
-	}
-	return fmt.Sprintf("regexp_like(%s)", strings.Join(args, ", "))
-}
-
-func (r *RegexpLike) compile(ctx *sql.Context) {
-	r.compileOnce.Do(func() {
-		r.re, r.compileErr = compileRegex(ctx, r.Pattern, r.Flags, r.FunctionName(), nil)
-	})
+	return regexpString(r.FunctionName(), r.Children())
 }
 
 // Eval implements the sql.Expression interface.
@@ -122,113 +97,26 @@ func (r *RegexpLike) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return cached, nil
 	}
 
-	r.compile(ctx)
-	if r.compileErr != nil {
-		return nil, r.compileErr
-	}
-	if r.re == nil {
-		return nil, nil
-	}
-
-	text, err := r.Text.Eval(ctx, row)
-	if err != nil {
+	re, release, err := r.matcher.get(ctx, r.FunctionName(), r.Text, r.Pattern, r.Flags, row)
+	if err != nil || re == nil {
 		return nil, err
 	}
-	if text == nil {
-		return nil, nil
-	}
-	text, err = sql.LongText.Convert(text)
-	if err != nil {
+	defer release()
+
+	text, ok, err := evalLongText(ctx, r.Text, row)
+	if err != nil || !ok {
 		return nil, err
 	}
 
 	var outVal int8
-	if r.re.MatchString(text.(string)) {
+	if re.Match(text) {
 		outVal = int8(1)
 	} else {
 		outVal = int8(0)
 	}
 
-	if canBeCached(r.Text) {
+	if canBeCached(r.Text) && canBeCached(r.Pattern) && (r.Flags == nil || canBeCached(r.Flags)) {
 		r.cachedVal.Store(outVal)
 	}
 	return outVal, nil
 }
-
-func compileRegex(ctx *sql.Context, pattern, flags sql.Expression, funcName string, row sql.Row) (*regexp.Regexp, error) {
-	patternVal, err := pattern.Eval(ctx, row)
-	if err != nil {
-		return nil, err
-	}
-	if patternVal == nil {
-		return nil, nil
-	}
-	patternVal, err = sql.LongText.Convert(patternVal)
-	if err != nil {
-		return nil, err
-	}
-
-	flagsStr := "(?i)"
-	if flags != nil {
-		f, err := flags.Eval(ctx, row)
-		if err != nil {
-			return nil, err
-		}
-		if f == nil {
-			return nil, nil
-		}
-		f, err = sql.LongText.Convert(f)
-		if err != nil {
-			return nil, err
-		}
-
-		flagsStr = f.(string)
-		flagsStr, err = consolidateRegexpFlags(flagsStr, funcName)
-		if err != nil {
-			return nil, err
-		}
-		flagsStr = fmt.Sprintf("(?%s)", flagsStr)
-		flagsStr = strings.Replace(flagsStr, "c", `\c`, -1)
-	}
-	return regexp.Compile(flagsStr + patternVal.(string))
-}
-
-// consolidateRegexpFlags consolidates regexp flags by removing duplicates, resolving order of conflicting flags, and
-// verifying that all flags are valid.
-func consolidateRegexpFlags(flags, funcName string) (string, error) {
-	flagSet := make(map[string]struct{})
-	// The flag 'u' is unsupported for now, as there isn't an equivalent flag in golang's regexp library
-	for _, flag := range flags {
-		switch flag {
-		case 'c':
-			if _, ok := flagSet["i"]; ok {
-				delete(flagSet, "i")
-			}
-		case 'i':
-			flagSet["i"] = struct{}{}
-		case 'm':
-			flagSet["m"] = struct{}{}
-		case 'n':
-			flagSet["s"] = struct{}{}
-		default:
-			return "", sql.ErrInvalidArgument.New(funcName)
-		}
-	}
-	flags = ""
-	for flag := range flagSet {
-		flags += flag
-	}
-	return flags, nil
-}
-
-func canBeCached(e sql.Expression) bool {
-	hasCols := false
-	sql.Inspect(e, func(e sql.Expression) bool {
-		switch e.(type) {
-		case *expression.GetField, *expression.UserVar, *expression.SystemVar, *expression.ProcedureParam:
-			hasCols = true
-		}
-		return true
-	})
-	return !hasCols
-}
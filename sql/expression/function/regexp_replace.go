@@ -0,0 +1,186 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RegexpReplace implements the REGEXP_REPLACE function, which replaces the matches of a regular expression.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-replace
+type RegexpReplace struct {
+	Text        sql.Expression
+	Pattern     sql.Expression
+	Replacement sql.Expression
+	Position    sql.Expression
+	Occurrence  sql.Expression
+	MatchType   sql.Expression
+
+	matcher regexpMatcher
+}
+
+var _ sql.FunctionExpression = (*RegexpReplace)(nil)
+
+// NewRegexpReplace creates a new RegexpReplace expression.
+func NewRegexpReplace(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 3 || len(args) > 6 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_replace", "3 to 6", len(args))
+	}
+	args = append(args, make([]sql.Expression, 6-len(args))...)
+	return &RegexpReplace{
+		Text:        args[0],
+		Pattern:     args[1],
+		Replacement: args[2],
+		Position:    args[3],
+		Occurrence:  args[4],
+		MatchType:   args[5],
+	}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpReplace) FunctionName() string {
+	return "regexp_replace"
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpReplace) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpReplace) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpReplace) Children() []sql.Expression {
+	return nonNilExpressions(r.Text, r.Pattern, r.Replacement, r.Position, r.Occurrence, r.MatchType)
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpReplace) Resolved() bool {
+	return resolvedExpressions(r.Children())
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpReplace) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	return NewRegexpReplace(ctx, children...)
+}
+
+func (r *RegexpReplace) String() string {
+	return regexpString(r.FunctionName(), r.Children())
+}
+
+// Eval implements the sql.Expression interface. Every match is replaced when the occurrence is 0, which is the
+// default, and only the match of that occurrence otherwise.
+func (r *RegexpReplace) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.RegexpReplace")
+	defer span.Finish()
+
+	text, ok, err := evalLongText(ctx, r.Text, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	replacement, ok, err := evalLongText(ctx, r.Replacement, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	pos, occurrence := int64(1), int64(0)
+	ok, err = evalRegexpInts(ctx, row, []sql.Expression{r.Position, r.Occurrence}, &pos, &occurrence)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if occurrence < 0 {
+		occurrence = 0
+	}
+
+	re, release, err := r.matcher.get(ctx, r.FunctionName(), r.Text, r.Pattern, r.MatchType, row)
+	if err != nil || re == nil {
+		return nil, err
+	}
+	defer release()
+
+	start, err := regexpSearchStart(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	n := -1
+	if occurrence > 0 {
+		n = int(occurrence)
+	}
+	matches := re.FindAllSubmatchIndex(text[start:], n)
+	if occurrence > 0 {
+		if int64(len(matches)) < occurrence {
+			return text, nil
+		}
+		matches = matches[occurrence-1:]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(text[:start])
+	searched := text[start:]
+	last := 0
+	for _, match := range matches {
+		sb.WriteString(searched[last:match[0]])
+		if err := expandReplacement(&sb, replacement, searched, match); err != nil {
+			return nil, err
+		}
+		last = match[1]
+	}
+	sb.WriteString(searched[last:])
+	return sb.String(), nil
+}
+
+// expandReplacement writes the replacement of a match, in which $n stands for the text matched by the nth group of the
+// regular expression, $0 for the whole match, and a backslash escapes the character that follows it.
+func expandReplacement(sb *strings.Builder, replacement, text string, match []int) error {
+	groups := len(match) / 2
+	for i := 0; i < len(replacement); i++ {
+		c := replacement[i]
+		switch {
+		case c == '\\' && i+1 < len(replacement):
+			i++
+			sb.WriteByte(replacement[i])
+		case c == '$' && i+1 < len(replacement) && isDigit(replacement[i+1]):
+			// As in MySQL, the group number is made of as many digits as still make a group of the expression
+			group := int(replacement[i+1] - '0')
+			if group >= groups {
+				return ErrRegexpIndexOutOfBounds.New()
+			}
+			i++
+			for i+1 < len(replacement) && isDigit(replacement[i+1]) {
+				next := group*10 + int(replacement[i+1]-'0')
+				if next >= groups {
+					break
+				}
+				group = next
+				i++
+			}
+			if match[2*group] >= 0 {
+				sb.WriteString(text[match[2*group]:match[2*group+1]])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRegexpReplace(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+		err      bool
+	}{
+		{[]interface{}{"a b c", "b", "X"}, "a X c", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X"}, "X X X", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 1, 2}, "abc X ghi", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 2}, "aX X X", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", "X", 1, 4}, "abc def ghi", false},
+		{[]interface{}{"ABC abc", "b", "X"}, "AXC aXc", false},
+		{[]interface{}{"ABC abc", "b", "X", 1, 0, "c"}, "ABC aXc", false},
+		{[]interface{}{"2021-07-08", "(\\d+)-(\\d+)-(\\d+)", "$3/$2/$1"}, "08/07/2021", false},
+		{[]interface{}{"abc", "(b)", "[$0\\$1$12]"}, "a[b$1b2]c", false},
+		{[]interface{}{"abc", "b", "$1"}, nil, true},
+		{[]interface{}{"año", "ñ", "n"}, "ano", false},
+		{[]interface{}{"abc", "b", "X", 5}, nil, true},
+		{[]interface{}{nil, "b", "X"}, nil, false},
+		{[]interface{}{"abc", "b", nil}, nil, false},
+	}
+
+	for _, test := range testCases {
+		t.Run(fmt.Sprint(test.args...), func(t *testing.T) {
+			f, err := NewRegexpReplace(sql.NewEmptyContext(), regexpArgs(test.args...)...)
+			require.NoError(t, err)
+			res, err := f.Eval(sql.NewEmptyContext(), nil)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RegexpSubstr implements the REGEXP_SUBSTR function, which returns the substring matched by a regular expression.
+// https://dev.mysql.com/doc/refman/8.0/en/regexp.html#function_regexp-substr
+type RegexpSubstr struct {
+	Text       sql.Expression
+	Pattern    sql.Expression
+	Position   sql.Expression
+	Occurrence sql.Expression
+	MatchType  sql.Expression
+
+	matcher regexpMatcher
+}
+
+var _ sql.FunctionExpression = (*RegexpSubstr)(nil)
+
+// NewRegexpSubstr creates a new RegexpSubstr expression.
+func NewRegexpSubstr(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) < 2 || len(args) > 5 {
+		return nil, sql.ErrInvalidArgumentNumber.New("regexp_substr", "2 to 5", len(args))
+	}
+	args = append(args, make([]sql.Expression, 5-len(args))...)
+	return &RegexpSubstr{
+		Text:       args[0],
+		Pattern:    args[1],
+		Position:   args[2],
+		Occurrence: args[3],
+		MatchType:  args[4],
+	}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r *RegexpSubstr) FunctionName() string {
+	return "regexp_substr"
+}
+
+// Type implements the sql.Expression interface.
+func (r *RegexpSubstr) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the sql.Expression interface.
+func (r *RegexpSubstr) IsNullable() bool { return true }
+
+// Children implements the sql.Expression interface.
+func (r *RegexpSubstr) Children() []sql.Expression {
+	return nonNilExpressions(r.Text, r.Pattern, r.Position, r.Occurrence, r.MatchType)
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RegexpSubstr) Resolved() bool {
+	return resolvedExpressions(r.Children())
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RegexpSubstr) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(r.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), len(r.Children()))
+	}
+	return NewRegexpSubstr(ctx, children...)
+}
+
+func (r *RegexpSubstr) String() string {
+	return regexpString(r.FunctionName(), r.Children())
+}
+
+// Eval implements the sql.Expression interface. The result is NULL if there is no such match.
+func (r *RegexpSubstr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	span, ctx := ctx.Span("function.RegexpSubstr")
+	defer span.Finish()
+
+	text, ok, err := evalLongText(ctx, r.Text, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	pos, occurrence := int64(1), int64(1)
+	ok, err = evalRegexpInts(ctx, row, []sql.Expression{r.Position, r.Occurrence}, &pos, &occurrence)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if occurrence < 1 {
+		occurrence = 1
+	}
+
+	re, release, err := r.matcher.get(ctx, r.FunctionName(), r.Text, r.Pattern, r.MatchType, row)
+	if err != nil || re == nil {
+		return nil, err
+	}
+	defer release()
+
+	start, err := regexpSearchStart(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := re.FindAllSubmatchIndex(text[start:], int(occurrence))
+	if int64(len(matches)) < occurrence {
+		return nil, nil
+	}
+	match := matches[occurrence-1]
+	return text[start+match[0] : start+match[1]], nil
+}
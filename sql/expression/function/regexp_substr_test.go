@@ -0,0 +1,60 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRegexpSubstr(t *testing.T) {
+	testCases := []struct {
+		args     []interface{}
+		expected interface{}
+		err      bool
+	}{
+		{[]interface{}{"abc def ghi", "[a-z]+"}, "abc", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", 1, 3}, "ghi", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", 3, 2}, "def", false},
+		{[]interface{}{"abc def ghi", "[a-z]+", 1, 4}, nil, false},
+		{[]interface{}{"Hello World", "world"}, "World", false},
+		{[]interface{}{"Hello World", "world", 1, 1, "c"}, nil, false},
+		{[]interface{}{"line1\nline2", "^line2$"}, nil, false},
+		{[]interface{}{"line1\nline2", "^line2$", 1, 1, "m"}, "line2", false},
+		{[]interface{}{"a\nb", "a.b", 1, 1, "n"}, "a\nb", false},
+		{[]interface{}{"añb", "ñ."}, "ñb", false},
+		{[]interface{}{"abc", "b", 5}, nil, true},
+		{[]interface{}{nil, "b"}, nil, false},
+		{[]interface{}{"abc", "b", 1, nil}, nil, false},
+	}
+
+	for _, test := range testCases {
+		t.Run(fmt.Sprint(test.args...), func(t *testing.T) {
+			f, err := NewRegexpSubstr(sql.NewEmptyContext(), regexpArgs(test.args...)...)
+			require.NoError(t, err)
+			res, err := f.Eval(sql.NewEmptyContext(), nil)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -27,26 +27,37 @@ var Defaults = []sql.Function{
 	// elt, find_in_set, insert, load_file, locate
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
+	sql.FunctionN{Name: "aes_decrypt", Fn: NewAESDecrypt},
+	sql.FunctionN{Name: "aes_encrypt", Fn: NewAESEncrypt},
 	sql.Function1{Name: "array_length", Fn: NewArrayLength},
 	sql.Function1{Name: "ascii", Fn: NewAscii},
 	sql.Function1{Name: "asin", Fn: NewAsin},
-	sql.Function1{Name: "atan", Fn: NewAtan},
+	sql.FunctionN{Name: "atan", Fn: newAtanOrAtan2},
+	sql.Function2{Name: "atan2", Fn: NewAtan2},
 	sql.Function1{Name: "avg", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewAvg(ctx, e) }},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
+	sql.Function1{Name: "bit_and", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewBitAnd(ctx, e) }},
 	sql.Function1{Name: "bit_length", Fn: NewBitlength},
+	sql.Function1{Name: "bit_or", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewBitOr(ctx, e) }},
+	sql.Function1{Name: "bit_xor", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewBitXor(ctx, e) }},
 	sql.Function1{Name: "ceil", Fn: NewCeil},
 	sql.Function1{Name: "ceiling", Fn: NewCeil},
+	sql.FunctionN{Name: "char", Fn: NewChar},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
 	sql.Function1{Name: "character_length", Fn: NewCharLength},
 	sql.FunctionN{Name: "coalesce", Fn: NewCoalesce},
+	sql.Function1{Name: "compress", Fn: NewCompress},
 	sql.FunctionN{Name: "concat", Fn: NewConcat},
 	sql.FunctionN{Name: "concat_ws", Fn: NewConcatWithSeparator},
 	sql.NewFunction0("connection_id", NewConnectionID),
+	sql.Function3{Name: "conv", Fn: NewConv},
+	sql.Function3{Name: "convert_tz", Fn: NewConvertTz},
 	sql.Function1{Name: "cos", Fn: NewCos},
 	sql.Function1{Name: "cot", Fn: NewCot},
 	sql.Function1{Name: "count", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewCount(ctx, e) }},
 	sql.Function1{Name: "crc32", Fn: NewCrc32},
+	sql.Function1{Name: "crc32c", Fn: NewCrc32c},
 	sql.NewFunction0("curdate", NewCurrDate),
 	sql.NewFunction0("current_date", NewCurrentDate),
 	sql.NewFunction0("current_time", NewCurrentTime),
@@ -58,25 +69,44 @@ var Defaults = []sql.Function{
 	sql.Function2{Name: "date_format", Fn: NewDateFormat},
 	sql.FunctionN{Name: "date_sub", Fn: NewDateSub},
 	sql.FunctionN{Name: "datetime", Fn: NewDatetime},
+	sql.Function2{Name: "datediff", Fn: NewDateDiff},
 	sql.Function1{Name: "day", Fn: NewDay},
 	sql.Function1{Name: "dayname", Fn: NewDayName},
 	sql.Function1{Name: "dayofmonth", Fn: NewDay},
 	sql.Function1{Name: "dayofweek", Fn: NewDayOfWeek},
 	sql.Function1{Name: "dayofyear", Fn: NewDayOfYear},
 	sql.Function1{Name: "degrees", Fn: NewDegrees},
+	sql.FunctionN{Name: "elt", Fn: NewElt},
+	sql.Function1{Name: "exp", Fn: NewExp},
 	sql.Function1{Name: "explode", Fn: NewExplode},
+	sql.FunctionN{Name: "export_set", Fn: NewExportSet},
+	sql.Function2{Name: "extract", Fn: NewExtract},
+	sql.FunctionN{Name: "field", Fn: NewField},
+	sql.Function2{Name: "find_in_set", Fn: NewFindInSet},
 	sql.Function1{Name: "first", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewFirst(ctx, e) }},
 	sql.Function1{Name: "floor", Fn: NewFloor},
+	sql.FunctionN{Name: "format", Fn: NewFormat},
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
+	sql.Function1{Name: "from_vector", Fn: NewVectorToString},
+	sql.Function2{Name: "get_format", Fn: NewGetFormat},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
 	sql.Function0{Name: "group_concat", Fn: aggregation.NewEmptyGroupConcat},
 	sql.Function1{Name: "hex", Fn: NewHex},
 	sql.Function1{Name: "hour", Fn: NewHour},
 	sql.Function3{Name: "if", Fn: NewIf},
 	sql.Function2{Name: "ifnull", Fn: NewIfNull},
+	sql.Function4{Name: "insert", Fn: NewInsert},
 	sql.Function2{Name: "instr", Fn: NewInstr},
+	sql.Function1{Name: "inet_aton", Fn: NewInetAton},
+	sql.Function1{Name: "inet_ntoa", Fn: NewInetNtoa},
+	sql.Function1{Name: "inet6_aton", Fn: NewInet6Aton},
+	sql.Function1{Name: "inet6_ntoa", Fn: NewInet6Ntoa},
 	sql.Function1{Name: "is_binary", Fn: NewIsBinary},
+	sql.Function1{Name: "is_ipv4", Fn: NewIsIPv4},
+	sql.Function1{Name: "is_ipv4_compat", Fn: NewIsIPv4Compat},
+	sql.Function1{Name: "is_ipv4_mapped", Fn: NewIsIPv4Mapped},
+	sql.Function1{Name: "is_ipv6", Fn: NewIsIPv6},
 	sql.Function1{Name: "is_uuid", Fn: NewIsUUID},
 	sql.Function1{Name: "isnull", Fn: NewIsNull},
 	sql.FunctionN{Name: "json_array", Fn: NewJSONArray},
@@ -111,36 +141,53 @@ var Defaults = []sql.Function{
 	sql.FunctionN{Name: "json_valid", Fn: NewJSONValid},
 	sql.FunctionN{Name: "json_value", Fn: NewJSONValue},
 	sql.Function1{Name: "last", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewLast(ctx, e) }},
+	sql.Function1{Name: "last_day", Fn: NewLastDay},
 	sql.Function0{Name: "last_insert_id", Fn: NewLastInsertId},
 	sql.Function1{Name: "lcase", Fn: NewLower},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
 	sql.Function2{Name: "left", Fn: NewLeft},
 	sql.Function1{Name: "length", Fn: NewLength},
 	sql.Function1{Name: "ln", Fn: NewLogBaseFunc(float64(math.E))},
+	sql.FunctionN{Name: "locate", Fn: NewLocate},
 	sql.FunctionN{Name: "log", Fn: NewLog},
 	sql.Function1{Name: "log10", Fn: NewLogBaseFunc(float64(10))},
 	sql.Function1{Name: "log2", Fn: NewLogBaseFunc(float64(2))},
 	sql.Function1{Name: "lower", Fn: NewLower},
 	sql.FunctionN{Name: "lpad", Fn: NewPadFunc(lPadType)},
 	sql.Function1{Name: "ltrim", Fn: NewTrimFunc(lTrimType)},
+	sql.Function2{Name: "makedate", Fn: NewMakeDate},
+	sql.Function3{Name: "maketime", Fn: NewMakeTime},
+	sql.FunctionN{Name: "make_set", Fn: NewMakeSet},
 	sql.Function1{Name: "max", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewMax(ctx, e) }},
 	sql.Function1{Name: "md5", Fn: NewMD5},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
 	sql.Function1{Name: "min", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewMin(ctx, e) }},
 	sql.Function1{Name: "minute", Fn: NewMinute},
+	sql.Function2{Name: "mod", Fn: NewMod},
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
 	sql.FunctionN{Name: "now", Fn: NewNow},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
+	sql.Function1{Name: "oct", Fn: NewOct},
+	sql.Function1{Name: "ord", Fn: NewOrd},
+	sql.Function2{Name: "period_add", Fn: NewPeriodAdd},
+	sql.NewFunction0("pi", NewPi),
+	sql.Function2{Name: "position", Fn: NewPosition},
 	sql.Function2{Name: "pow", Fn: NewPower},
 	sql.Function2{Name: "power", Fn: NewPower},
+	sql.Function1{Name: "quote", Fn: NewQuote},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.Function1{Name: "random_bytes", Fn: NewRandomBytes},
+	sql.FunctionN{Name: "regexp_instr", Fn: NewRegexpInstr},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
+	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
+	sql.FunctionN{Name: "regexp_substr", Fn: NewRegexpSubstr},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
 	sql.Function3{Name: "replace", Fn: NewReplace},
 	sql.Function1{Name: "reverse", Fn: NewReverse},
+	sql.Function2{Name: "right", Fn: NewRight},
 	sql.FunctionN{Name: "round", Fn: NewRound},
 	sql.Function0{Name: "row_count", Fn: NewRowCount},
 	sql.Function0{Name: "row_number", Fn: window.NewRowNumber},
@@ -148,6 +195,7 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "first_value", Fn: window.NewFirstValue},
 	sql.FunctionN{Name: "rpad", Fn: NewPadFunc(rPadType)},
 	sql.Function1{Name: "rtrim", Fn: NewTrimFunc(rTrimType)},
+	sql.Function1{Name: "sec_to_time", Fn: NewSecToTime},
 	sql.Function1{Name: "second", Fn: NewSecond},
 	sql.Function1{Name: "sha", Fn: NewSHA1},
 	sql.Function1{Name: "sha1", Fn: NewSHA1},
@@ -156,8 +204,16 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "sin", Fn: NewSin},
 	sql.Function1{Name: "sleep", Fn: NewSleep},
 	sql.Function1{Name: "soundex", Fn: NewSoundex},
+	sql.Function1{Name: "space", Fn: NewSpace},
 	sql.Function2{Name: "split", Fn: NewSplit},
 	sql.Function1{Name: "sqrt", Fn: NewSqrt},
+	sql.Function1{Name: "std", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(ctx, e) }},
+	sql.Function1{Name: "stddev", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(ctx, e) }},
+	sql.Function1{Name: "stddev_pop", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewStdDevPop(ctx, e) }},
+	sql.Function1{Name: "stddev_samp", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewStdDevSamp(ctx, e) }},
+	sql.Function2{Name: "str_to_date", Fn: NewStrToDate},
+	sql.Function2{Name: "strcmp", Fn: NewStrcmp},
+	sql.Function1{Name: "string_to_vector", Fn: NewStringToVector},
 	sql.FunctionN{Name: "substr", Fn: NewSubstring},
 	sql.FunctionN{Name: "substring", Fn: NewSubstring},
 	sql.Function3{Name: "substring_index", Fn: NewSubstringIndex},
@@ -166,9 +222,15 @@ var Defaults = []sql.Function{
 	sql.Function1{Name: "time_to_sec", Fn: NewTimeToSec},
 	sql.Function2{Name: "timediff", Fn: NewTimeDiff},
 	sql.FunctionN{Name: "timestamp", Fn: NewTimestamp},
+	sql.Function3{Name: "timestampadd", Fn: NewTimestampAdd},
+	sql.Function3{Name: "timestampdiff", Fn: NewTimestampDiff},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
+	sql.Function1{Name: "to_vector", Fn: NewStringToVector},
 	sql.Function1{Name: "trim", Fn: NewTrimFunc(bTrimType)},
+	sql.Function2{Name: "truncate", Fn: NewTruncate},
 	sql.Function1{Name: "ucase", Fn: NewUpper},
+	sql.Function1{Name: "uncompress", Fn: NewUncompress},
+	sql.Function1{Name: "uncompressed_length", Fn: NewUncompressedLength},
 	sql.Function1{Name: "unhex", Fn: NewUnhex},
 	sql.FunctionN{Name: "unix_timestamp", Fn: NewUnixTimestamp},
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
@@ -176,7 +238,17 @@ var Defaults = []sql.Function{
 	sql.NewFunction0("user", NewUser),
 	sql.FunctionN{Name: "utc_timestamp", Fn: NewUTCTimestamp},
 	sql.Function0{Name: "uuid", Fn: NewUUIDFunc},
+	sql.NewFunction0("uuid_short", NewUUIDShort),
 	sql.FunctionN{Name: "uuid_to_bin", Fn: NewUUIDToBin},
+	sql.Function1{Name: "validate_password_strength", Fn: NewValidatePasswordStrength},
+	sql.Function1{Name: "var_pop", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewVarPop(ctx, e) }},
+	sql.Function1{Name: "var_samp", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewVarSamp(ctx, e) }},
+	sql.Function1{Name: "variance", Fn: func(ctx *sql.Context, e sql.Expression) sql.Expression { return aggregation.NewVarPop(ctx, e) }},
+	sql.Function2{Name: "vec_distance_cosine", Fn: NewVecDistanceCosine},
+	sql.Function2{Name: "vec_distance_dot", Fn: NewVecDistanceDot},
+	sql.Function2{Name: "vec_distance_l2", Fn: NewVecDistanceL2},
+	sql.Function1{Name: "vector_dim", Fn: NewVectorDim},
+	sql.Function1{Name: "vector_to_string", Fn: NewVectorToString},
 	sql.FunctionN{Name: "week", Fn: NewWeek},
 	sql.Function1{Name: "values", Fn: NewValues},
 	sql.Function1{Name: "weekday", Fn: NewWeekday},
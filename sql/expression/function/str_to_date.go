@@ -0,0 +1,396 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// StrToDate parses a string into a date, a time or a datetime, following a format made of the same specifiers as
+// the ones of DATE_FORMAT.
+type StrToDate struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*StrToDate)(nil)
+
+// NewStrToDate returns a new StrToDate function.
+func NewStrToDate(ctx *sql.Context, str, format sql.Expression) sql.Expression {
+	return &StrToDate{
+		expression.BinaryExpression{
+			Left:  str,
+			Right: format,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *StrToDate) FunctionName() string {
+	return "str_to_date"
+}
+
+func (s *StrToDate) String() string {
+	return fmt.Sprintf("STR_TO_DATE(%s, %s)", s.Left, s.Right)
+}
+
+// Type implements the Expression interface. The type is given by the specifiers of the format, when it is known
+// before evaluation: DATE when it only has date specifiers, TIME when it only has time specifiers, and DATETIME
+// otherwise.
+func (s *StrToDate) Type() sql.Type {
+	if lit, ok := s.Right.(*expression.Literal); ok {
+		if format, ok := lit.Value().(string); ok {
+			return strToDateType(format)
+		}
+	}
+	return sql.Datetime
+}
+
+// IsNullable implements the Expression interface.
+func (s *StrToDate) IsNullable() bool {
+	return true
+}
+
+// WithChildren implements the Expression interface.
+func (s *StrToDate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewStrToDate(ctx, children[0], children[1]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, strings that do not match the format, or that do not make
+// a valid date, are NULL with a warning.
+func (s *StrToDate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, err := s.Left.Eval(ctx, row)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	format, err := s.Right.Eval(ctx, row)
+	if err != nil || format == nil {
+		return nil, err
+	}
+
+	str, err = sql.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+	format, err = sql.LongText.Convert(format)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := strToDateType(format.(string))
+	result, rest, ok := parseDateWithFormat(str.(string), format.(string), typ)
+	if !ok {
+		ctx.Warn(1411, "Incorrect %s value: '%s' for function str_to_date", strings.ToLower(typ.String()), str)
+		return nil, nil
+	}
+	if strings.TrimSpace(rest) != "" {
+		ctx.Warn(1292, "Truncated incorrect %s value: '%s'", strings.ToLower(typ.String()), str)
+	}
+	return result, nil
+}
+
+// strToDateType returns the type of the values that STR_TO_DATE parses with the format given.
+func strToDateType(format string) sql.Type {
+	hasDate, hasTime := false, false
+	for i := 0; i < len(format)-1; i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		spec, ok := dateSpecifiers[format[i]]
+		if !ok {
+			continue
+		}
+		if spec.time {
+			hasTime = true
+		} else {
+			hasDate = true
+		}
+	}
+
+	switch {
+	case hasDate && !hasTime:
+		return sql.Date
+	case hasTime && !hasDate:
+		return sql.Time
+	default:
+		return sql.Datetime
+	}
+}
+
+// parsedDate holds the parts of a date and time read by STR_TO_DATE.
+type parsedDate struct {
+	year, month, day                  int
+	hour, minute, second, microsecond int
+	yearDay                           int
+	twelveHour, pm                    bool
+}
+
+// parseDateWithFormat parses the string given following the format given, and returns the value it represents as a
+// value of the type given, along with the rest of the string that the format did not consume. The result is false if
+// the string does not match the format or is not a valid value.
+func parseDateWithFormat(str, format string, typ sql.Type) (interface{}, string, bool) {
+	var date parsedDate
+	rest, ok := date.parse(str, format)
+	if !ok {
+		return nil, "", false
+	}
+
+	if date.twelveHour {
+		if date.hour < 1 || date.hour > 12 {
+			return nil, "", false
+		}
+		date.hour %= 12
+		if date.pm {
+			date.hour += 12
+		}
+	}
+	if date.minute > 59 || date.second > 59 {
+		return nil, "", false
+	}
+
+	if typ == sql.Time {
+		d := time.Duration(date.hour)*time.Hour + time.Duration(date.minute)*time.Minute +
+			time.Duration(date.second)*time.Second + time.Duration(date.microsecond)*time.Microsecond
+		t, err := sql.Time.Convert(d)
+		if err != nil {
+			return nil, "", false
+		}
+		return t, rest, true
+	}
+
+	if date.yearDay > 0 && date.month == 0 && date.day == 0 {
+		if date.yearDay > int(calcDaysInYear(int32(date.year))) {
+			return nil, "", false
+		}
+		t := time.Date(date.year, time.January, date.yearDay, 0, 0, 0, 0, time.UTC)
+		date.month, date.day = int(t.Month()), t.Day()
+	}
+	if date.month < 1 || date.month > 12 || date.day < 1 || date.day > lastDayOfMonth(date.year, time.Month(date.month)) || date.hour > 23 {
+		return nil, "", false
+	}
+
+	t := time.Date(date.year, time.Month(date.month), date.day, date.hour, date.minute, date.second, date.microsecond*int(time.Microsecond), time.UTC)
+	res, err := typ.Convert(t)
+	if err != nil {
+		return nil, "", false
+	}
+	return res, rest, true
+}
+
+// parse reads the parts of the date given by the format from the string given, and returns the rest of the string.
+// As in MySQL, whitespace before every part of the string is skipped, and the format is only read until the end of
+// the string.
+func (d *parsedDate) parse(str, format string) (string, bool) {
+	for i := 0; i < len(format); i++ {
+		str = strings.TrimLeft(str, " \t\n\r")
+		if str == "" {
+			break
+		}
+
+		if format[i] != '%' || i == len(format)-1 {
+			if isSpace(format[i]) {
+				continue
+			}
+			if str[0] != format[i] {
+				return "", false
+			}
+			str = str[1:]
+			continue
+		}
+
+		i++
+		if format[i] == '%' {
+			if str[0] != '%' {
+				return "", false
+			}
+			str = str[1:]
+			continue
+		}
+
+		spec, ok := dateSpecifiers[format[i]]
+		switch {
+		case ok && spec.expansion != "":
+			str, ok = d.parse(str, spec.expansion)
+		case ok && spec.parse != nil:
+			str, ok = spec.parse(d, str)
+		default:
+			// The week specifiers are only meaningful together with a year and a weekday, which is not supported
+			ok = false
+		}
+		if !ok {
+			return "", false
+		}
+	}
+	return str, true
+}
+
+func (d *parsedDate) parseYear(str string) (string, bool) {
+	start := len(str)
+	year, rest, ok := parseDigits(str, 4)
+	if start-len(rest) <= 2 {
+		year = twoDigitYear(year)
+	}
+	d.year = year
+	return rest, ok
+}
+
+func (d *parsedDate) parseTwoDigitYear(str string) (string, bool) {
+	year, rest, ok := parseDigits(str, 2)
+	d.year = twoDigitYear(year)
+	return rest, ok
+}
+
+func (d *parsedDate) parseMonth(str string) (rest string, ok bool) {
+	d.month, rest, ok = parseDigits(str, 2)
+	return rest, ok
+}
+
+func (d *parsedDate) parseMonthName(str string) (rest string, ok bool) {
+	d.month, rest, ok = parseName(str, monthNames, false)
+	return rest, ok
+}
+
+func (d *parsedDate) parseShortMonthName(str string) (rest string, ok bool) {
+	d.month, rest, ok = parseName(str, monthNames, true)
+	return rest, ok
+}
+
+func (d *parsedDate) parseDay(str string) (rest string, ok bool) {
+	d.day, rest, ok = parseDigits(str, 2)
+	return rest, ok
+}
+
+// parseDayWithSuffix reads a day followed by its two-letter ordinal suffix, such as 1st or 22nd.
+func (d *parsedDate) parseDayWithSuffix(str string) (rest string, ok bool) {
+	d.day, rest, ok = parseDigits(str, 2)
+	if ok && len(rest) >= 2 {
+		rest = rest[2:]
+	}
+	return rest, ok
+}
+
+func (d *parsedDate) parseYearDay(str string) (rest string, ok bool) {
+	d.yearDay, rest, ok = parseDigits(str, 3)
+	return rest, ok
+}
+
+func (d *parsedDate) parseHour(str string) (rest string, ok bool) {
+	d.hour, rest, ok = parseDigits(str, 2)
+	return rest, ok
+}
+
+func (d *parsedDate) parseTwelveHour(str string) (rest string, ok bool) {
+	d.hour, rest, ok = parseDigits(str, 2)
+	d.twelveHour = true
+	return rest, ok
+}
+
+func (d *parsedDate) parseMinute(str string) (rest string, ok bool) {
+	d.minute, rest, ok = parseDigits(str, 2)
+	return rest, ok
+}
+
+func (d *parsedDate) parseSecond(str string) (rest string, ok bool) {
+	d.second, rest, ok = parseDigits(str, 2)
+	return rest, ok
+}
+
+// parseMicrosecond reads up to six digits of a fraction of a second.
+func (d *parsedDate) parseMicrosecond(str string) (rest string, ok bool) {
+	d.microsecond, rest, ok = parseDigits(str, 6)
+	for digits := len(str) - len(rest); digits < 6; digits++ {
+		d.microsecond *= 10
+	}
+	return rest, ok
+}
+
+func (d *parsedDate) parseAMPM(str string) (string, bool) {
+	if len(str) < 2 {
+		return "", false
+	}
+	switch strings.ToUpper(str[:2]) {
+	case "AM":
+	case "PM":
+		d.pm = true
+	default:
+		return "", false
+	}
+	return str[2:], true
+}
+
+// parseWeekdayName, parseShortWeekdayName and parseWeekday read a weekday, which does not change the date read.
+func (d *parsedDate) parseWeekdayName(str string) (string, bool) {
+	_, rest, ok := parseName(str, weekdayNames, false)
+	return rest, ok
+}
+
+func (d *parsedDate) parseShortWeekdayName(str string) (string, bool) {
+	_, rest, ok := parseName(str, weekdayNames, true)
+	return rest, ok
+}
+
+func (d *parsedDate) parseWeekday(str string) (string, bool) {
+	_, rest, ok := parseDigits(str, 1)
+	return rest, ok
+}
+
+var (
+	monthNames   = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+)
+
+// parseDigits reads a number of up to the given number of digits from the start of the string given, and returns it
+// along with the rest of the string.
+func parseDigits(str string, maxDigits int) (int, string, bool) {
+	n, i := 0, 0
+	for ; i < len(str) && i < maxDigits && str[i] >= '0' && str[i] <= '9'; i++ {
+		n = n*10 + int(str[i]-'0')
+	}
+	return n, str[i:], i > 0
+}
+
+// parseName reads one of the names given, or its first three letters if abbreviated is true, from the start of the
+// string given, ignoring case. It returns the number of the name, starting at 1, along with the rest of the string.
+func parseName(str string, names []string, abbreviated bool) (int, string, bool) {
+	for i, name := range names {
+		if abbreviated {
+			name = name[:3]
+		}
+		if len(str) >= len(name) && strings.EqualFold(str[:len(name)], name) {
+			return i + 1, str[len(name):], true
+		}
+	}
+	return 0, "", false
+}
+
+// twoDigitYear returns the year a two-digit year stands for, which is 2000-2069 for 00-69 and 1970-1999 for 70-99.
+func twoDigitYear(year int) int {
+	if year < 70 {
+		return year + 2000
+	}
+	return year + 1900
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
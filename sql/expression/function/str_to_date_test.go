@@ -0,0 +1,74 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestStrToDate(t *testing.T) {
+	tests := []struct {
+		str      string
+		format   string
+		expected interface{}
+		warnings int
+	}{
+		{"2021-03-04", "%Y-%m-%d", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"04/03/21", "%d/%m/%y", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"March 4th, 1999", "%M %D, %Y", time.Date(1999, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"mar 4 1999", "%b %e %Y", time.Date(1999, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"Thursday 1999 63", "%W %Y %j", time.Date(1999, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"2021-03-04 13:14:15.5", "%Y-%m-%d %H:%i:%s.%f", time.Date(2021, 3, 4, 13, 14, 15, 500000000, time.UTC), 0},
+		{"2021-03-04 01:14:15 PM", "%Y-%m-%d %r", time.Date(2021, 3, 4, 13, 14, 15, 0, time.UTC), 0},
+		{"12:00:00 AM 2021-03-04", "%r %Y-%m-%d", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"13:14:15", "%T", "13:14:15", 0},
+		{"  2021-03-04", "%Y-%m-%d", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), 0},
+		{"2021-03-04 trailing", "%Y-%m-%d", time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), 1},
+		{"2021-02-30", "%Y-%m-%d", nil, 1},
+		{"2021-13-01", "%Y-%m-%d", nil, 1},
+		{"2021-03", "%Y-%m-%d", nil, 1},
+		{"2021/03/04", "%Y-%m-%d", nil, 1},
+		{"13:00:00 PM", "%h:%i:%s %p", nil, 1},
+		{"12:61:00", "%H:%i:%s", nil, 1},
+		{"Smarch 4 1999", "%M %e %Y", nil, 1},
+		{"2021 10 1", "%X %V %w", nil, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.str+" "+test.format, func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			f := NewStrToDate(ctx, expression.NewLiteral(test.str, sql.LongText), expression.NewLiteral(test.format, sql.LongText))
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, val)
+			assert.Len(t, ctx.Warnings(), test.warnings)
+		})
+	}
+}
+
+func TestStrToDateType(t *testing.T) {
+	assert.Equal(t, sql.Date, strToDateType("%Y-%m-%d"))
+	assert.Equal(t, sql.Time, strToDateType("%H:%i"))
+	assert.Equal(t, sql.Time, strToDateType("%r"))
+	assert.Equal(t, sql.Datetime, strToDateType("%Y-%m-%d %T"))
+	assert.Equal(t, sql.Datetime, strToDateType("no specifiers"))
+}
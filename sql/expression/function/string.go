@@ -20,11 +20,13 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Ascii implements the sql function "ascii" which returns the numeric value of the leftmost character
@@ -103,6 +105,11 @@ func (h *Hex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 	switch val := arg.(type) {
 	case string:
+		// Strings are shown as the bytes that encode them in their character set
+		if st, ok := h.Child.Type().(sql.StringType); ok {
+			encoded, _ := st.CharacterSet().Encode(val)
+			val = string(encoded)
+		}
 		return hexForString(val), nil
 
 	case uint8, uint16, uint32, uint, int, int8, int16, int32, int64:
@@ -383,3 +390,234 @@ func (h *Bitlength) WithChildren(ctx *sql.Context, children ...sql.Expression) (
 	}
 	return NewBitlength(ctx, children[0]), nil
 }
+
+// Char implements the sql function "char" which returns the string made of the bytes of the given integers, skipping
+// NULLs. Integers larger than 255 give more than one byte, the most significant first.
+type Char struct {
+	args []sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Char)(nil)
+
+func NewChar(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	if len(args) == 0 {
+		return nil, sql.ErrInvalidArgumentNumber.New("CHAR", "1 or more", 0)
+	}
+	return &Char{args}, nil
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *Char) FunctionName() string {
+	return "char"
+}
+
+// Type implements the sql.Expression interface
+func (c *Char) Type() sql.Type { return sql.LongBlob }
+
+// IsNullable implements the sql.Expression interface
+func (c *Char) IsNullable() bool { return false }
+
+func (c *Char) String() string {
+	return fmt.Sprintf("CHAR(%s)", joinExpressions(c.args))
+}
+
+// Resolved implements the sql.Expression interface
+func (c *Char) Resolved() bool {
+	return resolvedExpressions(c.args)
+}
+
+// Children implements the sql.Expression interface
+func (c *Char) Children() []sql.Expression { return c.args }
+
+// Eval implements the sql.Expression interface
+func (c *Char) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var buf []byte
+	for _, arg := range c.args {
+		n, ok, err := evalBits(ctx, arg, row)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		n = uint64(uint32(n))
+		var b []byte
+		for ; n > 0; n >>= 8 {
+			b = append([]byte{byte(n)}, b...)
+		}
+		if len(b) == 0 {
+			b = []byte{0}
+		}
+		buf = append(buf, b...)
+	}
+	return string(buf), nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (c *Char) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NewChar(ctx, children...)
+}
+
+// Ord implements the sql function "ord" which returns the code of the leftmost character of a string, computed from
+// the bytes that encode it, the first one being the most significant.
+type Ord struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Ord)(nil)
+
+func NewOrd(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Ord{NewUnaryFunc(arg, "ORD", sql.Int64)}
+}
+
+// Eval implements the sql.Expression interface
+func (o *Ord) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, o.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if s == "" {
+		return int64(0), nil
+	}
+
+	_, size := utf8.DecodeRuneInString(s)
+	var code int64
+	for i := 0; i < size; i++ {
+		code = code<<8 | int64(s[i])
+	}
+	return code, nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (o *Ord) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 1)
+	}
+	return NewOrd(ctx, children[0]), nil
+}
+
+// Quote implements the sql function "quote" which returns a string quoted so that it can be used as a string literal
+// in a statement. NULL is returned as the word NULL.
+type Quote struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Quote)(nil)
+
+func NewQuote(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Quote{NewUnaryFunc(arg, "QUOTE", sql.LongText)}
+}
+
+// IsNullable implements the sql.Expression interface
+func (q *Quote) IsNullable() bool { return false }
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\x00", `\0`, "\x1a", `\Z`)
+
+// Eval implements the sql.Expression interface
+func (q *Quote) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	s, ok, err := evalLongText(ctx, q.Child, row)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return "NULL", nil
+	}
+	return "'" + quoteReplacer.Replace(s) + "'", nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (q *Quote) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(children), 1)
+	}
+	return NewQuote(ctx, children[0]), nil
+}
+
+// Space implements the sql function "space" which returns a string of the given number of spaces
+type Space struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Space)(nil)
+
+func NewSpace(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &Space{NewUnaryFunc(arg, "SPACE", sql.LongText)}
+}
+
+// Eval implements the sql.Expression interface
+func (s *Space) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := s.EvalChild(ctx, row)
+	if err != nil || arg == nil {
+		return nil, err
+	}
+	count, err := sql.Int32.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	if count.(int32) <= 0 {
+		return "", nil
+	}
+	return strings.Repeat(" ", int(count.(int32))), nil
+}
+
+// WithChildren implements the sql.Expression interface
+func (s *Space) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewSpace(ctx, children[0]), nil
+}
+
+// Strcmp implements the sql function "strcmp" which returns -1, 0 or 1 as the first string sorts before, the same as
+// or after the second one, by the rules of their collation.
+type Strcmp struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Strcmp)(nil)
+
+func NewStrcmp(ctx *sql.Context, a, b sql.Expression) sql.Expression {
+	return &Strcmp{expression.BinaryExpression{Left: a, Right: b}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *Strcmp) FunctionName() string {
+	return "strcmp"
+}
+
+// Type implements the sql.Expression interface
+func (s *Strcmp) Type() sql.Type { return sql.Int32 }
+
+func (s *Strcmp) String() string {
+	return fmt.Sprintf("STRCMP(%s, %s)", s.Left, s.Right)
+}
+
+// Eval implements the sql.Expression interface
+func (s *Strcmp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	a, ok, err := evalLongText(ctx, s.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	b, ok, err := evalLongText(ctx, s.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	cmp := expression.CollationOf(s.Left, s.Right).Compare(a, b)
+	switch {
+	case cmp < 0:
+		return int32(-1), nil
+	case cmp > 0:
+		return int32(1), nil
+	default:
+		return int32(0), nil
+	}
+}
+
+// WithChildren implements the sql.Expression interface
+func (s *Strcmp) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewStrcmp(ctx, children[0], children[1]), nil
+}
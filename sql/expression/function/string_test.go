@@ -145,3 +145,70 @@ func TestBitLength(t *testing.T) {
 	tf.AddSucceeding(128, time.Now())
 	tf.Test(t, nil, nil)
 }
+
+func TestChar(t *testing.T) {
+	f := sql.FunctionN{Name: "char", Fn: NewChar}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding("MySQL", 77, 121, 83, 81, "76")
+	tf.AddSucceeding("MMM", 77, 77.3, "77.3")
+	tf.AddSucceeding("ab", 97, nil, 98)
+	tf.AddSucceeding("\x01\x00", 256)
+	tf.AddSucceeding("\x01\x00\x00", 65536)
+	tf.AddSucceeding("\x00", 0)
+	tf.Test(t, nil, nil)
+}
+
+func TestOrd(t *testing.T) {
+	f := sql.Function1{Name: "ord", Fn: NewOrd}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(int64(50), "2")
+	tf.AddSucceeding(int64(50), 23)
+	tf.AddSucceeding(int64(97), "abc")
+	tf.AddSucceeding(int64(0xc3a9), "éa")
+	tf.AddSucceeding(int64(0), "")
+	tf.Test(t, nil, nil)
+}
+
+func TestQuote(t *testing.T) {
+	f := sql.Function1{Name: "quote", Fn: NewQuote}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding("NULL", nil)
+	tf.AddSucceeding("'Don\\'t!'", "Don't!")
+	tf.AddSucceeding("'a\\\\b'", "a\\b")
+	tf.AddSucceeding("'a\\0b\\Z'", "a\x00b\x1a")
+	tf.AddSucceeding("'12'", 12)
+	tf.Test(t, nil, nil)
+}
+
+func TestSpace(t *testing.T) {
+	f := sql.Function1{Name: "space", Fn: NewSpace}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("   ", 3)
+	tf.AddSucceeding("", 0)
+	tf.AddSucceeding("", -2)
+	tf.AddSucceeding("  ", "2")
+	tf.Test(t, nil, nil)
+}
+
+func TestStrcmp(t *testing.T) {
+	f := sql.Function2{Name: "strcmp", Fn: NewStrcmp}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "a")
+	tf.AddSucceeding(nil, "a", nil)
+	tf.AddSucceeding(int32(-1), "text", "text2")
+	tf.AddSucceeding(int32(1), "text2", "text")
+	tf.AddSucceeding(int32(0), "text", "text")
+	tf.AddSucceeding(int32(0), "TEXT", "text")
+	tf.Test(t, nil, nil)
+
+	ctx := sql.NewEmptyContext()
+	binary := NewStrcmp(ctx,
+		expression.NewLiteral("TEXT", sql.LongBlob),
+		expression.NewLiteral("text", sql.LongText),
+	)
+	v, err := binary.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(-1), v)
+}
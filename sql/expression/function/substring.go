@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Substring is a function to return a part of a string.
@@ -481,3 +482,291 @@ func (i Instr) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.E
 	}
 	return NewInstr(ctx, children[0], children[1]), nil
 }
+
+// Right is a function that returns the last N characters of a string expression.
+type Right struct {
+	str sql.Expression
+	len sql.Expression
+}
+
+var _ sql.FunctionExpression = Right{}
+
+// NewRight creates a new RIGHT function.
+func NewRight(ctx *sql.Context, str, len sql.Expression) sql.Expression {
+	return Right{str, len}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (r Right) FunctionName() string {
+	return "right"
+}
+
+// Children implements the Expression interface.
+func (r Right) Children() []sql.Expression {
+	return []sql.Expression{r.str, r.len}
+}
+
+// Eval implements the Expression interface.
+func (r Right) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, err := r.str.Eval(ctx, row)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	str, err = sql.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+	text := []rune(str.(string))
+
+	length, ok, err := evalInt64(ctx, r.len, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if length > int64(len(text)) {
+		length = int64(len(text))
+	}
+	if length <= 0 {
+		return "", nil
+	}
+
+	return string(text[int64(len(text))-length:]), nil
+}
+
+// IsNullable implements the Expression interface.
+func (r Right) IsNullable() bool {
+	return r.str.IsNullable() || r.len.IsNullable()
+}
+
+func (r Right) String() string {
+	return fmt.Sprintf("RIGHT(%s, %s)", r.str, r.len)
+}
+
+// Resolved implements the Expression interface.
+func (r Right) Resolved() bool {
+	return r.str.Resolved() && r.len.Resolved()
+}
+
+// Type implements the Expression interface.
+func (Right) Type() sql.Type { return sql.LongText }
+
+// WithChildren implements the Expression interface.
+func (r Right) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 2)
+	}
+	return NewRight(ctx, children[0], children[1]), nil
+}
+
+// Locate returns the position of the first occurrence of a substring in a string, starting at an optional position.
+// Unlike INSTR, it compares the strings by the rules of their collation, so it is case-insensitive unless a
+// case-sensitive or binary collation is involved. POSITION(substr IN str) is the same as LOCATE(substr, str).
+type Locate struct {
+	substr sql.Expression
+	str    sql.Expression
+	pos    sql.Expression
+}
+
+var _ sql.FunctionExpression = Locate{}
+
+// NewLocate creates a new LOCATE function.
+func NewLocate(ctx *sql.Context, args ...sql.Expression) (sql.Expression, error) {
+	switch len(args) {
+	case 2:
+		return Locate{substr: args[0], str: args[1]}, nil
+	case 3:
+		return Locate{substr: args[0], str: args[1], pos: args[2]}, nil
+	default:
+		return nil, sql.ErrInvalidArgumentNumber.New("LOCATE", "2 or 3", len(args))
+	}
+}
+
+// NewPosition creates a new LOCATE function for POSITION(substr IN str).
+func NewPosition(ctx *sql.Context, substr, str sql.Expression) sql.Expression {
+	return Locate{substr: substr, str: str}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l Locate) FunctionName() string {
+	return "locate"
+}
+
+// Children implements the Expression interface.
+func (l Locate) Children() []sql.Expression {
+	if l.pos == nil {
+		return []sql.Expression{l.substr, l.str}
+	}
+	return []sql.Expression{l.substr, l.str, l.pos}
+}
+
+// Eval implements the Expression interface. The result is 0 if the substring is not found, or if the position is not
+// in the string.
+func (l Locate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	substr, err := l.substr.Eval(ctx, row)
+	if err != nil || substr == nil {
+		return nil, err
+	}
+	str, err := l.str.Eval(ctx, row)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	substr, err = sql.LongText.Convert(substr)
+	if err != nil {
+		return nil, err
+	}
+	str, err = sql.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := int64(1)
+	if l.pos != nil {
+		var ok bool
+		pos, ok, err = evalInt64(ctx, l.pos, row)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+
+	text := []rune(str.(string))
+	if pos < 1 || pos > int64(len(text))+1 {
+		return int64(0), nil
+	}
+	collation := expression.CollationOf(l.substr, l.str)
+	idx := collationIndex(collation, text[pos-1:], []rune(substr.(string)))
+	if idx < 0 {
+		return int64(0), nil
+	}
+	return idx + pos, nil
+}
+
+// collationIndex returns the index of the first occurrence of the substring in the text, when compared by the
+// collation given, or -1 if there is none.
+func collationIndex(collation sql.Collation, text, substr []rune) int64 {
+	sub := string(substr)
+	for i := 0; i <= len(text)-len(substr); i++ {
+		if collation.Compare(string(text[i:i+len(substr)]), sub) == 0 {
+			return int64(i)
+		}
+	}
+	return -1
+}
+
+// IsNullable implements the Expression interface.
+func (l Locate) IsNullable() bool {
+	return true
+}
+
+func (l Locate) String() string {
+	if l.pos == nil {
+		return fmt.Sprintf("LOCATE(%s, %s)", l.substr, l.str)
+	}
+	return fmt.Sprintf("LOCATE(%s, %s, %s)", l.substr, l.str, l.pos)
+}
+
+// Resolved implements the Expression interface.
+func (l Locate) Resolved() bool {
+	return l.substr.Resolved() && l.str.Resolved() && (l.pos == nil || l.pos.Resolved())
+}
+
+// Type implements the Expression interface.
+func (Locate) Type() sql.Type { return sql.Int64 }
+
+// WithChildren implements the Expression interface.
+func (l Locate) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(l.Children()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), len(l.Children()))
+	}
+	return NewLocate(ctx, children...)
+}
+
+// Insert is a function that replaces the given number of characters of a string, from a position, with another
+// string. The string is returned unchanged if the position is not in it, and the rest of the string is replaced if
+// the number of characters is not within it.
+type Insert struct {
+	str    sql.Expression
+	pos    sql.Expression
+	len    sql.Expression
+	newStr sql.Expression
+}
+
+var _ sql.FunctionExpression = Insert{}
+
+// NewInsert creates a new INSERT function.
+func NewInsert(ctx *sql.Context, str, pos, len, newStr sql.Expression) sql.Expression {
+	return Insert{str, pos, len, newStr}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (i Insert) FunctionName() string {
+	return "insert"
+}
+
+// Children implements the Expression interface.
+func (i Insert) Children() []sql.Expression {
+	return []sql.Expression{i.str, i.pos, i.len, i.newStr}
+}
+
+// Eval implements the Expression interface.
+func (i Insert) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, err := i.str.Eval(ctx, row)
+	if err != nil || str == nil {
+		return nil, err
+	}
+	newStr, err := i.newStr.Eval(ctx, row)
+	if err != nil || newStr == nil {
+		return nil, err
+	}
+	str, err = sql.LongText.Convert(str)
+	if err != nil {
+		return nil, err
+	}
+	newStr, err = sql.LongText.Convert(newStr)
+	if err != nil {
+		return nil, err
+	}
+
+	pos, ok, err := evalInt64(ctx, i.pos, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	length, ok, err := evalInt64(ctx, i.len, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	text := []rune(str.(string))
+	if pos < 1 || pos > int64(len(text)) {
+		return str, nil
+	}
+	end := int64(len(text))
+	if length >= 0 && length < end-pos+1 {
+		end = pos - 1 + length
+	}
+	return string(text[:pos-1]) + newStr.(string) + string(text[end:]), nil
+}
+
+// IsNullable implements the Expression interface.
+func (i Insert) IsNullable() bool {
+	return true
+}
+
+func (i Insert) String() string {
+	return fmt.Sprintf("INSERT(%s, %s, %s, %s)", i.str, i.pos, i.len, i.newStr)
+}
+
+// Resolved implements the Expression interface.
+func (i Insert) Resolved() bool {
+	return i.str.Resolved() && i.pos.Resolved() && i.len.Resolved() && i.newStr.Resolved()
+}
+
+// Type implements the Expression interface.
+func (Insert) Type() sql.Type { return sql.LongText }
+
+// WithChildren implements the Expression interface.
+func (i Insert) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 4 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 4)
+	}
+	return NewInsert(ctx, children[0], children[1], children[2], children[3]), nil
+}
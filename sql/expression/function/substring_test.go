@@ -196,3 +196,53 @@ func TestLeft(t *testing.T) {
 		})
 	}
 }
+
+func TestRight(t *testing.T) {
+	f := sql.Function2{Name: "right", Fn: NewRight}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 1)
+	tf.AddSucceeding(nil, "foo", nil)
+	tf.AddSucceeding("bar", "foobar", 3)
+	tf.AddSucceeding("foobar", "foobar", 10)
+	tf.AddSucceeding("", "foobar", 0)
+	tf.AddSucceeding("", "foobar", -1)
+	tf.AddSucceeding("éè", "àéè", 2)
+	tf.Test(t, nil, nil)
+}
+
+func TestLocate(t *testing.T) {
+	f := sql.FunctionN{Name: "locate", Fn: NewLocate}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, "foobar")
+	tf.AddSucceeding(nil, "bar", nil)
+	tf.AddSucceeding(nil, "bar", "foobar", nil)
+	tf.AddSucceeding(int64(4), "bar", "foobarbar")
+	tf.AddSucceeding(int64(7), "bar", "foobarbar", 5)
+	tf.AddSucceeding(int64(4), "BAR", "foobarbar")
+	tf.AddSucceeding(int64(0), "xbar", "foobar")
+	tf.AddSucceeding(int64(0), "bar", "foobar", 0)
+	tf.AddSucceeding(int64(0), "bar", "foobar", 8)
+	tf.AddSucceeding(int64(1), "", "foobar")
+	// The default collation is accent-insensitive
+	tf.AddSucceeding(int64(2), "è", "àéèbar")
+	tf.Test(t, nil, nil)
+
+	_, err := NewLocate(sql.NewEmptyContext(), expression.NewLiteral("bar", sql.LongText))
+	require.Error(t, err)
+}
+
+func TestInsert(t *testing.T) {
+	f := sql.Function4{Name: "insert", Fn: NewInsert}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil, 3, 4, "What")
+	tf.AddSucceeding(nil, "Quadratic", nil, 4, "What")
+	tf.AddSucceeding(nil, "Quadratic", 3, nil, "What")
+	tf.AddSucceeding(nil, "Quadratic", 3, 4, nil)
+	tf.AddSucceeding("QuWhattic", "Quadratic", 3, 4, "What")
+	tf.AddSucceeding("Quadratic", "Quadratic", -1, 4, "What")
+	tf.AddSucceeding("Quadratic", "Quadratic", 10, 4, "What")
+	tf.AddSucceeding("QuWhat", "Quadratic", 3, 100, "What")
+	tf.AddSucceeding("QuWhat", "Quadratic", 3, -1, "What")
+	tf.AddSucceeding("QuWhatadratic", "Quadratic", 3, 0, "What")
+	tf.Test(t, nil, nil)
+}
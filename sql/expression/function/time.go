@@ -16,6 +16,7 @@ package function
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -791,7 +792,7 @@ func (n *Now) Children() []sql.Expression { return nil }
 
 // Eval implements the sql.Expression interface.
 func (n *Now) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := ctx.ToSessionTime(ctx.QueryTime())
 	// TODO: Now should return a string formatted depending on context.  This code handles string formatting
 	// and should be enabled at the time we fix the return type
 	/*s, err := formatDate("%Y-%m-%d %H:%i:%s", t)
@@ -1208,7 +1209,7 @@ func NewCurrentTime(ctx *sql.Context) sql.Expression {
 }
 
 func currTimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	t := ctx.QueryTime()
+	t := ctx.ToSessionTime(ctx.QueryTime())
 	return fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second()), nil
 }
 
@@ -1235,7 +1236,7 @@ func NewCurrTimestamp(ctx *sql.Context) sql.Expression {
 }
 
 func currDatetimeLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
-	return ctx.QueryTime(), nil
+	return ctx.ToSessionTime(ctx.QueryTime()), nil
 }
 
 // Eval implements sql.Expression
@@ -1247,3 +1248,232 @@ func (c CurrTimestamp) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 func (c CurrTimestamp) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
 	return NoArgFuncWithChildren(ctx, c, children)
 }
+
+// ConvertTz converts a datetime from one time zone to another. Time zones are either named time zones, such as
+// 'America/New_York', or offsets from UTC, such as '+05:30'.
+type ConvertTz struct {
+	dt     sql.Expression
+	fromTz sql.Expression
+	toTz   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*ConvertTz)(nil)
+
+// NewConvertTz creates a new ConvertTz expression.
+func NewConvertTz(ctx *sql.Context, dt, fromTz, toTz sql.Expression) sql.Expression {
+	return &ConvertTz{dt, fromTz, toTz}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *ConvertTz) FunctionName() string {
+	return "convert_tz"
+}
+
+// Children implements the Expression interface.
+func (c *ConvertTz) Children() []sql.Expression {
+	return []sql.Expression{c.dt, c.fromTz, c.toTz}
+}
+
+// Resolved implements the Expression interface.
+func (c *ConvertTz) Resolved() bool {
+	return c.dt.Resolved() && c.fromTz.Resolved() && c.toTz.Resolved()
+}
+
+// IsNullable implements the Expression interface.
+func (c *ConvertTz) IsNullable() bool {
+	return true
+}
+
+func (c *ConvertTz) String() string {
+	return fmt.Sprintf("CONVERT_TZ(%s, %s, %s)", c.dt, c.fromTz, c.toTz)
+}
+
+// Type implements the Expression interface.
+func (c *ConvertTz) Type() sql.Type {
+	return sql.Datetime
+}
+
+// WithChildren implements the Expression interface.
+func (c *ConvertTz) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 3)
+	}
+	return NewConvertTz(ctx, children[0], children[1], children[2]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, the result is NULL if either time zone is not valid.
+func (c *ConvertTz) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	dt, err := c.dt.Eval(ctx, row)
+	if err != nil || dt == nil {
+		return nil, err
+	}
+
+	datetime, err := sql.Datetime.Convert(dt)
+	if err != nil {
+		ctx.Warn(1292, "Incorrect datetime value: '%v'", dt)
+		return nil, nil
+	}
+
+	from, err := c.evalTimeZone(ctx, c.fromTz, row)
+	if err != nil || from == nil {
+		return nil, err
+	}
+	to, err := c.evalTimeZone(ctx, c.toTz, row)
+	if err != nil || to == nil {
+		return nil, err
+	}
+
+	return sql.ConvertTimeZone(datetime.(time.Time), from, to), nil
+}
+
+// evalTimeZone returns the time zone that the expression given evaluates to, or nil if it is not a valid time zone.
+func (c *ConvertTz) evalTimeZone(ctx *sql.Context, expr sql.Expression, row sql.Row) (*time.Location, error) {
+	tz, err := expr.Eval(ctx, row)
+	if err != nil || tz == nil {
+		return nil, err
+	}
+
+	tz, err = sql.LongText.Convert(tz)
+	if err != nil {
+		return nil, nil
+	}
+
+	if strings.EqualFold(tz.(string), sql.SystemTimeZone) {
+		return sql.SystemLocation(), nil
+	}
+	loc, err := sql.ParseTimeZone(tz.(string))
+	if err != nil {
+		return nil, nil
+	}
+	return loc, nil
+}
+
+// maxTimeMicroseconds is the largest TIME, 838:59:59, in microseconds.
+const maxTimeMicroseconds = (838*3600 + 59*60 + 59) * 1000000
+
+// microsecondsToTime returns the TIME of the number of microseconds given. As in MySQL, numbers of microseconds
+// outside of the range of TIME are clamped to it with a warning that shows the value given.
+func microsecondsToTime(ctx *sql.Context, micros float64, val interface{}) (interface{}, error) {
+	if micros > maxTimeMicroseconds || micros < -maxTimeMicroseconds {
+		ctx.Warn(1292, "Truncated incorrect time value: '%v'", val)
+		micros = math.Max(-maxTimeMicroseconds, math.Min(maxTimeMicroseconds, micros))
+	}
+	return sql.Time.Convert(time.Duration(math.Round(micros)) * time.Microsecond)
+}
+
+// MakeTime returns the time of an hour, a minute and a second.
+type MakeTime struct {
+	hour   sql.Expression
+	minute sql.Expression
+	second sql.Expression
+}
+
+var _ sql.FunctionExpression = (*MakeTime)(nil)
+
+// NewMakeTime creates a new MakeTime function.
+func NewMakeTime(ctx *sql.Context, hour, minute, second sql.Expression) sql.Expression {
+	return &MakeTime{hour, minute, second}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MakeTime) FunctionName() string {
+	return "maketime"
+}
+
+// Children implements the Expression interface.
+func (m *MakeTime) Children() []sql.Expression {
+	return []sql.Expression{m.hour, m.minute, m.second}
+}
+
+// Resolved implements the Expression interface.
+func (m *MakeTime) Resolved() bool {
+	return m.hour.Resolved() && m.minute.Resolved() && m.second.Resolved()
+}
+
+// IsNullable implements the Expression interface.
+func (m *MakeTime) IsNullable() bool {
+	return true
+}
+
+func (m *MakeTime) String() string {
+	return fmt.Sprintf("MAKETIME(%s, %s, %s)", m.hour, m.minute, m.second)
+}
+
+// Type implements the Expression interface.
+func (m *MakeTime) Type() sql.Type {
+	return sql.Time
+}
+
+// WithChildren implements the Expression interface.
+func (m *MakeTime) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 3)
+	}
+	return NewMakeTime(ctx, children[0], children[1], children[2]), nil
+}
+
+// Eval implements the Expression interface. As in MySQL, the result is NULL if the minute or the second is not
+// between 0 and 59.
+func (m *MakeTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	hour, ok, err := evalInt64(ctx, m.hour, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	minute, ok, err := evalInt64(ctx, m.minute, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	sec, err := m.second.Eval(ctx, row)
+	if err != nil || sec == nil {
+		return nil, err
+	}
+	second, err := sql.Float64.Convert(sec)
+	if err != nil {
+		return nil, err
+	}
+
+	if minute < 0 || minute > 59 || second.(float64) < 0 || second.(float64) >= 60 {
+		return nil, nil
+	}
+
+	str := fmt.Sprintf("%d:%02d:%02d", hour, minute, int64(second.(float64)))
+	micros := (float64(hour)*3600+float64(minute)*60)*1000000 + second.(float64)*1000000
+	if hour < 0 {
+		micros = (float64(hour)*3600-float64(minute)*60)*1000000 - second.(float64)*1000000
+	}
+	return microsecondsToTime(ctx, micros, str)
+}
+
+// SecToTime returns the time of a number of seconds.
+type SecToTime struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*SecToTime)(nil)
+
+// NewSecToTime creates a new SecToTime function.
+func NewSecToTime(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &SecToTime{NewUnaryFunc(arg, "SEC_TO_TIME", sql.Time)}
+}
+
+// Eval implements the Expression interface.
+func (s *SecToTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := s.EvalChild(ctx, row)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	seconds, err := sql.Float64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return microsecondsToTime(ctx, seconds.(float64)*1000000, val)
+}
+
+// WithChildren implements the Expression interface.
+func (s *SecToTime) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewSecToTime(ctx, children[0]), nil
+}
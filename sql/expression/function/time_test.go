@@ -351,7 +351,7 @@ func TestCalcWeek(t *testing.T) {
 }
 
 func TestNow(t *testing.T) {
-	date := time.Date(2018, time.December, 2, 16, 25, 0, 0, time.Local)
+	date := time.Date(2018, time.December, 2, 16, 25, 0, 0, time.UTC)
 	testNowFunc := func() time.Time {
 		return date
 	}
@@ -630,3 +630,62 @@ func TestTimeDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeTime(t *testing.T) {
+	testCases := []struct {
+		hour, minute, second interface{}
+		expected             interface{}
+		warnings             int
+	}{
+		{12, 15, 30, "12:15:30", 0},
+		{100, 0, 1.5, "100:00:01.500000", 0},
+		{-1, 30, 0, "-01:30:00", 0},
+		{839, 0, 0, "838:59:59", 1},
+		{-900, 0, 0, "-838:59:59", 1},
+		{12, 60, 0, nil, 0},
+		{12, 0, 60, nil, 0},
+		{12, -1, 0, nil, 0},
+		{nil, 0, 0, nil, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%v:%v:%v", tt.hour, tt.minute, tt.second), func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			f := NewMakeTime(ctx,
+				expression.NewLiteral(tt.hour, sql.Int64),
+				expression.NewLiteral(tt.minute, sql.Int64),
+				expression.NewLiteral(tt.second, sql.Float64),
+			)
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+			require.Len(t, ctx.Warnings(), tt.warnings)
+		})
+	}
+}
+
+func TestSecToTime(t *testing.T) {
+	testCases := []struct {
+		seconds  interface{}
+		expected interface{}
+		warnings int
+	}{
+		{0, "00:00:00", 0},
+		{3661, "01:01:01", 0},
+		{-90.25, "-00:01:30.250000", 0},
+		{"7200", "02:00:00", 0},
+		{3020400, "838:59:59", 1},
+		{nil, nil, 0},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.seconds), func(t *testing.T) {
+			ctx := sql.NewEmptyContext()
+			f := NewSecToTime(ctx, expression.NewLiteral(tt.seconds, sql.LongText))
+			val, err := f.Eval(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, val)
+			assert.Len(t, ctx.Warnings(), tt.warnings)
+		})
+	}
+}
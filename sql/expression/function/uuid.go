@@ -16,6 +16,8 @@ package function
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -102,6 +104,57 @@ func (u UUIDFunc) IsNullable() bool {
 	return false
 }
 
+// UUID_SHORT()
+//
+// Returns a “short” universal identifier as a 64-bit unsigned integer. Values returned by UUID_SHORT() differ from the
+// string-format 128-bit identifiers returned by the UUID() function and have different uniqueness properties. The
+// value is guaranteed to be unique if the server_id of the current server is between 0 and 255 and is unique among
+// the set of source and replica servers, if the system time is not set back between restarts, and if UUID_SHORT() is
+// invoked on average fewer than 16 million times per second between restarts.
+//
+// The value is (server_id & 255) << 56, plus the server startup time in seconds << 24, plus a counter incremented on
+// each call.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_uuid-short
+type UUIDShort struct {
+	NoArgFunc
+}
+
+// uuidShortSequence is the incremented part of UUID_SHORT(), which starts at the server's startup time.
+var uuidShortSequence = uint64(time.Now().Unix()) << 24
+
+var _ sql.FunctionExpression = UUIDShort{}
+var _ sql.NonDeterministicExpression = UUIDShort{}
+
+func NewUUIDShort(ctx *sql.Context) sql.Expression {
+	return UUIDShort{
+		NoArgFunc: NoArgFunc{"uuid_short", sql.Uint64},
+	}
+}
+
+// Eval implements sql.Expression
+func (u UUIDShort) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	var serverID uint64
+	if _, val, ok := sql.SystemVariables.GetGlobal("server_id"); ok {
+		id, err := sql.Uint64.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		serverID = id.(uint64)
+	}
+	seq := atomic.AddUint64(&uuidShortSequence, 1) - 1
+	return (serverID&255)<<56 + seq, nil
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (u UUIDShort) IsNonDeterministic() bool {
+	return true
+}
+
+// WithChildren implements sql.Expression
+func (u UUIDShort) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	return NoArgFuncWithChildren(ctx, u, children)
+}
+
 // IS_UUID(string_uuid)
 //
 // Returns 1 if the argument is a valid string-format UUID, 0 if the argument is not a valid UUID, and NULL if the
@@ -220,3 +220,20 @@ func TestBinToUUIDFailing(t *testing.T) {
 		})
 	}
 }
+
+func TestUUIDShort(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	f := NewUUIDShort(ctx)
+	require.True(t, f.(sql.NonDeterministicExpression).IsNonDeterministic())
+	require.Equal(t, sql.Uint64, f.Type())
+
+	_, serverID, ok := sql.SystemVariables.GetGlobal("server_id")
+	require.True(t, ok)
+
+	first, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	second, err := f.Eval(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, first.(uint64)+1, second.(uint64))
+	require.Equal(t, serverID.(uint64)&255, first.(uint64)>>56)
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// VecDistance is one of the VEC_DISTANCE_L2, VEC_DISTANCE_COSINE and VEC_DISTANCE_DOT functions, which return the
+// distance between two vectors of the same dimensions. Vectors may be given in their text format, as in
+// VEC_DISTANCE_L2(v, '[1,2,3]'). The cosine distance involving a vector of zeros is NULL.
+type VecDistance struct {
+	expression.BinaryExpression
+	distance sql.VectorDistance
+}
+
+var _ sql.FunctionExpression = (*VecDistance)(nil)
+
+// NewVecDistanceL2 creates a new VEC_DISTANCE_L2 function.
+func NewVecDistanceL2(ctx *sql.Context, a, b sql.Expression) sql.Expression {
+	return NewVecDistance(a, b, sql.VectorDistance_L2)
+}
+
+// NewVecDistanceCosine creates a new VEC_DISTANCE_COSINE function.
+func NewVecDistanceCosine(ctx *sql.Context, a, b sql.Expression) sql.Expression {
+	return NewVecDistance(a, b, sql.VectorDistance_Cosine)
+}
+
+// NewVecDistanceDot creates a new VEC_DISTANCE_DOT function.
+func NewVecDistanceDot(ctx *sql.Context, a, b sql.Expression) sql.Expression {
+	return NewVecDistance(a, b, sql.VectorDistance_Dot)
+}
+
+// NewVecDistance creates a new function returning the distance given between two vectors.
+func NewVecDistance(a, b sql.Expression, distance sql.VectorDistance) *VecDistance {
+	return &VecDistance{BinaryExpression: expression.BinaryExpression{Left: a, Right: b}, distance: distance}
+}
+
+// Distance returns the distance computed by the function.
+func (d *VecDistance) Distance() sql.VectorDistance {
+	return d.distance
+}
+
+// FunctionName implements sql.FunctionExpression
+func (d *VecDistance) FunctionName() string {
+	return "vec_distance_" + strings.ToLower(d.distance.String())
+}
+
+// Type implements sql.Expression
+func (d *VecDistance) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements sql.Expression
+func (d *VecDistance) IsNullable() bool {
+	return true
+}
+
+func (d *VecDistance) String() string {
+	return fmt.Sprintf("VEC_DISTANCE_%s(%s, %s)", d.distance, d.Left, d.Right)
+}
+
+// Eval implements sql.Expression
+func (d *VecDistance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	a, ok, err := evalVector(ctx, d.Left, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	b, ok, err := evalVector(ctx, d.Right, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	dist, err := d.distance.Distance(a, b)
+	if err != nil || math.IsNaN(dist) {
+		return nil, err
+	}
+	return dist, nil
+}
+
+// WithChildren implements sql.Expression
+func (d *VecDistance) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 2)
+	}
+	return NewVecDistance(children[0], children[1], d.distance), nil
+}
+
+// evalVector evaluates the expression given into a vector. The result is false if the expression is NULL.
+func evalVector(ctx *sql.Context, e sql.Expression, row sql.Row) ([]float32, bool, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return nil, false, err
+	}
+	vec, err := sql.Vector.Convert(val)
+	if err != nil {
+		return nil, false, err
+	}
+	return vec.([]float32), true, nil
+}
+
+// StringToVector returns the vector written in the text format given, such as '[1.5,2,-3]'. It is also the
+// TO_VECTOR function.
+// https://dev.mysql.com/doc/refman/9.0/en/vector-functions.html#function_string-to-vector
+type StringToVector struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*StringToVector)(nil)
+
+// NewStringToVector creates a new STRING_TO_VECTOR function.
+func NewStringToVector(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &StringToVector{NewUnaryFunc(arg, "STRING_TO_VECTOR", sql.Vector)}
+}
+
+// Eval implements the Expression interface.
+func (s *StringToVector) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	text, ok, err := evalLongText(ctx, s.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return sql.Vector.Convert(text)
+}
+
+// WithChildren implements the Expression interface.
+func (s *StringToVector) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	return NewStringToVector(ctx, children[0]), nil
+}
+
+// VectorToString returns the text format of a vector, with its elements in scientific notation. It is also the
+// FROM_VECTOR function.
+// https://dev.mysql.com/doc/refman/9.0/en/vector-functions.html#function_vector-to-string
+type VectorToString struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*VectorToString)(nil)
+
+// NewVectorToString creates a new VECTOR_TO_STRING function.
+func NewVectorToString(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &VectorToString{NewUnaryFunc(arg, "VECTOR_TO_STRING", sql.LongText)}
+}
+
+// Eval implements the Expression interface.
+func (v *VectorToString) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	vec, ok, err := evalVector(ctx, v.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return sql.FormatVector(vec), nil
+}
+
+// WithChildren implements the Expression interface.
+func (v *VectorToString) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVectorToString(ctx, children[0]), nil
+}
+
+// VectorDim returns the number of dimensions of a vector.
+// https://dev.mysql.com/doc/refman/9.0/en/vector-functions.html#function_vector-dim
+type VectorDim struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*VectorDim)(nil)
+
+// NewVectorDim creates a new VECTOR_DIM function.
+func NewVectorDim(ctx *sql.Context, arg sql.Expression) sql.Expression {
+	return &VectorDim{NewUnaryFunc(arg, "VECTOR_DIM", sql.Int64)}
+}
+
+// Eval implements the Expression interface.
+func (v *VectorDim) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	vec, ok, err := evalVector(ctx, v.Child, row)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return int64(len(vec)), nil
+}
+
+// WithChildren implements the Expression interface.
+func (v *VectorDim) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(v, len(children), 1)
+	}
+	return NewVectorDim(ctx, children[0]), nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestVecDistance(t *testing.T) {
+	tf := NewTestFactory(sql.Function2{Name: "vec_distance_l2", Fn: NewVecDistanceL2}.Fn)
+	tf.AddSucceeding(nil, nil, "[1,2]")
+	tf.AddSucceeding(5.0, "[0,0]", "[3,4]")
+	tf.AddSucceeding(0.0, "[1,2]", "[1,2]")
+	tf.AddFailing("[1,2]", "[1,2,3]")
+	tf.AddFailing("[1,2]", "1,2")
+	tf.Test(t, nil, nil)
+
+	tf = NewTestFactory(sql.Function2{Name: "vec_distance_cosine", Fn: NewVecDistanceCosine}.Fn)
+	tf.AddSucceeding(1.0, "[1,0]", "[0,3]")
+	tf.AddSucceeding(2.0, "[1,0]", "[-2,0]")
+	tf.AddSucceeding(nil, "[0,0]", "[1,1]")
+	tf.Test(t, nil, nil)
+
+	tf = NewTestFactory(sql.Function2{Name: "vec_distance_dot", Fn: NewVecDistanceDot}.Fn)
+	tf.AddSucceeding(-11.0, "[1,2]", "[3,4]")
+	tf.AddSucceeding(2.0, "[1,-1]", "[-1,1]")
+	tf.Test(t, nil, nil)
+}
+
+func TestStringToVector(t *testing.T) {
+	tests := []struct {
+		arg      interface{}
+		expected interface{}
+		err      bool
+	}{
+		{nil, nil, false},
+		{"[1, -2.5, 3e2]", []float32{1, -2.5, 300}, false},
+		{"[ ]", []float32{}, false},
+		{"[1,2", nil, true},
+		{"[1,,2]", nil, true},
+		{"[1,nan]", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.arg), func(t *testing.T) {
+			f := NewStringToVector(sql.NewEmptyContext(), expression.NewLiteral(tt.arg, sql.LongText))
+			val, err := f.Eval(sql.NewEmptyContext(), nil)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestVectorToString(t *testing.T) {
+	f := sql.Function1{Name: "vector_to_string", Fn: NewVectorToString}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding("[1.00000e+00,-2.50000e-01]", "[1,-0.25]")
+	tf.AddSucceeding("[3.00000e+02]", "[300]")
+	tf.AddFailing("300")
+	tf.Test(t, nil, nil)
+}
+
+func TestVectorDim(t *testing.T) {
+	f := sql.Function1{Name: "vector_dim", Fn: NewVectorDim}
+	tf := NewTestFactory(f.Fn)
+	tf.AddSucceeding(nil, nil)
+	tf.AddSucceeding(int64(3), "[1,2,3]")
+	tf.AddSucceeding(int64(0), "[]")
+	tf.Test(t, nil, nil)
+}
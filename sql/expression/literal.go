@@ -63,6 +63,9 @@ func (p *Literal) String() string {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 		return fmt.Sprintf("%d", v)
 	case string:
+		if sql.IsDecimal(p.fieldType) {
+			return v
+		}
 		return fmt.Sprintf("%q", v)
 	case []byte:
 		return "BLOB"
@@ -0,0 +1,149 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Match is a MATCH (...) AGAINST (...) expression, which returns the relevance of a row to a full-text search. Its
+// columns must be covered by a FULLTEXT index, which is assigned during analysis.
+type Match struct {
+	Columns []sql.Expression
+	Against sql.Expression
+	Mode    sql.FullTextSearchMode
+	// Index is the full-text index that is searched, set once the columns have been resolved.
+	Index sql.FullTextIndex
+
+	mu       *sync.Mutex
+	searcher sql.FullTextSearcher
+}
+
+var _ sql.Expression = (*Match)(nil)
+
+// NewMatch creates a new Match expression.
+func NewMatch(columns []sql.Expression, against sql.Expression, mode sql.FullTextSearchMode) *Match {
+	return &Match{
+		Columns: columns,
+		Against: against,
+		Mode:    mode,
+		mu:      &sync.Mutex{},
+	}
+}
+
+// WithIndex returns a copy of this expression that searches the index given.
+func (m *Match) WithIndex(index sql.FullTextIndex) *Match {
+	nm := NewMatch(m.Columns, m.Against, m.Mode)
+	nm.Index = index
+	return nm
+}
+
+// Resolved implements the Expression interface.
+func (m *Match) Resolved() bool {
+	for _, col := range m.Columns {
+		if !col.Resolved() {
+			return false
+		}
+	}
+	return m.Against.Resolved()
+}
+
+// Type implements the Expression interface.
+func (m *Match) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (m *Match) IsNullable() bool {
+	return false
+}
+
+// Children implements the Expression interface.
+func (m *Match) Children() []sql.Expression {
+	children := make([]sql.Expression, len(m.Columns)+1)
+	copy(children, m.Columns)
+	children[len(m.Columns)] = m.Against
+	return children
+}
+
+// WithChildren implements the Expression interface.
+func (m *Match) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(m.Columns)+1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), len(m.Columns)+1)
+	}
+	nm := NewMatch(children[:len(m.Columns)], children[len(m.Columns)], m.Mode)
+	nm.Index = m.Index
+	return nm, nil
+}
+
+// Eval implements the Expression interface.
+func (m *Match) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	searcher, err := m.getSearcher(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(m.Columns))
+	for i, col := range m.Columns {
+		values[i], err = col.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return searcher.Relevance(ctx, values)
+}
+
+// getSearcher returns the searcher for this expression, starting the search on the first call.
+func (m *Match) getSearcher(ctx *sql.Context) (sql.FullTextSearcher, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.searcher != nil {
+		return m.searcher, nil
+	}
+	if m.Index == nil {
+		return nil, sql.ErrFullTextIndexNotFound.New()
+	}
+
+	against, err := m.Against.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	query, err := sql.LongText.Convert(against)
+	if err != nil {
+		return nil, err
+	}
+	if query == nil {
+		query = ""
+	}
+
+	m.searcher, err = m.Index.FullTextSearch(ctx, query.(string), m.Mode)
+	if err != nil {
+		return nil, err
+	}
+	return m.searcher, nil
+}
+
+func (m *Match) String() string {
+	columns := make([]string, len(m.Columns))
+	for i, col := range m.Columns {
+		columns[i] = col.String()
+	}
+	return fmt.Sprintf("MATCH (%s) AGAINST (%s %s)", strings.Join(columns, ", "), m.Against, m.Mode)
+}
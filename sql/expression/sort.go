@@ -34,12 +34,16 @@ func (s *Sorter) Swap(i, j int) {
 }
 
 func (s *Sorter) Less(i, j int) bool {
+	return s.LessRows(s.Rows[i], s.Rows[j])
+}
+
+// LessRows returns whether the row a sorts before the row b. Rows that don't come from Rows, such as rows read back
+// from disk, can be compared with it.
+func (s *Sorter) LessRows(a, b sql.Row) bool {
 	if s.LastError != nil {
 		return false
 	}
 
-	a := s.Rows[i]
-	b := s.Rows[j]
 	for _, sf := range s.SortFields {
 		typ := sf.Column.Type()
 		av, err := sf.Column.Eval(s.Ctx, a)
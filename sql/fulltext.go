@@ -0,0 +1,225 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io/ioutil"
+	"strings"
+	"unicode"
+)
+
+// FullTextSearchMode is the modifier given to a MATCH ... AGAINST expression.
+type FullTextSearchMode byte
+
+const (
+	FullTextSearchMode_NaturalLanguage FullTextSearchMode = iota
+	FullTextSearchMode_Boolean
+)
+
+// String returns the modifier as it's written in a MATCH ... AGAINST expression.
+func (m FullTextSearchMode) String() string {
+	switch m {
+	case FullTextSearchMode_Boolean:
+		return "IN BOOLEAN MODE"
+	default:
+		return "IN NATURAL LANGUAGE MODE"
+	}
+}
+
+// FullTextIndex is an index that supports full-text searches through MATCH ... AGAINST. Full-text indexes are never
+// used for regular index lookups.
+type FullTextIndex interface {
+	Index
+	// FullTextSearch returns a FullTextSearcher that scores rows of the index's table against the given query.
+	FullTextSearch(ctx *Context, query string, mode FullTextSearchMode) (FullTextSearcher, error)
+}
+
+// FullTextSearcher computes the relevance of rows to a full-text search.
+type FullTextSearcher interface {
+	// Relevance returns the relevance of a row to the search, given the values of the columns named in MATCH(...).
+	// Rows that do not match the search have a relevance of 0.
+	Relevance(ctx *Context, values []interface{}) (float64, error)
+}
+
+// FullTextBuiltinStopwords is the value of ft_stopword_file that selects the built-in stopword list.
+const FullTextBuiltinStopwords = "(built-in)"
+
+// fullTextBuiltinStopwords are the default stopwords of InnoDB full-text indexes.
+var fullTextBuiltinStopwords = []string{
+	"a", "about", "an", "are", "as", "at", "be", "by", "com", "de", "en", "for", "from", "how", "i", "in", "is", "it",
+	"la", "of", "on", "or", "that", "the", "this", "to", "was", "what", "when", "where", "who", "will", "with", "und",
+	"www",
+}
+
+// FullTextConfig holds the settings used to split text into words for full-text indexing and searching.
+type FullTextConfig struct {
+	// MinWordLen is the minimum length of an indexed word, from ft_min_word_len.
+	MinWordLen int
+	// MaxWordLen is the maximum length of an indexed word, from ft_max_word_len.
+	MaxWordLen int
+	// Stopwords are the words that are never indexed, loaded according to ft_stopword_file.
+	Stopwords map[string]struct{}
+}
+
+// NewFullTextConfig returns the full-text configuration given by the current values of the ft_min_word_len,
+// ft_max_word_len and ft_stopword_file system variables. An empty ft_stopword_file disables stopwords, while any
+// value other than the built-in marker is read as a file of whitespace separated stopwords. The variables are read-only,
+// so full-text indexes read the configuration once, when they are built.
+func NewFullTextConfig() (FullTextConfig, error) {
+	config := FullTextConfig{Stopwords: make(map[string]struct{})}
+	if _, val, ok := SystemVariables.GetGlobal("ft_min_word_len"); ok {
+		config.MinWordLen = int(val.(int64))
+	}
+	if _, val, ok := SystemVariables.GetGlobal("ft_max_word_len"); ok {
+		config.MaxWordLen = int(val.(int64))
+	}
+
+	stopwordFile := FullTextBuiltinStopwords
+	if _, val, ok := SystemVariables.GetGlobal("ft_stopword_file"); ok {
+		stopwordFile = val.(string)
+	}
+	switch stopwordFile {
+	case "":
+	case FullTextBuiltinStopwords:
+		for _, word := range fullTextBuiltinStopwords {
+			config.Stopwords[word] = struct{}{}
+		}
+	default:
+		contents, err := ioutil.ReadFile(stopwordFile)
+		if err != nil {
+			return FullTextConfig{}, err
+		}
+		for _, word := range strings.Fields(string(contents)) {
+			config.Stopwords[strings.ToLower(word)] = struct{}{}
+		}
+	}
+	return config, nil
+}
+
+// Tokenize splits the given text into lowercase words. Words that are not indexable are included, so that the
+// positions of the words are preserved for phrase searches.
+func (c FullTextConfig) Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isFullTextWordRune(r)
+	})
+}
+
+// IsIndexable returns whether the given word is added to full-text indexes, which excludes stopwords and words
+// outside of the configured length bounds.
+func (c FullTextConfig) IsIndexable(word string) bool {
+	length := len([]rune(word))
+	if length < c.MinWordLen || (c.MaxWordLen > 0 && length > c.MaxWordLen) {
+		return false
+	}
+	_, isStopword := c.Stopwords[word]
+	return !isStopword
+}
+
+func isFullTextWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// FullTextOperator is the operator applied to a term of a boolean full-text search.
+type FullTextOperator byte
+
+const (
+	// FullTextOperator_Optional terms increase the relevance of rows that contain them.
+	FullTextOperator_Optional FullTextOperator = iota
+	// FullTextOperator_Required terms, prefixed with +, must be present in every matching row.
+	FullTextOperator_Required
+	// FullTextOperator_Excluded terms, prefixed with -, must not be present in any matching row.
+	FullTextOperator_Excluded
+	// FullTextOperator_Negated terms, prefixed with ~, decrease the relevance of rows that contain them.
+	FullTextOperator_Negated
+)
+
+// FullTextTerm is a single term of a full-text search.
+type FullTextTerm struct {
+	// Words holds a single word, or every word of a quoted phrase.
+	Words    []string
+	Operator FullTextOperator
+	// Prefix is set for words followed by *, which match every word beginning with them.
+	Prefix bool
+}
+
+// IsPhrase returns whether this term is a quoted phrase.
+func (t FullTextTerm) IsPhrase() bool {
+	return len(t.Words) > 1
+}
+
+// ParseFullTextQuery splits the query of a MATCH ... AGAINST expression into its terms. In natural language mode every
+// indexable word is an optional term. In boolean mode the operators +, -, ~, * and double-quoted phrases are
+// recognized; the grouping and weighting operators ( ) < > are ignored. Words that are not indexable are dropped,
+// unless they are part of a phrase.
+func ParseFullTextQuery(config FullTextConfig, query string, mode FullTextSearchMode) []FullTextTerm {
+	var terms []FullTextTerm
+	if mode != FullTextSearchMode_Boolean {
+		seen := make(map[string]struct{})
+		for _, word := range config.Tokenize(query) {
+			if _, ok := seen[word]; ok || !config.IsIndexable(word) {
+				continue
+			}
+			seen[word] = struct{}{}
+			terms = append(terms, FullTextTerm{Words: []string{word}})
+		}
+		return terms
+	}
+
+	runes := []rune(strings.ToLower(query))
+	operator := FullTextOperator_Optional
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '+':
+			operator = FullTextOperator_Required
+			i++
+		case r == '-':
+			operator = FullTextOperator_Excluded
+			i++
+		case r == '~':
+			operator = FullTextOperator_Negated
+			i++
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			words := config.Tokenize(string(runes[i+1 : end]))
+			if len(words) > 0 {
+				terms = append(terms, FullTextTerm{Words: words, Operator: operator})
+			}
+			operator = FullTextOperator_Optional
+			i = end + 1
+		case isFullTextWordRune(r):
+			end := i
+			for end < len(runes) && isFullTextWordRune(runes[end]) {
+				end++
+			}
+			word := string(runes[i:end])
+			prefix := end < len(runes) && runes[end] == '*'
+			_, isStopword := config.Stopwords[word]
+			if (prefix && !isStopword) || config.IsIndexable(word) {
+				terms = append(terms, FullTextTerm{Words: []string{word}, Operator: operator, Prefix: prefix})
+			}
+			operator = FullTextOperator_Optional
+			i = end
+		default:
+			// Whitespace and unsupported operators reset any pending operator
+			operator = FullTextOperator_Optional
+			i++
+		}
+	}
+	return terms
+}
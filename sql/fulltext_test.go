@@ -0,0 +1,76 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFullTextQuery(t *testing.T) {
+	config := FullTextConfig{
+		MinWordLen: 3,
+		MaxWordLen: 84,
+		Stopwords:  map[string]struct{}{"the": {}},
+	}
+
+	tests := []struct {
+		query    string
+		mode     FullTextSearchMode
+		expected []FullTextTerm
+	}{
+		{
+			"The quick, quick FOX is up",
+			FullTextSearchMode_NaturalLanguage,
+			[]FullTextTerm{
+				{Words: []string{"quick"}},
+				{Words: []string{"fox"}},
+			},
+		},
+		{
+			"+apple -Banana ~cherry date",
+			FullTextSearchMode_Boolean,
+			[]FullTextTerm{
+				{Words: []string{"apple"}, Operator: FullTextOperator_Required},
+				{Words: []string{"banana"}, Operator: FullTextOperator_Excluded},
+				{Words: []string{"cherry"}, Operator: FullTextOperator_Negated},
+				{Words: []string{"date"}},
+			},
+		},
+		{
+			`+"the red fox" ap* +the`,
+			FullTextSearchMode_Boolean,
+			[]FullTextTerm{
+				{Words: []string{"the", "red", "fox"}, Operator: FullTextOperator_Required},
+				{Words: []string{"ap"}, Prefix: true},
+			},
+		},
+		{
+			"(grouped) >weighted",
+			FullTextSearchMode_Boolean,
+			[]FullTextTerm{
+				{Words: []string{"grouped"}},
+				{Words: []string{"weighted"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			require.Equal(t, test.expected, ParseFullTextQuery(config, test.query, test.mode))
+		})
+	}
+}
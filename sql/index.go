@@ -57,6 +57,16 @@ type AscendIndex interface {
 	AscendRange(greaterOrEqual, lessThan []interface{}) (IndexLookup, error)
 }
 
+// OrderedIndex is an AscendIndex that can return all the rows of its table sorted in ascending order of the indexed
+// expressions, with NULLs first. The rows of its ordered lookups are sorted across all the partitions of the table,
+// in the order they are returned. The analyzer joins two tables ordered on the columns of an equality of the join
+// condition by merging those lookups, rather than looking up the rows of one table for every row of the other.
+type OrderedIndex interface {
+	AscendIndex
+	// AscendAll returns an IndexLookup for all the rows of the table, in ascending order of the indexed expressions.
+	AscendAll() (IndexLookup, error)
+}
+
 // DescendIndex is an index that is sorted in descending order.
 type DescendIndex interface {
 	// DescendGreater returns an IndexLookup for keys that are greater
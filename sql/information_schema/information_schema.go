@@ -22,12 +22,12 @@ import (
 	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 
 	. "github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 const (
@@ -560,7 +560,7 @@ func columnsRowIter(ctx *Context, cat *Catalog) (RowIter, error) {
 					c.Extra,                          // extra
 					"select",                         // privileges
 					c.Comment,                        // column_comment
-					"",                               // generation_expression
+					c.GenerationExpressionString(),   // generation_expression
 				})
 			}
 			return true, nil
@@ -942,6 +942,41 @@ func innoDBTempTableIter(ctx *Context, c *Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+func columnStatisticsRowIter(ctx *Context, c *Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			at, ok := t.(AnalyzableTable)
+			if !ok {
+				return true, nil
+			}
+			stats, err := at.ColumnStatistics(ctx)
+			if err != nil || stats == nil {
+				return err == nil, err
+			}
+
+			for _, col := range t.Schema() {
+				colStats := stats.Column(col.Name)
+				if colStats == nil {
+					continue
+				}
+				rows = append(rows, Row{
+					db.Name(),                                // schema_name
+					t.Name(),                                 // table_name
+					col.Name,                                 // column_name
+					histogramJSON(colStats, stats.CreatedAt), // histogram
+				})
+			}
+			return true, nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 func emptyRowIter(ctx *Context, c *Catalog) (RowIter, error) {
 	return RowsToRowIter(), nil
 }
@@ -960,6 +995,7 @@ func NewInformationSchemaDatabase(cat *Catalog) Database {
 				name:    ColumnStatisticsTableName,
 				schema:  columnStatisticsSchema,
 				catalog: cat,
+				rowIter: columnStatisticsRowIter,
 			},
 			TablesTableName: &informationSchemaTable{
 				name:    TablesTableName,
@@ -1195,3 +1231,74 @@ func getAutoIncrementValue(ctx *Context, t Table) (val interface{}) {
 	}
 	return
 }
+
+// histogramJSON returns the statistics of a column in the format of the histograms of MySQL.
+func histogramJSON(stats *ColumnStatistics, lastUpdated time.Time) JSONDocument {
+	buckets := make([]interface{}, len(stats.Histogram))
+	var cumulative uint64
+	for i, b := range stats.Histogram {
+		cumulative += b.RowCount
+		buckets[i] = []interface{}{
+			histogramValue(b.LowerBound),
+			histogramValue(b.UpperBound),
+			float64(cumulative) / float64(stats.RowCount),
+			b.DistinctCount,
+		}
+	}
+
+	collation := Collation_binary
+	if st, ok := stats.Type.(StringType); ok {
+		collation = st.Collation()
+	}
+
+	return JSONDocument{Val: map[string]interface{}{
+		"buckets":                     buckets,
+		"data-type":                   histogramDataType(stats.Type),
+		"null-values":                 stats.NullSelectivity(),
+		"collation-id":                collation.ID(),
+		"last-updated":                lastUpdated.UTC().Format("2006-01-02 15:04:05.000000"),
+		"sampling-rate":               1.0,
+		"histogram-type":              "equi-height",
+		"number-of-buckets-specified": stats.BucketsSpecified,
+	}}
+}
+
+// histogramDataType returns the name MySQL gives the type of the values of a histogram.
+func histogramDataType(t Type) string {
+	switch {
+	case IsInteger(t):
+		return "int"
+	case IsFloat(t):
+		return "double"
+	case IsDecimal(t):
+		return "decimal"
+	case t == Date:
+		return "date"
+	case IsTime(t):
+		return "datetime"
+	case t == Time:
+		return "time"
+	default:
+		switch t.(type) {
+		case EnumType:
+			return "enum"
+		case SetType:
+			return "set"
+		}
+		return "string"
+	}
+}
+
+// histogramValue returns a value of a histogram as a JSON value.
+func histogramValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.000000")
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
@@ -171,6 +171,15 @@ func (t numberTypeImpl) Convert(v interface{}) (interface{}, error) {
 		v = jd.Val
 	}
 
+	// Strings holding decimals, such as the values of DECIMAL expressions, are converted to integers like decimals
+	if str, ok := v.(string); ok && t.baseType != sqltypes.Float32 && t.baseType != sqltypes.Float64 {
+		if _, err := strconv.ParseInt(str, 0, 64); err != nil {
+			if dec, err := decimal.NewFromString(str); err == nil {
+				v = dec
+			}
+		}
+	}
+
 	switch t.baseType {
 	case sqltypes.Int8:
 		if dec, ok := v.(decimal.Decimal); ok {
@@ -22,8 +22,8 @@ import (
 	"time"
 	"unicode"
 
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/opentracing/opentracing-go"
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -31,6 +31,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 var (
@@ -161,13 +162,13 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		// TODO: fix this
 		ddl, err := sqlparser.ParseStrictDDL(query)
 		if err != nil {
-			return nil, err
+			return nil, sql.ErrSyntaxError.New(err.Error())
 		}
 		return convertDDL(ctx, query, ddl.(*sqlparser.DDL))
 	case *sqlparser.MultiAlterDDL:
 		multiAlterDdl, err := sqlparser.ParseStrictDDL(query)
 		if err != nil {
-			return nil, err
+			return nil, sql.ErrSyntaxError.New(err.Error())
 		}
 		return convertMultiAlterDDL(ctx, query, multiAlterDdl.(*sqlparser.MultiAlterDDL))
 	case *sqlparser.DBDDL:
@@ -212,6 +213,8 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		return convertLockTables(ctx, n)
 	case *sqlparser.UnlockTables:
 		return convertUnlockTables(ctx, n)
+	case *sqlparser.Analyze:
+		return convertAnalyze(ctx, n)
 	}
 }
 
@@ -948,6 +951,14 @@ func convertUnlockTables(ctx *sql.Context, s *sqlparser.UnlockTables) (sql.Node,
 	return plan.NewUnlockTables(), nil
 }
 
+func convertAnalyze(ctx *sql.Context, s *sqlparser.Analyze) (sql.Node, error) {
+	tables := make([]sql.Node, len(s.Tables))
+	for i, table := range s.Tables {
+		tables[i] = tableNameToUnresolvedTable(table)
+	}
+	return plan.NewAnalyzeTable(tables), nil
+}
+
 func convertSignalConditionItemName(name sqlparser.SignalConditionItemName) (plan.SignalConditionItemName, error) {
 	// We convert to our own plan equivalents to keep a separation between the parser and implementation
 	switch name {
@@ -1268,12 +1279,13 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 			continue
 		}
 
-		//TODO: add vitess support for FULLTEXT
 		constraint := sql.IndexConstraint_None
 		if idxDef.Info.Unique {
 			constraint = sql.IndexConstraint_Unique
 		} else if idxDef.Info.Spatial {
 			constraint = sql.IndexConstraint_Spatial
+		} else if idxDef.Info.Fulltext {
+			constraint = sql.IndexConstraint_Fulltext
 		}
 
 		columns := make([]sql.IndexColumn, len(idxDef.Columns))
@@ -1623,6 +1635,10 @@ func validateAutoIncrement(schema sql.Schema) error {
 // columnDefinitionToColumn returns the sql.Column for the column definition given, as part of a create table statement.
 func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition, indexes []*sqlparser.IndexDefinition) (*sql.Column, error) {
 	internalTyp, err := sql.ColumnTypeToType(&cd.Type)
+	if sql.ErrUnknownColumnType.Is(err) {
+		// Types that are not built in are custom types, which the analyzer resolves from the catalog
+		internalTyp, err = customColumnType(&cd.Type)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1660,18 +1676,62 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		extra = "auto_increment"
 	}
 
+	var generated *sql.ColumnDefaultValue
+	if cd.Type.GeneratedExpr != nil {
+		if cd.Type.Default != nil || cd.Type.Autoincrement {
+			return nil, sql.ErrGeneratedColumnWithDefault.New(cd.Name.String())
+		}
+		if isPkey && !bool(cd.Type.Stored) {
+			return nil, sql.ErrVirtualColumnPrimaryKey.New(cd.Name.String())
+		}
+
+		generatedExpr, err := ExprToExpression(ctx, cd.Type.GeneratedExpr)
+		if err != nil {
+			return nil, err
+		}
+		// Generated values are always expressions, and nullability is enforced when the row is written
+		generated, err = sql.NewColumnDefaultValue(generatedExpr, nil, false, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if cd.Type.Stored {
+			extra = "STORED GENERATED"
+		} else {
+			extra = "VIRTUAL GENERATED"
+		}
+	}
+
 	return &sql.Column{
 		Nullable:      !isPkey && !bool(cd.Type.NotNull),
 		Type:          internalTyp,
 		Name:          cd.Name.String(),
 		PrimaryKey:    isPkey,
 		Default:       defaultVal,
+		Generated:     generated,
+		Virtual:       generated != nil && !bool(cd.Type.Stored),
 		AutoIncrement: bool(cd.Type.Autoincrement),
 		Comment:       comment,
 		Extra:         extra,
 	}, nil
 }
 
+// customColumnType returns the sql.UnresolvedCustomType of the column type given.
+func customColumnType(ct *sqlparser.ColumnType) (sql.Type, error) {
+	var params []int64
+	for _, param := range []*sqlparser.SQLVal{ct.Length, ct.Scale} {
+		if param == nil {
+			break
+		}
+		p, err := strconv.ParseInt(string(param.Val), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+	return sql.NewUnresolvedCustomType(ct.Type, params...), nil
+}
+
 func convertDefaultExpression(ctx *sql.Context, defaultExpr sqlparser.Expr) (*sql.ColumnDefaultValue, error) {
 	if defaultExpr == nil {
 		return nil, nil
@@ -1781,6 +1841,18 @@ func tableExprToTable(
 				return plan.NewTableAlias(t.As.String(), node), nil
 			}
 
+			return node, nil
+		case *sqlparser.TableFuncExpr:
+			args, err := selectExprsToExpressions(ctx, e.Exprs)
+			if err != nil {
+				return nil, err
+			}
+
+			node := plan.NewUnresolvedTableFunction(e.Name.Lowered(), args)
+			if !t.As.IsEmpty() {
+				return plan.NewTableAlias(t.As.String(), node), nil
+			}
+
 			return node, nil
 		case *sqlparser.Subquery:
 			node, err := convert(ctx, e.Select, sqlparser.String(e.Select))
@@ -2223,11 +2295,10 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 
 		// NOTE: The count distinct expressions work differently due to the * syntax. eg. COUNT(*)
 		if v.Distinct && v.Name.Lowered() == "count" {
-			if len(exprs) != 1 {
-				return nil, ErrUnsupportedSyntax.New("more than one expression in COUNT")
+			if len(exprs) == 1 {
+				return aggregation.NewCountDistinct(exprs[0]), nil
 			}
-
-			return aggregation.NewCountDistinct(exprs[0]), nil
+			return aggregation.NewCountDistinct(expression.NewTuple(exprs...)), nil
 		}
 
 		// NOTE: Not all aggregate functions support DISTINCT. Fortunately, the vitess parser will throw
@@ -2299,6 +2370,18 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		}
 
 		return expression.NewConvert(expr, v.Type.Type), nil
+	case *sqlparser.ConvertUsingExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		charset, err := sql.ParseCharacterSet(strings.ToLower(v.Type))
+		if err != nil {
+			return nil, err
+		}
+
+		return expression.NewConvertUsing(expr, charset), nil
 	case *sqlparser.RangeCond:
 		val, err := ExprToExpression(ctx, v.Left)
 		if err != nil {
@@ -2347,10 +2430,51 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 		// TODO: get the original select statement, not the reconstruction
 		selectString := sqlparser.String(v.Select)
 		return plan.NewSubquery(node, selectString), nil
+	case *sqlparser.ExistsExpr:
+		subquery, err := ExprToExpression(ctx, v.Subquery)
+		if err != nil {
+			return nil, err
+		}
+		return plan.NewExistsSubquery(subquery.(*plan.Subquery)), nil
 	case *sqlparser.CaseExpr:
 		return caseExprToExpression(ctx, v)
 	case *sqlparser.IntervalExpr:
 		return intervalExprToExpression(ctx, v)
+	case *sqlparser.TimestampFuncExpr:
+		expr1, err := ExprToExpression(ctx, v.Expr1)
+		if err != nil {
+			return nil, err
+		}
+		expr2, err := ExprToExpression(ctx, v.Expr2)
+		if err != nil {
+			return nil, err
+		}
+		unit := expression.NewLiteral(v.Unit, sql.LongText)
+		return expression.NewUnresolvedFunction(v.Name, false, nil, unit, expr1, expr2), nil
+	case *sqlparser.ExtractFuncExpr:
+		expr, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		unit := expression.NewLiteral(v.Unit, sql.LongText)
+		return expression.NewUnresolvedFunction("extract", false, nil, unit, expr), nil
+	case *sqlparser.PositionExpr:
+		substr, err := ExprToExpression(ctx, v.Substr)
+		if err != nil {
+			return nil, err
+		}
+		str, err := ExprToExpression(ctx, v.Str)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewUnresolvedFunction("position", false, nil, substr, str), nil
+	case *sqlparser.GetFormatExpr:
+		format, err := ExprToExpression(ctx, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		typ := expression.NewLiteral(v.Type, sql.LongText)
+		return expression.NewUnresolvedFunction("get_format", false, nil, typ, format), nil
 	case *sqlparser.CollateExpr:
 		// TODO: handle collation
 		return ExprToExpression(ctx, v.Expr)
@@ -2360,6 +2484,8 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			return nil, err
 		}
 		return expression.NewUnresolvedFunction("values", false, nil, col), nil
+	case *sqlparser.MatchExpr:
+		return matchExprToExpression(ctx, v)
 	}
 }
 
@@ -2394,6 +2520,40 @@ func isAggregateFunc(v *sqlparser.FuncExpr) bool {
 	return v.IsAggregate()
 }
 
+// convertDecimal converts a fixed-point literal, such as 1.25, to a DECIMAL
+// literal whose precision and scale are the number of digits written.
+func convertDecimal(value string) (sql.Expression, error) {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	intPart, fracPart := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		intPart, fracPart = value[:i], value[i+1:]
+	}
+	scale := len(fracPart)
+	precision := len(strings.TrimLeft(intPart, "+-0")) + scale
+	if precision <= scale {
+		precision = scale + 1
+	}
+	if precision > sql.DecimalTypeMaxPrecision || scale > sql.DecimalTypeMaxScale {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return expression.NewLiteral(f, sql.Float64), nil
+	}
+	typ, err := sql.CreateDecimalType(uint8(precision), uint8(scale))
+	if err != nil {
+		return nil, err
+	}
+	val, err := typ.Convert(d)
+	if err != nil {
+		return nil, err
+	}
+	return expression.NewLiteral(val, typ), nil
+}
+
 // Convert an integer, represented by the specified string in the specified
 // base, to its smallest representation possible, out of:
 // int8, uint8, int16, uint16, int32, uint32, int64 and uint64
@@ -2433,8 +2593,16 @@ func convertVal(v *sqlparser.SQLVal) (sql.Expression, error) {
 	case sqlparser.StrVal:
 		return expression.NewLiteral(string(v.Val), sql.LongText), nil
 	case sqlparser.IntVal:
-		return convertInt(string(v.Val), 10)
+		expr, err := convertInt(string(v.Val), 10)
+		if err != nil {
+			// Integers too large for 64 bits are DECIMAL, as in MySQL
+			return convertDecimal(string(v.Val))
+		}
+		return expr, nil
 	case sqlparser.FloatVal:
+		if !strings.ContainsAny(string(v.Val), "eE") {
+			return convertDecimal(string(v.Val))
+		}
 		val, err := strconv.ParseFloat(string(v.Val), 64)
 		if err != nil {
 			return nil, err
@@ -2570,6 +2738,35 @@ func groupByToExpressions(ctx *sql.Context, g sqlparser.GroupBy) ([]sql.Expressi
 	return es, nil
 }
 
+func matchExprToExpression(ctx *sql.Context, m *sqlparser.MatchExpr) (sql.Expression, error) {
+	var mode sql.FullTextSearchMode
+	switch m.Option {
+	case "", sqlparser.NaturalLanguageModeStr:
+		mode = sql.FullTextSearchMode_NaturalLanguage
+	case sqlparser.BooleanModeStr:
+		mode = sql.FullTextSearchMode_Boolean
+	default:
+		return nil, ErrUnsupportedFeature.New("full-text search with query expansion")
+	}
+
+	columns, err := selectExprsToExpressions(ctx, m.Columns)
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range columns {
+		if _, ok := col.(*expression.UnresolvedColumn); !ok {
+			return nil, sql.ErrInvalidArgument.New("MATCH")
+		}
+	}
+
+	against, err := ExprToExpression(ctx, m.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression.NewMatch(columns, against, mode), nil
+}
+
 func selectExprToExpression(ctx *sql.Context, se sqlparser.SelectExpr) (sql.Expression, error) {
 	switch e := se.(type) {
 	default:
@@ -2648,10 +2845,40 @@ func unaryExprToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expres
 		}
 		return expr, nil
 	default:
+		if strings.HasPrefix(e.Operator, "_") {
+			return introducerToExpression(ctx, e)
+		}
 		return nil, ErrUnsupportedFeature.New("unary operator: " + e.Operator)
 	}
 }
 
+// introducerToExpression converts a string literal with a character set introducer, such as _latin1'abc', to a
+// literal of that character set. As with CONVERT ... USING, binary strings such as X'E9' are decoded in the character
+// set, and characters that it cannot represent are replaced with '?'.
+func introducerToExpression(ctx *sql.Context, e *sqlparser.UnaryExpr) (sql.Expression, error) {
+	charset, err := sql.ParseCharacterSet(strings.TrimSpace(e.Operator[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	// Introducers only apply to string literals, so anything else is returned as is
+	expr, err := ExprToExpression(ctx, e.Expr)
+	if err != nil {
+		return nil, err
+	}
+	exprLiteral, ok := expr.(*expression.Literal)
+	if !ok || !sql.IsText(exprLiteral.Type()) {
+		return expr, nil
+	}
+
+	converted := expression.NewConvertUsing(exprLiteral, charset)
+	val, err := converted.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return expression.NewLiteral(val, converted.Type()), nil
+}
+
 func binaryExprToExpression(ctx *sql.Context, be *sqlparser.BinaryExpr) (sql.Expression, error) {
 	switch strings.ToLower(be.Operator) {
 	case
@@ -2687,7 +2914,11 @@ func binaryExprToExpression(ctx *sql.Context, be *sqlparser.BinaryExpr) (sql.Exp
 			return nil, ErrUnsupportedSyntax.New("intervals cannot be added or subtracted from other intervals")
 		}
 
-		return expression.NewArithmetic(l, r, be.Operator), nil
+		arithmetic := expression.NewArithmetic(l, r, be.Operator)
+		if be.Operator == sqlparser.DivStr {
+			arithmetic = arithmetic.WithDivPrecisionIncrement(expression.DivPrecisionIncrement(ctx))
+		}
+		return arithmetic, nil
 	case
 		sqlparser.JSONExtractOp,
 		sqlparser.JSONUnquoteExtractOp:
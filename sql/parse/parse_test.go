@@ -21,7 +21,6 @@ import (
 	"testing"
 
 	"github.com/dolthub/vitess/go/sqltypes"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +30,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 var showCollationProjection = plan.NewProject([]sql.Expression{
@@ -880,6 +880,25 @@ CREATE TABLE t2
 			Nullable: false,
 		}, nil,
 	),
+	`ALTER TABLE foo ADD COLUMN bar INT GENERATED ALWAYS AS (baz + 1) STORED NOT NULL`: plan.NewAddColumn(
+		sql.UnresolvedDatabase(""), "foo", &sql.Column{
+			Name:      "bar",
+			Type:      sql.Int32,
+			Nullable:  false,
+			Generated: MustStringToColumnDefaultValue(sql.NewEmptyContext(), "baz + 1", nil, true),
+			Extra:     "STORED GENERATED",
+		}, nil,
+	),
+	`ALTER TABLE foo ADD COLUMN bar VARCHAR(10) AS (concat(baz, ')'))`: plan.NewAddColumn(
+		sql.UnresolvedDatabase(""), "foo", &sql.Column{
+			Name:      "bar",
+			Type:      sql.MustCreateStringWithDefaults(sqltypes.VarChar, 10),
+			Nullable:  true,
+			Generated: MustStringToColumnDefaultValue(sql.NewEmptyContext(), "concat(baz, ')')", nil, true),
+			Virtual:   true,
+			Extra:     "VIRTUAL GENERATED",
+		}, nil,
+	),
 	`ALTER TABLE foo ADD COLUMN bar INT NOT NULL DEFAULT 42 COMMENT 'hello' AFTER baz`: plan.NewAddColumn(
 		sql.UnresolvedDatabase(""), "foo", &sql.Column{
 			Name:     "bar",
@@ -1175,6 +1194,23 @@ CREATE TABLE t2
 			plan.NewUnresolvedTableAsOf("foo", "",
 				expression.NewLiteral("2019-01-01", sql.LongText))),
 	),
+	`SELECT s.value FROM Generate_Series(1, foo + 1) AS s, bar;`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewUnresolvedQualifiedColumn("s", "value"),
+		},
+		plan.NewCrossJoin(
+			plan.NewTableAlias("s",
+				plan.NewUnresolvedTableFunction("generate_series", []sql.Expression{
+					expression.NewLiteral(int8(1), sql.Int8),
+					expression.NewArithmetic(
+						expression.NewUnresolvedColumn("foo"),
+						expression.NewLiteral(int8(1), sql.Int8),
+						"+",
+					),
+				})),
+			plan.NewUnresolvedTable("bar", ""),
+		),
+	),
 	`SELECT foo, bar FROM foo WHERE foo = bar;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("foo"),
@@ -1277,6 +1313,44 @@ CREATE TABLE t2
 			}),
 			"a"),
 	),
+	`SELECT EXTRACT(YEAR FROM d), extract(day_minute from d) FROM t`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("EXTRACT(YEAR FROM d)",
+				expression.NewUnresolvedFunction("extract", false, nil, expression.NewLiteral("YEAR", sql.LongText), expression.NewUnresolvedColumn("d")),
+			),
+			expression.NewAlias("extract(day_minute from d)",
+				expression.NewUnresolvedFunction("extract", false, nil, expression.NewLiteral("day_minute", sql.LongText), expression.NewUnresolvedColumn("d")),
+			),
+		},
+		plan.NewUnresolvedTable("t", ""),
+	),
+	`SELECT GET_FORMAT(DATE, 'EUR'), get_format(datetime, s) FROM t`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("GET_FORMAT(DATE, 'EUR')",
+				expression.NewUnresolvedFunction("get_format", false, nil, expression.NewLiteral("DATE", sql.LongText), expression.NewLiteral("EUR", sql.LongText)),
+			),
+			expression.NewAlias("get_format(datetime, s)",
+				expression.NewUnresolvedFunction("get_format", false, nil, expression.NewLiteral("datetime", sql.LongText), expression.NewUnresolvedColumn("s")),
+			),
+		},
+		plan.NewUnresolvedTable("t", ""),
+	),
+	`SELECT POSITION('b' IN s), INSERT(s, 1, 2, 'x') FROM t`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("POSITION('b' IN s)",
+				expression.NewUnresolvedFunction("position", false, nil, expression.NewLiteral("b", sql.LongText), expression.NewUnresolvedColumn("s")),
+			),
+			expression.NewAlias("INSERT(s, 1, 2, 'x')",
+				expression.NewUnresolvedFunction("insert", false, nil,
+					expression.NewUnresolvedColumn("s"),
+					expression.NewLiteral(int8(1), sql.Int8),
+					expression.NewLiteral(int8(2), sql.Int8),
+					expression.NewLiteral("x", sql.LongText),
+				),
+			),
+		},
+		plan.NewUnresolvedTable("t", ""),
+	),
 	`SELECT column_0 FROM (values row(1,2), row(3,4)) a limit 1`: plan.NewLimit(expression.NewLiteral(int8(1), sql.Int8),
 		plan.NewProject(
 			[]sql.Expression{
@@ -1309,6 +1383,22 @@ CREATE TABLE t2
 			plan.NewUnresolvedTable("foo", ""),
 		),
 	),
+	`SELECT foo FROM foo WHERE MATCH (foo, bar) AGAINST ('baz' IN BOOLEAN MODE);`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewUnresolvedColumn("foo"),
+		},
+		plan.NewFilter(
+			expression.NewMatch(
+				[]sql.Expression{
+					expression.NewUnresolvedColumn("foo"),
+					expression.NewUnresolvedColumn("bar"),
+				},
+				expression.NewLiteral("baz", sql.LongText),
+				sql.FullTextSearchMode_Boolean,
+			),
+			plan.NewUnresolvedTable("foo", ""),
+		),
+	),
 	`SELECT foo, bar FROM foo WHERE foo = :var;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("foo"),
@@ -1915,6 +2005,36 @@ CREATE TABLE t2
 			plan.NewUnresolvedTable("foo", ""),
 		),
 	),
+	`SELECT * FROM foo WHERE EXISTS (SELECT * FROM baz WHERE baz.j = foo.i)`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewFilter(
+			plan.NewExistsSubquery(
+				plan.NewSubquery(plan.NewProject(
+					[]sql.Expression{expression.NewStar()},
+					plan.NewFilter(
+						expression.NewEquals(
+							expression.NewUnresolvedQualifiedColumn("baz", "j"),
+							expression.NewUnresolvedQualifiedColumn("foo", "i"),
+						),
+						plan.NewUnresolvedTable("baz", ""),
+					),
+				), "select * from baz where baz.j = foo.i"),
+			),
+			plan.NewUnresolvedTable("foo", ""),
+		),
+	),
+	`SELECT * FROM foo WHERE NOT EXISTS (SELECT * FROM baz)`: plan.NewProject(
+		[]sql.Expression{expression.NewStar()},
+		plan.NewFilter(
+			expression.NewNot(plan.NewExistsSubquery(
+				plan.NewSubquery(plan.NewProject(
+					[]sql.Expression{expression.NewStar()},
+					plan.NewUnresolvedTable("baz", ""),
+				), "select * from baz"),
+			)),
+			plan.NewUnresolvedTable("foo", ""),
+		),
+	),
 	`SELECT a, b FROM t ORDER BY 2, 1`: plan.NewSort(
 		[]sql.SortField{
 			{
@@ -2023,13 +2143,21 @@ CREATE TABLE t2
 		[]sql.Expression{
 			expression.NewAlias("1.0 * a + 2.0 * b",
 				expression.NewPlus(
-					expression.NewMult(expression.NewLiteral(float64(1.0), sql.Float64), expression.NewUnresolvedColumn("a")),
-					expression.NewMult(expression.NewLiteral(float64(2.0), sql.Float64), expression.NewUnresolvedColumn("b")),
+					expression.NewMult(expression.NewLiteral("1.0", sql.MustCreateDecimalType(2, 1)), expression.NewUnresolvedColumn("a")),
+					expression.NewMult(expression.NewLiteral("2.0", sql.MustCreateDecimalType(2, 1)), expression.NewUnresolvedColumn("b")),
 				),
 			),
 		},
 		plan.NewUnresolvedTable("t", ""),
 	),
+	`SELECT 0.25, 1.5e3, 18446744073709551616;`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewLiteral("0.25", sql.MustCreateDecimalType(3, 2)),
+			expression.NewAlias("1.5e3", expression.NewLiteral(float64(1500), sql.Float64)),
+			expression.NewLiteral("18446744073709551616", sql.MustCreateDecimalType(20, 0)),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
 	`SELECT '1.0' + 2;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewAlias("'1.0' + 2",
@@ -2324,6 +2452,17 @@ CREATE TABLE t2
 		{Table: plan.NewUnresolvedTable("bar", ""), Write: true},
 		{Table: plan.NewUnresolvedTable("baz", "")},
 	}),
+	`ANALYZE TABLE foo`: plan.NewAnalyzeTable([]sql.Node{
+		plan.NewUnresolvedTable("foo", ""),
+	}),
+	"analyze local table foo, `mydb`.bar;": plan.NewAnalyzeTable([]sql.Node{
+		plan.NewUnresolvedTable("foo", ""),
+		plan.NewUnresolvedTable("bar", "mydb"),
+	}),
+	`ANALYZE NO_WRITE_TO_BINLOG TABLE foo , bar`: plan.NewAnalyzeTable([]sql.Node{
+		plan.NewUnresolvedTable("foo", ""),
+		plan.NewUnresolvedTable("bar", ""),
+	}),
 	`SHOW CREATE DATABASE foo`:                 plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	`SHOW CREATE SCHEMA foo`:                   plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), false),
 	`SHOW CREATE DATABASE IF NOT EXISTS foo`:   plan.NewShowCreateDatabase(sql.UnresolvedDatabase("foo"), true),
@@ -2643,6 +2782,16 @@ CREATE TABLE t2
 		[]sql.Expression{},
 		plan.NewUnresolvedTable("foo", ""),
 	),
+	`SELECT COUNT(DISTINCT i, j) FROM foo`: plan.NewGroupBy(
+		[]sql.Expression{
+			aggregation.NewCountDistinct(expression.NewTuple(
+				expression.NewUnresolvedColumn("i"),
+				expression.NewUnresolvedColumn("j"),
+			)),
+		},
+		[]sql.Expression{},
+		plan.NewUnresolvedTable("foo", ""),
+	),
 	`SELECT AVG(DISTINCT a) FROM foo`: plan.NewGroupBy(
 		[]sql.Expression{
 			expression.NewAlias("AVG(DISTINCT a)",
@@ -3207,19 +3356,22 @@ func assertNodesEqualWithDiff(t *testing.T, expected, actual sql.Node) bool {
 }
 
 var fixturesErrors = map[string]*errors.Kind{
-	`SHOW METHEMONEY`:                                         ErrUnsupportedFeature,
-	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                    ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                    ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                    ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                    ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                  ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`: ErrUnsupportedSyntax,
-	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                errInvalidDescribeFormat,
-	`CREATE TABLE test (pk int, primary key(pk, noexist))`:    ErrUnknownIndexColumn,
-	`SELECT a, count(i) over (order by x) FROM foo`:           ErrUnsupportedFeature,
-	`SELECT a, count(i) over (partition by y) FROM foo`:       ErrUnsupportedFeature,
-	`SELECT i, row_number() over (order by a) group by 1`:     ErrUnsupportedFeature,
-	`SELECT i, row_number() over (order by a), max(b)`:        ErrUnsupportedFeature,
+	`SHOW METHEMONEY`:                                                      ErrUnsupportedFeature,
+	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                                 ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                                 ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                                 ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                                 ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                               ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:              ErrUnsupportedSyntax,
+	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                             errInvalidDescribeFormat,
+	`CREATE TABLE test (pk int, primary key(pk, noexist))`:                 ErrUnknownIndexColumn,
+	`SELECT a, count(i) over (order by x) FROM foo`:                        ErrUnsupportedFeature,
+	`SELECT a, count(i) over (partition by y) FROM foo`:                    ErrUnsupportedFeature,
+	`SELECT i, row_number() over (order by a) group by 1`:                  ErrUnsupportedFeature,
+	`SELECT i, row_number() over (order by a), max(b)`:                     ErrUnsupportedFeature,
+	`SELECT a FROM foo WHERE MATCH (a) AGAINST ('b' WITH QUERY EXPANSION)`: ErrUnsupportedFeature,
+	`CREATE TABLE t (a int, b int DEFAULT 1 AS (a + 1))`:                   sql.ErrGeneratedColumnWithDefault,
+	`CREATE TABLE t (a int, b int AS (a + 1) VIRTUAL, primary key (b))`:    sql.ErrVirtualColumnPrimaryKey,
 }
 
 func TestParseErrors(t *testing.T) {
@@ -149,6 +149,14 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 			}
 		}
 
+		if p.Constraint == sql.IndexConstraint_Fulltext {
+			for _, col := range indexable.Schema() {
+				if seenCols[col.Name] && !sql.IsTextOnly(col.Type) {
+					return sql.ErrFullTextColumnType.New(col.Name)
+				}
+			}
+		}
+
 		return indexable.CreateIndex(ctx, p.IndexName, p.Using, p.Constraint, p.Columns, p.Comment)
 	case IndexAction_Drop:
 		return indexable.DropIndex(ctx, p.IndexName)
@@ -152,12 +152,12 @@ func (a *AddColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 }
 
 func (a *AddColumn) Expressions() []sql.Expression {
-	return expression.WrapExpressions(a.column.Default)
+	return expression.WrapExpressions(a.column.Default, a.column.Generated)
 }
 
 func (a *AddColumn) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
-	if len(exprs) != 1 {
-		return nil, sql.ErrInvalidChildrenNumber.New(a, len(exprs), 1)
+	if len(exprs) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(exprs), 2)
 	}
 	na := *a
 	unwrappedColDefVal, ok := exprs[0].(*expression.Wrapper).Unwrap().(*sql.ColumnDefaultValue)
@@ -166,12 +166,18 @@ func (a *AddColumn) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	} else { // nil fails type check
 		na.column.Default = nil
 	}
+	unwrappedGenerated, ok := exprs[1].(*expression.Wrapper).Unwrap().(*sql.ColumnDefaultValue)
+	if ok {
+		na.column.Generated = unwrappedGenerated
+	} else { // nil fails type check
+		na.column.Generated = nil
+	}
 	return &na, nil
 }
 
 // Resolved implements the Resolvable interface.
 func (a *AddColumn) Resolved() bool {
-	return a.ddlNode.Resolved() && a.column.Default.Resolved()
+	return a.ddlNode.Resolved() && a.column.Default.Resolved() && a.column.Generated.Resolved()
 }
 
 func (a *AddColumn) validateDefaultPosition(tblSch sql.Schema) error {
@@ -198,6 +204,10 @@ func (a *AddColumn) validateDefaultPosition(tblSch sql.Schema) error {
 	if err != nil {
 		return err
 	}
+	err = inspectGeneratedForInvalidColumns(a.column, colsAfterThis)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -254,7 +264,7 @@ func (d *DropColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	}
 
 	for _, col := range tbl.Schema() {
-		if col.Default == nil {
+		if col.Default == nil && col.Generated == nil {
 			continue
 		}
 		var err error
@@ -271,6 +281,22 @@ func (d *DropColumn) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		if err != nil {
 			return nil, err
 		}
+		if col.Name == d.column {
+			continue
+		}
+		sql.Inspect(col.Generated, func(expr sql.Expression) bool {
+			switch expr := expr.(type) {
+			case *expression.GetField:
+				if expr.Name() == d.column {
+					err = sql.ErrDropColumnReferencedInGenerated.New(d.column, col.Name)
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return sql.RowsToRowIter(), alterable.DropColumn(ctx, d.column)
@@ -424,12 +450,12 @@ func (m *ModifyColumn) WithChildren(children ...sql.Node) (sql.Node, error) {
 }
 
 func (m *ModifyColumn) Expressions() []sql.Expression {
-	return expression.WrapExpressions(m.column.Default)
+	return expression.WrapExpressions(m.column.Default, m.column.Generated)
 }
 
 func (m *ModifyColumn) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
-	if len(exprs) != 1 {
-		return nil, sql.ErrInvalidChildrenNumber.New(m, len(exprs), 1)
+	if len(exprs) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(exprs), 2)
 	}
 	nm := *m
 	unwrappedColDefVal, ok := exprs[0].(*expression.Wrapper).Unwrap().(*sql.ColumnDefaultValue)
@@ -438,12 +464,18 @@ func (m *ModifyColumn) WithExpressions(exprs ...sql.Expression) (sql.Node, error
 	} else { // nil fails type check
 		nm.column.Default = nil
 	}
+	unwrappedGenerated, ok := exprs[1].(*expression.Wrapper).Unwrap().(*sql.ColumnDefaultValue)
+	if ok {
+		nm.column.Generated = unwrappedGenerated
+	} else { // nil fails type check
+		nm.column.Generated = nil
+	}
 	return &nm, nil
 }
 
 // Resolved implements the Resolvable interface.
 func (m *ModifyColumn) Resolved() bool {
-	return m.ddlNode.Resolved() && m.column.Default.Resolved()
+	return m.ddlNode.Resolved() && m.column.Default.Resolved() && m.column.Generated.Resolved()
 }
 
 // Gets an AlterableTable with the name given from the database, or an error if it cannot.
@@ -503,12 +535,20 @@ func (m *ModifyColumn) validateDefaultPosition(tblSch sql.Schema) error {
 	if err != nil {
 		return err
 	}
+	err = inspectGeneratedForInvalidColumns(m.column, colsAfterThis)
+	if err != nil {
+		return err
+	}
 	thisCol := map[string]*sql.Column{m.column.Name: m.column}
 	for _, colBefore := range colsBeforeThis {
 		err = inspectDefaultForInvalidColumns(colBefore, thisCol)
 		if err != nil {
 			return err
 		}
+		err = inspectGeneratedForInvalidColumns(colBefore, thisCol)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -548,6 +588,26 @@ func updateDefaultsOnColumnRename(ctx *sql.Context, tbl sql.AlterableTable, oldN
 	return nil
 }
 
+// inspectGeneratedForInvalidColumns returns an error if the generation expression of the column given references a
+// generated column defined after it (or itself).
+func inspectGeneratedForInvalidColumns(col *sql.Column, columnsAfterThis map[string]*sql.Column) error {
+	if col.Generated == nil {
+		return nil
+	}
+	var err error
+	sql.Inspect(col.Generated, func(expr sql.Expression) bool {
+		switch expr := expr.(type) {
+		case *expression.GetField:
+			if refCol, ok := columnsAfterThis[expr.Name()]; ok && refCol.Generated != nil {
+				err = sql.ErrGeneratedColumnRefersToLater.New(col.Name)
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
 func inspectDefaultForInvalidColumns(col *sql.Column, columnsAfterThis map[string]*sql.Column) error {
 	if col.Default == nil {
 		return nil
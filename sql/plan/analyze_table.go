@@ -0,0 +1,190 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AnalyzeTable computes the statistics of the columns of tables, and stores them in the tables that can hold them for
+// the optimizer to estimate the selectivity of filters with.
+type AnalyzeTable struct {
+	Tables []sql.Node
+}
+
+var _ sql.Node = (*AnalyzeTable)(nil)
+
+// NewAnalyzeTable creates a new AnalyzeTable node.
+func NewAnalyzeTable(tables []sql.Node) *AnalyzeTable {
+	return &AnalyzeTable{Tables: tables}
+}
+
+var analyzeTableSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Op", Type: sql.LongText},
+	{Name: "Msg_type", Type: sql.LongText},
+	{Name: "Msg_text", Type: sql.LongText},
+}
+
+// Children implements the sql.Node interface.
+func (a *AnalyzeTable) Children() []sql.Node {
+	return a.Tables
+}
+
+// Resolved implements the sql.Node interface.
+func (a *AnalyzeTable) Resolved() bool {
+	for _, t := range a.Tables {
+		if !t.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Schema implements the sql.Node interface.
+func (a *AnalyzeTable) Schema() sql.Schema {
+	return analyzeTableSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (a *AnalyzeTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.AnalyzeTable")
+	defer span.Finish()
+
+	rows := make([]sql.Row, len(a.Tables))
+	for i, t := range a.Tables {
+		rt, ok := t.(*ResolvedTable)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node to analyze: %T", t)
+		}
+
+		name := rt.Name()
+		if rt.Database != nil {
+			name = rt.Database.Name() + "." + name
+		}
+
+		analyzable, ok := analyzableTable(rt.Table)
+		if !ok {
+			rows[i] = sql.NewRow(name, "analyze", "note", "The storage engine for the table doesn't support analyze")
+			continue
+		}
+
+		stats, err := computeTableStatistics(ctx, rt.Table)
+		if err != nil {
+			return nil, err
+		}
+		if err := analyzable.SetColumnStatistics(ctx, stats); err != nil {
+			return nil, err
+		}
+		rows[i] = sql.NewRow(name, "analyze", "status", "OK")
+	}
+
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AnalyzeTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != len(a.Tables) {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), len(a.Tables))
+	}
+	return NewAnalyzeTable(children), nil
+}
+
+func (a *AnalyzeTable) String() string {
+	children := make([]string, len(a.Tables))
+	for i, t := range a.Tables {
+		children[i] = t.String()
+	}
+
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("AnalyzeTable")
+	_ = p.WriteChildren(children...)
+	return p.String()
+}
+
+// analyzableTable returns the table given, or the one it wraps, as a sql.AnalyzableTable.
+func analyzableTable(t sql.Table) (sql.AnalyzableTable, bool) {
+	for {
+		switch tt := t.(type) {
+		case sql.AnalyzableTable:
+			return tt, true
+		case sql.TableWrapper:
+			t = tt.Underlying()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// computeTableStatistics reads all the rows of the table given and returns the statistics of its columns.
+func computeTableStatistics(ctx *sql.Context, table sql.Table) (*sql.TableStatistics, error) {
+	schema := table.Schema()
+	builders := make([]*sql.ColumnStatisticsBuilder, len(schema))
+	for i, col := range schema {
+		if sql.HasColumnStatistics(col.Type) {
+			builders[i] = sql.NewColumnStatisticsBuilder(col)
+		}
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+
+	var rowCount uint64
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = iter.Close(ctx)
+			return nil, err
+		}
+
+		rowCount++
+		for i, b := range builders {
+			if b != nil {
+				b.Add(row[i])
+			}
+		}
+	}
+	if err := iter.Close(ctx); err != nil {
+		return nil, err
+	}
+
+	stats := &sql.TableStatistics{
+		RowCount:  rowCount,
+		Columns:   make(map[string]*sql.ColumnStatistics),
+		CreatedAt: time.Now(),
+	}
+	for i, b := range builders {
+		if b == nil {
+			continue
+		}
+		colStats, err := b.Build(sql.DefaultHistogramBuckets)
+		if err != nil {
+			return nil, err
+		}
+		stats.Columns[strings.ToLower(schema[i].Name)] = colStats
+	}
+	return stats, nil
+}
@@ -18,10 +18,10 @@ import (
 	"fmt"
 
 	"github.com/dolthub/vitess/go/mysql"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 // CreateDB creates an in memory database that lasts the length of the process only.
@@ -204,7 +204,7 @@ func (c *CreateTable) Schema() sql.Schema {
 func (c *CreateTable) Resolved() bool {
 	resolved := c.ddlNode.Resolved()
 	for _, col := range c.schema {
-		resolved = resolved && col.Default.Resolved()
+		resolved = resolved && col.Default.Resolved() && col.Generated.Resolved()
 	}
 	return resolved
 }
@@ -281,6 +281,16 @@ func (c *CreateTable) createIndexes(ctx *sql.Context, tableNode sql.Table) error
 	}
 
 	for _, idxDef := range c.idxDefs {
+		if idxDef.Constraint == sql.IndexConstraint_Fulltext {
+			for _, idxCol := range idxDef.Columns {
+				for _, col := range c.schema {
+					if strings.EqualFold(col.Name, idxCol.Name) && !sql.IsTextOnly(col.Type) {
+						return sql.ErrFullTextColumnType.New(col.Name)
+					}
+				}
+			}
+		}
+
 		err := idxAlterable.CreateIndex(ctx, idxDef.IndexName, idxDef.Using, idxDef.Constraint, idxDef.Columns, idxDef.Comment)
 		if err != nil {
 			return err
@@ -441,13 +451,19 @@ func (c *CreateTable) schemaDebugString() string {
 	return p.String()
 }
 
+// Expressions implements the sql.Expressioner interface. The default value of the column at index i of the schema is
+// at index i, its generation expression is at index len(schema)+i, and the check constraints follow both.
 func (c *CreateTable) Expressions() []sql.Expression {
-	exprs := make([]sql.Expression, len(c.schema)+len(c.chDefs))
+	exprs := make([]sql.Expression, 2*len(c.schema)+len(c.chDefs))
 	i := 0
 	for _, col := range c.schema {
 		exprs[i] = expression.WrapExpression(col.Default)
 		i++
 	}
+	for _, col := range c.schema {
+		exprs[i] = expression.WrapExpression(col.Generated)
+		i++
+	}
 	for _, ch := range c.chDefs {
 		exprs[i] = ch.Expr
 		i++
@@ -487,8 +503,8 @@ func (c *CreateTable) Temporary() TempTableOption {
 }
 
 func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
-	if len(exprs) != len(c.schema)+len(c.chDefs) {
-		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.schema)+len(c.chDefs))
+	if len(exprs) != 2*len(c.schema)+len(c.chDefs) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), 2*len(c.schema)+len(c.chDefs))
 	}
 
 	nc := *c
@@ -503,8 +519,17 @@ func (c *CreateTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error)
 		}
 	}
 
-	for ; i < len(c.chDefs)+len(c.schema); i++ {
-		nc.chDefs[i-len(c.schema)].Expr = exprs[i]
+	for ; i < 2*len(c.schema); i++ {
+		unwrappedGenerated, ok := exprs[i].(*expression.Wrapper).Unwrap().(*sql.ColumnDefaultValue)
+		if ok {
+			nc.schema[i-len(c.schema)].Generated = unwrappedGenerated
+		} else { // nil fails type check
+			nc.schema[i-len(c.schema)].Generated = nil
+		}
+	}
+
+	for ; i < len(c.chDefs)+2*len(c.schema); i++ {
+		nc.chDefs[i-2*len(c.schema)].Expr = exprs[i]
 	}
 
 	return &nc, nil
@@ -518,6 +543,9 @@ func (c *CreateTable) validateDefaultPosition() error {
 		if err := inspectDefaultForInvalidColumns(col, colsAfterThis); err != nil {
 			return err
 		}
+		if err := inspectGeneratedForInvalidColumns(col, colsAfterThis); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -131,7 +131,7 @@ func (d *deleteIter) Next() (sql.Row, error) {
 		row = row[len(row)-len(d.schema):]
 	}
 
-	return row, d.deleter.Delete(d.ctx, row)
+	return row, d.deleter.Delete(d.ctx, d.ctx.RowFromSessionTime(d.schema, row))
 }
 
 func (d *deleteIter) Close(ctx *sql.Context) error {
@@ -47,7 +47,7 @@ func (d *Distinct) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, newDistinctIter(ctx, it)), nil
+	return sql.NewSpanIter(span, newDistinctIter(ctx, d.Child.Schema(), it)), nil
 }
 
 // WithChildren implements the Node interface.
@@ -74,28 +74,47 @@ func (d Distinct) DebugString() string {
 }
 
 // distinctIter keeps track of the hashes of all rows that have been emitted.
-// It does not emit any rows whose hashes have been seen already.
-// TODO: come up with a way to use less memory than keeping all hashes in memory.
-// Even though they are just 64-bit integers, this could be a problem in large
-// result sets.
+// It does not emit any rows whose hashes have been seen already. Strings are
+// hashed by their collation keys, so strings that are equal in their collation
+// are duplicates.
+// When the hashes take more memory than the tmp_table_size session variable
+// allows, or no memory is left, the hashes seen so far are kept but rows with
+// new hashes are written to partitions on disk by their hash instead of being
+// emitted. Once the child is exhausted, the partitions are deduplicated one at a
+// time, each with hashes of its own.
 type distinctIter struct {
+	ctx       *sql.Context
 	childIter sql.RowIter
+	schema    sql.Schema
 	seen      sql.KeyValueCache
 	dispose   sql.DisposeFunc
+	// size is an estimate of the memory taken by the hashes in seen.
+	size       uint64
+	maxSize    uint64
+	partitions spillPartitions
+	partition  int
+	// reader reads the rows of the current partition, once the child is exhausted.
+	reader *spillFileReader
 }
 
-func newDistinctIter(ctx *sql.Context, child sql.RowIter) *distinctIter {
+// distinctHashSize is an estimate of the memory taken by each hash of a distinctIter.
+const distinctHashSize = 48
+
+func newDistinctIter(ctx *sql.Context, schema sql.Schema, child sql.RowIter) *distinctIter {
 	cache, dispose := ctx.Memory.NewHistoryCache()
 	return &distinctIter{
+		ctx:       ctx,
 		childIter: child,
+		schema:    schema,
 		seen:      cache,
 		dispose:   dispose,
+		maxSize:   uintSessionVariable(ctx, "tmp_table_size"),
 	}
 }
 
 func (di *distinctIter) Next() (sql.Row, error) {
 	for {
-		row, err := di.childIter.Next()
+		row, err := di.nextRow()
 		if err != nil {
 			if err == io.EOF {
 				di.Dispose()
@@ -103,7 +122,7 @@ func (di *distinctIter) Next() (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		hash, err := sql.HashOfSchema(row, di.schema)
 		if err != nil {
 			return nil, err
 		}
@@ -112,14 +131,81 @@ func (di *distinctIter) Next() (sql.Row, error) {
 			continue
 		}
 
+		if di.partitions != nil && di.reader == nil {
+			if err := di.partitions.Write(hash, row); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		if err := di.seen.Put(hash, struct{}{}); err != nil {
-			return nil, err
+			if !sql.ErrNoMemoryAvailable.Is(err) || di.reader != nil {
+				return nil, err
+			}
+			if err := di.startSpilling(); err != nil {
+				return nil, err
+			}
+			if err := di.partitions.Write(hash, row); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		di.size += distinctHashSize
+		if di.reader == nil && di.maxSize > 0 && di.size >= di.maxSize {
+			if err := di.startSpilling(); err != nil {
+				return nil, err
+			}
 		}
 
 		return row, nil
 	}
 }
 
+// startSpilling creates the partitions that rows with hashes that haven't been seen are written to from now on.
+func (di *distinctIter) startSpilling() error {
+	partitions, err := newSpillPartitions(di.ctx, di.schema)
+	if err != nil {
+		return err
+	}
+	di.partitions = partitions
+	return nil
+}
+
+// nextRow returns the next row of the child, and then the rows of each of the partitions, if there are any. The
+// hashes seen are reset before reading each partition.
+func (di *distinctIter) nextRow() (sql.Row, error) {
+	if di.reader == nil {
+		row, err := di.childIter.Next()
+		if err != io.EOF || di.partitions == nil {
+			return row, err
+		}
+	} else {
+		row, err := di.reader.Next()
+		if err != io.EOF {
+			return row, err
+		}
+	}
+
+	for di.partition < len(di.partitions) {
+		reader, err := di.partitions[di.partition].Reader()
+		if err != nil {
+			return nil, err
+		}
+		di.partition++
+		di.reader = reader
+		di.dispose()
+		di.seen, di.dispose = di.ctx.Memory.NewHistoryCache()
+		di.size = 0
+
+		row, err := di.reader.Next()
+		if err != io.EOF {
+			return row, err
+		}
+	}
+	return nil, io.EOF
+}
+
 func (di *distinctIter) Close(ctx *sql.Context) error {
 	di.Dispose()
 	return di.childIter.Close(ctx)
@@ -129,6 +215,10 @@ func (di *distinctIter) Dispose() {
 	if di.dispose != nil {
 		di.dispose()
 	}
+	if di.partitions != nil {
+		_ = di.partitions.Remove()
+		di.partitions = nil
+	}
 }
 
 // OrderedDistinct is a Distinct node optimized for sorted row sets.
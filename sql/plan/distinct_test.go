@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"fmt"
 	"io"
 	"testing"
 
@@ -72,6 +73,36 @@ func TestDistinct(t *testing.T) {
 	require.Equal([]string{"john", "jane", "martha"}, results)
 }
 
+func TestDistinctSpill(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, "tmp_table_size", uint64(1024)))
+
+	childSchema := sql.Schema{
+		{Name: "a", Type: sql.Int64},
+		{Name: "b", Type: sql.Text, Nullable: true},
+	}
+	child := memory.NewTable("test", childSchema)
+
+	var expected []sql.Row
+	for i := 0; i < 1000; i++ {
+		row := sql.NewRow(int64(i%300), fmt.Sprintf("b%d", i%300))
+		if i < 300 {
+			expected = append(expected, row)
+		}
+		require.NoError(child.Insert(sql.NewEmptyContext(), row))
+	}
+
+	iter, err := NewDistinct(NewResolvedTable(child, nil, nil)).RowIter(ctx, nil)
+	require.NoError(err)
+	actual, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	// The rows seen before the spill are returned first and in order, the rest partition by partition
+	require.ElementsMatch(expected, actual)
+	require.Equal(expected[:10], actual[:10])
+}
+
 func TestOrderedDistinct(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -0,0 +1,79 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ExistsSubquery is an expression that checks whether a subquery returns any row. It's in the plan package, instead of
+// the expression package, because Subquery is itself in the plan package.
+type ExistsSubquery struct {
+	Query *Subquery
+}
+
+var _ sql.Expression = (*ExistsSubquery)(nil)
+
+// NewExistsSubquery returns an EXISTS expression on the subquery given.
+func NewExistsSubquery(query *Subquery) *ExistsSubquery {
+	return &ExistsSubquery{Query: query}
+}
+
+// Eval implements the Expression interface.
+func (e *ExistsSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return e.Query.HasResultRow(ctx, row)
+}
+
+// Type implements the Expression interface.
+func (e *ExistsSubquery) Type() sql.Type {
+	return sql.Boolean
+}
+
+// IsNullable implements the Expression interface. EXISTS is never NULL.
+func (e *ExistsSubquery) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the Expression interface.
+func (e *ExistsSubquery) Resolved() bool {
+	return e.Query.Resolved()
+}
+
+// Children implements the Expression interface.
+func (e *ExistsSubquery) Children() []sql.Expression {
+	return []sql.Expression{e.Query}
+}
+
+// WithChildren implements the Expression interface.
+func (e *ExistsSubquery) WithChildren(ctx *sql.Context, children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+	query, ok := children[0].(*Subquery)
+	if !ok {
+		return nil, fmt.Errorf("EXISTS requires a subquery, but got %T", children[0])
+	}
+	return NewExistsSubquery(query), nil
+}
+
+func (e *ExistsSubquery) String() string {
+	return fmt.Sprintf("EXISTS %s", e.Query)
+}
+
+func (e *ExistsSubquery) DebugString() string {
+	return fmt.Sprintf("EXISTS %s", sql.DebugString(e.Query))
+}
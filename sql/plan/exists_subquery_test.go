@@ -0,0 +1,78 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestExistsSubquery(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.Schema{
+		{Name: "t", Source: "foo", Type: sql.Text},
+		{Name: "u", Source: "foo", Type: sql.Int64},
+	})
+	require.NoError(t, table.Insert(ctx, sql.Row{"one", int64(1)}))
+	require.NoError(t, table.Insert(ctx, sql.Row{"two", int64(2)}))
+	require.NoError(t, table.Insert(ctx, sql.Row{"three", int64(3)}))
+
+	// The row of the outer scope has a single column, so the columns of the subquery start at 1
+	exists := plan.NewExistsSubquery(plan.NewSubquery(plan.NewFilter(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(1, sql.Text, "foo", "t", false),
+			expression.NewGetField(0, sql.Text, "x", true),
+		),
+		plan.NewResolvedTable(table, nil, nil),
+	), "select * from foo where t = x"))
+
+	testCases := []struct {
+		name     string
+		row      sql.Row
+		expected interface{}
+	}{
+		{"match", sql.NewRow("two"), true},
+		{"no match", sql.NewRow("four"), false},
+		{"null", sql.NewRow(nil), false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := exists.Eval(ctx, tt.row)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+
+	// Results that can be cached don't depend on the row
+	empty := plan.NewExistsSubquery(plan.NewSubquery(plan.NewFilter(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(1, sql.Text, "foo", "t", false),
+			expression.NewLiteral("four", sql.LongText),
+		),
+		plan.NewResolvedTable(table, nil, nil),
+	), "select * from foo where t = 'four'").WithCachedResults())
+	for _, row := range []sql.Row{sql.NewRow("one"), sql.NewRow("four")} {
+		result, err := empty.Eval(ctx, row)
+		require.NoError(t, err)
+		require.Equal(t, false, result)
+	}
+}
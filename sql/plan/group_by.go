@@ -238,6 +238,10 @@ func (i *groupByIter) Close(ctx *sql.Context) error {
 	return i.child.Close(ctx)
 }
 
+// groupByGroupingIter aggregates the rows of its child in a hash table of groups. When the groups take more memory
+// than the tmp_table_size session variable allows, or no memory is left, and all the aggregations can be spilled, the
+// groups are written to partitions on disk by their key and aggregation carries on with an empty table. Once all the
+// rows are read, the partitions are aggregated one at a time by merging the partial buffers of their groups.
 type groupByGroupingIter struct {
 	selectedExprs []sql.Expression
 	groupByExprs  []sql.Expression
@@ -247,6 +251,12 @@ type groupByGroupingIter struct {
 	child         sql.RowIter
 	ctx           *sql.Context
 	dispose       sql.DisposeFunc
+	computed      bool
+	// size is an estimate of the memory taken by the groups in aggregations.
+	size       uint64
+	spillable  bool
+	partitions spillPartitions
+	partition  int
 }
 
 func newGroupByGroupingIter(
@@ -259,19 +269,26 @@ func newGroupByGroupingIter(
 		groupByExprs:  groupByExprs,
 		child:         child,
 		ctx:           ctx,
+		spillable:     canSpillAggregations(selectedExprs),
 	}
 }
 
 func (i *groupByGroupingIter) Next() (sql.Row, error) {
-	if i.aggregations == nil {
-		i.aggregations, i.dispose = i.ctx.Memory.NewHistoryCache()
+	if !i.computed {
+		i.computed = true
+		i.resetAggregations()
 		if err := i.compute(); err != nil {
 			return nil, err
 		}
 	}
 
-	if i.pos >= len(i.keys) {
-		return nil, io.EOF
+	for i.pos >= len(i.keys) {
+		if i.partition >= len(i.partitions) {
+			return nil, io.EOF
+		}
+		if err := i.loadPartition(); err != nil {
+			return nil, err
+		}
 	}
 
 	buffers, err := i.aggregations.Get(i.keys[i.pos])
@@ -283,6 +300,8 @@ func (i *groupByGroupingIter) Next() (sql.Row, error) {
 }
 
 func (i *groupByGroupingIter) compute() error {
+	maxSize := uintSessionVariable(i.ctx, "tmp_table_size")
+
 	for {
 		row, err := i.child.Next()
 		if err != nil {
@@ -309,10 +328,19 @@ func (i *groupByGroupingIter) compute() error {
 			}
 
 			if err := i.aggregations.Put(key, buf); err != nil {
-				return err
+				if !i.spillable || !sql.ErrNoMemoryAvailable.Is(err) || len(i.keys) == 0 {
+					return err
+				}
+				if err := i.spill(); err != nil {
+					return err
+				}
+				if err := i.aggregations.Put(key, buf); err != nil {
+					return err
+				}
 			}
 
 			i.keys = append(i.keys, key)
+			i.size += estimateGroupSize(buf)
 		}
 
 		b, err := i.aggregations.Get(key)
@@ -324,11 +352,116 @@ func (i *groupByGroupingIter) compute() error {
 		if err != nil {
 			return err
 		}
+
+		if i.spillable && maxSize > 0 && i.size >= maxSize {
+			if err := i.spill(); err != nil {
+				return err
+			}
+		}
 	}
 
+	if i.partitions != nil && len(i.keys) > 0 {
+		return i.spill()
+	}
 	return nil
 }
 
+// spill writes the groups in memory to the partitions on disk, each as its key followed by the encoding of its
+// buffers, and empties the hash table of groups.
+func (i *groupByGroupingIter) spill() error {
+	if i.partitions == nil {
+		sch := sql.Schema{{Name: "key", Type: sql.Uint64}}
+		for range i.selectedExprs {
+			sch = append(sch, &sql.Column{Name: "buffer", Type: sql.LongBlob})
+		}
+		partitions, err := newSpillPartitions(i.ctx, sch)
+		if err != nil {
+			return err
+		}
+		i.partitions = partitions
+	}
+
+	for _, key := range i.keys {
+		b, err := i.aggregations.Get(key)
+		if err != nil {
+			return err
+		}
+		buffers := b.([]sql.Row)
+
+		record := make(sql.Row, len(buffers)+1)
+		record[0] = key
+		for j, expr := range i.selectedExprs {
+			if record[j+1], err = encodeAggregationBuffer(expr, buffers[j]); err != nil {
+				return err
+			}
+		}
+		if err := i.partitions.Write(key, record); err != nil {
+			return err
+		}
+	}
+
+	i.resetAggregations()
+	return nil
+}
+
+// loadPartition aggregates the groups of the next partition on disk into the hash table of groups.
+func (i *groupByGroupingIter) loadPartition() error {
+	i.resetAggregations()
+	r, err := i.partitions[i.partition].Reader()
+	if err != nil {
+		return err
+	}
+	i.partition++
+
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		key := record[0].(uint64)
+		partial := make([]sql.Row, len(i.selectedExprs))
+		for j, expr := range i.selectedExprs {
+			if partial[j], err = decodeAggregationBuffer(expr, record[j+1].([]byte)); err != nil {
+				return err
+			}
+		}
+
+		b, err := i.aggregations.Get(key)
+		if err != nil {
+			if err := i.aggregations.Put(key, partial); err != nil {
+				return err
+			}
+			i.keys = append(i.keys, key)
+			continue
+		}
+
+		buffers := b.([]sql.Row)
+		for j, expr := range i.selectedExprs {
+			if agg, ok := expr.(sql.Aggregation); ok {
+				if err := agg.Merge(i.ctx, buffers[j], partial[j]); err != nil {
+					return err
+				}
+			} else if len(partial[j]) > 0 {
+				buffers[j] = partial[j]
+			}
+		}
+	}
+}
+
+func (i *groupByGroupingIter) resetAggregations() {
+	if i.dispose != nil {
+		i.dispose()
+	}
+	i.aggregations, i.dispose = i.ctx.Memory.NewHistoryCache()
+	i.keys = nil
+	i.pos = 0
+	i.size = 0
+}
+
 func (i *groupByGroupingIter) Close(ctx *sql.Context) error {
 	i.aggregations = nil
 	if i.dispose != nil {
@@ -336,7 +469,63 @@ func (i *groupByGroupingIter) Close(ctx *sql.Context) error {
 		i.dispose = nil
 	}
 
-	return i.child.Close(ctx)
+	var removeErr error
+	if i.partitions != nil {
+		removeErr = i.partitions.Remove()
+		i.partitions = nil
+	}
+
+	if err := i.child.Close(ctx); err != nil {
+		return err
+	}
+	return removeErr
+}
+
+// canSpillAggregations returns whether the buffers of all the expressions given can be written to disk and merged.
+// Aggregations over DISTINCT expressions can't, as they keep the values they have seen in the expression.
+func canSpillAggregations(exprs []sql.Expression) bool {
+	for _, e := range exprs {
+		if _, ok := e.(sql.Aggregation); ok {
+			if _, ok := e.(sql.SpillableAggregation); !ok {
+				return false
+			}
+		}
+		hasDistinct := false
+		sql.Inspect(e, func(e sql.Expression) bool {
+			if _, ok := e.(*expression.DistinctExpression); ok {
+				hasDistinct = true
+			}
+			return !hasDistinct
+		})
+		if hasDistinct {
+			return false
+		}
+	}
+	return true
+}
+
+// estimateGroupSize returns an estimate of the memory taken by a group with the buffers given.
+func estimateGroupSize(buffers []sql.Row) uint64 {
+	// The key of the group and the slice of its buffers
+	size := uint64(8 + 24)
+	for _, b := range buffers {
+		size += sql.EstimateRowSize(b)
+	}
+	return size
+}
+
+func encodeAggregationBuffer(expr sql.Expression, buffer sql.Row) ([]byte, error) {
+	if agg, ok := expr.(sql.SpillableAggregation); ok {
+		return agg.EncodeBuffer(nil, buffer)
+	}
+	return sql.EncodeRow(nil, sql.Schema{{Type: expr.Type(), Nullable: true}}, buffer)
+}
+
+func decodeAggregationBuffer(expr sql.Expression, data []byte) (sql.Row, error) {
+	if agg, ok := expr.(sql.SpillableAggregation); ok {
+		return agg.DecodeBuffer(data)
+	}
+	return sql.DecodeRow(data, sql.Schema{{Type: expr.Type(), Nullable: true}})
 }
 
 func groupingKey(
@@ -350,7 +539,7 @@ func groupingKey(
 		if err != nil {
 			return 0, err
 		}
-		_, err = hash.Write(([]byte)(fmt.Sprintf("%#v,", v)))
+		_, err = hash.Write(([]byte)(fmt.Sprintf("%#v,", sql.CollationKey(expr.Type(), v))))
 		if err != nil {
 			return 0, err
 		}
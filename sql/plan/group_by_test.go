@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"io"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -166,6 +168,73 @@ func TestGroupByAggregationGrouping(t *testing.T) {
 	require.Equal(expected, rows)
 }
 
+func TestGroupBySpill(t *testing.T) {
+	require := require.New(t)
+
+	childSchema := sql.Schema{
+		{Name: "k", Type: sql.Int64},
+		{Name: "v", Type: sql.Int64, Nullable: true},
+	}
+	child := memory.NewTable("test", childSchema)
+	for i := 0; i < 2000; i++ {
+		var v interface{} = int64(i)
+		if i%7 == 0 {
+			v = nil
+		}
+		require.NoError(child.Insert(sql.NewEmptyContext(), sql.NewRow(int64(i%500), v)))
+	}
+
+	k := expression.NewGetField(0, sql.Int64, "k", false)
+	v := expression.NewGetField(1, sql.Int64, "v", true)
+	ctx := sql.NewEmptyContext()
+	groupConcat, err := aggregation.NewGroupConcat(ctx, "", nil, ",", []sql.Expression{v}, 1024)
+	require.NoError(err)
+	p := NewGroupBy(
+		[]sql.Expression{
+			k,
+			aggregation.NewCount(ctx, v),
+			aggregation.NewSum(ctx, v),
+			aggregation.NewMax(ctx, v),
+			aggregation.NewFirst(ctx, v),
+			groupConcat,
+		},
+		[]sql.Expression{k},
+		NewResolvedTable(child, nil, nil),
+	)
+
+	expected, err := sql.NodeToRows(ctx, p)
+	require.NoError(err)
+	require.Len(expected, 500)
+
+	ctx = sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, "tmp_table_size", uint64(1024)))
+	childIter, err := p.Child.RowIter(ctx, nil)
+	require.NoError(err)
+	iter := newGroupByGroupingIter(ctx, p.SelectedExprs, p.GroupByExprs, childIter)
+
+	var actual []sql.Row
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		actual = append(actual, row)
+	}
+	require.ElementsMatch(expected, actual)
+
+	require.NotNil(iter.partitions, "expected the groups to be spilled to disk")
+	var files []string
+	for _, f := range iter.partitions {
+		files = append(files, f.file.Name())
+	}
+	require.NoError(iter.Close(ctx))
+	for _, file := range files {
+		_, err := os.Stat(file)
+		require.True(os.IsNotExist(err))
+	}
+}
+
 func BenchmarkGroupBy(b *testing.B) {
 	table := benchmarkTable(b)
 
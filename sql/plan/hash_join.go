@@ -0,0 +1,571 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// HashJoin is a join on the equality of expressions over its left and right children. It reads all the rows of one
+// child, the build side, into a hash table keyed by those expressions, and then looks up each row of the other child,
+// the probe side, in it. The analyzer builds on the child with fewer rows. Rows with a NULL key never match. The whole
+// join condition is evaluated on every pair of rows with the same hash, so it may contain other predicates than the
+// equalities that are hashed.
+//
+// When the hash table takes more memory than the tmp_table_size session variable allows, or no memory is left, the
+// rows of both children are written to partitions on disk by their hash, and then each partition of the build side is
+// loaded and joined with the same partition of the probe side, one at a time.
+type HashJoin struct {
+	BinaryNode
+	// The join condition.
+	Cond      sql.Expression
+	joinType  JoinType
+	buildLeft bool
+	scopeLen  int
+}
+
+var _ sql.Expressioner = (*HashJoin)(nil)
+
+// NewHashJoin returns a hash join of the type given between left and right, which builds its hash table on left if
+// buildLeft is true and on right otherwise.
+func NewHashJoin(left, right sql.Node, joinType JoinType, cond sql.Expression, buildLeft bool, scopeLen int) *HashJoin {
+	return &HashJoin{
+		BinaryNode: BinaryNode{left, right},
+		Cond:       cond,
+		joinType:   joinType,
+		buildLeft:  buildLeft,
+		scopeLen:   scopeLen,
+	}
+}
+
+// JoinType returns the join type for this hash join.
+func (j *HashJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+// BuildsLeft returns whether the hash table is built on the left child rather than the right one.
+func (j *HashJoin) BuildsLeft() bool {
+	return j.buildLeft
+}
+
+func (j *HashJoin) Schema() sql.Schema {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	case JoinTypeRight:
+		return append(makeNullable(j.left.Schema()), j.right.Schema()...)
+	case JoinTypeSemi, JoinTypeAnti:
+		return j.left.Schema()
+	default:
+		return append(j.left.Schema(), j.right.Schema()...)
+	}
+}
+
+func (j *HashJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+func (j *HashJoin) Expressions() []sql.Expression {
+	return []sql.Expression{j.Cond}
+}
+
+func (j *HashJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+	return NewHashJoin(j.left, j.right, j.joinType, exprs[0], j.buildLeft, j.scopeLen), nil
+}
+
+func (j *HashJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+	return NewHashJoin(children[0], children[1], j.joinType, j.Cond, j.buildLeft, j.scopeLen), nil
+}
+
+func (j *HashJoin) name() string {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return "LeftHashJoin"
+	case JoinTypeRight:
+		return "RightHashJoin"
+	case JoinTypeSemi:
+		return "SemiHashJoin"
+	case JoinTypeAnti:
+		return "AntiHashJoin"
+	default:
+		return "HashJoin"
+	}
+}
+
+func (j *HashJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%s%s", j.name(), j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *HashJoin) DebugString() string {
+	side := "right"
+	if j.buildLeft {
+		side = "left"
+	}
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%s%s (build: %s)", j.name(), sql.DebugString(j.Cond), side)
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+func (j *HashJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan."+j.name(), opentracing.Tags{
+		"build": j.buildLeft,
+	})
+
+	leftLen := len(j.left.Schema())
+	keys := joinKeys(j.Cond, j.scopeLen, leftLen)
+	leftKeys := make([]sql.Expression, len(keys))
+	rightKeys := make([]sql.Expression, len(keys))
+	for k, key := range keys {
+		leftKeys[k] = key.left
+		rightKey, err := key.rightOnScope(ctx, j.scopeLen, leftLen)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		rightKeys[k] = rightKey
+	}
+
+	l, err := j.left.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	r, err := j.right.RowIter(ctx, row)
+	if err != nil {
+		_ = l.Close(ctx)
+		span.Finish()
+		return nil, err
+	}
+
+	iter := &hashJoinIter{
+		ctx:       ctx,
+		typ:       j.joinType,
+		cond:      j.Cond,
+		buildLeft: j.buildLeft,
+		scope:     row[:j.scopeLen],
+		leftLen:   leftLen,
+		rightLen:  len(j.right.Schema()),
+		keys:      keys,
+		maxSize:   uintSessionVariable(ctx, "tmp_table_size"),
+	}
+	if j.buildLeft {
+		iter.build, iter.probe = l, r
+		iter.buildKeys, iter.probeKeys = leftKeys, rightKeys
+		iter.buildSchema, iter.probeSchema = j.left.Schema(), j.right.Schema()
+	} else {
+		iter.build, iter.probe = r, l
+		iter.buildKeys, iter.probeKeys = rightKeys, leftKeys
+		iter.buildSchema, iter.probeSchema = j.right.Schema(), j.left.Schema()
+	}
+	iter.probeSource = iter.probe.Next
+
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// CanHashJoin returns whether the condition given, of a join with the scope and left schema given, has an equality
+// between an expression over the left side and one over the right side that a hash join can hash on.
+func CanHashJoin(cond sql.Expression, scopeLen int, left sql.Schema) bool {
+	return len(joinKeys(cond, scopeLen, len(left))) > 0
+}
+
+// hashJoinRowSize is an estimate of the memory taken by each row in the hash table of a hashJoinIter besides the row.
+const hashJoinRowSize = 32
+
+// hashJoinIter joins the rows of the probe side with the rows of the build side with the same hash. When the
+// hash table of the build side doesn't fit in memory, both sides are partitioned to disk, and the iterator joins each
+// pair of partitions in turn.
+type hashJoinIter struct {
+	ctx       *sql.Context
+	typ       JoinType
+	cond      sql.Expression
+	buildLeft bool
+	scope     sql.Row
+	leftLen   int
+	rightLen  int
+	keys      []joinKey
+
+	build, probe             sql.RowIter
+	buildKeys, probeKeys     []sql.Expression
+	buildSchema, probeSchema sql.Schema
+	built                    bool
+
+	// The hash table of the build rows being joined.
+	rows    []sql.Row
+	hashes  []uint64
+	matched []bool
+	table   map[uint64][]int
+	// size is an estimate of the memory taken by the hash table.
+	size    uint64
+	maxSize uint64
+
+	buildPartitions, probePartitions spillPartitions
+	partition                        int
+	probeSource                      func() (sql.Row, error)
+
+	probeRow     sql.Row
+	probeMatched bool
+	candidates   []int
+	pos          int
+	// unmatched is set once the probe rows for the hash table are exhausted, when the build rows that matched no
+	// probe row are returned, starting at unmatchedPos.
+	unmatched    bool
+	unmatchedPos int
+}
+
+func (i *hashJoinIter) Next() (sql.Row, error) {
+	if !i.built {
+		if err := i.buildTable(); err != nil {
+			return nil, err
+		}
+		i.built = true
+	}
+
+	for {
+		if i.unmatched {
+			if i.buildPreserved() {
+				for i.unmatchedPos < len(i.rows) {
+					pos := i.unmatchedPos
+					i.unmatchedPos++
+					if !i.matched[pos] {
+						return i.unmatchedRow(i.rows[pos], i.buildLeft), nil
+					}
+				}
+			}
+			if i.partition >= len(i.buildPartitions) {
+				return nil, io.EOF
+			}
+			if err := i.loadPartition(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if i.probeRow == nil {
+			row, err := i.probeSource()
+			if err == io.EOF {
+				i.unmatched = true
+				i.unmatchedPos = 0
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			hash, ok, err := i.hash(i.probeKeys, row)
+			if err != nil {
+				return nil, err
+			}
+			i.probeRow = row
+			i.probeMatched = false
+			i.candidates = nil
+			if ok {
+				i.candidates = i.table[hash]
+			}
+			i.pos = 0
+		}
+
+		for i.pos < len(i.candidates) {
+			idx := i.candidates[i.pos]
+			i.pos++
+			if i.buildLeft && (i.typ == JoinTypeSemi || i.typ == JoinTypeAnti) && i.matched[idx] {
+				// This left row has already been returned or excluded
+				continue
+			}
+
+			row := i.joinedRow(i.rows[idx], i.probeRow)
+			matches, err := conditionIsTrue(i.ctx, row, i.cond)
+			if err != nil {
+				return nil, err
+			}
+			if !matches {
+				continue
+			}
+
+			i.matched[idx] = true
+			i.probeMatched = true
+			switch i.typ {
+			case JoinTypeSemi:
+				if i.buildLeft {
+					return i.scopedRow(i.rows[idx]), nil
+				}
+				i.pos = len(i.candidates)
+				return i.scopedRow(i.probeRow), nil
+			case JoinTypeAnti:
+				if !i.buildLeft {
+					i.pos = len(i.candidates)
+				}
+			default:
+				return row, nil
+			}
+		}
+
+		probeRow := i.probeRow
+		i.probeRow = nil
+		if !i.probeMatched && i.probePreserved() {
+			return i.unmatchedRow(probeRow, !i.buildLeft), nil
+		}
+	}
+}
+
+// buildPreserved returns whether the build rows that match no probe row are returned.
+func (i *hashJoinIter) buildPreserved() bool {
+	if i.buildLeft {
+		return i.typ == JoinTypeLeft || i.typ == JoinTypeAnti
+	}
+	return i.typ == JoinTypeRight
+}
+
+// probePreserved returns whether the probe rows that match no build row are returned.
+func (i *hashJoinIter) probePreserved() bool {
+	if i.buildLeft {
+		return i.typ == JoinTypeRight
+	}
+	return i.typ == JoinTypeLeft || i.typ == JoinTypeAnti
+}
+
+// joinedRow returns the row that the join condition is evaluated on for the build and probe rows given.
+func (i *hashJoinIter) joinedRow(build, probe sql.Row) sql.Row {
+	left, right := probe, build
+	if i.buildLeft {
+		left, right = build, probe
+	}
+	row := make(sql.Row, len(i.scope)+i.leftLen+i.rightLen)
+	copy(row, i.scope)
+	copy(row[len(i.scope):], left)
+	copy(row[len(i.scope)+i.leftLen:], right)
+	return row
+}
+
+// scopedRow returns the row given after the scope row.
+func (i *hashJoinIter) scopedRow(row sql.Row) sql.Row {
+	result := make(sql.Row, len(i.scope)+len(row))
+	copy(result, i.scope)
+	copy(result[len(i.scope):], row)
+	return result
+}
+
+// unmatchedRow returns the result for a left or right row that matched no row of the other side.
+func (i *hashJoinIter) unmatchedRow(row sql.Row, isLeft bool) sql.Row {
+	if i.typ == JoinTypeAnti {
+		return i.scopedRow(row)
+	}
+	result := make(sql.Row, len(i.scope)+i.leftLen+i.rightLen)
+	copy(result, i.scope)
+	if isLeft {
+		copy(result[len(i.scope):], row)
+	} else {
+		copy(result[len(i.scope)+i.leftLen:], row)
+	}
+	return result
+}
+
+// hash returns the hash of the keys given for the row given of one of the sides, and false if any of them is NULL.
+func (i *hashJoinIter) hash(keys []sql.Expression, row sql.Row) (uint64, bool, error) {
+	evalRow := i.scopedRow(row)
+	values := make(sql.Row, len(keys))
+	for k, key := range keys {
+		v, err := key.Eval(i.ctx, evalRow)
+		if err != nil {
+			return 0, false, err
+		}
+		if v == nil {
+			return 0, false, nil
+		}
+		if values[k], err = i.keys[k].normalize(v); err != nil {
+			return 0, false, err
+		}
+	}
+	hash, err := sql.HashOf(values)
+	return hash, true, err
+}
+
+// add adds a build row to the hash table. Rows with a NULL key are only kept to be returned as unmatched.
+func (i *hashJoinIter) add(row sql.Row) error {
+	hash, ok, err := i.hash(i.buildKeys, row)
+	if err != nil {
+		return err
+	}
+	if !ok && !i.buildPreserved() {
+		return nil
+	}
+
+	idx := len(i.rows)
+	i.rows = append(i.rows, row)
+	i.hashes = append(i.hashes, hash)
+	i.matched = append(i.matched, false)
+	if ok {
+		i.table[hash] = append(i.table[hash], idx)
+	}
+	i.size += sql.EstimateRowSize(row) + hashJoinRowSize
+	return nil
+}
+
+// reset empties the hash table.
+func (i *hashJoinIter) reset() {
+	i.rows = nil
+	i.hashes = nil
+	i.matched = nil
+	i.table = make(map[uint64][]int)
+	i.size = 0
+}
+
+// buildTable reads the build side into the hash table, or into partitions on disk along with the probe side if it
+// doesn't fit in memory.
+func (i *hashJoinIter) buildTable() error {
+	i.reset()
+	for {
+		row, err := i.build.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if i.buildPartitions != nil {
+			if err := i.writePartition(i.buildPartitions, i.buildKeys, row, i.buildPreserved()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := i.add(row); err != nil {
+			return err
+		}
+		if (i.maxSize > 0 && i.size >= i.maxSize) || !i.ctx.Memory.HasAvailable() {
+			if err := i.spill(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if i.buildPartitions == nil {
+		return nil
+	}
+
+	for {
+		row, err := i.probe.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := i.writePartition(i.probePartitions, i.probeKeys, row, i.probePreserved()); err != nil {
+			return err
+		}
+	}
+	return i.loadPartition()
+}
+
+// spill moves the rows of the hash table to partitions on disk, which the rest of the build side and the probe side
+// are written to as well.
+func (i *hashJoinIter) spill() error {
+	var err error
+	if i.buildPartitions, err = newSpillPartitions(i.ctx, i.buildSchema); err != nil {
+		return err
+	}
+	if i.probePartitions, err = newSpillPartitions(i.ctx, i.probeSchema); err != nil {
+		return err
+	}
+	for idx, row := range i.rows {
+		if err := i.buildPartitions.Write(i.hashes[idx], row); err != nil {
+			return err
+		}
+	}
+	i.reset()
+	return nil
+}
+
+// writePartition writes a row to the partition of its hash. Rows with a NULL key match nothing, so they are only
+// written if they are returned when unmatched, to the first partition.
+func (i *hashJoinIter) writePartition(partitions spillPartitions, keys []sql.Expression, row sql.Row, preserved bool) error {
+	hash, ok, err := i.hash(keys, row)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if !preserved {
+			return nil
+		}
+		hash = 0
+	}
+	return partitions.Write(hash, row)
+}
+
+// loadPartition loads the next partition of the build side into the hash table, and probes it with the same
+// partition of the probe side.
+func (i *hashJoinIter) loadPartition() error {
+	reader, err := i.buildPartitions[i.partition].Reader()
+	if err != nil {
+		return err
+	}
+	i.reset()
+	for {
+		row, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := i.add(row); err != nil {
+			return err
+		}
+	}
+
+	probeReader, err := i.probePartitions[i.partition].Reader()
+	if err != nil {
+		return err
+	}
+	i.probeSource = probeReader.Next
+	i.partition++
+	i.unmatched = false
+	return nil
+}
+
+func (i *hashJoinIter) Close(ctx *sql.Context) error {
+	i.reset()
+	err := i.build.Close(ctx)
+	if probeErr := i.probe.Close(ctx); err == nil {
+		err = probeErr
+	}
+	if i.buildPartitions != nil {
+		if removeErr := i.buildPartitions.Remove(); err == nil {
+			err = removeErr
+		}
+		i.buildPartitions = nil
+	}
+	if i.probePartitions != nil {
+		if removeErr := i.probePartitions.Remove(); err == nil {
+			err = removeErr
+		}
+		i.probePartitions = nil
+	}
+	return err
+}
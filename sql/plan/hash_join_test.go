@@ -0,0 +1,166 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestHashJoin(t *testing.T) {
+	left := memory.NewTable("left", sql.Schema{
+		{Name: "a", Source: "left", Type: sql.Int64, Nullable: true},
+		{Name: "x", Source: "left", Type: sql.Text},
+	})
+	right := memory.NewTable("right", sql.Schema{
+		{Name: "b", Source: "right", Type: sql.Int32, Nullable: true},
+		{Name: "y", Source: "right", Type: sql.Text},
+	})
+	for i := 0; i < 200; i++ {
+		var a interface{} = int64(i % 70)
+		if i%13 == 0 {
+			a = nil
+		}
+		require.NoError(t, left.Insert(sql.NewEmptyContext(), sql.NewRow(a, fmt.Sprintf("l%d", i))))
+	}
+	for i := 0; i < 150; i++ {
+		var b interface{} = int32(i%90 + 20)
+		if i%17 == 0 {
+			b = nil
+		}
+		require.NoError(t, right.Insert(sql.NewEmptyContext(), sql.NewRow(b, fmt.Sprintf("r%d", i))))
+	}
+
+	// An equality between the sides, and a predicate that isn't hashed
+	cond := expression.NewAnd(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(2, sql.Int32, "right", "b", true),
+			expression.NewGetFieldWithTable(0, sql.Int64, "left", "a", true),
+		),
+		expression.NewLessThan(
+			expression.NewGetFieldWithTable(2, sql.Int32, "right", "b", true),
+			expression.NewLiteral(int32(60), sql.Int32),
+		),
+	)
+	l := NewResolvedTable(left, nil, nil)
+	r := NewResolvedTable(right, nil, nil)
+
+	leftRows, err := sql.NodeToRows(sql.NewEmptyContext(), l)
+	require.NoError(t, err)
+	rightRows, err := sql.NodeToRows(sql.NewEmptyContext(), r)
+	require.NoError(t, err)
+	var semi, anti []sql.Row
+	for _, lr := range leftRows {
+		found := false
+		for _, rr := range rightRows {
+			ok, err := conditionIsTrue(sql.NewEmptyContext(), append(lr.Copy(), rr...), cond)
+			require.NoError(t, err)
+			found = found || ok
+		}
+		if found {
+			semi = append(semi, lr)
+		} else {
+			anti = append(anti, lr)
+		}
+	}
+
+	expected := map[JoinType][]sql.Row{JoinTypeSemi: semi, JoinTypeAnti: anti}
+	for typ, join := range map[JoinType]sql.Node{
+		JoinTypeInner: NewInnerJoin(l, r, cond),
+		JoinTypeLeft:  NewLeftJoin(l, r, cond),
+		JoinTypeRight: NewRightJoin(l, r, cond),
+	} {
+		expected[typ], err = sql.NodeToRows(sql.NewEmptyContext(), join)
+		require.NoError(t, err)
+	}
+
+	for typ, rows := range expected {
+		for _, buildLeft := range []bool{false, true} {
+			for _, spill := range []bool{false, true} {
+				t.Run(fmt.Sprintf("%s build left %t spill %t", typ, buildLeft, spill), func(t *testing.T) {
+					require := require.New(t)
+					ctx := sql.NewEmptyContext()
+					if spill {
+						require.NoError(ctx.SetSessionVariable(ctx, "tmp_table_size", uint64(1024)))
+					}
+
+					iter, err := NewHashJoin(l, r, typ, cond, buildLeft, 0).RowIter(ctx, nil)
+					require.NoError(err)
+					var actual []sql.Row
+					for {
+						row, err := iter.Next()
+						if err == io.EOF {
+							break
+						}
+						require.NoError(err)
+						actual = append(actual, row)
+					}
+					require.Equal(spill, iter.(*hashJoinIter).buildPartitions != nil)
+					require.NoError(iter.Close(ctx))
+
+					require.ElementsMatch(rows, actual)
+				})
+			}
+		}
+	}
+}
+
+func TestHashJoinKeys(t *testing.T) {
+	require := require.New(t)
+
+	// The scope has one column, the left side two and the right side two
+	scope := expression.NewGetField(0, sql.Int64, "scope", false)
+	i := expression.NewGetField(1, sql.Int64, "i", false)
+	s := expression.NewGetField(2, sql.Text, "s", false)
+	d := expression.NewGetField(3, sql.MustCreateDecimalType(10, 2), "d", false)
+	t2 := expression.NewGetField(4, sql.Text, "t", false)
+	cond := expression.JoinAnd(
+		expression.NewEquals(d, i),
+		expression.NewEquals(s, t2),
+		expression.NewEquals(t2, i),
+		expression.NewEquals(i, scope),
+	)
+
+	keys := joinKeys(cond, 1, 2)
+	require.Len(keys, 2)
+	require.Equal(i, keys[0].left)
+	require.Equal(d, keys[0].right)
+	require.Equal(s, keys[1].left)
+	require.Equal(t2, keys[1].right)
+
+	// Values that compare as equal have the same key
+	one, err := keys[0].normalize(int64(1))
+	require.NoError(err)
+	oneDecimal, err := keys[0].normalize(decimal.RequireFromString("1.00"))
+	require.NoError(err)
+	require.Equal(one, oneDecimal)
+
+	upper, err := keys[1].normalize("ABC")
+	require.NoError(err)
+	lower, err := keys[1].normalize("abc")
+	require.NoError(err)
+	require.Equal(upper, lower)
+
+	require.False(CanHashJoin(expression.NewEquals(t2, i), 1, sql.Schema{{}, {}}))
+	require.True(CanHashJoin(cond, 1, sql.Schema{{}, {}}))
+}
@@ -105,6 +105,9 @@ func (n *HashLookup) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 }
 
 // Convert a tuple expression returning []interface{} into something comparable.
+// Strings are replaced by their keys in the collation of the child projection,
+// on both sides of the lookup, so that strings equal in that collation find
+// each other.
 // Fast paths a few smaller slices into fixed size arrays, puts everything else
 // through string serialization and a hash for now. It is OK to hash lossy here
 // as the join condition is still evaluated after the matching rows are returned.
@@ -113,6 +116,7 @@ func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row)
 	if err != nil {
 		return nil, err
 	}
+	key = sql.CollationKey(n.childProjection.Type(), key)
 	if s, ok := key.([]interface{}); ok {
 		switch len(s) {
 		case 0:
@@ -53,33 +53,38 @@ func NewIndexedJoin(left, right sql.Node, joinType JoinType, cond sql.Expression
 
 func (ij *IndexedJoin) String() string {
 	pr := sql.NewTreePrinter()
-	joinType := ""
-	switch ij.joinType {
-	case JoinTypeLeft:
-		joinType = "Left"
-	case JoinTypeRight:
-		joinType = "Right"
-	}
-	_ = pr.WriteNode("%sIndexedJoin%s", joinType, ij.Cond)
+	_ = pr.WriteNode("%sIndexedJoin%s", ij.joinTypePrefix(), ij.Cond)
 	_ = pr.WriteChildren(ij.left.String(), ij.right.String())
 	return pr.String()
 }
 
 func (ij *IndexedJoin) DebugString() string {
 	pr := sql.NewTreePrinter()
-	joinType := ""
+	_ = pr.WriteNode("%sIndexedJoin%s", ij.joinTypePrefix(), sql.DebugString(ij.Cond))
+	_ = pr.WriteChildren(sql.DebugString(ij.left), sql.DebugString(ij.right))
+	return pr.String()
+}
+
+// joinTypePrefix returns the prefix of the name of this indexed join for its join type.
+func (ij *IndexedJoin) joinTypePrefix() string {
 	switch ij.joinType {
 	case JoinTypeLeft:
-		joinType = "Left"
+		return "Left"
 	case JoinTypeRight:
-		joinType = "Right"
+		return "Right"
+	case JoinTypeSemi:
+		return "Semi"
+	case JoinTypeAnti:
+		return "Anti"
+	default:
+		return ""
 	}
-	_ = pr.WriteNode("%sIndexedJoin%s", joinType, sql.DebugString(ij.Cond))
-	_ = pr.WriteChildren(sql.DebugString(ij.left), sql.DebugString(ij.right))
-	return pr.String()
 }
 
 func (ij *IndexedJoin) Schema() sql.Schema {
+	if ij.joinType == JoinTypeSemi || ij.joinType == JoinTypeAnti {
+		return ij.left.Schema()
+	}
 	return append(ij.left.Schema(), ij.right.Schema()...)
 }
 
@@ -206,6 +211,9 @@ func (i *indexedJoinIter) Next() (sql.Row, error) {
 					row := i.buildRow(primary, nil)
 					return i.removeParentRow(row), nil
 				}
+				if !i.foundMatch && i.joinType == JoinTypeAnti {
+					return i.removeParentRow(primary), nil
+				}
 				continue
 			}
 			return nil, err
@@ -222,10 +230,34 @@ func (i *indexedJoinIter) Next() (sql.Row, error) {
 		}
 
 		i.foundMatch = true
+		switch i.joinType {
+		case JoinTypeSemi:
+			if err := i.skipSecondary(); err != nil {
+				return nil, err
+			}
+			return i.removeParentRow(primary), nil
+		case JoinTypeAnti:
+			if err := i.skipSecondary(); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		return i.removeParentRow(row), nil
 	}
 }
 
+// skipSecondary moves on to the next primary row without reading the rest of the secondary rows of this one, once a
+// semi or anti join has found it a match.
+func (i *indexedJoinIter) skipSecondary() error {
+	i.primaryRow = nil
+	if i.secondary == nil {
+		return nil
+	}
+	err := i.secondary.Close(i.ctx)
+	i.secondary = nil
+	return err
+}
+
 func (i *indexedJoinIter) removeParentRow(r sql.Row) sql.Row {
 	copy(r[i.scopeLen:], r[len(i.parentRow):])
 	r = r[:len(r)-len(i.parentRow)+i.scopeLen]
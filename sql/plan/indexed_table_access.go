@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -96,7 +97,7 @@ func (i *IndexedTableAccess) getLookup(ctx *sql.Context, row sql.Row) (sql.Index
 		}
 	}
 
-	lookup, err := i.index.Get(key...)
+	lookup, err := i.index.Get(i.keyFromSessionTime(ctx, key)...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +105,33 @@ func (i *IndexedTableAccess) getLookup(ctx *sql.Context, row sql.Row) (sql.Index
 	return lookup, nil
 }
 
+// keyFromSessionTime returns the key given with its values for TIMESTAMP columns converted from the session's time
+// zone to UTC, which is how tables store timestamps. Values that aren't timestamps are left for the index to reject.
+func (i *IndexedTableAccess) keyFromSessionTime(ctx *sql.Context, key []interface{}) []interface{} {
+	if ctx.TimeZone() == time.UTC {
+		return key
+	}
+
+	schema := i.ResolvedTable.Schema()
+	for j, expr := range i.index.Expressions() {
+		if j >= len(key) {
+			break
+		}
+		table, column := "", expr
+		if dot := strings.LastIndex(expr, "."); dot >= 0 {
+			table, column = expr[:dot], expr[dot+1:]
+		}
+		idx := schema.IndexOf(column, table)
+		if idx < 0 || !sql.IsTimestamp(schema[idx].Type) {
+			continue
+		}
+		if utc, err := ctx.TimestampFromSessionTime(key[j]); err == nil {
+			key[j] = utc
+		}
+	}
+	return key
+}
+
 func (i *IndexedTableAccess) String() string {
 	return fmt.Sprintf("IndexedTableAccess(%s on %s)", i.Name(), formatIndexDecoratorString(i.index))
 }
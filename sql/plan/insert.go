@@ -244,6 +244,11 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 		row = row[len(row)-len(i.schema):]
 	}
 
+	err = applyGeneratedColumns(i.ctx, i.schema, row)
+	if err != nil {
+		return i.ignoreOrClose(err)
+	}
+
 	err = i.validateNullability(i.schema, row)
 	if err != nil {
 		return i.ignoreOrClose(err)
@@ -284,7 +289,7 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 		// May have multiple duplicate pk & unique errors due to multiple indexes
 		//TODO: how does this interact with triggers?
 		for {
-			if err := i.replacer.Insert(i.ctx, row); err != nil {
+			if err := i.replacer.Insert(i.ctx, i.ctx.RowFromSessionTime(i.schema, row)); err != nil {
 				if !sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) {
 					_ = i.rowSource.Close(i.ctx)
 					return nil, err
@@ -296,8 +301,9 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 					return nil, err
 				}
 				// the row had to be deleted, write the values into the toReturn row
-				for i := 0; i < len(ue.Existing); i++ {
-					toReturn[i] = ue.Existing[i]
+				existing := i.ctx.RowToSessionTime(i.schema, ue.Existing)
+				for i := 0; i < len(existing); i++ {
+					toReturn[i] = existing[i]
 				}
 			} else {
 				break
@@ -305,7 +311,7 @@ func (i *insertIter) Next() (returnRow sql.Row, returnErr error) {
 		}
 		return toReturn, nil
 	} else {
-		if err := i.inserter.Insert(i.ctx, row); err != nil {
+		if err := i.inserter.Insert(i.ctx, i.ctx.RowFromSessionTime(i.schema, row)); err != nil {
 			if (!sql.ErrPrimaryKeyViolation.Is(err) && !sql.ErrUniqueKeyViolation.Is(err) && !sql.ErrDuplicateEntry.Is(err)) || len(i.updateExprs) == 0 {
 				return i.ignoreOrClose(err)
 			}
@@ -326,12 +332,19 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(row, rowToUpdate sql.Row) (retur
 		return nil, err
 	}
 
+	// Existing rows come straight from the table, so their timestamps are still in UTC
+	rowToUpdate = i.ctx.RowToSessionTime(i.schema, rowToUpdate)
 	newRow, err := applyUpdateExpressions(i.ctx, i.updateExprs, rowToUpdate)
 	if err != nil {
 		return nil, err
 	}
 
-	err = i.updater.Update(i.ctx, rowToUpdate, newRow)
+	err = applyGeneratedColumns(i.ctx, i.schema, newRow)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.updater.Update(i.ctx, i.ctx.RowFromSessionTime(i.schema, rowToUpdate), i.ctx.RowFromSessionTime(i.schema, newRow))
 	if err != nil {
 		return nil, err
 	}
@@ -452,6 +465,22 @@ func (i *insertIter) warnOnIgnorableError(err error) error {
 	return err
 }
 
+// applyGeneratedColumns sets the value of every generated column in the schema given by evaluating its generation
+// expression against the row given. The row is modified in place.
+func applyGeneratedColumns(ctx *sql.Context, schema sql.Schema, row sql.Row) error {
+	for i, col := range schema {
+		if col.Generated == nil {
+			continue
+		}
+		val, err := col.Generated.Eval(ctx, row)
+		if err != nil {
+			return err
+		}
+		row[i] = val
+	}
+	return nil
+}
+
 func toInt64(x interface{}) int64 {
 	switch x := x.(type) {
 	case int:
@@ -84,7 +84,7 @@ func (in *InSubquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			return nil, nil
 		}
 
-		key, err := sql.HashOf(sql.NewRow(left))
+		key, err := sql.HashOf(sql.NewRow(sql.CollationKey(typ, left)))
 		if err != nil {
 			return nil, err
 		}
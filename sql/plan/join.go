@@ -359,6 +359,10 @@ const (
 	JoinTypeInner JoinType = iota
 	JoinTypeLeft
 	JoinTypeRight
+	// JoinTypeSemi returns the rows of the left side that match at least one row of the right side, once each.
+	JoinTypeSemi
+	// JoinTypeAnti returns the rows of the left side that match no row of the right side.
+	JoinTypeAnti
 )
 
 func (t JoinType) String() string {
@@ -369,6 +373,10 @@ func (t JoinType) String() string {
 		return "LeftJoin"
 	case JoinTypeRight:
 		return "RightJoin"
+	case JoinTypeSemi:
+		return "SemiJoin"
+	case JoinTypeAnti:
+		return "AntiJoin"
 	default:
 		return "INVALID"
 	}
@@ -628,6 +636,9 @@ func (i *joinIter) Next() (sql.Row, error) {
 					row := i.buildRow(primary, nil)
 					return row, nil
 				}
+				if !i.foundMatch && i.typ == JoinTypeAnti {
+					return i.primaryOnlyRow(primary), nil
+				}
 				continue
 			}
 			return nil, err
@@ -644,10 +655,61 @@ func (i *joinIter) Next() (sql.Row, error) {
 		}
 
 		i.foundMatch = true
+		switch i.typ {
+		case JoinTypeSemi:
+			if err := i.skipSecondary(); err != nil {
+				return nil, err
+			}
+			return i.primaryOnlyRow(primary), nil
+		case JoinTypeAnti:
+			if err := i.skipSecondary(); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		return row, nil
 	}
 }
 
+// skipSecondary moves on to the next primary row without reading the rest of the secondary rows of this one, once a
+// semi or anti join has found it a match. While the secondary rows are first read, they are read to the end instead,
+// so that they can be kept in memory for the next primary rows.
+func (i *joinIter) skipSecondary() error {
+	switch i.mode {
+	case memoryMode:
+		i.primaryRow = nil
+		i.pos = 0
+		return nil
+	case multipassMode:
+		i.primaryRow = nil
+		if i.secondary == nil {
+			return nil
+		}
+		err := i.secondary.Close(i.ctx)
+		i.secondary = nil
+		return err
+	default:
+		for {
+			_, err := i.loadSecondary()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// primaryOnlyRow returns the result of a semi or anti join for the primary row given, which is the scope followed by
+// the primary row alone.
+func (i *joinIter) primaryOnlyRow(primary sql.Row) sql.Row {
+	row := make(sql.Row, i.scopeLen+len(primary)-len(i.originalRow))
+	copy(row, primary[:i.scopeLen])
+	copy(row[i.scopeLen:], primary[len(i.originalRow):])
+	return row
+}
+
 // buildRow builds the resulting row using the rows from the primary and
 // secondary branches depending on the join type.
 func (i *joinIter) buildRow(primary, secondary sql.Row) sql.Row {
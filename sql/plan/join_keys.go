@@ -0,0 +1,183 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// joinKeyKind is how the values of a join key are compared, and normalized so that values which compare as equal have
+// the same hash.
+type joinKeyKind byte
+
+const (
+	// joinKeyNumber keys are numbers, compared as doubles.
+	joinKeyNumber joinKeyKind = iota
+	// joinKeyString keys are strings, compared in a collation.
+	joinKeyString
+	// joinKeyTime keys are dates and times, compared as instants.
+	joinKeyTime
+)
+
+// joinKey is an equality in the condition of a hash or merge join, between an expression over its left side and one
+// over its right side.
+type joinKey struct {
+	left, right sql.Expression
+	kind        joinKeyKind
+	collation   sql.Collation
+}
+
+// joinKeys returns the equalities between the left and the right side in the conjunction given that can be hashed
+// and compared on, in the order they appear in it. Columns before scopeLen are from the outer scope, then come leftLen columns of the left side and then the
+// columns of the right side.
+func joinKeys(cond sql.Expression, scopeLen, leftLen int) []joinKey {
+	var keys []joinKey
+	var collect func(e sql.Expression)
+	collect = func(e sql.Expression) {
+		switch e := e.(type) {
+		case *expression.And:
+			collect(e.Left)
+			collect(e.Right)
+		case *expression.Equals:
+			left, right := e.Left(), e.Right()
+			switch {
+			case joinSide(left, scopeLen, leftLen) == JoinTypeLeft && joinSide(right, scopeLen, leftLen) == JoinTypeRight:
+			case joinSide(left, scopeLen, leftLen) == JoinTypeRight && joinSide(right, scopeLen, leftLen) == JoinTypeLeft:
+				left, right = right, left
+			default:
+				return
+			}
+			key := joinKey{left: left, right: right}
+			lt, rt := left.Type(), right.Type()
+			switch {
+			case sql.IsCustomType(lt) || sql.IsCustomType(rt):
+				return
+			case sql.IsNumber(lt) && sql.IsNumber(rt):
+				key.kind = joinKeyNumber
+			case sql.IsText(lt) && sql.IsText(rt):
+				key.kind = joinKeyString
+				key.collation = expression.CollationOf(left, right)
+			case sql.IsTime(lt) && sql.IsTime(rt):
+				key.kind = joinKeyTime
+			default:
+				return
+			}
+			keys = append(keys, key)
+		}
+	}
+	collect(cond)
+	return keys
+}
+
+// joinSide returns JoinTypeLeft if the expression given only reads columns of the scope and of the left side, and
+// at least one of the left side, JoinTypeRight if the same holds for the right side, and JoinTypeInner otherwise.
+// Expressions with subqueries or non-deterministic results are on neither side.
+func joinSide(e sql.Expression, scopeLen, leftLen int) JoinType {
+	var left, right, other bool
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.GetField:
+			switch {
+			case e.Index() < scopeLen:
+			case e.Index() < scopeLen+leftLen:
+				left = true
+			default:
+				right = true
+			}
+		case *Subquery:
+			other = true
+		case sql.NonDeterministicExpression:
+			if e.IsNonDeterministic() {
+				other = true
+			}
+		}
+		return true
+	})
+	switch {
+	case other || left == right:
+		return JoinTypeInner
+	case left:
+		return JoinTypeLeft
+	default:
+		return JoinTypeRight
+	}
+}
+
+// normalize returns the value to hash for the value given of this key.
+func (k joinKey) normalize(v interface{}) (interface{}, error) {
+	switch k.kind {
+	case joinKeyNumber:
+		f, err := sql.Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		if f == 0.0 {
+			// Negative zero equals zero
+			return float64(0), nil
+		}
+		return f, nil
+	case joinKeyString:
+		return k.collation.Key(joinKeyText(v)), nil
+	case joinKeyTime:
+		t, err := sql.Datetime.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		tt := t.(time.Time)
+		return [2]int64{tt.Unix(), int64(tt.Nanosecond())}, nil
+	default:
+		return v, nil
+	}
+}
+
+// compare returns -1, 0 or 1 when the first of the non-NULL values given of this key is less than, equal to or greater
+// than the second one.
+func (k joinKey) compare(a, b interface{}) (int, error) {
+	switch k.kind {
+	case joinKeyNumber:
+		return sql.Float64.Compare(a, b)
+	case joinKeyString:
+		return k.collation.Compare(joinKeyText(a), joinKeyText(b)), nil
+	default:
+		return sql.Datetime.Compare(a, b)
+	}
+}
+
+// rightOnScope returns the right expression of this key rewritten to be evaluated on the scope followed by a row of
+// the right side, without the row of the left side in between.
+func (k joinKey) rightOnScope(ctx *sql.Context, scopeLen, leftLen int) (sql.Expression, error) {
+	return expression.TransformUp(ctx, k.right, func(e sql.Expression) (sql.Expression, error) {
+		if gf, ok := e.(*expression.GetField); ok && gf.Index() >= scopeLen {
+			return gf.WithIndex(gf.Index() - leftLen), nil
+		}
+		return e, nil
+	})
+}
+
+// joinKeyText returns the string value of a string key.
+func joinKeyText(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case []byte:
+		return string(s)
+	default:
+		return fmt.Sprint(s)
+	}
+}
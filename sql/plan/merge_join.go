@@ -0,0 +1,418 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var (
+	// ErrMergeJoinUnsorted is returned when a child of a merge join returns its rows out of the order of the join key.
+	ErrMergeJoinUnsorted = errors.NewKind("merge join input is not sorted on %s")
+	// ErrMergeJoinNoKey is returned when the condition of a merge join has no equality between its sides to merge on.
+	ErrMergeJoinNoKey = errors.NewKind("merge join condition has no equality between its sides: %s")
+)
+
+// MergeJoin is a join on the equality of expressions over its left and right children, whose rows both children return
+// in ascending order of those expressions. It reads both children in step, and joins each row of the left side with
+// the group of rows of the right side with the same key, so it holds no more than one group of right rows at a time.
+// Rows with a NULL key never match. The first equality of the join condition between the two sides is the one merged
+// on, and the whole condition is evaluated on every pair of rows with the same key.
+type MergeJoin struct {
+	BinaryNode
+	// The join condition.
+	Cond     sql.Expression
+	joinType JoinType
+	scopeLen int
+}
+
+var _ sql.Expressioner = (*MergeJoin)(nil)
+
+// NewMergeJoin returns a merge join of the type given between left and right, which must be an inner, left or right
+// join.
+func NewMergeJoin(left, right sql.Node, joinType JoinType, cond sql.Expression, scopeLen int) *MergeJoin {
+	return &MergeJoin{
+		BinaryNode: BinaryNode{left, right},
+		Cond:       cond,
+		joinType:   joinType,
+		scopeLen:   scopeLen,
+	}
+}
+
+// JoinType returns the join type for this merge join.
+func (j *MergeJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+func (j *MergeJoin) Schema() sql.Schema {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	case JoinTypeRight:
+		return append(makeNullable(j.left.Schema()), j.right.Schema()...)
+	default:
+		return append(j.left.Schema(), j.right.Schema()...)
+	}
+}
+
+func (j *MergeJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+func (j *MergeJoin) Expressions() []sql.Expression {
+	return []sql.Expression{j.Cond}
+}
+
+func (j *MergeJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+	return NewMergeJoin(j.left, j.right, j.joinType, exprs[0], j.scopeLen), nil
+}
+
+func (j *MergeJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+	return NewMergeJoin(children[0], children[1], j.joinType, j.Cond, j.scopeLen), nil
+}
+
+func (j *MergeJoin) name() string {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return "LeftMergeJoin"
+	case JoinTypeRight:
+		return "RightMergeJoin"
+	default:
+		return "MergeJoin"
+	}
+}
+
+func (j *MergeJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%s%s", j.name(), j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *MergeJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%s%s", j.name(), sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+func (j *MergeJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan." + j.name())
+
+	leftLen := len(j.left.Schema())
+	keys := joinKeys(j.Cond, j.scopeLen, leftLen)
+	if len(keys) == 0 {
+		span.Finish()
+		return nil, ErrMergeJoinNoKey.New(j.Cond)
+	}
+	rightKey, err := keys[0].rightOnScope(ctx, j.scopeLen, leftLen)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	l, err := j.left.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	r, err := j.right.RowIter(ctx, row)
+	if err != nil {
+		_ = l.Close(ctx)
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &mergeJoinIter{
+		ctx:      ctx,
+		typ:      j.joinType,
+		cond:     j.Cond,
+		key:      keys[0],
+		leftKey:  keys[0].left,
+		rightKey: rightKey,
+		scope:    row[:j.scopeLen],
+		leftLen:  leftLen,
+		rightLen: len(j.right.Schema()),
+		left:     l,
+		right:    r,
+	}), nil
+}
+
+// CanMergeJoin returns whether the condition given, of a join with the scope and left schema given, has an equality
+// between an expression over the left side and one over the right side that a merge join can merge on. Its first
+// such equality is the one merged on.
+func CanMergeJoin(cond sql.Expression, scopeLen int, left sql.Schema) bool {
+	return len(joinKeys(cond, scopeLen, len(left))) > 0
+}
+
+// mergeJoinIter joins each row of the left side with the current group of rows of the right side with the same key,
+// reading the next groups of the right side while their keys are less than the key of the left row.
+type mergeJoinIter struct {
+	ctx               *sql.Context
+	typ               JoinType
+	cond              sql.Expression
+	key               joinKey
+	leftKey, rightKey sql.Expression
+	scope             sql.Row
+	leftLen, rightLen int
+	left, right       sql.RowIter
+
+	// The left row being joined with the group, and its key
+	leftRow     sql.Row
+	leftValue   interface{}
+	leftMatched bool
+	pos         int
+
+	// The right rows with the same key, and whether each matched any left row
+	group        []sql.Row
+	groupValue   interface{}
+	groupMatched []bool
+
+	// The first right row after the group, and its key
+	nextRight      sql.Row
+	nextRightValue interface{}
+	rightDone      bool
+	leftDone       bool
+
+	// Right rows that matched no left row, to return in a right join
+	unmatched []sql.Row
+}
+
+func (i *mergeJoinIter) Next() (sql.Row, error) {
+	for {
+		if len(i.unmatched) > 0 {
+			row := i.unmatched[0]
+			i.unmatched = i.unmatched[1:]
+			return i.unmatchedRow(row, false), nil
+		}
+
+		if i.leftDone {
+			if i.typ != JoinTypeRight || (i.rightDone && i.nextRight == nil) {
+				return nil, io.EOF
+			}
+			// The rest of the right side matches no left row
+			row := i.nextRight
+			i.nextRight = nil
+			if row == nil {
+				var err error
+				if row, err = i.right.Next(); err != nil {
+					return nil, err
+				}
+			}
+			return i.unmatchedRow(row, false), nil
+		}
+
+		if i.leftRow != nil {
+			for i.pos < len(i.group) {
+				pos := i.pos
+				i.pos++
+				row := i.joinedRow(i.leftRow, i.group[pos])
+				matches, err := conditionIsTrue(i.ctx, row, i.cond)
+				if err != nil {
+					return nil, err
+				}
+				if matches {
+					i.leftMatched = true
+					i.groupMatched[pos] = true
+					return row, nil
+				}
+			}
+
+			leftRow := i.leftRow
+			i.leftRow = nil
+			if !i.leftMatched && i.typ == JoinTypeLeft {
+				return i.unmatchedRow(leftRow, true), nil
+			}
+			continue
+		}
+
+		leftRow, err := i.left.Next()
+		if err == io.EOF {
+			i.leftDone = true
+			i.discardGroup()
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := i.leftKey.Eval(i.ctx, i.scopedRow(leftRow))
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			if i.typ == JoinTypeLeft {
+				return i.unmatchedRow(leftRow, true), nil
+			}
+			continue
+		}
+		if i.leftValue != nil {
+			if cmp, err := i.key.compare(value, i.leftValue); err != nil {
+				return nil, err
+			} else if cmp < 0 {
+				return nil, ErrMergeJoinUnsorted.New(i.key.left)
+			}
+		}
+		i.leftValue = value
+
+		cmp, err := i.seek(value)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case cmp == 0:
+			i.leftRow = leftRow
+			i.leftMatched = false
+			i.pos = 0
+		case i.typ == JoinTypeLeft:
+			return i.unmatchedRow(leftRow, true), nil
+		case len(i.group) == 0 && i.rightDone:
+			// No other left row can match
+			i.leftDone = true
+		}
+	}
+}
+
+// seek reads the groups of the right side until one whose key isn't less than the value given, and returns how its
+// key compares to the value, or 1 if there are no groups left.
+func (i *mergeJoinIter) seek(value interface{}) (int, error) {
+	for {
+		if len(i.group) > 0 {
+			cmp, err := i.key.compare(i.groupValue, value)
+			if err != nil || cmp >= 0 {
+				return cmp, err
+			}
+			i.discardGroup()
+		}
+		if i.rightDone {
+			return 1, nil
+		}
+		if err := i.loadGroup(); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// loadGroup reads the next group of right rows with the same key. Right rows with a NULL key match no left row.
+func (i *mergeJoinIter) loadGroup() error {
+	if i.nextRight != nil {
+		i.group = append(i.group, i.nextRight)
+		i.groupMatched = append(i.groupMatched, false)
+		i.groupValue = i.nextRightValue
+		i.nextRight, i.nextRightValue = nil, nil
+	}
+
+	for {
+		row, err := i.right.Next()
+		if err == io.EOF {
+			i.rightDone = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		value, err := i.rightKey.Eval(i.ctx, i.scopedRow(row))
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			if i.typ == JoinTypeRight {
+				i.unmatched = append(i.unmatched, row)
+			}
+			continue
+		}
+
+		if len(i.group) > 0 {
+			cmp, err := i.key.compare(value, i.groupValue)
+			if err != nil {
+				return err
+			}
+			if cmp < 0 {
+				return ErrMergeJoinUnsorted.New(i.key.right)
+			}
+			if cmp > 0 {
+				i.nextRight, i.nextRightValue = row, value
+				return nil
+			}
+		}
+
+		i.group = append(i.group, row)
+		i.groupMatched = append(i.groupMatched, false)
+		i.groupValue = value
+	}
+}
+
+// discardGroup drops the current group of right rows, keeping those that matched no left row to return them in a
+// right join.
+func (i *mergeJoinIter) discardGroup() {
+	if i.typ == JoinTypeRight {
+		for pos, row := range i.group {
+			if !i.groupMatched[pos] {
+				i.unmatched = append(i.unmatched, row)
+			}
+		}
+	}
+	i.group = i.group[:0]
+	i.groupMatched = i.groupMatched[:0]
+	i.groupValue = nil
+}
+
+// joinedRow returns the scope followed by the left and right rows given.
+func (i *mergeJoinIter) joinedRow(left, right sql.Row) sql.Row {
+	row := make(sql.Row, len(i.scope)+i.leftLen+i.rightLen)
+	copy(row, i.scope)
+	copy(row[len(i.scope):], left)
+	copy(row[len(i.scope)+i.leftLen:], right)
+	return row
+}
+
+// scopedRow returns the scope followed by the row given.
+func (i *mergeJoinIter) scopedRow(row sql.Row) sql.Row {
+	result := make(sql.Row, len(i.scope)+len(row))
+	copy(result, i.scope)
+	copy(result[len(i.scope):], row)
+	return result
+}
+
+// unmatchedRow returns the result for a left or right row that matched no row of the other side.
+func (i *mergeJoinIter) unmatchedRow(row sql.Row, isLeft bool) sql.Row {
+	result := make(sql.Row, len(i.scope)+i.leftLen+i.rightLen)
+	copy(result, i.scope)
+	if isLeft {
+		copy(result[len(i.scope):], row)
+	} else {
+		copy(result[len(i.scope)+i.leftLen:], row)
+	}
+	return result
+}
+
+func (i *mergeJoinIter) Close(ctx *sql.Context) error {
+	err := i.left.Close(ctx)
+	if rightErr := i.right.Close(ctx); err == nil {
+		err = rightErr
+	}
+	return err
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestMergeJoin(t *testing.T) {
+	left := memory.NewTable("left", sql.Schema{
+		{Name: "a", Source: "left", Type: sql.Int64, Nullable: true},
+		{Name: "x", Source: "left", Type: sql.Text},
+	})
+	right := memory.NewTable("right", sql.Schema{
+		{Name: "b", Source: "right", Type: sql.Int32, Nullable: true},
+		{Name: "y", Source: "right", Type: sql.Text},
+	})
+	// Both sides are sorted on their keys, with NULLs first and duplicate keys
+	for i := 0; i < 200; i++ {
+		var a interface{} = int64(i / 3)
+		if i < 5 {
+			a = nil
+		}
+		require.NoError(t, left.Insert(sql.NewEmptyContext(), sql.NewRow(a, fmt.Sprintf("l%d", i))))
+	}
+	for i := 0; i < 150; i++ {
+		var b interface{} = int32(i/2 + 20)
+		if i < 4 {
+			b = nil
+		}
+		require.NoError(t, right.Insert(sql.NewEmptyContext(), sql.NewRow(b, fmt.Sprintf("r%d", i))))
+	}
+
+	// An equality between the sides, and a predicate that isn't merged on
+	cond := expression.NewAnd(
+		expression.NewEquals(
+			expression.NewGetFieldWithTable(2, sql.Int32, "right", "b", true),
+			expression.NewGetFieldWithTable(0, sql.Int64, "left", "a", true),
+		),
+		expression.NewLessThan(
+			expression.NewGetFieldWithTable(2, sql.Int32, "right", "b", true),
+			expression.NewLiteral(int32(60), sql.Int32),
+		),
+	)
+	l := NewResolvedTable(left, nil, nil)
+	r := NewResolvedTable(right, nil, nil)
+
+	for typ, join := range map[JoinType]sql.Node{
+		JoinTypeInner: NewInnerJoin(l, r, cond),
+		JoinTypeLeft:  NewLeftJoin(l, r, cond),
+		JoinTypeRight: NewRightJoin(l, r, cond),
+	} {
+		t.Run(typ.String(), func(t *testing.T) {
+			require := require.New(t)
+			expected, err := sql.NodeToRows(sql.NewEmptyContext(), join)
+			require.NoError(err)
+			actual, err := sql.NodeToRows(sql.NewEmptyContext(), NewMergeJoin(l, r, typ, cond, 0))
+			require.NoError(err)
+			require.ElementsMatch(expected, actual)
+		})
+	}
+}
+
+func TestMergeJoinStrings(t *testing.T) {
+	require := require.New(t)
+
+	left := memory.NewTable("left", sql.Schema{{Name: "s", Source: "left", Type: sql.Text}})
+	right := memory.NewTable("right", sql.Schema{{Name: "t", Source: "right", Type: sql.Text}})
+	for _, s := range []string{"a", "B", "b", "résumé", "z"} {
+		require.NoError(left.Insert(sql.NewEmptyContext(), sql.NewRow(s)))
+	}
+	for _, s := range []string{"A", "b", "c", "RESUME"} {
+		require.NoError(right.Insert(sql.NewEmptyContext(), sql.NewRow(s)))
+	}
+
+	cond := expression.NewEquals(
+		expression.NewGetFieldWithTable(0, sql.Text, "left", "s", false),
+		expression.NewGetFieldWithTable(1, sql.Text, "right", "t", false),
+	)
+	rows, err := sql.NodeToRows(sql.NewEmptyContext(), NewMergeJoin(
+		NewResolvedTable(left, nil, nil), NewResolvedTable(right, nil, nil), JoinTypeInner, cond, 0))
+	require.NoError(err)
+	require.Equal([]sql.Row{{"a", "A"}, {"B", "b"}, {"b", "b"}, {"résumé", "RESUME"}}, rows)
+}
+
+func TestMergeJoinUnsorted(t *testing.T) {
+	require := require.New(t)
+
+	left := memory.NewTable("left", sql.Schema{{Name: "a", Source: "left", Type: sql.Int64}})
+	right := memory.NewTable("right", sql.Schema{{Name: "b", Source: "right", Type: sql.Int64}})
+	for _, v := range []int64{1, 3, 2} {
+		require.NoError(left.Insert(sql.NewEmptyContext(), sql.NewRow(v)))
+		require.NoError(right.Insert(sql.NewEmptyContext(), sql.NewRow(v)))
+	}
+
+	cond := expression.NewEquals(
+		expression.NewGetFieldWithTable(0, sql.Int64, "left", "a", false),
+		expression.NewGetFieldWithTable(1, sql.Int64, "right", "b", false),
+	)
+	_, err := sql.NodeToRows(sql.NewEmptyContext(), NewMergeJoin(
+		NewResolvedTable(left, nil, nil), NewResolvedTable(right, nil, nil), JoinTypeInner, cond, 0))
+	require.True(ErrMergeJoinUnsorted.Is(err))
+}
@@ -99,26 +99,29 @@ func (p *QueryProcess) DebugString() string {
 }
 
 // shouldSetFoundRows returns whether the query process should set the FOUND_ROWS query variable. It should do this for
-// any select except a Limit with a SQL_CALC_FOUND_ROWS modifier, which is handled in the Limit node itself.
+// any select except a Limit or TopN with a SQL_CALC_FOUND_ROWS modifier, which is handled in the node itself.
 func (p *QueryProcess) shouldSetFoundRows() bool {
-	var limit *Limit
+	calcFoundRows := false
 	Inspect(p.Child, func(n sql.Node) bool {
 		switch n := n.(type) {
 		case *StartTransaction:
 			return true
+		case *Project:
+			// A TopN may be below the projection of its rows
+			_, ok := n.Child.(*TopN)
+			return ok
 		case *Limit:
-			limit = n
+			calcFoundRows = n.CalcFoundRows
+			return false
+		case *TopN:
+			calcFoundRows = n.CalcFoundRows
 			return false
 		default:
 			return false
 		}
 	})
 
-	if limit == nil {
-		return true
-	}
-
-	return !limit.CalcFoundRows
+	return !calcFoundRows
 }
 
 // ProcessIndexableTable is a wrapper for sql.Tables inside a query process
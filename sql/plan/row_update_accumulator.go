@@ -131,7 +131,7 @@ func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
 	// TODO: If CLIENT_FOUND_ROWS is set, the affected-rows value is 1 (not 0) if an existing row is set to its current values.
 	oldRow := row[:len(row)/2]
 	newRow := row[len(row)/2:]
-	if equals, err := oldRow.Equals(newRow, o.schema); err == nil {
+	if equals, err := oldRow.Identical(newRow, o.schema); err == nil {
 		if !equals {
 			o.rowsAffected += 2
 		}
@@ -156,7 +156,7 @@ func (u *updateRowHandler) handleRowUpdate(row sql.Row) error {
 	u.rowsMatched++
 	oldRow := row[:len(row)/2]
 	newRow := row[len(row)/2:]
-	if equals, err := oldRow.Equals(newRow, u.schema); err == nil {
+	if equals, err := oldRow.Identical(newRow, u.schema); err == nil {
 		if !equals {
 			u.rowsAffected++
 		}
@@ -0,0 +1,196 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SemiJoin returns the rows of its left child for which at least one row of its right child matches the join
+// condition, once each. It only returns the columns of the left child. The analyzer builds semi joins from IN and
+// EXISTS subqueries.
+type SemiJoin struct {
+	joinStruct
+}
+
+var _ JoinNode = (*SemiJoin)(nil)
+var _ sql.Expressioner = (*SemiJoin)(nil)
+
+// NewSemiJoin creates a new semi join node from two nodes.
+func NewSemiJoin(left, right sql.Node, cond sql.Expression) *SemiJoin {
+	return &SemiJoin{
+		joinStruct{
+			BinaryNode: BinaryNode{
+				left:  left,
+				right: right,
+			},
+			Cond: cond,
+		},
+	}
+}
+
+func (j *SemiJoin) JoinType() JoinType {
+	return JoinTypeSemi
+}
+
+// Schema implements the Node interface.
+func (j *SemiJoin) Schema() sql.Schema {
+	return j.left.Schema()
+}
+
+// Resolved implements the Resolvable interface.
+func (j *SemiJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+// RowIter implements the Node interface.
+func (j *SemiJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return joinRowIter(ctx, JoinTypeSemi, j.left, j.right, j.Cond, row, j.ScopeLen, j.JoinMode)
+}
+
+// WithChildren implements the Node interface.
+func (j *SemiJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+
+	nj := *j
+	nj.BinaryNode = BinaryNode{children[0], children[1]}
+	return &nj, nil
+}
+
+// WithExpressions implements the Expressioner interface.
+func (j *SemiJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+
+	nj := *j
+	nj.Cond = exprs[0]
+	return &nj, nil
+}
+
+func (j *SemiJoin) WithScopeLen(i int) JoinNode {
+	nj := *j
+	nj.ScopeLen = i
+	return &nj
+}
+
+func (j SemiJoin) WithMultipassMode() JoinNode {
+	j.JoinMode = multipassMode
+	return &j
+}
+
+func (j *SemiJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("SemiJoin%s", j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *SemiJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("SemiJoin%s", sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+// AntiJoin returns the rows of its left child for which no row of its right child matches the join condition. It
+// only returns the columns of the left child. The analyzer builds anti joins from NOT IN and NOT EXISTS subqueries.
+type AntiJoin struct {
+	joinStruct
+}
+
+var _ JoinNode = (*AntiJoin)(nil)
+var _ sql.Expressioner = (*AntiJoin)(nil)
+
+// NewAntiJoin creates a new anti join node from two nodes.
+func NewAntiJoin(left, right sql.Node, cond sql.Expression) *AntiJoin {
+	return &AntiJoin{
+		joinStruct{
+			BinaryNode: BinaryNode{
+				left:  left,
+				right: right,
+			},
+			Cond: cond,
+		},
+	}
+}
+
+func (j *AntiJoin) JoinType() JoinType {
+	return JoinTypeAnti
+}
+
+// Schema implements the Node interface.
+func (j *AntiJoin) Schema() sql.Schema {
+	return j.left.Schema()
+}
+
+// Resolved implements the Resolvable interface.
+func (j *AntiJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+// RowIter implements the Node interface.
+func (j *AntiJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return joinRowIter(ctx, JoinTypeAnti, j.left, j.right, j.Cond, row, j.ScopeLen, j.JoinMode)
+}
+
+// WithChildren implements the Node interface.
+func (j *AntiJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+
+	nj := *j
+	nj.BinaryNode = BinaryNode{children[0], children[1]}
+	return &nj, nil
+}
+
+// WithExpressions implements the Expressioner interface.
+func (j *AntiJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+
+	nj := *j
+	nj.Cond = exprs[0]
+	return &nj, nil
+}
+
+func (j *AntiJoin) WithScopeLen(i int) JoinNode {
+	nj := *j
+	nj.ScopeLen = i
+	return &nj
+}
+
+func (j AntiJoin) WithMultipassMode() JoinNode {
+	j.JoinMode = multipassMode
+	return &j
+}
+
+func (j *AntiJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AntiJoin%s", j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *AntiJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AntiJoin%s", sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
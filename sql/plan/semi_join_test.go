@@ -0,0 +1,121 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestSemiAndAntiJoins(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		testSemiAndAntiJoins(t, sql.NewEmptyContext())
+	})
+
+	t.Run("in memory", func(t *testing.T) {
+		ctx := sql.NewEmptyContext()
+		require.NoError(t, ctx.SetSessionVariable(ctx, inMemoryJoinSessionVar, true))
+		testSemiAndAntiJoins(t, ctx)
+	})
+
+	t.Run("multipass", func(t *testing.T) {
+		ctx := sql.NewContext(context.TODO(), sql.WithMemoryManager(
+			sql.NewMemoryManager(mockReporter{2, 1}),
+		))
+		testSemiAndAntiJoins(t, ctx)
+	})
+}
+
+func testSemiAndAntiJoins(t *testing.T, ctx *sql.Context) {
+	t.Helper()
+
+	ltable := memory.NewTable("left", lSchema)
+	rtable := memory.NewTable("right", rSchema)
+	insertData(t, ltable)
+	insertData(t, rtable)
+	require.NoError(t, rtable.Insert(ctx, sql.NewRow("col1_3", "col2_3", int32(3), int64(6))))
+
+	first := sql.Row{"col1_1", "col2_1", int32(1), int64(2)}
+	second := sql.Row{"col1_2", "col2_2", int32(3), int64(4)}
+
+	// The first left row matches two right rows, and the second one none
+	plusTwo := expression.NewEquals(
+		expression.NewPlus(
+			expression.NewGetField(2, sql.Int32, "lcol3", false),
+			expression.NewLiteral(int32(2), sql.Int32),
+		),
+		expression.NewGetField(6, sql.Int32, "rcol3", false),
+	)
+	// Both left rows match several right rows
+	lessOrEqual := expression.NewLessThanOrEqual(
+		expression.NewGetField(2, sql.Int32, "lcol3", false),
+		expression.NewGetField(6, sql.Int32, "rcol3", false),
+	)
+
+	testCases := []struct {
+		name     string
+		join     sql.Node
+		expected []sql.Row
+	}{
+		{
+			"semi join with some matches",
+			NewSemiJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), plusTwo),
+			[]sql.Row{first},
+		},
+		{
+			"anti join with some matches",
+			NewAntiJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), plusTwo),
+			[]sql.Row{second},
+		},
+		{
+			"semi join with many matches",
+			NewSemiJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), lessOrEqual),
+			[]sql.Row{first, second},
+		},
+		{
+			"anti join with many matches",
+			NewAntiJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), lessOrEqual),
+			nil,
+		},
+		{
+			"indexed semi join",
+			NewIndexedJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), JoinTypeSemi, plusTwo, 0),
+			[]sql.Row{first},
+		},
+		{
+			"indexed anti join",
+			NewIndexedJoin(NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), JoinTypeAnti, plusTwo, 0),
+			[]sql.Row{second},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, lSchema, tt.join.Schema())
+
+			iter, err := tt.join.RowIter(ctx, nil)
+			require.NoError(t, err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rows)
+		})
+	}
+}
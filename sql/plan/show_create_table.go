@@ -168,6 +168,14 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 	for i, col := range schema {
 		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(col.Type.String()))
 
+		if col.Generated != nil {
+			storage := "STORED"
+			if col.Virtual {
+				storage = "VIRTUAL"
+			}
+			stmt = fmt.Sprintf("%s GENERATED ALWAYS AS %s %s", stmt, parenthesized(col.Generated.Expression.String()), storage)
+		}
+
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
 		}
@@ -216,6 +224,8 @@ func (i *showCreateTablesIter) produceCreateTableStatement(table sql.Table) (str
 		unique := ""
 		if index.IsUnique() {
 			unique = "UNIQUE "
+		} else if _, ok := index.(sql.FullTextIndex); ok {
+			unique = "FULLTEXT "
 		}
 
 		key := fmt.Sprintf("  %sKEY `%s` (%s)", unique, index.ID(), strings.Join(indexCols, ","))
@@ -331,3 +341,35 @@ func produceCreateViewStatement(view *SubqueryAlias) string {
 func (i *showCreateTablesIter) Close(*sql.Context) error {
 	return nil
 }
+
+// parenthesized returns the expression string given enclosed in a single pair of parentheses, unless it is already
+// enclosed in one, as the strings of arithmetic expressions are.
+func parenthesized(expr string) string {
+	if strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")") {
+		depth := 0
+		var quote rune
+		for i, r := range expr {
+			switch {
+			case quote != 0:
+				if r == quote {
+					quote = 0
+				}
+				continue
+			case r == '\'' || r == '"' || r == '`':
+				quote = r
+				continue
+			case r == '(':
+				depth++
+			case r == ')':
+				depth--
+			}
+			if depth == 0 {
+				if i == len(expr)-1 {
+					return expr
+				}
+				break
+			}
+		}
+	}
+	return "(" + expr + ")"
+}
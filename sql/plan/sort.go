@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"container/heap"
 	"fmt"
 	"io"
 	"sort"
@@ -120,12 +121,23 @@ func (s *Sort) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	return NewSort(fields, s.Child), nil
 }
 
+// sortMaxRuns is the number of sorted runs a sort spills to disk before merging them into one. Every run is a file
+// kept open until the sort is done, so this bounds the number of files a sort has open.
+const sortMaxRuns = 16
+
 type sortIter struct {
 	ctx        *sql.Context
 	s          *Sort
 	childIter  sql.RowIter
 	sortedRows []sql.Row
 	idx        int
+	// cache holds the rows read from the child that haven't been spilled to disk, and dispose releases it.
+	cache   sql.RowsCache
+	dispose sql.DisposeFunc
+	// runs are the sorted runs spilled to disk when the rows to sort don't fit in memory. When there are any, rows
+	// are returned by merging them instead of from sortedRows.
+	runs   []*spillFile
+	merger *sortRunMerger
 }
 
 func newSortIter(ctx *sql.Context, s *Sort, child sql.RowIter) *sortIter {
@@ -146,6 +158,10 @@ func (i *sortIter) Next() (sql.Row, error) {
 		i.idx = 0
 	}
 
+	if i.merger != nil {
+		return i.merger.Next()
+	}
+
 	if i.idx >= len(i.sortedRows) {
 		return nil, io.EOF
 	}
@@ -156,13 +172,43 @@ func (i *sortIter) Next() (sql.Row, error) {
 
 func (i *sortIter) Close(ctx *sql.Context) error {
 	i.sortedRows = nil
-	return i.childIter.Close(ctx)
+	i.merger = nil
+	if i.dispose != nil {
+		i.dispose()
+		i.dispose = nil
+	}
+	var runErr error
+	for _, run := range i.runs {
+		if err := run.Remove(); err != nil && runErr == nil {
+			runErr = err
+		}
+	}
+	i.runs = nil
+
+	if err := i.childIter.Close(ctx); err != nil {
+		return err
+	}
+	return runErr
+}
+
+func (i *sortIter) newSorter(rows []sql.Row) *expression.Sorter {
+	return &expression.Sorter{
+		SortFields: i.s.SortFields,
+		Rows:       rows,
+		LastError:  nil,
+		Ctx:        i.ctx,
+	}
 }
 
+// computeSortedRows reads all the rows of the child and sorts them. Rows are kept in a rows cache of the memory
+// manager until they exceed the sort_buffer_size session variable, or until there is no memory left, at which point
+// the cached rows are sorted and written to disk as a run. If any runs were written, the rows are returned by merging
+// them.
 func (i *sortIter) computeSortedRows() error {
-	cache, dispose := i.ctx.Memory.NewRowsCache()
-	defer dispose()
+	maxSize := uintSessionVariable(i.ctx, "sort_buffer_size")
+	i.cache, i.dispose = i.ctx.Memory.NewRowsCache()
 
+	var size uint64
 	for {
 		row, err := i.childIter.Next()
 
@@ -173,22 +219,219 @@ func (i *sortIter) computeSortedRows() error {
 			return err
 		}
 
-		if err := cache.Add(row); err != nil {
+		err = i.cache.Add(row)
+		if sql.ErrNoMemoryAvailable.Is(err) && len(i.cache.Get()) > 0 {
+			if err := i.spillCache(); err != nil {
+				return err
+			}
+			size = 0
+			err = i.cache.Add(row)
+		}
+		if err != nil {
 			return err
 		}
+
+		size += sql.EstimateRowSize(row)
+		if maxSize > 0 && size >= maxSize {
+			if err := i.spillCache(); err != nil {
+				return err
+			}
+			size = 0
+		}
 	}
 
-	rows := cache.Get()
-	sorter := &expression.Sorter{
-		SortFields: i.s.SortFields,
-		Rows:       rows,
-		LastError:  nil,
-		Ctx:        i.ctx,
+	rows := i.cache.Get()
+	if len(i.runs) == 0 {
+		sorter := i.newSorter(rows)
+		sort.Stable(sorter)
+		if sorter.LastError != nil {
+			return sorter.LastError
+		}
+		i.sortedRows = rows
+		return nil
 	}
+
+	if len(rows) > 0 {
+		if err := i.spillCache(); err != nil {
+			return err
+		}
+	}
+
+	merger, err := newSortRunMerger(i.newSorter(nil), i.runs)
+	if err != nil {
+		return err
+	}
+	i.merger = merger
+	return nil
+}
+
+// spillCache spills the rows of the cache to disk and replaces the cache with an empty one.
+func (i *sortIter) spillCache() error {
+	if err := i.spill(i.cache.Get()); err != nil {
+		return err
+	}
+	i.dispose()
+	i.cache, i.dispose = i.ctx.Memory.NewRowsCache()
+	return nil
+}
+
+// spill sorts the rows given and writes them to a new run on disk.
+func (i *sortIter) spill(rows []sql.Row) error {
+	sorter := i.newSorter(rows)
 	sort.Stable(sorter)
 	if sorter.LastError != nil {
 		return sorter.LastError
 	}
-	i.sortedRows = rows
+
+	run, err := newSpillFile(i.ctx, i.s.Child.Schema())
+	if err != nil {
+		return err
+	}
+	i.runs = append(i.runs, run)
+	if err := run.Write(rows...); err != nil {
+		return err
+	}
+
+	if len(i.runs) >= sortMaxRuns {
+		return i.mergeRuns()
+	}
 	return nil
 }
+
+// mergeRuns merges all the runs into a single one, in a pass of its own, so that no more than sortMaxRuns files are
+// open at once. As the merged run holds the rows of the first runs, the runs spilled after it keep the sort stable.
+func (i *sortIter) mergeRuns() error {
+	merged, err := newSpillFile(i.ctx, i.s.Child.Schema())
+	if err != nil {
+		return err
+	}
+
+	err = func() error {
+		merger, err := newSortRunMerger(i.newSorter(nil), i.runs)
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := merger.Next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := merged.Write(row); err != nil {
+				return err
+			}
+		}
+	}()
+	if err != nil {
+		_ = merged.Remove()
+		return err
+	}
+
+	runs := i.runs
+	i.runs = []*spillFile{merged}
+	for _, run := range runs {
+		if rerr := run.Remove(); rerr != nil && err == nil {
+			err = rerr
+		}
+	}
+	return err
+}
+
+// sortRunMerger returns the rows of a number of sorted runs in order, by keeping the next row of every run in a
+// heap. Rows that sort equally are returned in the order of their runs, which keeps the sort stable.
+type sortRunMerger struct {
+	sorter  *expression.Sorter
+	readers []*spillFileReader
+	heads   sortRunHeap
+}
+
+type sortRunHead struct {
+	row sql.Row
+	run int
+}
+
+func newSortRunMerger(sorter *expression.Sorter, runs []*spillFile) (*sortRunMerger, error) {
+	m := &sortRunMerger{sorter: sorter}
+	m.heads.sorter = sorter
+	for idx, run := range runs {
+		reader, err := run.Reader()
+		if err != nil {
+			return nil, err
+		}
+		m.readers = append(m.readers, reader)
+
+		row, err := reader.Next()
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		m.heads.heads = append(m.heads.heads, sortRunHead{row: row, run: idx})
+	}
+
+	heap.Init(&m.heads)
+	if sorter.LastError != nil {
+		return nil, sorter.LastError
+	}
+	return m, nil
+}
+
+func (m *sortRunMerger) Next() (sql.Row, error) {
+	if len(m.heads.heads) == 0 {
+		return nil, io.EOF
+	}
+
+	head := &m.heads.heads[0]
+	row := head.row
+	next, err := m.readers[head.run].Next()
+	if err == io.EOF {
+		heap.Pop(&m.heads)
+	} else if err != nil {
+		return nil, err
+	} else {
+		head.row = next
+		heap.Fix(&m.heads, 0)
+	}
+
+	if m.sorter.LastError != nil {
+		return nil, m.sorter.LastError
+	}
+	return row, nil
+}
+
+type sortRunHeap struct {
+	sorter *expression.Sorter
+	heads  []sortRunHead
+}
+
+func (h *sortRunHeap) Len() int {
+	return len(h.heads)
+}
+
+func (h *sortRunHeap) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	if h.sorter.LessRows(a.row, b.row) {
+		return true
+	}
+	if h.sorter.LessRows(b.row, a.row) {
+		return false
+	}
+	return a.run < b.run
+}
+
+func (h *sortRunHeap) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *sortRunHeap) Push(x interface{}) {
+	h.heads = append(h.heads, x.(sortRunHead))
+}
+
+func (h *sortRunHeap) Pop() interface{} {
+	last := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return last
+}
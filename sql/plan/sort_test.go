@@ -16,6 +16,8 @@ package plan
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"testing"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -294,3 +296,127 @@ func TestSortDescending(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, actual)
 }
+
+func TestSortSpill(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	const numRows = 5000
+	s := newSpillSort(t, ctx, numRows)
+
+	// With a sort buffer that holds all the rows they are sorted in memory
+	require.NoError(ctx.SetSessionVariable(ctx, "sort_buffer_size", uint64(16777216)))
+	childIter, err := s.Child.RowIter(ctx, nil)
+	require.NoError(err)
+	iter := newSortIter(ctx, s, childIter)
+	_, err = iter.Next()
+	require.NoError(err)
+	require.Empty(iter.runs)
+	require.Len(iter.sortedRows, numRows)
+	require.NoError(iter.Close(ctx))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "sort_buffer_size", uint64(32768)))
+	actual, runs := sortSpilled(t, ctx, s)
+	require.True(runs > 1, "expected the sort to spill runs to disk")
+	requireSortedStable(t, actual, numRows)
+}
+
+func TestSortSpillMergePasses(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	require.NoError(ctx.SetSessionVariable(ctx, "sort_buffer_size", uint64(32768)))
+
+	const numRows = 40000
+	s := newSpillSort(t, ctx, numRows)
+
+	// The rows fill many more runs than a sort keeps, so they are merged in several passes
+	var size uint64
+	rows, err := sql.NodeToRows(ctx, s.Child)
+	require.NoError(err)
+	for _, row := range rows {
+		size += sql.EstimateRowSize(row)
+	}
+	require.True(size/32768 > 2*sortMaxRuns)
+
+	actual, runs := sortSpilled(t, ctx, s)
+	require.True(runs > 1, "expected the sort to spill runs to disk")
+	require.True(runs <= sortMaxRuns, "expected at most %d runs, got %d", sortMaxRuns, runs)
+	requireSortedStable(t, actual, numRows)
+}
+
+// newSpillSort returns a sort of a table with the number of rows given by a key with many duplicates and NULLs, and
+// the order the rows were inserted in.
+func newSpillSort(t *testing.T, ctx *sql.Context, numRows int) *Sort {
+	schema := sql.Schema{
+		{Name: "k", Type: sql.Int64, Nullable: true},
+		{Name: "seq", Type: sql.Int64},
+		{Name: "s", Type: sql.LongText},
+	}
+	child := memory.NewTable("test", schema)
+
+	for i := 0; i < numRows; i++ {
+		var k interface{} = int64((i * 7919) % 101)
+		if i%97 == 0 {
+			k = nil
+		}
+		require.NoError(t, child.Insert(ctx, sql.NewRow(k, int64(i), fmt.Sprintf("row %d", i))))
+	}
+
+	sf := []sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "k", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+	}
+	return NewSort(sf, NewResolvedTable(child, nil, nil))
+}
+
+// sortSpilled returns the rows of the sort given and the number of runs it had on disk at the end, and checks that
+// the files of the runs are removed when the sort is closed.
+func sortSpilled(t *testing.T, ctx *sql.Context, s *Sort) ([]sql.Row, int) {
+	require := require.New(t)
+	childIter, err := s.Child.RowIter(ctx, nil)
+	require.NoError(err)
+	iter := newSortIter(ctx, s, childIter)
+
+	var actual []sql.Row
+	for {
+		row, err := iter.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		actual = append(actual, row)
+	}
+
+	var files []string
+	for _, run := range iter.runs {
+		files = append(files, run.file.Name())
+	}
+	require.NoError(iter.Close(ctx))
+	for _, file := range files {
+		_, err := os.Stat(file)
+		require.True(os.IsNotExist(err))
+	}
+	return actual, len(files)
+}
+
+// requireSortedStable checks that the rows given of a sort made by newSpillSort are all of them, sorted by their keys
+// and in the order they were inserted for the same key.
+func requireSortedStable(t *testing.T, actual []sql.Row, numRows int) {
+	require := require.New(t)
+	require.Len(actual, numRows)
+	for i := 1; i < len(actual); i++ {
+		prev, cur := actual[i-1], actual[i]
+		if prev[0] == nil {
+			if cur[0] == nil {
+				require.Less(prev[1].(int64), cur[1].(int64))
+			}
+			continue
+		}
+		require.NotNil(cur[0])
+		require.LessOrEqual(prev[0].(int64), cur[0].(int64))
+		if prev[0] == cur[0] {
+			// The sort is stable, so rows with the same key keep the order they were inserted in
+			require.Less(prev[1].(int64), cur[1].(int64))
+		}
+		require.Equal(fmt.Sprintf("row %d", cur[1]), cur[2])
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// spillPartitionCount is the number of partitions that operators spilling hash tables to disk split their rows into.
+const spillPartitionCount = 16
+
+// spillFile is a temporary file in the tmpdir of the session that rows that don't fit in memory are written to. Rows
+// are written as their length followed by their encoding.
+type spillFile struct {
+	sch  sql.Schema
+	file *os.File
+	w    *bufio.Writer
+}
+
+func newSpillFile(ctx *sql.Context, sch sql.Schema) (*spillFile, error) {
+	var dir string
+	if tmpdir, err := ctx.GetSessionVariable(ctx, "tmpdir"); err == nil {
+		dir, _ = tmpdir.(string)
+	}
+	if dir == "" {
+		dir = os.TempDir()
+	}
+
+	file, err := ioutil.TempFile(dir, "spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &spillFile{sch: sch, file: file, w: bufio.NewWriter(file)}, nil
+}
+
+// Write appends the rows given to the file.
+func (f *spillFile) Write(rows ...sql.Row) error {
+	var lenBuf [binary.MaxVarintLen64]byte
+	var buf []byte
+	for _, row := range rows {
+		var err error
+		if buf, err = sql.EncodeRow(buf[:0], f.sch, row); err != nil {
+			return err
+		}
+		if _, err := f.w.Write(lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(buf)))]); err != nil {
+			return err
+		}
+		if _, err := f.w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reader returns a reader of the rows of the file, from the first one. No more rows may be written after it's called.
+func (f *spillFile) Reader() (*spillFileReader, error) {
+	if err := f.w.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return &spillFileReader{sch: f.sch, r: bufio.NewReader(f.file)}, nil
+}
+
+// Remove closes and deletes the file.
+func (f *spillFile) Remove() error {
+	closeErr := f.file.Close()
+	if err := os.Remove(f.file.Name()); err != nil {
+		return err
+	}
+	return closeErr
+}
+
+type spillFileReader struct {
+	sch sql.Schema
+	r   *bufio.Reader
+	buf []byte
+}
+
+func (r *spillFileReader) Next() (sql.Row, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(cap(r.buf)) < n {
+		r.buf = make([]byte, n)
+	}
+	r.buf = r.buf[:n]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return sql.DecodeRow(r.buf, r.sch)
+}
+
+// spillPartitions are spill files that rows are written to by a hash of their keys, so that all the rows with the
+// same key are in the same partition.
+type spillPartitions []*spillFile
+
+func newSpillPartitions(ctx *sql.Context, sch sql.Schema) (spillPartitions, error) {
+	partitions := make(spillPartitions, 0, spillPartitionCount)
+	for j := 0; j < spillPartitionCount; j++ {
+		f, err := newSpillFile(ctx, sch)
+		if err != nil {
+			_ = partitions.Remove()
+			return nil, err
+		}
+		partitions = append(partitions, f)
+	}
+	return partitions, nil
+}
+
+// Write appends the row given to the partition of the hash given.
+func (p spillPartitions) Write(hash uint64, row sql.Row) error {
+	return p[hash%uint64(len(p))].Write(row)
+}
+
+// Remove deletes the files of all the partitions.
+func (p spillPartitions) Remove() error {
+	var removeErr error
+	for _, f := range p {
+		if err := f.Remove(); err != nil && removeErr == nil {
+			removeErr = err
+		}
+	}
+	return removeErr
+}
+
+// uintSessionVariable returns the value of the unsigned integer session variable given, or 0 if it can't be read.
+func uintSessionVariable(ctx *sql.Context, name string) uint64 {
+	val, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return 0
+	}
+	n, err := sql.Uint64.Convert(val)
+	if err != nil {
+		return 0
+	}
+	return n.(uint64)
+}
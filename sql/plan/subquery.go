@@ -205,7 +205,7 @@ func (s *Subquery) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 func prependRowInPlan(row sql.Row) func(n sql.Node) (sql.Node, error) {
 	return func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
-		case *Project, *GroupBy, *Having, *SubqueryAlias, *Window, sql.Table, *ValueDerivedTable:
+		case *Project, *GroupBy, *Having, *SubqueryAlias, *Window, sql.Table, *ValueDerivedTable, *ResolvedTableFunction:
 			return &prependNode{
 				UnaryNode: UnaryNode{Child: n},
 				row:       row,
@@ -241,6 +241,40 @@ func (s *Subquery) EvalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 	return result, nil
 }
 
+// HasResultRow returns whether the subquery returns any row. When its results can be cached, they are all read and
+// cached. Otherwise, it stops at the first row.
+func (s *Subquery) HasResultRow(ctx *sql.Context, row sql.Row) (bool, error) {
+	if s.canCacheResults {
+		rows, err := s.EvalMultiple(ctx, row)
+		if err != nil {
+			return false, err
+		}
+		return len(rows) > 0, nil
+	}
+
+	q, err := TransformUp(s.Query, prependRowInPlan(row))
+	if err != nil {
+		return false, err
+	}
+
+	iter, err := q.RowIter(ctx, row)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = iter.Next()
+	if err != nil && err != io.EOF {
+		_ = iter.Close(ctx)
+		return false, err
+	}
+	hasRow := err == nil
+
+	if err := iter.Close(ctx); err != nil {
+		return false, err
+	}
+	return hasRow, nil
+}
+
 func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, error) {
 	// Any source of rows, as well as any node that alters the schema of its children, needs to be wrapped so that its
 	// result rows are prepended with the scope row.
@@ -278,7 +312,7 @@ func (s *Subquery) evalMultiple(ctx *sql.Context, row sql.Row) ([]interface{}, e
 }
 
 // HashMultiple returns all rows returned by a subquery, backed by a sql.KeyValueCache. Keys are constructed using the
-// 64-bit hash of the values stored.
+// 64-bit hash of the values stored, with strings hashed by their key in the collation of the subquery's type.
 func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCache, error) {
 	s.cacheMu.Lock()
 	cached := s.resultsCached && s.hashCache != nil
@@ -297,7 +331,7 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 		defer s.cacheMu.Unlock()
 		if !s.resultsCached || s.hashCache == nil {
 			hashCache, disposeFn := ctx.Memory.NewHistoryCache()
-			err = putAllRows(hashCache, result)
+			err = putAllRows(hashCache, s.Type(), result)
 			if err != nil {
 				return nil, err
 			}
@@ -307,12 +341,12 @@ func (s *Subquery) HashMultiple(ctx *sql.Context, row sql.Row) (sql.KeyValueCach
 	}
 
 	cache := sql.NewMapCache()
-	return cache, putAllRows(cache, result)
+	return cache, putAllRows(cache, s.Type(), result)
 }
 
-func putAllRows(cache sql.KeyValueCache, vals []interface{}) error {
+func putAllRows(cache sql.KeyValueCache, typ sql.Type, vals []interface{}) error {
 	for _, val := range vals {
-		rowKey, err := sql.HashOf(sql.NewRow(val))
+		rowKey, err := sql.HashOf(sql.NewRow(sql.CollationKey(typ, val)))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,222 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// UnresolvedTableFunction is a call to a table function in the FROM clause of a query, whose function has not been
+// looked up yet.
+type UnresolvedTableFunction struct {
+	name      string
+	Arguments []sql.Expression
+}
+
+var _ sql.Node = (*UnresolvedTableFunction)(nil)
+var _ sql.Expressioner = (*UnresolvedTableFunction)(nil)
+
+// NewUnresolvedTableFunction creates a new UnresolvedTableFunction.
+func NewUnresolvedTableFunction(name string, args []sql.Expression) *UnresolvedTableFunction {
+	return &UnresolvedTableFunction{name: name, Arguments: args}
+}
+
+// Name implements the Nameable interface.
+func (t *UnresolvedTableFunction) Name() string {
+	return t.name
+}
+
+// Resolved implements the Resolvable interface.
+func (*UnresolvedTableFunction) Resolved() bool {
+	return false
+}
+
+// Children implements the Node interface.
+func (*UnresolvedTableFunction) Children() []sql.Node { return nil }
+
+// Schema implements the Node interface.
+func (*UnresolvedTableFunction) Schema() sql.Schema { return nil }
+
+// RowIter implements the Node interface.
+func (*UnresolvedTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, ErrUnresolvedTable.New()
+}
+
+// WithChildren implements the Node interface.
+func (t *UnresolvedTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+
+	return t, nil
+}
+
+// Expressions implements the Expressioner interface.
+func (t *UnresolvedTableFunction) Expressions() []sql.Expression {
+	return t.Arguments
+}
+
+// WithExpressions implements the Expressioner interface.
+func (t *UnresolvedTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(t.Arguments) {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(exprs), len(t.Arguments))
+	}
+
+	return NewUnresolvedTableFunction(t.name, exprs), nil
+}
+
+func (t *UnresolvedTableFunction) String() string {
+	return fmt.Sprintf("UnresolvedTableFunction(%s)", tableFunctionCallString(t.name, t.Arguments))
+}
+
+// ResolvedTableFunction is a call to a table function in the FROM clause of a query. It returns the rows of the
+// function for the values of its arguments.
+type ResolvedTableFunction struct {
+	// function is held by pointer, as the analyzer compares nodes with reflect.DeepEqual, which never finds Go
+	// functions equal, and table functions may be made of them.
+	function  *tableFunction
+	name      string
+	Arguments []sql.Expression
+}
+
+type tableFunction struct {
+	sql.TableFunction
+}
+
+var _ sql.Node = (*ResolvedTableFunction)(nil)
+var _ sql.Expressioner = (*ResolvedTableFunction)(nil)
+
+// NewResolvedTableFunction creates a new ResolvedTableFunction for the call of the function given, under the name it
+// was called by, with the arguments given.
+func NewResolvedTableFunction(fn sql.TableFunction, name string, args []sql.Expression) (*ResolvedTableFunction, error) {
+	if len(args) != len(fn.ArgumentTypes()) {
+		return nil, sql.ErrInvalidArgumentNumber.New(name, len(fn.ArgumentTypes()), len(args))
+	}
+
+	return &ResolvedTableFunction{function: &tableFunction{fn}, name: name, Arguments: args}, nil
+}
+
+// Function returns the table function called.
+func (t *ResolvedTableFunction) Function() sql.TableFunction {
+	return t.function.TableFunction
+}
+
+// Name implements the Nameable interface.
+func (t *ResolvedTableFunction) Name() string {
+	return t.name
+}
+
+// Resolved implements the Resolvable interface.
+func (t *ResolvedTableFunction) Resolved() bool {
+	return expression.ExpressionsResolved(t.Arguments...)
+}
+
+// Children implements the Node interface.
+func (*ResolvedTableFunction) Children() []sql.Node { return nil }
+
+// Schema implements the Node interface. The columns of the function's schema have the name of the function as their
+// source.
+func (t *ResolvedTableFunction) Schema() sql.Schema {
+	fnSchema := t.function.Schema()
+	schema := make(sql.Schema, len(fnSchema))
+	for i, col := range fnSchema {
+		c := *col
+		c.Source = t.name
+		schema[i] = &c
+	}
+	return schema
+}
+
+// RowIter implements the Node interface.
+func (t *ResolvedTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.ResolvedTableFunction", opentracing.Tag{Key: "function", Value: t.name})
+
+	argTypes := t.function.ArgumentTypes()
+	args := make([]interface{}, len(t.Arguments))
+	for i, arg := range t.Arguments {
+		val, err := arg.Eval(ctx, row)
+		if err != nil {
+			span.Finish()
+			return nil, err
+		}
+		if val != nil {
+			val, err = argTypes[i].Convert(val)
+			if err != nil {
+				span.Finish()
+				return nil, err
+			}
+		}
+		args[i] = val
+	}
+
+	iter, err := t.function.RowIter(ctx, args)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// WithChildren implements the Node interface.
+func (t *ResolvedTableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+
+	return t, nil
+}
+
+// Expressions implements the Expressioner interface.
+func (t *ResolvedTableFunction) Expressions() []sql.Expression {
+	return t.Arguments
+}
+
+// WithExpressions implements the Expressioner interface.
+func (t *ResolvedTableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(t.Arguments) {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(exprs), len(t.Arguments))
+	}
+
+	nt := *t
+	nt.Arguments = exprs
+	return &nt, nil
+}
+
+func (t *ResolvedTableFunction) String() string {
+	return fmt.Sprintf("TableFunction(%s)", tableFunctionCallString(t.name, t.Arguments))
+}
+
+func (t *ResolvedTableFunction) DebugString() string {
+	args := make([]string, len(t.Arguments))
+	for i, arg := range t.Arguments {
+		args[i] = sql.DebugString(arg)
+	}
+	return fmt.Sprintf("TableFunction(%s(%s))", t.name, strings.Join(args, ", "))
+}
+
+func tableFunctionCallString(name string, args []sql.Expression) string {
+	argStrs := make([]string, len(args))
+	for i, arg := range args {
+		argStrs[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(argStrs, ", "))
+}
@@ -0,0 +1,293 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// TopN is a Limit, with an optional Offset, over a Sort. Instead of sorting all the rows of its child, it only keeps
+// the first Limit + Offset of them in a bounded heap as it reads them.
+type TopN struct {
+	UnaryNode
+	Limit sql.Expression
+	// Offset is the number of leading rows to skip, or nil if there is none.
+	Offset        sql.Expression
+	SortFields    []sql.SortField
+	CalcFoundRows bool
+}
+
+// NewTopN creates a new TopN node returning the first rows of the child given sorted by the fields given.
+func NewTopN(sortFields []sql.SortField, limit, offset sql.Expression, child sql.Node) *TopN {
+	return &TopN{
+		UnaryNode:  UnaryNode{Child: child},
+		Limit:      limit,
+		Offset:     offset,
+		SortFields: sortFields,
+	}
+}
+
+var _ sql.Expressioner = (*TopN)(nil)
+
+// Resolved implements the Resolvable interface.
+func (n *TopN) Resolved() bool {
+	for _, f := range n.SortFields {
+		if !f.Column.Resolved() {
+			return false
+		}
+	}
+	return n.Child.Resolved() && n.Limit.Resolved() && (n.Offset == nil || n.Offset.Resolved())
+}
+
+// RowIter implements the Node interface.
+func (n *TopN) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	span, ctx := ctx.Span("plan.TopN", opentracing.Tag{Key: "limit", Value: n.Limit})
+
+	limit, err := getInt64Value(ctx, n.Limit)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	var offset int64
+	if n.Offset != nil {
+		if offset, err = getInt64Value(ctx, n.Offset); err != nil {
+			span.Finish()
+			return nil, err
+		}
+	}
+
+	i, err := n.Child.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+	return sql.NewSpanIter(span, &topNIter{
+		ctx:       ctx,
+		n:         n,
+		childIter: i,
+		limit:     limit,
+		offset:    offset,
+		idx:       -1,
+	}), nil
+}
+
+func (n *TopN) String() string {
+	pr := sql.NewTreePrinter()
+	var fields = make([]string, len(n.SortFields))
+	for i, f := range n.SortFields {
+		fields[i] = fmt.Sprintf("%s %s", f.Column, f.Order)
+	}
+	_ = pr.WriteNode("TopN(%s)", n.describe(strings.Join(fields, ", "), n.Limit.String(), n.Offset))
+	_ = pr.WriteChildren(n.Child.String())
+	return pr.String()
+}
+
+func (n *TopN) DebugString() string {
+	pr := sql.NewTreePrinter()
+	var fields = make([]string, len(n.SortFields))
+	for i, f := range n.SortFields {
+		fields[i] = sql.DebugString(f)
+	}
+	_ = pr.WriteNode("TopN(%s)", n.describe(strings.Join(fields, ", "), sql.DebugString(n.Limit), n.Offset))
+	_ = pr.WriteChildren(sql.DebugString(n.Child))
+	return pr.String()
+}
+
+func (n *TopN) describe(fields, limit string, offset sql.Expression) string {
+	desc := fmt.Sprintf("Limit: [%s]; ", limit)
+	if offset != nil {
+		desc += fmt.Sprintf("Offset: [%s]; ", offset)
+	}
+	return desc + fields
+}
+
+// Expressions implements the Expressioner interface.
+func (n *TopN) Expressions() []sql.Expression {
+	var exprs = make([]sql.Expression, len(n.SortFields))
+	for i, f := range n.SortFields {
+		exprs[i] = f.Column
+	}
+	return exprs
+}
+
+// WithChildren implements the Node interface.
+func (n *TopN) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+
+	nn := *n
+	nn.Child = children[0]
+	return &nn, nil
+}
+
+// WithExpressions implements the Expressioner interface.
+func (n *TopN) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(n.SortFields) {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(exprs), len(n.SortFields))
+	}
+
+	var fields = make([]sql.SortField, len(n.SortFields))
+	for i, expr := range exprs {
+		fields[i] = sql.SortField{
+			Column:       expr,
+			NullOrdering: n.SortFields[i].NullOrdering,
+			Order:        n.SortFields[i].Order,
+		}
+	}
+
+	nn := *n
+	nn.SortFields = fields
+	return &nn, nil
+}
+
+type topNIter struct {
+	ctx       *sql.Context
+	n         *TopN
+	childIter sql.RowIter
+	limit     int64
+	offset    int64
+	// numRows is the number of rows read from the child, reported as the found rows with SQL_CALC_FOUND_ROWS.
+	numRows    int64
+	sortedRows []sql.Row
+	idx        int
+}
+
+func (i *topNIter) Next() (sql.Row, error) {
+	if i.idx == -1 {
+		err := i.computeTopRows()
+		if err != nil {
+			return nil, err
+		}
+		i.idx = 0
+	}
+
+	if i.idx >= len(i.sortedRows) {
+		return nil, io.EOF
+	}
+	row := i.sortedRows[i.idx]
+	i.idx++
+	return row, nil
+}
+
+func (i *topNIter) Close(ctx *sql.Context) error {
+	i.sortedRows = nil
+	if err := i.childIter.Close(ctx); err != nil {
+		return err
+	}
+
+	if i.n.CalcFoundRows {
+		ctx.SetLastQueryInfo(sql.FoundRows, i.numRows)
+	}
+	return nil
+}
+
+// computeTopRows reads all the rows of the child, keeping the first limit + offset of them in sort order in a heap
+// whose top is the last of them. Rows that sort equally keep the order they were read in, as with a stable sort.
+func (i *topNIter) computeTopRows() error {
+	n := i.limit + i.offset
+	h := &topNHeap{sorter: &expression.Sorter{SortFields: i.n.SortFields, Ctx: i.ctx}}
+
+	for {
+		row, err := i.childIter.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		i.numRows++
+
+		if n <= 0 {
+			if !i.n.CalcFoundRows {
+				break
+			}
+			continue
+		}
+
+		if int64(len(h.rows)) < n {
+			heap.Push(h, topNRow{row: row, seq: i.numRows})
+		} else if h.sorter.LessRows(row, h.rows[0].row) {
+			// The row read is later than all the rows in the heap, so it only replaces the last of them if it
+			// sorts strictly before it.
+			h.rows[0] = topNRow{row: row, seq: i.numRows}
+			heap.Fix(h, 0)
+		}
+		if h.sorter.LastError != nil {
+			return h.sorter.LastError
+		}
+	}
+
+	sort.Sort(sort.Reverse(h))
+	if h.sorter.LastError != nil {
+		return h.sorter.LastError
+	}
+
+	var rows []sql.Row
+	for j := i.offset; j < int64(len(h.rows)); j++ {
+		rows = append(rows, h.rows[j].row)
+	}
+	i.sortedRows = rows
+	return nil
+}
+
+type topNRow struct {
+	row sql.Row
+	seq int64
+}
+
+// topNHeap is a max-heap of rows by their sort order and then by the order they were read in.
+type topNHeap struct {
+	sorter *expression.Sorter
+	rows   []topNRow
+}
+
+func (h *topNHeap) Len() int {
+	return len(h.rows)
+}
+
+func (h *topNHeap) Less(i, j int) bool {
+	a, b := h.rows[i], h.rows[j]
+	if h.sorter.LessRows(b.row, a.row) {
+		return true
+	}
+	if h.sorter.LessRows(a.row, b.row) {
+		return false
+	}
+	return a.seq > b.seq
+}
+
+func (h *topNHeap) Swap(i, j int) {
+	h.rows[i], h.rows[j] = h.rows[j], h.rows[i]
+}
+
+func (h *topNHeap) Push(x interface{}) {
+	h.rows = append(h.rows, x.(topNRow))
+}
+
+func (h *topNHeap) Pop() interface{} {
+	last := h.rows[len(h.rows)-1]
+	h.rows = h.rows[:len(h.rows)-1]
+	return last
+}
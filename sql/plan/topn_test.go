@@ -0,0 +1,80 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestTopN(t *testing.T) {
+	schema := sql.Schema{
+		{Name: "k", Type: sql.Int64, Nullable: true},
+		{Name: "seq", Type: sql.Int64},
+	}
+	child := memory.NewTable("test", schema)
+	for i := 0; i < 100; i++ {
+		var k interface{} = int64((i * 37) % 10)
+		if i%11 == 0 {
+			k = nil
+		}
+		require.NoError(t, child.Insert(sql.NewEmptyContext(), sql.NewRow(k, int64(i))))
+	}
+
+	sortFields := [][]sql.SortField{
+		{{Column: expression.NewGetField(0, sql.Int64, "k", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst}},
+		{{Column: expression.NewGetField(0, sql.Int64, "k", true), Order: sql.Descending, NullOrdering: sql.NullsFirst}},
+	}
+
+	for _, sf := range sortFields {
+		for _, limit := range []int64{0, 1, 7, 10, 99, 100, 150} {
+			for _, offset := range []int64{0, 3, 95, 200} {
+				t.Run(fmt.Sprintf("%s limit %d offset %d", sf[0].Order, limit, offset), func(t *testing.T) {
+					require := require.New(t)
+					ctx := sql.NewEmptyContext()
+
+					// A stable sort followed by an offset and a limit returns the same rows in the same order
+					expected, err := sql.NodeToRows(ctx, NewLimit(
+						expression.NewLiteral(limit, sql.Int64),
+						NewOffset(expression.NewLiteral(offset, sql.Int64), NewSort(sf, NewResolvedTable(child, nil, nil))),
+					))
+					require.NoError(err)
+
+					topN := NewTopN(sf, expression.NewLiteral(limit, sql.Int64), expression.NewLiteral(offset, sql.Int64), NewResolvedTable(child, nil, nil))
+					topN.CalcFoundRows = true
+					actual, err := sql.NodeToRows(ctx, topN)
+					require.NoError(err)
+					require.Equal(expected, actual)
+					require.Equal(int64(100), ctx.GetLastQueryInfo(sql.FoundRows))
+				})
+			}
+		}
+	}
+}
+
+func TestTopNString(t *testing.T) {
+	require := require.New(t)
+	sf := []sql.SortField{{Column: expression.NewGetField(0, sql.Int64, "k", true), Order: sql.Descending}}
+	child := NewResolvedTable(memory.NewTable("test", nil), nil, nil)
+
+	require.Equal("TopN(Limit: [10]; k DESC)\n └─ Table(test)\n", NewTopN(sf, expression.NewLiteral(10, sql.Int64), nil, child).String())
+	require.Equal("TopN(Limit: [10]; Offset: [5]; k DESC)\n └─ Table(test)\n", NewTopN(sf, expression.NewLiteral(10, sql.Int64), expression.NewLiteral(5, sql.Int64), child).String())
+}
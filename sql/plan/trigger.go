@@ -110,7 +110,7 @@ func prependRowInPlanForTriggerExecution(row sql.Row) func(n, parent sql.Node, c
 					row:       row,
 				}, nil
 			}
-		case *ResolvedTable, *IndexedTableAccess:
+		case *ResolvedTable, *IndexedTableAccess, *ResolvedTableFunction:
 			return &prependNode{
 				UnaryNode: UnaryNode{Child: n},
 				row:       row,
@@ -150,7 +150,7 @@ func (u *updateIter) Next() (sql.Row, error) {
 	}
 
 	oldRow, newRow := oldAndNewRow[:len(oldAndNewRow)/2], oldAndNewRow[len(oldAndNewRow)/2:]
-	if equals, err := oldRow.Equals(newRow, u.schema); err == nil {
+	if equals, err := oldRow.Identical(newRow, u.schema); err == nil {
 		// TODO: we aren't enforcing other kinds of constraints here, like nullability
 		if !equals {
 			// apply check constraints
@@ -169,7 +169,7 @@ func (u *updateIter) Next() (sql.Row, error) {
 				}
 			}
 
-			err = u.updater.Update(u.ctx, oldRow, newRow)
+			err = u.updater.Update(u.ctx, u.ctx.RowFromSessionTime(u.schema, oldRow), u.ctx.RowFromSessionTime(u.schema, newRow))
 			if err != nil {
 				return nil, err
 			}
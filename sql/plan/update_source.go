@@ -115,6 +115,11 @@ func (u *updateSourceIter) Next() (sql.Row, error) {
 		newRow = newRow[len(newRow)-expectedSchemaLen:]
 	}
 
+	err = applyGeneratedColumns(u.ctx, u.tableSchema, newRow)
+	if err != nil {
+		return nil, err
+	}
+
 	return oldRow.Append(newRow), nil
 }
 
@@ -67,6 +67,26 @@ func (r Row) Equals(row Row, schema Schema) (bool, error) {
 	return true, nil
 }
 
+// Identical returns whether this row has exactly the same values as the row given. Unlike Equals, strings that are
+// equal in the collation of their column but differ in case, accents or trailing spaces are not identical, so that
+// updates to them are not lost.
+func (r Row) Identical(row Row, schema Schema) (bool, error) {
+	equals, err := r.Equals(row, schema)
+	if err != nil || !equals {
+		return false, err
+	}
+
+	for i, colLeft := range r {
+		if sLeft, ok := colLeft.(string); ok {
+			if sRight, ok := row[i].(string); ok && sLeft != sRight {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
 // FormatRow returns a formatted string representing this row's values
 func FormatRow(row Row) string {
 	var sb strings.Builder
@@ -0,0 +1,450 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnsupportedRowValue is returned when a row holds a value that cannot be encoded.
+var ErrUnsupportedRowValue = errors.NewKind("cannot encode value %v of type %T")
+
+// ErrInvalidEncodedRow is returned when an encoded row cannot be decoded.
+var ErrInvalidEncodedRow = errors.NewKind("invalid encoded row")
+
+// The tags that precede every encoded value, telling the Go type it's decoded to.
+const (
+	encodedNull byte = iota
+	encodedFalse
+	encodedTrue
+	encodedInt8
+	encodedInt16
+	encodedInt32
+	encodedInt64
+	encodedInt
+	encodedUint8
+	encodedUint16
+	encodedUint32
+	encodedUint64
+	encodedUint
+	encodedFloat32
+	encodedFloat64
+	encodedString
+	encodedBytes
+	encodedTime
+	encodedDecimal
+	encodedJSON
+	encodedArray
+	encodedMap
+	encodedVector
+	// encodedTypeValue is a value of a type that is not built in, encoded as its type's SQL representation and
+	// decoded by converting that representation with the type.
+	encodedTypeValue
+)
+
+// EncodeRow appends the binary encoding of the row given, whose columns have the types of the schema given, to the
+// buffer given. Values of all of the types in this package are encoded exactly as they are held, so that DecodeRow
+// returns an identical row. Values of custom types are encoded through the SQL and Convert methods of their type.
+// Rows may be longer than the schema when a scope row is prepended to them, in which case the schema describes their
+// trailing columns and the values of the leading ones must be of built-in types. Encoded rows are used to hold rows on
+// disk, such as the sorted runs of a sort that does not fit in memory.
+func EncodeRow(buf []byte, sch Schema, row Row) ([]byte, error) {
+	buf = appendUvarint(buf, uint64(len(row)))
+	for i, v := range row {
+		var err error
+		if buf, err = EncodeValue(buf, columnType(sch, len(row), i), v); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+// DecodeRow returns the row encoded by EncodeRow in the data given, which must be the whole encoding, along with the
+// schema it was encoded with.
+func DecodeRow(data []byte, sch Schema) (Row, error) {
+	n, data, err := readUvarint(data)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(data)) {
+		return nil, ErrInvalidEncodedRow.New()
+	}
+
+	row := make(Row, n)
+	for i := range row {
+		if row[i], data, err = DecodeValue(data, columnType(sch, len(row), i)); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) != 0 {
+		return nil, ErrInvalidEncodedRow.New()
+	}
+	return row, nil
+}
+
+// columnType returns the type of the i-th column of a row of the length given, described by the schema given.
+func columnType(sch Schema, rowLen, i int) Type {
+	if i -= rowLen - len(sch); i < 0 || i >= len(sch) {
+		return nil
+	}
+	return sch[i].Type
+}
+
+// EncodeValue appends the binary encoding of the value given, of the type given, to the buffer given. The type is
+// only used for values that are not of a built-in Go type, and may be nil.
+func EncodeValue(buf []byte, typ Type, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(buf, encodedNull), nil
+	case bool:
+		if v {
+			return append(buf, encodedTrue), nil
+		}
+		return append(buf, encodedFalse), nil
+	case int8:
+		return appendVarint(append(buf, encodedInt8), int64(v)), nil
+	case int16:
+		return appendVarint(append(buf, encodedInt16), int64(v)), nil
+	case int32:
+		return appendVarint(append(buf, encodedInt32), int64(v)), nil
+	case int64:
+		return appendVarint(append(buf, encodedInt64), v), nil
+	case int:
+		return appendVarint(append(buf, encodedInt), int64(v)), nil
+	case uint8:
+		return appendUvarint(append(buf, encodedUint8), uint64(v)), nil
+	case uint16:
+		return appendUvarint(append(buf, encodedUint16), uint64(v)), nil
+	case uint32:
+		return appendUvarint(append(buf, encodedUint32), uint64(v)), nil
+	case uint64:
+		return appendUvarint(append(buf, encodedUint64), v), nil
+	case uint:
+		return appendUvarint(append(buf, encodedUint), uint64(v)), nil
+	case float32:
+		return appendUint32(append(buf, encodedFloat32), math.Float32bits(v)), nil
+	case float64:
+		return appendUint64(append(buf, encodedFloat64), math.Float64bits(v)), nil
+	case string:
+		return appendBytes(append(buf, encodedString), []byte(v)), nil
+	case []byte:
+		return appendBytes(append(buf, encodedBytes), v), nil
+	case time.Time:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(append(buf, encodedTime), data), nil
+	case decimal.Decimal:
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(append(buf, encodedDecimal), data), nil
+	case JSONDocument:
+		return EncodeValue(append(buf, encodedJSON), nil, v.Val)
+	case []interface{}:
+		buf = appendUvarint(append(buf, encodedArray), uint64(len(v)))
+		for _, e := range v {
+			var err error
+			if buf, err = EncodeValue(buf, nil, e); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case map[string]interface{}:
+		buf = appendUvarint(append(buf, encodedMap), uint64(len(v)))
+		for k, e := range v {
+			buf = appendBytes(buf, []byte(k))
+			var err error
+			if buf, err = EncodeValue(buf, nil, e); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case []float32:
+		return appendBytes(append(buf, encodedVector), EncodeVector(v)), nil
+	default:
+		if typ == nil {
+			return nil, ErrUnsupportedRowValue.New(v, v)
+		}
+		sqlVal, err := typ.SQL(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendBytes(append(buf, encodedTypeValue), sqlVal.Raw()), nil
+	}
+}
+
+// DecodeValue decodes the value at the start of the data given, which was encoded by EncodeValue with the type given.
+// It returns the value and the data following it.
+func DecodeValue(data []byte, typ Type) (interface{}, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, ErrInvalidEncodedRow.New()
+	}
+	tag, data := data[0], data[1:]
+
+	switch tag {
+	case encodedNull:
+		return nil, data, nil
+	case encodedFalse:
+		return false, data, nil
+	case encodedTrue:
+		return true, data, nil
+	case encodedInt8, encodedInt16, encodedInt32, encodedInt64, encodedInt:
+		n, rest, err := readVarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tag {
+		case encodedInt8:
+			return int8(n), rest, nil
+		case encodedInt16:
+			return int16(n), rest, nil
+		case encodedInt32:
+			return int32(n), rest, nil
+		case encodedInt:
+			return int(n), rest, nil
+		default:
+			return n, rest, nil
+		}
+	case encodedUint8, encodedUint16, encodedUint32, encodedUint64, encodedUint:
+		n, rest, err := readUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tag {
+		case encodedUint8:
+			return uint8(n), rest, nil
+		case encodedUint16:
+			return uint16(n), rest, nil
+		case encodedUint32:
+			return uint32(n), rest, nil
+		case encodedUint:
+			return uint(n), rest, nil
+		default:
+			return n, rest, nil
+		}
+	case encodedFloat32:
+		if len(data) < 4 {
+			return nil, nil, ErrInvalidEncodedRow.New()
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(data)), data[4:], nil
+	case encodedFloat64:
+		if len(data) < 8 {
+			return nil, nil, ErrInvalidEncodedRow.New()
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), data[8:], nil
+	case encodedString:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		return string(b), rest, nil
+	case encodedBytes:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		return append([]byte{}, b...), rest, nil
+	case encodedTime:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary(b); err != nil {
+			return nil, nil, err
+		}
+		return t, rest, nil
+	case encodedDecimal:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		var d decimal.Decimal
+		if err := d.UnmarshalBinary(b); err != nil {
+			return nil, nil, err
+		}
+		return d, rest, nil
+	case encodedJSON:
+		val, rest, err := DecodeValue(data, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return JSONDocument{Val: val}, rest, nil
+	case encodedArray:
+		n, rest, err := readUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n > uint64(len(rest)) {
+			return nil, nil, ErrInvalidEncodedRow.New()
+		}
+		arr := make([]interface{}, n)
+		for i := range arr {
+			if arr[i], rest, err = DecodeValue(rest, nil); err != nil {
+				return nil, nil, err
+			}
+		}
+		return arr, rest, nil
+	case encodedMap:
+		n, rest, err := readUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n > uint64(len(rest)) {
+			return nil, nil, ErrInvalidEncodedRow.New()
+		}
+		m := make(map[string]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			var k []byte
+			if k, rest, err = readBytes(rest); err != nil {
+				return nil, nil, err
+			}
+			var e interface{}
+			if e, rest, err = DecodeValue(rest, nil); err != nil {
+				return nil, nil, err
+			}
+			m[string(k)] = e
+		}
+		return m, rest, nil
+	case encodedVector:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		vec, err := Vector.Convert(b)
+		if err != nil {
+			return nil, nil, err
+		}
+		return vec, rest, nil
+	case encodedTypeValue:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if typ == nil {
+			return nil, nil, ErrInvalidEncodedRow.New()
+		}
+		val, err := typ.Convert(string(b))
+		if err != nil {
+			return nil, nil, err
+		}
+		return val, rest, nil
+	default:
+		return nil, nil, ErrInvalidEncodedRow.New()
+	}
+}
+
+// EstimateRowSize returns an estimate of the number of bytes of memory held by the row given, used to decide when
+// rows no longer fit in a buffer of a given size.
+func EstimateRowSize(row Row) uint64 {
+	size := uint64(24)
+	for _, v := range row {
+		size += estimateValueSize(v)
+	}
+	return size
+}
+
+func estimateValueSize(v interface{}) uint64 {
+	// Every value is held in an interface, which takes two words
+	const interfaceSize = 16
+	switch v := v.(type) {
+	case nil, bool, int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint, float32, float64:
+		return interfaceSize
+	case string:
+		return interfaceSize + 16 + uint64(len(v))
+	case []byte:
+		return interfaceSize + 24 + uint64(len(v))
+	case []float32:
+		return interfaceSize + 24 + 4*uint64(len(v))
+	case []interface{}:
+		size := uint64(interfaceSize + 24)
+		for _, e := range v {
+			size += estimateValueSize(e)
+		}
+		return size
+	case map[string]interface{}:
+		size := uint64(interfaceSize + 48)
+		for k, e := range v {
+			size += 16 + uint64(len(k)) + estimateValueSize(e)
+		}
+		return size
+	case JSONDocument:
+		return interfaceSize + estimateValueSize(v.Val)
+	default:
+		// Times, decimals and values of custom types
+		return interfaceSize + 32
+	}
+}
+
+func appendVarint(buf []byte, n int64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	return append(buf, scratch[:binary.PutVarint(scratch[:], n)]...)
+}
+
+func appendUvarint(buf []byte, n uint64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	return append(buf, scratch[:binary.PutUvarint(scratch[:], n)]...)
+}
+
+func appendUint32(buf []byte, n uint32) []byte {
+	var scratch [4]byte
+	binary.LittleEndian.PutUint32(scratch[:], n)
+	return append(buf, scratch[:]...)
+}
+
+func appendUint64(buf []byte, n uint64) []byte {
+	var scratch [8]byte
+	binary.LittleEndian.PutUint64(scratch[:], n)
+	return append(buf, scratch[:]...)
+}
+
+func appendBytes(buf []byte, b []byte) []byte {
+	return append(appendUvarint(buf, uint64(len(b))), b...)
+}
+
+func readVarint(data []byte) (int64, []byte, error) {
+	n, size := binary.Varint(data)
+	if size <= 0 {
+		return 0, nil, ErrInvalidEncodedRow.New()
+	}
+	return n, data[size:], nil
+}
+
+func readUvarint(data []byte) (uint64, []byte, error) {
+	n, size := binary.Uvarint(data)
+	if size <= 0 {
+		return 0, nil, ErrInvalidEncodedRow.New()
+	}
+	return n, data[size:], nil
+}
+
+func readBytes(data []byte) ([]byte, []byte, error) {
+	n, data, err := readUvarint(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if n > uint64(len(data)) {
+		return nil, nil, ErrInvalidEncodedRow.New()
+	}
+	return data[:n], data[n:], nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// point is a value of pointType, which isn't of any type known to the row encoding.
+type point struct {
+	x, y int64
+}
+
+// pointType holds points, represented in SQL as "x,y".
+type pointType struct {
+	sql.StringType
+}
+
+func (pointType) Convert(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case point:
+		return v, nil
+	case string:
+		var p point
+		if _, err := fmt.Sscanf(v, "%d,%d", &p.x, &p.y); err != nil {
+			return nil, err
+		}
+		return p, nil
+	default:
+		return nil, sql.ErrInvalidType.New(v)
+	}
+}
+
+func (pointType) SQL(v interface{}) (sqltypes.Value, error) {
+	p := v.(point)
+	return sqltypes.MakeTrusted(sqltypes.VarChar, []byte(fmt.Sprintf("%d,%d", p.x, p.y))), nil
+}
+
+func TestRowEncoding(t *testing.T) {
+	require := require.New(t)
+
+	ts := time.Date(2021, 3, 14, 15, 9, 26, 535897000, time.UTC)
+	vec := []float32{1, -2.5, 3.25}
+	ptType := pointType{StringType: sql.LongText}
+	sch := sql.Schema{
+		{Name: "null", Type: sql.Int64, Nullable: true},
+		{Name: "bool", Type: sql.Boolean},
+		{Name: "i8", Type: sql.Int8},
+		{Name: "i16", Type: sql.Int16},
+		{Name: "i32", Type: sql.Int32},
+		{Name: "i64", Type: sql.Int64},
+		{Name: "u8", Type: sql.Uint8},
+		{Name: "u16", Type: sql.Uint16},
+		{Name: "u32", Type: sql.Uint32},
+		{Name: "u64", Type: sql.Uint64},
+		{Name: "f32", Type: sql.Float32},
+		{Name: "f64", Type: sql.Float64},
+		{Name: "text", Type: sql.LongText},
+		{Name: "blob", Type: sql.LongBlob},
+		{Name: "ts", Type: sql.Datetime},
+		{Name: "dec", Type: sql.MustCreateDecimalType(10, 4)},
+		{Name: "json", Type: sql.JSON},
+		{Name: "vec", Type: sql.MustCreateVectorType(3)},
+		{Name: "pt", Type: ptType},
+	}
+	row := sql.NewRow(
+		nil,
+		true,
+		int8(-8),
+		int16(-16),
+		int32(-32),
+		int64(-1)<<62,
+		uint8(8),
+		uint16(16),
+		uint32(32),
+		uint64(1)<<63,
+		float32(3.5),
+		-1.25e-300,
+		"hello, 世界",
+		[]byte{0, 1, 2, 255},
+		ts,
+		decimal.RequireFromString("-123.4500"),
+		sql.JSONDocument{Val: map[string]interface{}{
+			"a": []interface{}{float64(1), "two", nil, false},
+			"b": map[string]interface{}{"c": int64(3)},
+		}},
+		vec,
+		point{x: -1, y: 2},
+	)
+
+	buf, err := sql.EncodeRow(nil, sch, row)
+	require.NoError(err)
+	decoded, err := sql.DecodeRow(buf, sch)
+	require.NoError(err)
+	require.Len(decoded, len(row))
+	for i := range row {
+		switch v := row[i].(type) {
+		case time.Time:
+			require.True(v.Equal(decoded[i].(time.Time)))
+		case decimal.Decimal:
+			require.Equal(v.String(), decoded[i].(decimal.Decimal).String())
+		default:
+			require.Equal(row[i], decoded[i], "column %s", sch[i].Name)
+		}
+	}
+
+	// Rows with a prepended scope row are described by the schema of their trailing columns
+	scoped := append(sql.NewRow("scope", int64(1)), sql.NewRow(point{x: 3, y: 4})...)
+	buf, err = sql.EncodeRow(buf[:0], sch[len(sch)-1:], scoped)
+	require.NoError(err)
+	decoded, err = sql.DecodeRow(buf, sch[len(sch)-1:])
+	require.NoError(err)
+	require.Equal(scoped, decoded)
+
+	// Values of unknown Go types need their type
+	_, err = sql.EncodeRow(nil, nil, sql.NewRow(point{}))
+	require.True(sql.ErrUnsupportedRowValue.Is(err))
+
+	_, err = sql.DecodeRow(buf[:len(buf)-1], sch[len(sch)-1:])
+	require.Error(err)
+}
+
+func TestEstimateRowSize(t *testing.T) {
+	require := require.New(t)
+
+	small := sql.EstimateRowSize(sql.NewRow(int64(1), "a"))
+	large := sql.EstimateRowSize(sql.NewRow(int64(1), string(make([]byte, 1000))))
+	require.True(small > 0)
+	require.True(large >= small+999)
+}
@@ -66,3 +66,20 @@ func TestRowsToRowIter(t *testing.T) {
 	err = iter.Close(ctx)
 	require.NoError(err)
 }
+
+func TestRowIdentical(t *testing.T) {
+	require := require.New(t)
+
+	schema := Schema{{Name: "s", Type: Text}, {Name: "i", Type: Int64}}
+	equals, err := NewRow("abc", int64(1)).Equals(NewRow("ABC", int64(1)), schema)
+	require.NoError(err)
+	require.True(equals)
+
+	identical, err := NewRow("abc", int64(1)).Identical(NewRow("ABC", int64(1)), schema)
+	require.NoError(err)
+	require.False(identical)
+
+	identical, err = NewRow("abc", int64(1)).Identical(NewRow("abc", int64(1)), schema)
+	require.NoError(err)
+	require.True(identical)
+}
@@ -0,0 +1,317 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultHistogramBuckets is the number of buckets ANALYZE TABLE builds the histograms of columns with, which is the
+// default of MySQL.
+const DefaultHistogramBuckets = 100
+
+// AnalyzableTable is a table that stores the column statistics ANALYZE TABLE computes for it. The optimizer reads them
+// back to estimate the selectivity of filters on the table.
+type AnalyzableTable interface {
+	Table
+	// ColumnStatistics returns the statistics last stored for this table, or nil if it was never analyzed.
+	ColumnStatistics(ctx *Context) (*TableStatistics, error)
+	// SetColumnStatistics stores the statistics given for this table, replacing any previous ones.
+	SetColumnStatistics(ctx *Context, stats *TableStatistics) error
+}
+
+// TableStatistics are the statistics of the columns of a table, as computed by ANALYZE TABLE.
+type TableStatistics struct {
+	// RowCount is the number of rows the table had when it was analyzed.
+	RowCount uint64
+	// Columns are the statistics of the columns of the table, keyed by lower case column name. Columns whose values
+	// have no order, such as JSON columns, have no statistics.
+	Columns map[string]*ColumnStatistics
+	// CreatedAt is the time the statistics were computed.
+	CreatedAt time.Time
+}
+
+// Column returns the statistics of the column with the name given, or nil if there are none.
+func (s *TableStatistics) Column(name string) *ColumnStatistics {
+	if s == nil {
+		return nil
+	}
+	return s.Columns[strings.ToLower(name)]
+}
+
+// ColumnStatistics are the statistics of the values of a column.
+type ColumnStatistics struct {
+	// Column is the name of the column.
+	Column string
+	// Type is the type of the column.
+	Type Type
+	// RowCount is the number of rows of the table, including those with a NULL value.
+	RowCount uint64
+	// NullCount is the number of rows with a NULL value.
+	NullCount uint64
+	// DistinctCount is the number of distinct non-NULL values.
+	DistinctCount uint64
+	// Min and Max are the smallest and largest non-NULL values, or nil if all the values are NULL.
+	Min, Max interface{}
+	// Histogram is an equi-height histogram of the non-NULL values.
+	Histogram Histogram
+	// BucketsSpecified is the number of buckets the histogram was built with. It has fewer when there are fewer
+	// distinct values.
+	BucketsSpecified int
+}
+
+// Histogram is an equi-height histogram: buckets hold about the same number of rows, in ascending order of value, and
+// rows with the same value are always in the same bucket.
+type Histogram []HistogramBucket
+
+// HistogramBucket is a bucket of a Histogram.
+type HistogramBucket struct {
+	// LowerBound and UpperBound are the smallest and largest values in the bucket.
+	LowerBound, UpperBound interface{}
+	// RowCount is the number of rows in the bucket.
+	RowCount uint64
+	// DistinctCount is the number of distinct values in the bucket.
+	DistinctCount uint64
+}
+
+// HasColumnStatistics returns whether ANALYZE TABLE computes statistics for columns of the type given, which it does
+// for all the types whose values are ordered.
+func HasColumnStatistics(t Type) bool {
+	return !IsJSON(t) && !IsVector(t) && !IsArray(t) && !IsTuple(t)
+}
+
+// ColumnStatisticsBuilder computes the statistics of a column from all its values.
+type ColumnStatisticsBuilder struct {
+	column string
+	typ    Type
+	values []interface{}
+	nulls  uint64
+}
+
+// NewColumnStatisticsBuilder returns a builder for the statistics of the column given.
+func NewColumnStatisticsBuilder(column *Column) *ColumnStatisticsBuilder {
+	return &ColumnStatisticsBuilder{column: column.Name, typ: column.Type}
+}
+
+// Add adds a value of the column.
+func (b *ColumnStatisticsBuilder) Add(v interface{}) {
+	if v == nil {
+		b.nulls++
+		return
+	}
+	b.values = append(b.values, v)
+}
+
+// Build returns the statistics of the values added, with a histogram of at most the number of buckets given.
+func (b *ColumnStatisticsBuilder) Build(buckets int) (*ColumnStatistics, error) {
+	var err error
+	sort.SliceStable(b.values, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var cmp int
+		cmp, err = b.typ.Compare(b.values[i], b.values[j])
+		return cmp < 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &ColumnStatistics{
+		Column:           b.column,
+		Type:             b.typ,
+		RowCount:         uint64(len(b.values)) + b.nulls,
+		NullCount:        b.nulls,
+		BucketsSpecified: buckets,
+	}
+	if len(b.values) == 0 {
+		return stats, nil
+	}
+	stats.Min, stats.Max = b.values[0], b.values[len(b.values)-1]
+
+	// The rows of each value go in the last bucket, unless that one already holds its share of the rows, or it would
+	// get more rows over its share than it's short of it with them. There are never more buckets than asked for.
+	var bucket *HistogramBucket
+	for i := 0; i < len(b.values); {
+		v := b.values[i]
+		j := i + 1
+		for ; j < len(b.values); j++ {
+			cmp, err := b.typ.Compare(v, b.values[j])
+			if err != nil {
+				return nil, err
+			}
+			if cmp != 0 {
+				break
+			}
+		}
+
+		// In units of 1/buckets of a row, so that shares of rows are whole numbers
+		share := len(stats.Histogram) * len(b.values)
+		before, after := i*buckets, j*buckets
+		if bucket == nil || (len(stats.Histogram) < buckets && (before >= share || after-share > share-before)) {
+			stats.Histogram = append(stats.Histogram, HistogramBucket{LowerBound: v})
+			bucket = &stats.Histogram[len(stats.Histogram)-1]
+		}
+		bucket.UpperBound = v
+		bucket.RowCount += uint64(j - i)
+		bucket.DistinctCount++
+		stats.DistinctCount++
+		i = j
+	}
+
+	return stats, nil
+}
+
+// NullSelectivity returns the fraction of the rows whose value is NULL.
+func (c *ColumnStatistics) NullSelectivity() float64 {
+	if c.RowCount == 0 {
+		return 0
+	}
+	return float64(c.NullCount) / float64(c.RowCount)
+}
+
+// EqualsSelectivity returns the estimated fraction of the rows whose value equals the one given.
+func (c *ColumnStatistics) EqualsSelectivity(v interface{}) (float64, error) {
+	if c.RowCount == 0 || v == nil {
+		return 0, nil
+	}
+	v, err := c.Type.Convert(v)
+	if err != nil {
+		// The value can't be a value of the column, so no row has it
+		return 0, nil
+	}
+
+	for _, bucket := range c.Histogram {
+		cmp, err := c.Type.Compare(v, bucket.UpperBound)
+		if err != nil {
+			return 0, err
+		}
+		if cmp > 0 {
+			continue
+		}
+		cmp, err = c.Type.Compare(v, bucket.LowerBound)
+		if err != nil {
+			return 0, err
+		}
+		if cmp < 0 {
+			// The value falls between buckets, which means no row had it when the table was analyzed
+			return 0, nil
+		}
+		return bucket.valueRows() / float64(c.RowCount), nil
+	}
+	return 0, nil
+}
+
+// RangeSelectivity returns the estimated fraction of the rows whose value is between the bounds given, each of which
+// is inclusive or not. A nil bound leaves that side of the range open.
+func (c *ColumnStatistics) RangeSelectivity(lower, upper interface{}, lowerInclusive, upperInclusive bool) (float64, error) {
+	if c.RowCount == 0 {
+		return 0, nil
+	}
+
+	below := float64(c.RowCount - c.NullCount)
+	if upper != nil {
+		var err error
+		below, err = c.rowsBelow(upper, upperInclusive)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if lower != nil {
+		lowerRows, err := c.rowsBelow(lower, !lowerInclusive)
+		if err != nil {
+			return 0, err
+		}
+		below -= lowerRows
+	}
+
+	if below < 0 {
+		return 0, nil
+	}
+	return below / float64(c.RowCount), nil
+}
+
+// rowsBelow returns the estimated number of non-NULL rows whose value is less than the one given, or equal to it if
+// inclusive is true. Within a bucket, rows are assumed to be evenly spread between its bounds.
+func (c *ColumnStatistics) rowsBelow(v interface{}, inclusive bool) (float64, error) {
+	v, err := c.Type.Convert(v)
+	if err != nil {
+		return 0, err
+	}
+
+	var rows float64
+	for _, bucket := range c.Histogram {
+		cmp, err := c.Type.Compare(v, bucket.UpperBound)
+		if err != nil {
+			return 0, err
+		}
+		if cmp > 0 || (cmp == 0 && inclusive) {
+			rows += float64(bucket.RowCount)
+			continue
+		}
+
+		cmp, err = c.Type.Compare(v, bucket.LowerBound)
+		if err != nil {
+			return 0, err
+		}
+		if cmp < 0 || (cmp == 0 && !inclusive) {
+			break
+		}
+
+		// The rows of the bounds are at either end of the bucket, and those of the values between them in between
+		fraction := 0.5
+		if low, high, val, ok := c.interpolationPoints(bucket.LowerBound, bucket.UpperBound, v); ok && high > low {
+			fraction = (val - low) / (high - low)
+		}
+		rows += fraction * (float64(bucket.RowCount) - bucket.valueRows())
+		if inclusive {
+			rows += bucket.valueRows()
+		}
+		break
+	}
+	return rows, nil
+}
+
+// interpolationPoints returns the values given as numbers, if the column has a numeric or time type.
+func (c *ColumnStatistics) interpolationPoints(values ...interface{}) (low, high, val float64, ok bool) {
+	points := make([]float64, len(values))
+	for i, v := range values {
+		switch {
+		case IsNumber(c.Type):
+			f, err := Float64.Convert(v)
+			if err != nil {
+				return 0, 0, 0, false
+			}
+			points[i] = f.(float64)
+		case IsTime(c.Type):
+			t, ok := v.(time.Time)
+			if !ok {
+				return 0, 0, 0, false
+			}
+			points[i] = float64(t.UnixNano())
+		default:
+			return 0, 0, 0, false
+		}
+	}
+	return points[0], points[1], points[2], true
+}
+
+// valueRows returns the average number of rows with each of the values in the bucket.
+func (b HistogramBucket) valueRows() float64 {
+	return float64(b.RowCount) / float64(b.DistinctCount)
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnStatisticsBuilder(t *testing.T) {
+	require := require.New(t)
+
+	b := NewColumnStatisticsBuilder(&Column{Name: "n", Type: Int64})
+	for _, v := range []interface{}{int64(5), nil, int64(1), int64(3), int64(3), int64(3), nil, int64(2), int64(4), int64(1)} {
+		b.Add(v)
+	}
+	stats, err := b.Build(3)
+	require.NoError(err)
+
+	require.Equal(uint64(10), stats.RowCount)
+	require.Equal(uint64(2), stats.NullCount)
+	require.Equal(uint64(5), stats.DistinctCount)
+	require.Equal(int64(1), stats.Min)
+	require.Equal(int64(5), stats.Max)
+	require.Equal(3, stats.BucketsSpecified)
+	// The three rows with value 3 share a bucket, even though it makes it bigger than the others
+	require.Equal(Histogram{
+		{LowerBound: int64(1), UpperBound: int64(2), RowCount: 3, DistinctCount: 2},
+		{LowerBound: int64(3), UpperBound: int64(3), RowCount: 3, DistinctCount: 1},
+		{LowerBound: int64(4), UpperBound: int64(5), RowCount: 2, DistinctCount: 2},
+	}, stats.Histogram)
+
+	b = NewColumnStatisticsBuilder(&Column{Name: "n", Type: Int64})
+	b.Add(nil)
+	stats, err = b.Build(DefaultHistogramBuckets)
+	require.NoError(err)
+	require.Equal(uint64(1), stats.RowCount)
+	require.Nil(stats.Min)
+	require.Empty(stats.Histogram)
+}
+
+func TestColumnStatisticsSelectivity(t *testing.T) {
+	b := NewColumnStatisticsBuilder(&Column{Name: "n", Type: Int64})
+	for i := 0; i < 100; i++ {
+		b.Add(int64(i))
+	}
+	for i := 0; i < 100; i++ {
+		b.Add(int64(50))
+	}
+	for i := 0; i < 50; i++ {
+		b.Add(nil)
+	}
+	stats, err := b.Build(10)
+	require.NoError(t, err)
+
+	require.InDelta(t, 0.2, stats.NullSelectivity(), 0.0001)
+
+	testCases := []struct {
+		name     string
+		estimate func() (float64, error)
+		expected float64
+	}{
+		{"equals common value", func() (float64, error) { return stats.EqualsSelectivity(50) }, 101.0 / 250},
+		{"equals rare value", func() (float64, error) { return stats.EqualsSelectivity(10) }, 1.0 / 250},
+		{"equals value out of range", func() (float64, error) { return stats.EqualsSelectivity(500) }, 0},
+		{"equals null", func() (float64, error) { return stats.EqualsSelectivity(nil) }, 0},
+		{"equals converted value", func() (float64, error) { return stats.EqualsSelectivity("10") }, 1.0 / 250},
+		{"less than", func() (float64, error) { return stats.RangeSelectivity(nil, 50, false, false) }, 50.0 / 250},
+		{"less than or equal", func() (float64, error) { return stats.RangeSelectivity(nil, 50, false, true) }, 151.0 / 250},
+		{"greater than", func() (float64, error) { return stats.RangeSelectivity(60, nil, false, false) }, 39.0 / 250},
+		{"between", func() (float64, error) { return stats.RangeSelectivity(20, 39, true, true) }, 20.0 / 250},
+		{"empty range", func() (float64, error) { return stats.RangeSelectivity(60, 20, true, true) }, 0},
+		{"whole range", func() (float64, error) { return stats.RangeSelectivity(nil, nil, false, false) }, 200.0 / 250},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := tt.estimate()
+			require.NoError(t, err)
+			require.InDelta(t, tt.expected, s, 0.01)
+		})
+	}
+}
+
+func TestColumnStatisticsTimeSelectivity(t *testing.T) {
+	require := require.New(t)
+
+	b := NewColumnStatisticsBuilder(&Column{Name: "d", Type: Datetime})
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 40; i++ {
+		b.Add(start.Add(time.Duration(i) * 24 * time.Hour))
+	}
+	stats, err := b.Build(4)
+	require.NoError(err)
+
+	s, err := stats.RangeSelectivity("2021-01-15", nil, true, false)
+	require.NoError(err)
+	require.InDelta(26.0/40, s, 0.05)
+}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -44,6 +45,8 @@ var (
 	ErrLengthTooLarge    = errors.NewKind("length is %v but max allowed is %v")
 	ErrLengthBeyondLimit = errors.NewKind("string is too large for column")
 	ErrBinaryCollation   = errors.NewKind("binary types must have the binary collation")
+	// ErrIncorrectStringValue is thrown when a string has a character that its character set cannot represent.
+	ErrIncorrectStringValue = errors.NewKind("Incorrect string value: '%v' for character set %v")
 
 	TinyText   = MustCreateStringWithDefaults(sqltypes.Text, tinyTextBlobMax/Collation_Default.CharacterSet().MaxLength())
 	Text       = MustCreateStringWithDefaults(sqltypes.Text, textBlobMax/Collation_Default.CharacterSet().MaxLength())
@@ -189,7 +192,8 @@ func CreateLongText(collation Collation) StringType {
 	return MustCreateString(sqltypes.Text, longTextBlobMax/collation.CharacterSet().MaxLength(), collation)
 }
 
-// Compare implements Type interface.
+// Compare implements Type interface. Values are converted to strings of this type, and compared by the rules of its
+// collation.
 func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 	if hasNulls, res := compareNulls(a, b); hasNulls {
 		return res, nil
@@ -213,12 +217,7 @@ func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 		bs = bi.(string)
 	}
 
-	// TODO: should be comparing based on the collation for many cases, but the way this function is used throughout the
-	// codebase causes problems if made case insensitive.  Need to revisit usings strings.Compare for now.
-	//
-	// return Collations[t.collationName].Compare(as, bs), nil
-
-	return strings.Compare(as, bs), nil
+	return Collations[t.collationName].Compare(as, bs), nil
 }
 
 // Convert implements Type interface.
@@ -282,22 +281,24 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 		return nil, ErrConvertToSQL.New(t)
 	}
 
+	cs := t.CharacterSet()
+	if r, ok := cs.unrepresentableRune(val); ok {
+		return nil, ErrIncorrectStringValue.New(escapeRune(r), cs)
+	}
+
 	if t.baseType == sqltypes.Text {
-		// for TEXT types, we use the byte length instead of the character length
-		if int64(len(val)) > t.MaxByteLength() {
+		// for TEXT types, we use the byte length in the character set instead of the character length
+		if int64(cs.EncodedLength(val)) > t.MaxByteLength() {
+			return nil, ErrLengthBeyondLimit.New()
+		}
+	} else if cs == CharacterSet_binary {
+		// binary strings are made of bytes rather than characters
+		if int64(len(val)) > t.charLength {
 			return nil, ErrLengthBeyondLimit.New()
 		}
 	} else {
-		if t.CharacterSet().MaxLength() == 1 {
-			// if the character set only has a max size of 1, we can just count the bytes
-			if int64(len(val)) > t.charLength {
-				return nil, ErrLengthBeyondLimit.New()
-			}
-		} else {
-			//TODO: this should count the string's length properly according to the character set
-			if int64(len(val)) > t.charLength {
-				return nil, ErrLengthBeyondLimit.New()
-			}
+		if int64(utf8.RuneCountInString(val)) > t.charLength {
+			return nil, ErrLengthBeyondLimit.New()
 		}
 	}
 
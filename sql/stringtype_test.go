@@ -46,7 +46,11 @@ func TestStringCompare(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), 1, false, -1},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), 1, 1, 0},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), true, 1, 1},
-		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), "True", true, -1},
+		// Values that are not strings are converted, and compared by the collation of the type
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), "True", true, 0},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_bin), "True", true, -1},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), []byte("ABC"), "abc", 0},
+		{MustCreateString(sqltypes.VarChar, 10, Collation_utf8mb4_bin), []byte("ABC"), "abc", -1},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), false, true, -1},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), "0x12345de", "0xed54321", -1},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 10), "0xed54321", "0x12345de", 1},
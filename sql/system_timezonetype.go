@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "strings"
+
+// systemTimeZoneType is an internal string type ONLY for system variables that hold a time zone.
+type systemTimeZoneType struct {
+	systemStringType
+}
+
+var _ SystemVariableType = systemTimeZoneType{}
+
+// NewSystemTimeZoneType returns a new systemTimeZoneType.
+func NewSystemTimeZoneType(varName string) SystemVariableType {
+	return systemTimeZoneType{systemStringType{varName}}
+}
+
+// Convert implements Type interface.
+func (t systemTimeZoneType) Convert(v interface{}) (interface{}, error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, ErrInvalidSystemVariableValue.New(t.varName, v)
+	}
+	if strings.EqualFold(value, SystemTimeZone) {
+		return SystemTimeZone, nil
+	}
+	if _, err := ParseTimeZone(value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// MustConvert implements the Type interface.
+func (t systemTimeZoneType) MustConvert(v interface{}) interface{} {
+	value, err := t.Convert(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// String implements Type interface.
+func (t systemTimeZoneType) String() string {
+	return "SYSTEM_TIME_ZONE"
+}
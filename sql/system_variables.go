@@ -382,7 +382,7 @@ var systemVars = map[string]SystemVariable{
 		Scope:             SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: false,
-		Type:              NewSystemStringType("block_encryption_mode"),
+		Type:              NewSystemEnumType("block_encryption_mode", "aes-128-ecb", "aes-192-ecb", "aes-256-ecb", "aes-128-cbc", "aes-192-cbc", "aes-256-cbc", "aes-128-cfb1", "aes-192-cfb1", "aes-256-cfb1", "aes-128-cfb8", "aes-192-cfb8", "aes-256-cfb8", "aes-128-cfb128", "aes-192-cfb128", "aes-256-cfb128", "aes-128-ofb", "aes-192-ofb", "aes-256-ofb"),
 		Default:           "aes-128-ecb",
 	},
 	"bulk_insert_buffer_size": {
@@ -791,7 +791,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              NewSystemIntType("ft_max_word_len", 10, 9223372036854775807, false),
-		Default:           int64(0),
+		Default:           int64(84),
 	},
 	"ft_min_word_len": {
 		Name:              "ft_min_word_len",
@@ -815,7 +815,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              NewSystemStringType("ft_stopword_file"),
-		Default:           "",
+		Default:           "(built-in)",
 	},
 	"general_log": {
 		Name:              "general_log",
@@ -969,6 +969,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
+	"innodb_autoinc_lock_mode": {
+		Name:              "innodb_autoinc_lock_mode",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("innodb_autoinc_lock_mode", 0, 2, false),
+		Default:           int64(2),
+	},
 	"interactive_timeout": {
 		Name:              "interactive_timeout",
 		Scope:             SystemVariableScope_Both,
@@ -2028,6 +2036,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("select_into_disk_sync_delay", 0, 31536000, false),
 		Default:           int64(0),
 	},
+	"server_id": {
+		Name:              "server_id",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemUintType("server_id", 0, 4294967295),
+		Default:           uint64(1),
+	},
 	"session_track_gtids": {
 		Name:              "session_track_gtids",
 		Scope:             SystemVariableScope_Both,
@@ -2570,7 +2586,7 @@ var systemVars = map[string]SystemVariable{
 		Scope:             SystemVariableScope_Both,
 		Dynamic:           true,
 		SetVarHintApplies: true,
-		Type:              NewSystemStringType("time_zone"),
+		Type:              NewSystemTimeZoneType("time_zone"),
 		Default:           "SYSTEM",
 	},
 	//TODO: this needs to utilize a function as the value is not static
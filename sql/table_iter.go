@@ -58,7 +58,7 @@ func (i *TableRowIter) Next() (Row, error) {
 			return nil, err
 		}
 
-		i.rows = rows
+		i.rows = NewSessionTimeRowIter(i.ctx, i.table.Schema(), rows)
 	}
 
 	row, err := i.rows.Next()
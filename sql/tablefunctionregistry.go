@@ -0,0 +1,119 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/internal/similartext"
+)
+
+// ErrTableFunctionAlreadyRegistered is thrown when a table function is already registered
+var ErrTableFunctionAlreadyRegistered = errors.NewKind("table function '%s' is already registered")
+
+// ErrTableFunctionNotFound is thrown when a table function is not found
+var ErrTableFunctionNotFound = errors.NewKind("table function: '%s' not found")
+
+// ErrTableFunctionColumnReference is thrown when an argument of a table function references a column of a table in
+// the same FROM clause, which table functions can't see
+var ErrTableFunctionColumnReference = errors.NewKind("arguments of table function '%s' can only reference columns of outer queries, but %s is in the same FROM clause")
+
+// TableFunction is a function defined by the user that returns rows rather than a value. It is called in the FROM
+// clause of a query, where it is used as a table, as in SELECT * FROM generate_series(1, 10).
+type TableFunction interface {
+	// Name returns the name of the function.
+	Name() string
+	// Schema returns the schema of the rows returned by the function.
+	Schema() Schema
+	// ArgumentTypes returns the types of the arguments of the function. The arguments of a call are converted to
+	// these types before RowIter is called.
+	ArgumentTypes() []Type
+	// RowIter returns the rows of the function for the arguments given.
+	RowIter(ctx *Context, args []interface{}) (RowIter, error)
+}
+
+// TableFunctionF is a TableFunction whose rows are returned by a Go function.
+type TableFunctionF struct {
+	FunctionName   string
+	FunctionSchema Schema
+	ArgTypes       []Type
+	Fn             func(ctx *Context, args []interface{}) (RowIter, error)
+}
+
+var _ TableFunction = TableFunctionF{}
+
+// Name implements the TableFunction interface.
+func (fn TableFunctionF) Name() string {
+	return fn.FunctionName
+}
+
+// Schema implements the TableFunction interface.
+func (fn TableFunctionF) Schema() Schema {
+	return fn.FunctionSchema
+}
+
+// ArgumentTypes implements the TableFunction interface.
+func (fn TableFunctionF) ArgumentTypes() []Type {
+	return fn.ArgTypes
+}
+
+// RowIter implements the TableFunction interface.
+func (fn TableFunctionF) RowIter(ctx *Context, args []interface{}) (RowIter, error) {
+	return fn.Fn(ctx, args)
+}
+
+// TableFunctionRegistry is used to register table functions. Names of table functions are case-insensitive.
+type TableFunctionRegistry map[string]TableFunction
+
+// NewTableFunctionRegistry creates a new TableFunctionRegistry.
+func NewTableFunctionRegistry() TableFunctionRegistry {
+	return make(TableFunctionRegistry)
+}
+
+// Register registers table functions.
+// If a table function with that name is already registered,
+// the ErrTableFunctionAlreadyRegistered will be returned
+func (r TableFunctionRegistry) Register(fn ...TableFunction) error {
+	for _, f := range fn {
+		name := strings.ToLower(f.Name())
+		if _, ok := r[name]; ok {
+			return ErrTableFunctionAlreadyRegistered.New(f.Name())
+		}
+		r[name] = f
+	}
+	return nil
+}
+
+// MustRegister registers table functions.
+// If a table function with that name is already registered, it will panic!
+func (r TableFunctionRegistry) MustRegister(fn ...TableFunction) {
+	if err := r.Register(fn...); err != nil {
+		panic(err)
+	}
+}
+
+// TableFunction returns the table function with the given name.
+func (r TableFunctionRegistry) TableFunction(name string) (TableFunction, error) {
+	if fn, ok := r[strings.ToLower(name)]; ok {
+		return fn, nil
+	}
+	if len(r) == 0 {
+		return nil, ErrTableFunctionNotFound.New(name)
+	}
+	similar := similartext.FindFromMap(r, name)
+	return nil, ErrTableFunctionNotFound.New(name + similar)
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestTableFunctionRegistry(t *testing.T) {
+	require := require.New(t)
+
+	c := sql.NewCatalog()
+	fn := sql.TableFunctionF{
+		FunctionName:   "Series",
+		FunctionSchema: sql.Schema{{Name: "value", Type: sql.Int64}},
+		ArgTypes:       []sql.Type{sql.Int64},
+		Fn: func(ctx *sql.Context, args []interface{}) (sql.RowIter, error) {
+			return sql.RowsToRowIter(sql.NewRow(args[0])), nil
+		},
+	}
+	c.TableFunctions.MustRegister(fn)
+
+	f, err := c.TableFunctions.TableFunction("series")
+	require.NoError(err)
+	require.Equal("Series", f.Name())
+	require.Equal([]sql.Type{sql.Int64}, f.ArgumentTypes())
+
+	ctx := sql.NewEmptyContext()
+	iter, err := f.RowIter(ctx, []interface{}{int64(5)})
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(5)}}, rows)
+
+	err = c.TableFunctions.Register(fn)
+	require.True(sql.ErrTableFunctionAlreadyRegistered.Is(err))
+
+	_, err = c.TableFunctions.TableFunction("serie")
+	require.True(sql.ErrTableFunctionNotFound.Is(err))
+	_, err = sql.NewTableFunctionRegistry().TableFunction("series")
+	require.True(sql.ErrTableFunctionNotFound.Is(err))
+}
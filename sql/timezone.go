@@ -0,0 +1,226 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	// The time zone database is embedded so that named time zones work without one installed on the host.
+	_ "time/tzdata"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnknownTimeZone is returned when a time zone is neither a named time zone nor a valid offset.
+var ErrUnknownTimeZone = errors.NewKind("Unknown or incorrect time zone: '%s'")
+
+// SystemTimeZone is the value of time_zone that means the time zone of the server, given by system_time_zone.
+const SystemTimeZone = "SYSTEM"
+
+// loadedTimeZones caches the locations of named time zones, which are otherwise read from the time zone database
+// every time they are loaded.
+var loadedTimeZones sync.Map
+
+// timeZoneOffsetRegex matches time zones given as an offset from UTC, such as '+05:30' or '-8:00'.
+var timeZoneOffsetRegex = regexp.MustCompile(`^([+-])(\d{1,2}):(\d{2})$`)
+
+// ParseTimeZone returns the location of a time zone given as MySQL accepts them: either an offset from UTC between
+// '-13:59' and '+14:00', or the name of a time zone in the time zone database, such as 'America/New_York'.
+func ParseTimeZone(tz string) (*time.Location, error) {
+	if strings.EqualFold(tz, "UTC") {
+		return time.UTC, nil
+	}
+
+	if m := timeZoneOffsetRegex.FindStringSubmatch(tz); m != nil {
+		hours, _ := strconv.Atoi(m[2])
+		minutes, _ := strconv.Atoi(m[3])
+		offset := hours*60 + minutes
+		if m[1] == "-" {
+			offset = -offset
+		}
+		if minutes > 59 || offset < -(13*60+59) || offset > 14*60 {
+			return nil, ErrUnknownTimeZone.New(tz)
+		}
+		if offset == 0 {
+			return time.UTC, nil
+		}
+		return time.FixedZone(tz, offset*60), nil
+	}
+
+	// Relative names would be resolved against the working directory, and Local against the host's settings
+	if tz == "" || tz == "Local" || strings.HasPrefix(tz, ".") || strings.HasPrefix(tz, "/") {
+		return nil, ErrUnknownTimeZone.New(tz)
+	}
+	if loc, ok := loadedTimeZones.Load(tz); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, ErrUnknownTimeZone.New(tz)
+	}
+	loadedTimeZones.Store(tz, loc)
+	return loc, nil
+}
+
+// TimeZone returns the time zone of this session, which is given by time_zone, or by system_time_zone when time_zone
+// is SYSTEM. Time zones that cannot be parsed are treated as UTC.
+func (c *Context) TimeZone() *time.Location {
+	tz, err := c.GetSessionVariable(c, "time_zone")
+	if err != nil {
+		return time.UTC
+	}
+	name, _ := tz.(string)
+	if strings.EqualFold(name, SystemTimeZone) {
+		return SystemLocation()
+	}
+
+	loc, err := ParseTimeZone(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// SystemLocation returns the time zone of the server, which is given by system_time_zone.
+func SystemLocation() *time.Location {
+	_, val, ok := SystemVariables.GetGlobal("system_time_zone")
+	if !ok {
+		return time.UTC
+	}
+	name, _ := val.(string)
+	loc, err := ParseTimeZone(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ToSessionTime returns the instant given as the wall clock time of this session's time zone. Like every DATETIME,
+// the time returned is in UTC.
+func (c *Context) ToSessionTime(t time.Time) time.Time {
+	return wallClock(t.In(c.TimeZone()))
+}
+
+// FromSessionTime returns the wall clock time given, in this session's time zone, as the wall clock time in UTC.
+func (c *Context) FromSessionTime(t time.Time) time.Time {
+	return ConvertTimeZone(t, c.TimeZone(), time.UTC)
+}
+
+// TimestampFromSessionTime returns the value given, a TIMESTAMP in this session's time zone, converted to UTC, which
+// is how tables store timestamps.
+func (c *Context) TimestampFromSessionTime(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ts, err := Timestamp.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	t := ts.(time.Time)
+	if t.Equal(zeroTime) {
+		return t, nil
+	}
+	return c.FromSessionTime(t), nil
+}
+
+// ConvertTimeZone returns the wall clock time given, in the time zone from, as the wall clock time in the time zone
+// to. Like every DATETIME, the time returned is in UTC.
+func ConvertTimeZone(t time.Time, from, to *time.Location) time.Time {
+	if from == to {
+		return t
+	}
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), from)
+	return wallClock(local.In(to))
+}
+
+// wallClock returns the wall clock time of the time given, in UTC.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// timestampColumns returns the indexes of the TIMESTAMP columns of the schema given.
+func timestampColumns(schema Schema) []int {
+	var idxs []int
+	for i, col := range schema {
+		if IsTimestamp(col.Type) {
+			idxs = append(idxs, i)
+		}
+	}
+	return idxs
+}
+
+// RowToSessionTime returns the row given, of a table with the schema given, with the values of its TIMESTAMP columns,
+// which tables store in UTC, converted to this session's time zone. The row is returned as is when there are no
+// values to convert.
+func (c *Context) RowToSessionTime(schema Schema, row Row) Row {
+	return convertRowTimestamps(schema, row, time.UTC, c.TimeZone())
+}
+
+// RowFromSessionTime returns the row given, of a table with the schema given, with the values of its TIMESTAMP
+// columns converted from this session's time zone to UTC, which is how tables store them. The row is returned as is
+// when there are no values to convert.
+func (c *Context) RowFromSessionTime(schema Schema, row Row) Row {
+	return convertRowTimestamps(schema, row, c.TimeZone(), time.UTC)
+}
+
+func convertRowTimestamps(schema Schema, row Row, from, to *time.Location) Row {
+	if from == to || row == nil || len(row) != len(schema) {
+		return row
+	}
+
+	var converted Row
+	for _, i := range timestampColumns(schema) {
+		t, ok := row[i].(time.Time)
+		if !ok || t.Equal(zeroTime) {
+			continue
+		}
+		if converted == nil {
+			converted = row.Copy()
+		}
+		converted[i] = ConvertTimeZone(t, from, to)
+	}
+
+	if converted == nil {
+		return row
+	}
+	return converted
+}
+
+// NewSessionTimeRowIter returns an iterator over the rows of the iterator given, which are rows of a table with the
+// schema given, with their TIMESTAMP values converted to this session's time zone.
+func NewSessionTimeRowIter(ctx *Context, schema Schema, iter RowIter) RowIter {
+	tz := ctx.TimeZone()
+	if tz == time.UTC || len(timestampColumns(schema)) == 0 {
+		return iter
+	}
+	return &sessionTimeRowIter{RowIter: iter, schema: schema, timeZone: tz}
+}
+
+type sessionTimeRowIter struct {
+	RowIter
+	schema   Schema
+	timeZone *time.Location
+}
+
+func (i *sessionTimeRowIter) Next() (Row, error) {
+	row, err := i.RowIter.Next()
+	if err != nil {
+		return nil, err
+	}
+	return convertRowTimestamps(i.schema, row, time.UTC, i.timeZone), nil
+}
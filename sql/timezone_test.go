@@ -0,0 +1,89 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	tests := []struct {
+		tz     string
+		offset int
+		err    bool
+	}{
+		{"UTC", 0, false},
+		{"+00:00", 0, false},
+		{"+05:30", 5*3600 + 30*60, false},
+		{"-8:00", -8 * 3600, false},
+		{"+14:00", 14 * 3600, false},
+		{"-13:59", -(13*3600 + 59*60), false},
+		{"+14:01", 0, true},
+		{"+05:60", 0, true},
+		{"Asia/Kolkata", 5*3600 + 30*60, false},
+		{"Nowhere/Special", 0, true},
+		{"Local", 0, true},
+		{"../etc/passwd", 0, true},
+		{"", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tz, func(t *testing.T) {
+			loc, err := ParseTimeZone(test.tz)
+			if test.err {
+				require.Error(t, err)
+				assert.True(t, ErrUnknownTimeZone.Is(err))
+				return
+			}
+			require.NoError(t, err)
+			_, offset := time.Date(2021, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(t, test.offset, offset)
+		})
+	}
+}
+
+func TestConvertTimeZone(t *testing.T) {
+	newYork, err := ParseTimeZone("America/New_York")
+	require.NoError(t, err)
+
+	winter := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 1, 1, 7, 0, 0, 0, time.UTC), ConvertTimeZone(winter, time.UTC, newYork))
+	assert.Equal(t, time.Date(2021, 7, 1, 8, 0, 0, 0, time.UTC), ConvertTimeZone(summer, time.UTC, newYork))
+	assert.Equal(t, time.Date(2021, 1, 1, 17, 0, 0, 0, time.UTC), ConvertTimeZone(winter, newYork, time.UTC))
+}
+
+func TestRowSessionTime(t *testing.T) {
+	schema := Schema{
+		{Name: "ts", Type: Timestamp},
+		{Name: "dt", Type: Datetime},
+	}
+	ctx := NewEmptyContext()
+	require.NoError(t, ctx.SetSessionVariable(ctx, "time_zone", "+02:00"))
+
+	stored := Row{time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)}
+	local := ctx.RowToSessionTime(schema, stored)
+	assert.Equal(t, Row{time.Date(2021, 1, 1, 14, 0, 0, 0, time.UTC), time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)}, local)
+	assert.Equal(t, time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), stored[0])
+	assert.Equal(t, stored, ctx.RowFromSessionTime(schema, local))
+
+	err := ctx.SetSessionVariable(ctx, "time_zone", "Nowhere/Special")
+	require.Error(t, err)
+	assert.True(t, ErrUnknownTimeZone.Is(err))
+}
@@ -23,11 +23,11 @@ import (
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
-	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/internal/regex"
+	"github.com/dolthub/go-mysql-server/sqlparser"
 )
 
 var (
@@ -46,6 +46,9 @@ var (
 	// ErrConvertToSQL is returned when Convert failed.
 	// It makes an error less verbose comparing to what spf13/cast returns.
 	ErrConvertToSQL = errors.NewKind("incompatible conversion to SQL type: %s")
+
+	// ErrUnknownColumnType is returned when a column is declared with a type that is neither built in nor registered.
+	ErrUnknownColumnType = errors.NewKind("unknown type: %v")
 )
 
 // Type represents a SQL type.
@@ -435,7 +438,7 @@ func ColumnTypeToType(ct *sqlparser.ColumnType) (Type, error) {
 	case "polygon":
 	case "multipolygon":
 	default:
-		return nil, fmt.Errorf("unknown type: %v", ct.Type)
+		return nil, ErrUnknownColumnType.New(ct.Type)
 	}
 	return nil, fmt.Errorf("type not yet implemented: %v", ct.Type)
 }
@@ -592,6 +595,12 @@ func IsTime(t Type) bool {
 	return ok
 }
 
+// IsTimestamp checks if t is a timestamp
+func IsTimestamp(t Type) bool {
+	dt, ok := t.(datetimeType)
+	return ok && dt.baseType == sqltypes.Timestamp
+}
+
 // IsTuple checks if t is a tuple type.
 // Note that tupleType instances with just 1 value are not considered
 // as a tuple, but a parenthesized value.
@@ -0,0 +1,191 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sqlparser"
+)
+
+// ErrCustomTypeAlreadyRegistered is thrown when a custom type is already registered
+var ErrCustomTypeAlreadyRegistered = errors.NewKind("type '%s' is already registered")
+
+// ErrCustomTypeNameReserved is thrown when a custom type is registered with the name of a built-in type
+var ErrCustomTypeNameReserved = errors.NewKind("type '%s' is a built-in type and cannot be registered")
+
+// ErrInvalidCustomTypeParameters is thrown when a custom type is declared with parameters that it does not take
+var ErrInvalidCustomTypeParameters = errors.NewKind("invalid parameters for type %s: %v")
+
+// CustomType is a type that is not built in, but registered in Catalog.CustomTypes by an integrator so that columns may be
+// declared with it, as in CREATE TABLE t (x MONEY). Values of the type are converted and compared by the methods of
+// Type, and sent to clients as the MySQL type returned by Type(), encoded by SQL(). String returns the declaration
+// of the type in DDL, such as MONEY(10,2), which must declare the same type when parsed.
+type CustomType interface {
+	Type
+	// Name returns the name that the type is registered with.
+	Name() string
+}
+
+// CustomTypeConstructor returns the custom type declared with the parameters given, such as the 10 and 2 of
+// MONEY(10, 2). The parameters are empty when the declaration has none.
+type CustomTypeConstructor func(params []int64) (CustomType, error)
+
+// TypeRegistry is used to register custom types. Names of types are case-insensitive.
+type TypeRegistry struct {
+	mu    sync.RWMutex
+	types map[string]CustomTypeConstructor
+}
+
+// NewTypeRegistry creates a new TypeRegistry.
+func NewTypeRegistry() *TypeRegistry {
+	return &TypeRegistry{types: make(map[string]CustomTypeConstructor)}
+}
+
+// Register registers a custom type under the name given. If a type with that name is already registered, or if the
+// name is that of a built-in type, an error is returned.
+func (r *TypeRegistry) Register(name string, ctor CustomTypeConstructor) error {
+	if _, err := ColumnTypeToType(&sqlparser.ColumnType{Type: name}); !ErrUnknownColumnType.Is(err) {
+		return ErrCustomTypeNameReserved.New(name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	lowerName := strings.ToLower(name)
+	if _, ok := r.types[lowerName]; ok {
+		return ErrCustomTypeAlreadyRegistered.New(name)
+	}
+	r.types[lowerName] = ctor
+	return nil
+}
+
+// MustRegister registers a custom type under the name given.
+// If the type cannot be registered, it will panic!
+func (r *TypeRegistry) MustRegister(name string, ctor CustomTypeConstructor) {
+	if err := r.Register(name, ctor); err != nil {
+		panic(err)
+	}
+}
+
+// Unregister removes the custom type with the name given, if it is registered. Existing columns of the type keep it.
+func (r *TypeRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.types, strings.ToLower(name))
+}
+
+// IsRegistered returns whether a custom type with the name given is registered.
+func (r *TypeRegistry) IsRegistered(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.types[strings.ToLower(name)]
+	return ok
+}
+
+// Len returns the number of registered custom types.
+func (r *TypeRegistry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.types)
+}
+
+// Type returns the custom type with the name given, declared with the parameters given.
+func (r *TypeRegistry) Type(name string, params ...int64) (CustomType, error) {
+	r.mu.RLock()
+	ctor, ok := r.types[strings.ToLower(name)]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, ErrUnknownColumnType.New(name)
+	}
+	return ctor(params)
+}
+
+// IsCustomType returns whether the type given is a custom type.
+func IsCustomType(t Type) bool {
+	_, ok := t.(CustomType)
+	return ok
+}
+
+// UnresolvedCustomType is the type of a column declared with a type that is not built in, such as MONEY(10, 2), until
+// the analyzer replaces it with the custom type of that name registered in the catalog.
+type UnresolvedCustomType struct {
+	name   string
+	params []int64
+}
+
+var _ Type = UnresolvedCustomType{}
+
+// NewUnresolvedCustomType creates a new UnresolvedCustomType of the name and parameters given.
+func NewUnresolvedCustomType(name string, params ...int64) UnresolvedCustomType {
+	return UnresolvedCustomType{name: name, params: params}
+}
+
+// Name returns the name of the type as declared.
+func (t UnresolvedCustomType) Name() string {
+	return t.name
+}
+
+// Params returns the parameters of the type as declared, which are empty when the declaration has none.
+func (t UnresolvedCustomType) Params() []int64 {
+	return t.params
+}
+
+// Compare implements the Type interface.
+func (t UnresolvedCustomType) Compare(interface{}, interface{}) (int, error) {
+	return 0, ErrUnknownColumnType.New(t.name)
+}
+
+// Convert implements the Type interface.
+func (t UnresolvedCustomType) Convert(interface{}) (interface{}, error) {
+	return nil, ErrUnknownColumnType.New(t.name)
+}
+
+// Promote implements the Type interface.
+func (t UnresolvedCustomType) Promote() Type {
+	return t
+}
+
+// SQL implements the Type interface.
+func (t UnresolvedCustomType) SQL(interface{}) (sqltypes.Value, error) {
+	return sqltypes.Value{}, ErrUnknownColumnType.New(t.name)
+}
+
+// Type implements the Type interface.
+func (t UnresolvedCustomType) Type() query.Type {
+	return sqltypes.Null
+}
+
+// Zero implements the Type interface.
+func (t UnresolvedCustomType) Zero() interface{} {
+	return nil
+}
+
+// String implements the Type interface.
+func (t UnresolvedCustomType) String() string {
+	if len(t.params) == 0 {
+		return t.name
+	}
+	params := make([]string, len(t.params))
+	for i, p := range t.params {
+		params[i] = fmt.Sprint(p)
+	}
+	return t.name + "(" + strings.Join(params, ",") + ")"
+}
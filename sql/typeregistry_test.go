@@ -0,0 +1,90 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sqlparser"
+)
+
+// labelType is a custom type that holds strings of at most the length given.
+type labelType struct {
+	sql.StringType
+	params []int64
+}
+
+func (labelType) Name() string {
+	return "label"
+}
+
+func newLabelType(params []int64) (sql.CustomType, error) {
+	if len(params) > 1 {
+		return nil, sql.ErrInvalidCustomTypeParameters.New("label", params)
+	}
+	length := int64(255)
+	if len(params) == 1 {
+		length = params[0]
+	}
+	return labelType{StringType: sql.MustCreateStringWithDefaults(sqltypes.VarChar, length), params: params}, nil
+}
+
+func TestTypeRegistry(t *testing.T) {
+	require := require.New(t)
+
+	r := sql.NewTypeRegistry()
+	require.False(r.IsRegistered("label"))
+	r.MustRegister("Label", newLabelType)
+	require.True(r.IsRegistered("LABEL"))
+	require.Equal(1, r.Len())
+
+	require.True(sql.ErrCustomTypeAlreadyRegistered.Is(r.Register("label", newLabelType)))
+	require.True(sql.ErrCustomTypeNameReserved.Is(r.Register("varchar", newLabelType)))
+	require.True(sql.ErrCustomTypeNameReserved.Is(r.Register("enum", newLabelType)))
+
+	typ, err := r.Type("label", 10)
+	require.NoError(err)
+	require.Equal([]int64{10}, typ.(labelType).params)
+	require.True(sql.IsCustomType(typ))
+	require.False(sql.IsCustomType(sql.LongText))
+
+	_, err = r.Type("label", 1, 2)
+	require.True(sql.ErrInvalidCustomTypeParameters.Is(err))
+	_, err = r.Type("other")
+	require.True(sql.ErrUnknownColumnType.Is(err))
+
+	r.Unregister("label")
+	require.False(r.IsRegistered("label"))
+}
+
+func TestUnresolvedCustomType(t *testing.T) {
+	require := require.New(t)
+
+	_, err := sql.ColumnTypeToType(&sqlparser.ColumnType{Type: "label"})
+	require.True(sql.ErrUnknownColumnType.Is(err))
+
+	typ := sql.NewUnresolvedCustomType("label", 10, 2)
+	require.Equal("label", typ.Name())
+	require.Equal([]int64{10, 2}, typ.Params())
+	require.Equal("label(10,2)", typ.String())
+	require.Equal("label", sql.NewUnresolvedCustomType("label").String())
+
+	_, err = typ.Convert("x")
+	require.True(sql.ErrUnknownColumnType.Is(err))
+}
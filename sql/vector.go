@@ -0,0 +1,90 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import "math"
+
+// VectorDistance is a measure of the distance between two vectors, by which the rows nearest to a vector are found.
+type VectorDistance byte
+
+const (
+	// VectorDistance_L2 is the Euclidean distance between two vectors.
+	VectorDistance_L2 VectorDistance = iota
+	// VectorDistance_Cosine is one minus the cosine of the angle between two vectors, which ranges from 0 for vectors
+	// pointing the same way to 2 for vectors pointing opposite ways.
+	VectorDistance_Cosine
+	// VectorDistance_Dot is the negated dot product of two vectors, so that vectors with larger dot products are
+	// nearer.
+	VectorDistance_Dot
+)
+
+// String returns the name of the distance, as in the name of the function computing it.
+func (d VectorDistance) String() string {
+	switch d {
+	case VectorDistance_Cosine:
+		return "COSINE"
+	case VectorDistance_Dot:
+		return "DOT"
+	default:
+		return "L2"
+	}
+}
+
+// Distance returns the distance between the vectors given, which must have the same number of dimensions. The cosine
+// distance involving a vector of zeros is NaN, as the angle between them is undefined.
+func (d VectorDistance) Distance(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, ErrVectorDimensions.New(len(b), len(a))
+	}
+
+	switch d {
+	case VectorDistance_Cosine:
+		var dot, normA, normB float64
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+			normA += float64(a[i]) * float64(a[i])
+			normB += float64(b[i]) * float64(b[i])
+		}
+		if normA == 0 || normB == 0 {
+			return math.NaN(), nil
+		}
+		return 1 - dot/math.Sqrt(normA*normB), nil
+	case VectorDistance_Dot:
+		var dot float64
+		for i := range a {
+			dot += float64(a[i]) * float64(b[i])
+		}
+		return -dot, nil
+	default:
+		var sum float64
+		for i := range a {
+			diff := float64(a[i]) - float64(b[i])
+			sum += diff * diff
+		}
+		return math.Sqrt(sum), nil
+	}
+}
+
+// VectorIndex is an index on a VECTOR column that finds the rows whose vectors are nearest to a given vector. The
+// analyzer uses it for queries ordered by the distance of the column to a constant vector with a LIMIT, such as
+// SELECT * FROM t ORDER BY VEC_DISTANCE_L2(v, '[1,2]') LIMIT 10. Implementations may be approximate nearest neighbor
+// indexes, which return rows that are likely, but not certain, to be the nearest ones. The rows of a lookup are still
+// sorted by their exact distance afterwards, so a lookup may return more rows than asked for, in any order.
+type VectorIndex interface {
+	Index
+	// Nearest returns an IndexLookup for the k rows of the index's table whose vectors are nearest to the one given,
+	// as measured by the distance given.
+	Nearest(ctx *Context, distance VectorDistance, vec []float32, k int) (IndexLookup, error)
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorDistance(t *testing.T) {
+	tests := []struct {
+		distance VectorDistance
+		a, b     []float32
+		expected float64
+	}{
+		{VectorDistance_L2, []float32{0, 0}, []float32{3, 4}, 5},
+		{VectorDistance_L2, []float32{1, 2}, []float32{1, 2}, 0},
+		{VectorDistance_Cosine, []float32{1, 0}, []float32{0, 5}, 1},
+		{VectorDistance_Cosine, []float32{1, 1}, []float32{2, 2}, 0},
+		{VectorDistance_Cosine, []float32{1, 0}, []float32{-1, 0}, 2},
+		{VectorDistance_Dot, []float32{1, 2}, []float32{3, 4}, -11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.distance.String(), func(t *testing.T) {
+			d, err := tt.distance.Distance(tt.a, tt.b)
+			require.NoError(t, err)
+			require.InDelta(t, tt.expected, d, 1e-9)
+		})
+	}
+
+	d, err := VectorDistance_Cosine.Distance([]float32{0, 0}, []float32{1, 1})
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(d))
+
+	_, err = VectorDistance_L2.Distance([]float32{1}, []float32{1, 2})
+	require.True(t, ErrVectorDimensions.Is(err))
+}
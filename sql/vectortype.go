@@ -0,0 +1,280 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+const (
+	// VectorTypeMaxDimensions is the maximum number of dimensions of a VECTOR.
+	VectorTypeMaxDimensions = 16383
+	// VectorTypeDefaultDimensions is the number of dimensions of a VECTOR declared without any.
+	VectorTypeDefaultDimensions = 2048
+)
+
+var (
+	// ErrVectorDimensions is returned when a vector does not have the number of dimensions of its type.
+	ErrVectorDimensions = errors.NewKind("vector has %d dimensions, but %d are expected")
+
+	// ErrInvalidVectorDimensions is returned when a VECTOR type is declared with an invalid number of dimensions.
+	ErrInvalidVectorDimensions = errors.NewKind("VECTOR dimensions must be between 1 and %d, but got %d")
+
+	// ErrNotVector is returned when a value cannot be converted to a vector.
+	ErrNotVector = errors.NewKind("value %v is not a valid vector")
+
+	// Vector is a VECTOR of any number of dimensions, which is the type of vectors returned by functions.
+	Vector VectorType = vectorType{arrayType: arrayType{underlying: Float32}}
+)
+
+// VectorType is an array of float32 with a fixed number of dimensions, declared as VECTOR(n). It is registered in the
+// CustomTypes of every Catalog, as it is not a built-in type of MySQL. Values of the type are held as []float32, sent to clients as the
+// little-endian binary encoding of their elements, as MySQL does, and written as text in the format
+// [1.5,2,-3].
+type VectorType interface {
+	CustomType
+	// Dimensions returns the number of dimensions of the vectors of the type, or 0 if they may have any number.
+	Dimensions() int
+}
+
+type vectorType struct {
+	arrayType
+	dimensions int
+}
+
+var _ VectorType = vectorType{}
+
+// newVectorType is the CustomTypeConstructor of VECTOR, which takes the number of dimensions as its only parameter.
+func newVectorType(params []int64) (CustomType, error) {
+	switch len(params) {
+	case 0:
+		return CreateVectorType(VectorTypeDefaultDimensions)
+	case 1:
+		return CreateVectorType(int(params[0]))
+	default:
+		return nil, ErrInvalidCustomTypeParameters.New("vector", params)
+	}
+}
+
+// CreateVectorType returns a VECTOR type with the number of dimensions given.
+func CreateVectorType(dimensions int) (VectorType, error) {
+	if dimensions < 1 || dimensions > VectorTypeMaxDimensions {
+		return nil, ErrInvalidVectorDimensions.New(VectorTypeMaxDimensions, dimensions)
+	}
+	return vectorType{arrayType: arrayType{underlying: Float32}, dimensions: dimensions}, nil
+}
+
+// MustCreateVectorType is the same as CreateVectorType except it panics on errors.
+func MustCreateVectorType(dimensions int) VectorType {
+	vt, err := CreateVectorType(dimensions)
+	if err != nil {
+		panic(err)
+	}
+	return vt
+}
+
+// IsVector returns whether the type given is a VECTOR type.
+func IsVector(t Type) bool {
+	_, ok := t.(VectorType)
+	return ok
+}
+
+// Name implements CustomType interface.
+func (t vectorType) Name() string {
+	return "vector"
+}
+
+// Dimensions implements VectorType interface.
+func (t vectorType) Dimensions() int {
+	return t.dimensions
+}
+
+// Compare implements Type interface. Shorter vectors sort first, and vectors of the same length are compared element
+// by element.
+func (t vectorType) Compare(a, b interface{}) (int, error) {
+	if hasNulls, res := compareNulls(a, b); hasNulls {
+		return res, nil
+	}
+
+	left, err := Vector.Convert(a)
+	if err != nil {
+		return 0, err
+	}
+	right, err := Vector.Convert(b)
+	if err != nil {
+		return 0, err
+	}
+
+	l, r := left.([]float32), right.([]float32)
+	if len(l) != len(r) {
+		if len(l) < len(r) {
+			return -1, nil
+		}
+		return 1, nil
+	}
+	for i := range l {
+		if l[i] < r[i] {
+			return -1, nil
+		} else if l[i] > r[i] {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// Convert implements Type interface. Strings are read in the text format of vectors, binary strings as the
+// little-endian encoding of their elements, and arrays element by element.
+func (t vectorType) Convert(v interface{}) (interface{}, error) {
+	var vec []float32
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []float32:
+		vec = v
+	case []float64:
+		vec = make([]float32, len(v))
+		for i, f := range v {
+			vec[i] = float32(f)
+		}
+	case string:
+		var err error
+		if vec, err = ParseVector(v); err != nil {
+			return nil, err
+		}
+	case []byte:
+		if len(v)%4 != 0 {
+			return nil, ErrNotVector.New(v)
+		}
+		vec = make([]float32, len(v)/4)
+		for i := range vec {
+			vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(v[i*4:]))
+		}
+	default:
+		arr, err := t.arrayType.Convert(v)
+		if err != nil {
+			return nil, ErrNotVector.New(v)
+		}
+		elems := arr.([]interface{})
+		vec = make([]float32, len(elems))
+		for i, e := range elems {
+			if e == nil {
+				return nil, ErrNotVector.New(v)
+			}
+			vec[i] = e.(float32)
+		}
+	}
+
+	for _, f := range vec {
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			return nil, ErrNotVector.New(FormatVector(vec))
+		}
+	}
+	if t.dimensions > 0 && len(vec) != t.dimensions {
+		return nil, ErrVectorDimensions.New(len(vec), t.dimensions)
+	}
+	return vec, nil
+}
+
+// Promote implements Type interface.
+func (t vectorType) Promote() Type {
+	return t
+}
+
+// SQL implements Type interface.
+func (t vectorType) SQL(v interface{}) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+
+	vec, err := t.Convert(v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.VarBinary, EncodeVector(vec.([]float32))), nil
+}
+
+// String implements Type interface.
+func (t vectorType) String() string {
+	if t.dimensions == 0 {
+		return "vector"
+	}
+	return fmt.Sprintf("vector(%d)", t.dimensions)
+}
+
+// Type implements Type interface.
+func (t vectorType) Type() query.Type {
+	return sqltypes.VarBinary
+}
+
+// Zero implements Type interface.
+func (t vectorType) Zero() interface{} {
+	return make([]float32, t.dimensions)
+}
+
+// EncodeVector returns the binary encoding of the vector given: its elements in little-endian order.
+func EncodeVector(vec []float32) []byte {
+	encoded := make([]byte, 4*len(vec))
+	for i, f := range vec {
+		binary.LittleEndian.PutUint32(encoded[i*4:], math.Float32bits(f))
+	}
+	return encoded
+}
+
+// ParseVector returns the vector written in the text format given, a bracketed list of numbers separated by commas
+// such as [1.5,2,-3].
+func ParseVector(s string) ([]float32, error) {
+	trimmed := strings.TrimSpace(s)
+	if len(trimmed) < 2 || trimmed[0] != '[' || trimmed[len(trimmed)-1] != ']' {
+		return nil, ErrNotVector.New(s)
+	}
+	trimmed = strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+	if trimmed == "" {
+		return []float32{}, nil
+	}
+
+	parts := strings.Split(trimmed, ",")
+	vec := make([]float32, len(parts))
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 32)
+		if err != nil {
+			return nil, ErrNotVector.New(s)
+		}
+		vec[i] = float32(f)
+	}
+	return vec, nil
+}
+
+// FormatVector returns the text format of the vector given, with every element in scientific notation as MySQL
+// writes them, such as [1.50000e+00,2.00000e+00].
+func FormatVector(vec []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, f := range vec {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(f), 'e', 5, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
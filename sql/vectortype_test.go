@@ -0,0 +1,96 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorType(t *testing.T) {
+	require := require.New(t)
+
+	typ := MustCreateVectorType(2)
+	require.Equal("vector(2)", typ.String())
+	require.Equal(2, typ.Dimensions())
+	require.Equal(sqltypes.VarBinary, typ.Type())
+	require.True(IsVector(typ))
+	require.True(IsCustomType(typ))
+
+	_, err := CreateVectorType(0)
+	require.True(ErrInvalidVectorDimensions.Is(err))
+	_, err = CreateVectorType(VectorTypeMaxDimensions + 1)
+	require.True(ErrInvalidVectorDimensions.Is(err))
+
+	conversions := []struct {
+		val      interface{}
+		expected []float32
+	}{
+		{"[1,2]", []float32{1, 2}},
+		{" [ 1.5 , -2e1 ] ", []float32{1.5, -20}},
+		{[]float32{1, 2}, []float32{1, 2}},
+		{[]float64{1, 2}, []float32{1, 2}},
+		{[]interface{}{1, "2"}, []float32{1, 2}},
+		{[]byte{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}, []float32{1, 2}},
+	}
+	for _, c := range conversions {
+		val, err := typ.Convert(c.val)
+		require.NoError(err)
+		require.Equal(c.expected, val)
+	}
+
+	for _, val := range []interface{}{"[1]", "[1,2,3]", []float32{1}} {
+		_, err := typ.Convert(val)
+		require.True(ErrVectorDimensions.Is(err), "%v", val)
+	}
+	for _, val := range []interface{}{"1,2", "[1,x]", []byte{1, 2, 3}, 5, []interface{}{1, nil}, "[1,inf]"} {
+		_, err := typ.Convert(val)
+		require.True(ErrNotVector.Is(err), "%v", val)
+	}
+
+	cmp, err := typ.Compare("[1,2]", []float32{1, 3})
+	require.NoError(err)
+	require.Equal(-1, cmp)
+	cmp, err = Vector.Compare("[1,2,0]", "[5,5]")
+	require.NoError(err)
+	require.Equal(1, cmp)
+	cmp, err = typ.Compare("[1,2]", []float32{1, 2})
+	require.NoError(err)
+	require.Equal(0, cmp)
+
+	val, err := typ.SQL("[1,2]")
+	require.NoError(err)
+	require.Equal([]byte{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}, val.Raw())
+
+	require.Equal("[1.00000e+00,-2.50000e-01]", FormatVector([]float32{1, -0.25}))
+}
+
+func TestVectorTypeRegistered(t *testing.T) {
+	require := require.New(t)
+
+	customTypes := NewCatalog().CustomTypes
+	typ, err := customTypes.Type("VECTOR", 4)
+	require.NoError(err)
+	require.Equal(MustCreateVectorType(4), typ)
+
+	typ, err = customTypes.Type("vector")
+	require.NoError(err)
+	require.Equal(VectorTypeDefaultDimensions, typ.(VectorType).Dimensions())
+
+	_, err = customTypes.Type("vector", 1, 2)
+	require.True(ErrInvalidCustomTypeParameters.Is(err))
+}
@@ -0,0 +1,23 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlparser is the SQL parser of the engine. It started as a copy of github.com/dolthub/vitess/go/vt/sqlparser,
+// as of the version of vitess that go.mod requires, and its grammar has since been extended with the syntax that the
+// engine supports beyond vitess, such as generated columns, charset introducers, table functions and custom types.
+//
+// The package is public because its types are part of the API of the engine: sql.ColumnTypeToType and
+// parse.ExprToExpression take them, so integrators that build plans from parsed statements must use this package
+// rather than the vitess one. The grammar is in sql.y, and sql.go is generated from it with `make sql.go`, which must
+// be run after any change to the grammar.
+package sqlparser
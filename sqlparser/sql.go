@@ -0,0 +1,10996 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func setDDL(yylex interface{}, ddl *DDL) {
+	yylex.(*Tokenizer).partialDDL = ddl
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+// skipToEnd forces the lexer to end prematurely. Not all SQL statements
+// are supported by the Parser, thus calling skipToEnd will make the lexer
+// return EOF early.
+func skipToEnd(yylex interface{}) {
+	yylex.(*Tokenizer).SkipToEnd = true
+}
+
+//line sql.y:63
+type yySymType struct {
+	yys                      int
+	empty                    struct{}
+	statement                Statement
+	selStmt                  SelectStatement
+	ddl                      *DDL
+	ddls                     []*DDL
+	ins                      *Insert
+	byt                      byte
+	bytes                    []byte
+	bytes2                   [][]byte
+	str                      string
+	int                      int
+	strs                     []string
+	selectExprs              SelectExprs
+	selectExpr               SelectExpr
+	columns                  Columns
+	statements               Statements
+	partitions               Partitions
+	colName                  *ColName
+	tableExprs               TableExprs
+	tableExpr                TableExpr
+	subquery                 *Subquery
+	simpleTableExpr          SimpleTableExpr
+	joinCondition            JoinCondition
+	tableName                TableName
+	tableNames               TableNames
+	indexHints               *IndexHints
+	asOf                     *AsOf
+	expr                     Expr
+	exprs                    Exprs
+	boolVal                  BoolVal
+	boolean                  bool
+	sqlVal                   *SQLVal
+	colTuple                 ColTuple
+	values                   Values
+	valTuple                 ValTuple
+	whens                    []*When
+	when                     *When
+	orderBy                  OrderBy
+	order                    *Order
+	limit                    *Limit
+	assignExprs              AssignmentExprs
+	assignExpr               *AssignmentExpr
+	setVarExprs              SetVarExprs
+	setVarExpr               *SetVarExpr
+	setScope                 SetScope
+	colIdent                 ColIdent
+	colIdents                []ColIdent
+	tableIdent               TableIdent
+	convertType              *ConvertType
+	aliasedTableName         *AliasedTableExpr
+	TableSpec                *TableSpec
+	columnType               ColumnType
+	columnOrder              *ColumnOrder
+	triggerOrder             *TriggerOrder
+	colKeyOpt                ColumnKeyOption
+	optVal                   Expr
+	LengthScaleOption        LengthScaleOption
+	columnDefinition         *ColumnDefinition
+	indexDefinition          *IndexDefinition
+	indexInfo                *IndexInfo
+	indexOption              *IndexOption
+	indexOptions             []*IndexOption
+	indexColumn              *IndexColumn
+	indexColumns             []*IndexColumn
+	constraintDefinition     *ConstraintDefinition
+	constraintInfo           ConstraintInfo
+	ReferenceAction          ReferenceAction
+	partDefs                 []*PartitionDefinition
+	partDef                  *PartitionDefinition
+	partSpec                 *PartitionSpec
+	showFilter               *ShowFilter
+	over                     *Over
+	caseStatementCases       []CaseStatementCase
+	caseStatementCase        CaseStatementCase
+	ifStatementConditions    []IfStatementCondition
+	ifStatementCondition     IfStatementCondition
+	signalInfo               SignalInfo
+	signalInfos              []SignalInfo
+	signalConditionItemName  SignalConditionItemName
+	declareHandlerAction     DeclareHandlerAction
+	declareHandlerCondition  DeclareHandlerCondition
+	declareHandlerConditions []DeclareHandlerCondition
+	procedureParam           ProcedureParam
+	procedureParams          []ProcedureParam
+	characteristic           Characteristic
+	characteristics          []Characteristic
+	Fields                   *Fields
+	Lines                    *Lines
+	EnclosedBy               *EnclosedBy
+	tableAndLockType         *TableAndLockType
+	tableAndLockTypes        TableAndLockTypes
+	lockType                 LockType
+}
+
+const LEX_ERROR = 57346
+const UNION = 57347
+const SELECT = 57348
+const STREAM = 57349
+const INSERT = 57350
+const UPDATE = 57351
+const DELETE = 57352
+const FROM = 57353
+const WHERE = 57354
+const GROUP = 57355
+const HAVING = 57356
+const ORDER = 57357
+const BY = 57358
+const LIMIT = 57359
+const OFFSET = 57360
+const FOR = 57361
+const CALL = 57362
+const ALL = 57363
+const DISTINCT = 57364
+const AS = 57365
+const EXISTS = 57366
+const ASC = 57367
+const DESC = 57368
+const INTO = 57369
+const DUPLICATE = 57370
+const DEFAULT = 57371
+const SET = 57372
+const LOCK = 57373
+const UNLOCK = 57374
+const KEYS = 57375
+const OF = 57376
+const OUTFILE = 57377
+const DATA = 57378
+const LOAD = 57379
+const LINES = 57380
+const TERMINATED = 57381
+const ESCAPED = 57382
+const ENCLOSED = 57383
+const OPTIONALLY = 57384
+const STARTING = 57385
+const UNIQUE = 57386
+const KEY = 57387
+const SYSTEM_TIME = 57388
+const VALUES = 57389
+const LAST_INSERT_ID = 57390
+const SQL_CALC_FOUND_ROWS = 57391
+const NEXT = 57392
+const VALUE = 57393
+const SHARE = 57394
+const MODE = 57395
+const SQL_NO_CACHE = 57396
+const SQL_CACHE = 57397
+const JOIN = 57398
+const STRAIGHT_JOIN = 57399
+const LEFT = 57400
+const RIGHT = 57401
+const INNER = 57402
+const OUTER = 57403
+const CROSS = 57404
+const NATURAL = 57405
+const USE = 57406
+const FORCE = 57407
+const ON = 57408
+const USING = 57409
+const ID = 57410
+const HEX = 57411
+const STRING = 57412
+const INTEGRAL = 57413
+const FLOAT = 57414
+const HEXNUM = 57415
+const VALUE_ARG = 57416
+const LIST_ARG = 57417
+const COMMENT = 57418
+const COMMENT_KEYWORD = 57419
+const BIT_LITERAL = 57420
+const NULL = 57421
+const TRUE = 57422
+const FALSE = 57423
+const OFF = 57424
+const OR = 57425
+const AND = 57426
+const NOT = 57427
+const BETWEEN = 57428
+const CASE = 57429
+const WHEN = 57430
+const THEN = 57431
+const ELSE = 57432
+const ELSEIF = 57433
+const END = 57434
+const LE = 57435
+const GE = 57436
+const NE = 57437
+const NULL_SAFE_EQUAL = 57438
+const IS = 57439
+const LIKE = 57440
+const REGEXP = 57441
+const IN = 57442
+const SHIFT_LEFT = 57443
+const SHIFT_RIGHT = 57444
+const DIV = 57445
+const MOD = 57446
+const UNARY = 57447
+const COLLATE = 57448
+const BINARY = 57449
+const UNDERSCORE_BINARY = 57450
+const UNDERSCORE_CHARSET = 57451
+const INTERVAL = 57452
+const JSON_EXTRACT_OP = 57453
+const JSON_UNQUOTE_EXTRACT_OP = 57454
+const CREATE = 57455
+const ALTER = 57456
+const DROP = 57457
+const RENAME = 57458
+const ANALYZE = 57459
+const ADD = 57460
+const FLUSH = 57461
+const MODIFY = 57462
+const CHANGE = 57463
+const SCHEMA = 57464
+const TABLE = 57465
+const INDEX = 57466
+const INDEXES = 57467
+const VIEW = 57468
+const TO = 57469
+const IGNORE = 57470
+const IF = 57471
+const PRIMARY = 57472
+const COLUMN = 57473
+const SPATIAL = 57474
+const FULLTEXT = 57475
+const KEY_BLOCK_SIZE = 57476
+const CHECK = 57477
+const ACTION = 57478
+const CASCADE = 57479
+const CONSTRAINT = 57480
+const FOREIGN = 57481
+const NO = 57482
+const REFERENCES = 57483
+const RESTRICT = 57484
+const FIRST = 57485
+const AFTER = 57486
+const SHOW = 57487
+const DESCRIBE = 57488
+const EXPLAIN = 57489
+const DATE = 57490
+const ESCAPE = 57491
+const REPAIR = 57492
+const OPTIMIZE = 57493
+const TRUNCATE = 57494
+const FORMAT = 57495
+const MAXVALUE = 57496
+const PARTITION = 57497
+const REORGANIZE = 57498
+const LESS = 57499
+const THAN = 57500
+const PROCEDURE = 57501
+const TRIGGER = 57502
+const TRIGGERS = 57503
+const FUNCTION = 57504
+const STATUS = 57505
+const VARIABLES = 57506
+const WARNINGS = 57507
+const SEQUENCE = 57508
+const EACH = 57509
+const ROW = 57510
+const BEFORE = 57511
+const FOLLOWS = 57512
+const PRECEDES = 57513
+const DEFINER = 57514
+const INVOKER = 57515
+const INOUT = 57516
+const OUT = 57517
+const DETERMINISTIC = 57518
+const CONTAINS = 57519
+const READS = 57520
+const MODIFIES = 57521
+const SQL = 57522
+const SECURITY = 57523
+const TEMPORARY = 57524
+const CLASS_ORIGIN = 57525
+const SUBCLASS_ORIGIN = 57526
+const MESSAGE_TEXT = 57527
+const MYSQL_ERRNO = 57528
+const CONSTRAINT_CATALOG = 57529
+const CONSTRAINT_SCHEMA = 57530
+const CONSTRAINT_NAME = 57531
+const CATALOG_NAME = 57532
+const SCHEMA_NAME = 57533
+const TABLE_NAME = 57534
+const COLUMN_NAME = 57535
+const CURSOR_NAME = 57536
+const SIGNAL = 57537
+const RESIGNAL = 57538
+const SQLSTATE = 57539
+const DECLARE = 57540
+const CONDITION = 57541
+const CURSOR = 57542
+const CONTINUE = 57543
+const EXIT = 57544
+const UNDO = 57545
+const HANDLER = 57546
+const FOUND = 57547
+const SQLWARNING = 57548
+const SQLEXCEPTION = 57549
+const BEGIN = 57550
+const START = 57551
+const TRANSACTION = 57552
+const COMMIT = 57553
+const ROLLBACK = 57554
+const SAVEPOINT = 57555
+const WORK = 57556
+const RELEASE = 57557
+const BIT = 57558
+const TINYINT = 57559
+const SMALLINT = 57560
+const MEDIUMINT = 57561
+const INT = 57562
+const INTEGER = 57563
+const BIGINT = 57564
+const INTNUM = 57565
+const REAL = 57566
+const DOUBLE = 57567
+const FLOAT_TYPE = 57568
+const DECIMAL = 57569
+const NUMERIC = 57570
+const DEC = 57571
+const FIXED = 57572
+const PRECISION = 57573
+const TIME = 57574
+const TIMESTAMP = 57575
+const DATETIME = 57576
+const YEAR = 57577
+const CHAR = 57578
+const VARCHAR = 57579
+const BOOL = 57580
+const CHARACTER = 57581
+const VARBINARY = 57582
+const NCHAR = 57583
+const NVARCHAR = 57584
+const NATIONAL = 57585
+const VARYING = 57586
+const TEXT = 57587
+const TINYTEXT = 57588
+const MEDIUMTEXT = 57589
+const LONGTEXT = 57590
+const LONG = 57591
+const BLOB = 57592
+const TINYBLOB = 57593
+const MEDIUMBLOB = 57594
+const LONGBLOB = 57595
+const JSON = 57596
+const ENUM = 57597
+const GEOMETRY = 57598
+const POINT = 57599
+const LINESTRING = 57600
+const POLYGON = 57601
+const GEOMETRYCOLLECTION = 57602
+const MULTIPOINT = 57603
+const MULTILINESTRING = 57604
+const MULTIPOLYGON = 57605
+const LOCAL = 57606
+const LOW_PRIORITY = 57607
+const NO_WRITE_TO_BINLOG = 57608
+const NULLX = 57609
+const AUTO_INCREMENT = 57610
+const APPROXNUM = 57611
+const SIGNED = 57612
+const UNSIGNED = 57613
+const ZEROFILL = 57614
+const GENERATED = 57615
+const ALWAYS = 57616
+const STORED = 57617
+const VIRTUAL = 57618
+const COLLATION = 57619
+const DATABASES = 57620
+const SCHEMAS = 57621
+const TABLES = 57622
+const FULL = 57623
+const PROCESSLIST = 57624
+const COLUMNS = 57625
+const FIELDS = 57626
+const ENGINES = 57627
+const PLUGINS = 57628
+const NAMES = 57629
+const CHARSET = 57630
+const GLOBAL = 57631
+const SESSION = 57632
+const ISOLATION = 57633
+const LEVEL = 57634
+const READ = 57635
+const WRITE = 57636
+const ONLY = 57637
+const REPEATABLE = 57638
+const COMMITTED = 57639
+const UNCOMMITTED = 57640
+const SERIALIZABLE = 57641
+const CURRENT_TIMESTAMP = 57642
+const DATABASE = 57643
+const CURRENT_DATE = 57644
+const CURRENT_USER = 57645
+const CURRENT_TIME = 57646
+const LOCALTIME = 57647
+const LOCALTIMESTAMP = 57648
+const UTC_DATE = 57649
+const UTC_TIME = 57650
+const UTC_TIMESTAMP = 57651
+const REPLACE = 57652
+const CONVERT = 57653
+const CAST = 57654
+const SUBSTR = 57655
+const SUBSTRING = 57656
+const GROUP_CONCAT = 57657
+const SEPARATOR = 57658
+const TIMESTAMPADD = 57659
+const TIMESTAMPDIFF = 57660
+const EXTRACT = 57661
+const POSITION = 57662
+const GET_FORMAT = 57663
+const OVER = 57664
+const WINDOW = 57665
+const GROUPING = 57666
+const GROUPS = 57667
+const AVG = 57668
+const BIT_AND = 57669
+const BIT_OR = 57670
+const BIT_XOR = 57671
+const COUNT = 57672
+const JSON_ARRAYAGG = 57673
+const JSON_OBJECTAGG = 57674
+const MAX = 57675
+const MIN = 57676
+const STDDEV_POP = 57677
+const STDDEV = 57678
+const STD = 57679
+const STDDEV_SAMP = 57680
+const SUM = 57681
+const VAR_POP = 57682
+const VARIANCE = 57683
+const VAR_SAMP = 57684
+const CUME_DIST = 57685
+const DENSE_RANK = 57686
+const FIRST_VALUE = 57687
+const LAG = 57688
+const LAST_VALUE = 57689
+const LEAD = 57690
+const NTH_VALUE = 57691
+const NTILE = 57692
+const ROW_NUMBER = 57693
+const PERCENT_RANK = 57694
+const RANK = 57695
+const MATCH = 57696
+const AGAINST = 57697
+const BOOLEAN = 57698
+const LANGUAGE = 57699
+const WITH = 57700
+const QUERY = 57701
+const EXPANSION = 57702
+const UNUSED = 57703
+const ARRAY = 57704
+const DESCRIPTION = 57705
+const EMPTY = 57706
+const EXCEPT = 57707
+const JSON_TABLE = 57708
+const LATERAL = 57709
+const MEMBER = 57710
+const RECURSIVE = 57711
+const ACTIVE = 57712
+const ADMIN = 57713
+const BUCKETS = 57714
+const CLONE = 57715
+const COMPONENT = 57716
+const DEFINITION = 57717
+const ENFORCED = 57718
+const EXCLUDE = 57719
+const FOLLOWING = 57720
+const GEOMCOLLECTION = 57721
+const GET_MASTER_PUBLIC_KEY = 57722
+const HISTOGRAM = 57723
+const HISTORY = 57724
+const INACTIVE = 57725
+const INVISIBLE = 57726
+const LOCKED = 57727
+const MASTER_COMPRESSION_ALGORITHMS = 57728
+const MASTER_PUBLIC_KEY_PATH = 57729
+const MASTER_TLS_CIPHERSUITES = 57730
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57731
+const NESTED = 57732
+const NETWORK_NAMESPACE = 57733
+const NOWAIT = 57734
+const NULLS = 57735
+const OJ = 57736
+const OLD = 57737
+const OPTIONAL = 57738
+const ORDINALITY = 57739
+const ORGANIZATION = 57740
+const OTHERS = 57741
+const PATH = 57742
+const PERSIST = 57743
+const PERSIST_ONLY = 57744
+const PRECEDING = 57745
+const PRIVILEGE_CHECKS_USER = 57746
+const PROCESS = 57747
+const RANDOM = 57748
+const REFERENCE = 57749
+const REQUIRE_ROW_FORMAT = 57750
+const RESOURCE = 57751
+const RESPECT = 57752
+const RESTART = 57753
+const RETAIN = 57754
+const REUSE = 57755
+const ROLE = 57756
+const SECONDARY = 57757
+const SECONDARY_ENGINE = 57758
+const SECONDARY_LOAD = 57759
+const SECONDARY_UNLOAD = 57760
+const SKIP = 57761
+const SRID = 57762
+const THREAD_PRIORITY = 57763
+const TIES = 57764
+const UNBOUNDED = 57765
+const VCPU = 57766
+const VISIBLE = 57767
+const SYSTEM = 57768
+const INFILE = 57769
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"OR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_CHARSET",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"FLUSH",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"SEQUENCE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"NO_WRITE_TO_BINLOG",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"SUBSTR",
+	"SUBSTRING",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"EXTRACT",
+	"POSITION",
+	"GET_FORMAT",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"GROUPS",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"UNUSED",
+	"ARRAY",
+	"DESCRIPTION",
+	"EMPTY",
+	"EXCEPT",
+	"JSON_TABLE",
+	"LATERAL",
+	"MEMBER",
+	"RECURSIVE",
+	"ACTIVE",
+	"ADMIN",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"EXCLUDE",
+	"FOLLOWING",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"LOCKED",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NESTED",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"OPTIONAL",
+	"ORDINALITY",
+	"ORGANIZATION",
+	"OTHERS",
+	"PATH",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRECEDING",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"RANDOM",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"REUSE",
+	"ROLE",
+	"SECONDARY",
+	"SECONDARY_ENGINE",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"SKIP",
+	"SRID",
+	"THREAD_PRIORITY",
+	"TIES",
+	"UNBOUNDED",
+	"VCPU",
+	"VISIBLE",
+	"SYSTEM",
+	"INFILE",
+	"';'",
+}
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 33,
+	5, 49,
+	-2, 863,
+	-1, 41,
+	140, 924,
+	141, 950,
+	-2, 120,
+	-1, 48,
+	180, 511,
+	181, 511,
+	-2, 501,
+	-1, 55,
+	1, 1373,
+	445, 1373,
+	-2, 537,
+	-1, 445,
+	127, 960,
+	-2, 954,
+	-1, 446,
+	127, 961,
+	-2, 955,
+	-1, 552,
+	97, 1191,
+	127, 1191,
+	-2, 908,
+	-1, 553,
+	97, 1294,
+	127, 1294,
+	-2, 909,
+	-1, 558,
+	97, 1211,
+	127, 1211,
+	-2, 910,
+	-1, 559,
+	97, 1252,
+	127, 1252,
+	-2, 911,
+	-1, 560,
+	97, 1253,
+	127, 1253,
+	-2, 912,
+	-1, 561,
+	97, 1145,
+	127, 1145,
+	-2, 916,
+	-1, 563,
+	97, 1230,
+	127, 1230,
+	-2, 918,
+	-1, 1006,
+	1, 589,
+	5, 589,
+	12, 589,
+	13, 589,
+	14, 589,
+	15, 589,
+	17, 589,
+	19, 589,
+	30, 589,
+	31, 589,
+	56, 589,
+	57, 589,
+	58, 589,
+	59, 589,
+	60, 589,
+	62, 589,
+	63, 589,
+	66, 589,
+	67, 589,
+	69, 589,
+	70, 589,
+	445, 589,
+	-2, 621,
+	-1, 1011,
+	67, 66,
+	69, 66,
+	-2, 70,
+	-1, 1211,
+	127, 963,
+	-2, 959,
+	-1, 1385,
+	68, 367,
+	71, 367,
+	-2, 1184,
+	-1, 1386,
+	68, 369,
+	-2, 1110,
+	-1, 1389,
+	68, 365,
+	71, 365,
+	-2, 1045,
+	-1, 1390,
+	68, 366,
+	71, 366,
+	-2, 1055,
+	-1, 1391,
+	23, 328,
+	-2, 237,
+	-1, 1429,
+	23, 328,
+	-2, 238,
+	-1, 1479,
+	68, 443,
+	71, 443,
+	-2, 409,
+	-1, 1524,
+	5, 50,
+	-2, 687,
+	-1, 1852,
+	1, 590,
+	5, 590,
+	12, 590,
+	13, 590,
+	14, 590,
+	15, 590,
+	17, 590,
+	19, 590,
+	30, 590,
+	31, 590,
+	56, 590,
+	57, 590,
+	58, 590,
+	59, 590,
+	60, 590,
+	62, 590,
+	63, 590,
+	66, 590,
+	67, 590,
+	69, 590,
+	70, 590,
+	445, 590,
+	-2, 621,
+	-1, 1857,
+	1, 642,
+	5, 642,
+	12, 642,
+	13, 642,
+	14, 642,
+	15, 642,
+	17, 642,
+	19, 642,
+	30, 642,
+	31, 642,
+	56, 642,
+	57, 642,
+	58, 642,
+	59, 642,
+	60, 642,
+	62, 642,
+	63, 642,
+	66, 642,
+	67, 642,
+	69, 642,
+	70, 642,
+	445, 642,
+	-2, 621,
+	-1, 1988,
+	5, 50,
+	-2, 883,
+	-1, 2128,
+	41, 970,
+	-2, 968,
+	-1, 2133,
+	23, 328,
+	-2, 235,
+	-1, 2134,
+	23, 328,
+	-2, 236,
+	-1, 2238,
+	5, 50,
+	-2, 886,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 26103
+
+var yyAct = [...]int{
+
+	479, 78, 2388, 2342, 2363, 2258, 2353, 2244, 2344, 2354,
+	2143, 1435, 2176, 7, 2175, 6, 1998, 2174, 5, 2177,
+	8, 2284, 2221, 2227, 2101, 2128, 1850, 450, 1042, 1870,
+	1622, 82, 1764, 1754, 2257, 754, 2064, 1830, 1593, 1433,
+	1650, 1340, 437, 2046, 1391, 2028, 478, 430, 1338, 397,
+	1188, 1098, 1831, 1871, 1763, 576, 1382, 1361, 2245, 926,
+	1924, 1334, 1647, 1707, 463, 1594, 1372, 1827, 376, 379,
+	92, 1006, 103, 1477, 1842, 1371, 764, 1508, 1836, 78,
+	1423, 1236, 578, 1249, 1181, 372, 2173, 3, 1461, 1282,
+	1124, 1775, 443, 1197, 572, 1731, 1690, 827, 1316, 1323,
+	1267, 1730, 554, 1378, 1145, 1002, 1419, 834, 1213, 812,
+	830, 867, 571, 448, 1169, 1022, 433, 550, 557, 791,
+	1003, 1021, 546, 551, 876, 396, 1013, 2410, 741, 790,
+	573, 2406, 399, 2396, 1407, 1394, 2378, 2376, 942, 2358,
+	543, 2337, 373, 374, 375, 2292, 943, 81, 718, 1167,
+	2022, 67, 1905, 2029, 2369, 2277, 2352, 2235, 2325, 2276,
+	2234, 2031, 1792, 1559, 1970, 429, 84, 717, 2153, 891,
+	890, 900, 901, 893, 894, 895, 896, 897, 898, 899,
+	892, 1631, 1648, 902, 1630, 34, 1173, 1632, 34, 361,
+	34, 34, 1270, 1473, 106, 1866, 1867, 752, 1358, 1359,
+	1865, 1357, 86, 87, 88, 89, 90, 1023, 387, 1024,
+	767, 768, 1588, 386, 1171, 1172, 2243, 2242, 1887, 746,
+	1673, 1393, 34, 1472, 70, 37, 38, 358, 809, 1589,
+	2034, 2085, 1336, 766, 1395, 341, 98, 340, 1395, 114,
+	110, 111, 1408, 112, 567, 1399, 1401, 79, 1400, 720,
+	79, 1961, 79, 79, 492, 1959, 498, 500, 499, 496,
+	497, 495, 494, 493, 2071, 1154, 2032, 2033, 2035, 2036,
+	2037, 501, 502, 503, 504, 505, 116, 115, 1776, 342,
+	1413, 1812, 1408, 385, 79, 1420, 345, 745, 749, 100,
+	1277, 751, 394, 97, 775, 2289, 354, 359, 360, 108,
+	107, 366, 2367, 2287, 2288, 1170, 2339, 2125, 2124, 2123,
+	2122, 2121, 2119, 2120, 2246, 2206, 2207, 1490, 2281, 2282,
+	1778, 377, 2001, 1614, 747, 750, 2171, 748, 1442, 761,
+	762, 1489, 351, 763, 760, 352, 2351, 2324, 357, 104,
+	759, 722, 721, 2222, 70, 37, 38, 753, 753, 105,
+	1757, 1708, 769, 1441, 770, 767, 768, 2168, 1317, 753,
+	380, 369, 2047, 2048, 1041, 1041, 39, 2402, 1875, 78,
+	78, 2411, 1736, 1494, 1278, 1280, 1279, 2408, 2209, 1929,
+	452, 780, 1488, 782, 1873, 393, 781, 1709, 367, 1041,
+	817, 1041, 2102, 1875, 1040, 2397, 2057, 370, 824, 1780,
+	2379, 381, 719, 728, 1784, 2104, 1779, 392, 1777, 393,
+	83, 1712, 343, 1782, 1114, 777, 1105, 1621, 113, 1620,
+	1619, 1952, 715, 1725, 1155, 820, 1781, 1680, 738, 723,
+	109, 1398, 79, 1486, 1480, 1481, 2154, 1479, 1945, 1482,
+	1483, 1635, 1783, 1785, 1627, 911, 378, 2030, 913, 1408,
+	356, 346, 347, 776, 364, 779, 783, 1904, 348, 350,
+	1041, 344, 363, 362, 378, 1422, 744, 1527, 99, 2056,
+	2233, 1710, 1711, 2392, 1492, 1495, 2103, 1646, 924, 106,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 737, 941, 944, 944, 944, 950, 944, 944, 950,
+	944, 950, 959, 960, 961, 962, 963, 964, 965, 966,
+	967, 968, 969, 970, 971, 972, 973, 974, 975, 976,
+	977, 978, 979, 980, 981, 982, 983, 984, 985, 986,
+	987, 988, 989, 990, 991, 992, 993, 994, 995, 996,
+	997, 816, 1008, 71, 378, 755, 916, 917, 918, 919,
+	920, 921, 922, 923, 77, 814, 774, 77, 1487, 77,
+	77, 2333, 1068, 1646, 1173, 836, 925, 1978, 1642, 1100,
+	1811, 2348, 880, 1645, 2343, 1343, 1345, 2060, 557, 914,
+	915, 1513, 1498, 557, 108, 107, 1535, 1485, 2346, 1651,
+	2055, 77, 1171, 1172, 1532, 1192, 1034, 2390, 1019, 1035,
+	2391, 2291, 2389, 1001, 793, 794, 795, 796, 797, 798,
+	799, 800, 801, 802, 803, 804, 882, 737, 892, 1353,
+	1751, 902, 825, 1646, 1362, 902, 1146, 1184, 875, 1794,
+	1491, 1101, 945, 947, 949, 951, 953, 955, 956, 958,
+	946, 948, 1646, 952, 954, 1220, 957, 1755, 1452, 1041,
+	1041, 874, 873, 1026, 1840, 1055, 1344, 1894, 1027, 1645,
+	1218, 1219, 1217, 71, 757, 1162, 1039, 1189, 1190, 875,
+	95, 1017, 1268, 895, 896, 897, 898, 899, 892, 2061,
+	1493, 902, 1032, 1012, 784, 891, 890, 900, 901, 893,
+	894, 895, 896, 897, 898, 899, 892, 1069, 724, 902,
+	727, 873, 2395, 914, 915, 1663, 2382, 2364, 2381, 1895,
+	2334, 914, 915, 1107, 378, 94, 1880, 870, 875, 1645,
+	1668, 1667, 1147, 2345, 2347, 1738, 1736, 2403, 874, 873,
+	1744, 2260, 743, 1743, 1746, 1036, 771, 1268, 1645, 1543,
+	753, 1750, 1664, 1462, 1453, 1747, 875, 753, 753, 753,
+	1739, 2239, 93, 2021, 874, 873, 1669, 2020, 1661, 1695,
+	758, 2399, 753, 753, 1662, 1082, 1085, 1086, 1087, 1088,
+	1089, 1090, 875, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
+	2404, 1070, 1071, 1072, 1073, 1049, 1053, 1083, 1050, 1056,
+	1052, 1054, 1051, 1693, 1057, 1058, 1059, 1060, 1061, 1062,
+	1063, 1064, 1065, 1066, 1067, 1074, 1075, 1076, 1077, 1078,
+	1079, 1080, 1081, 731, 732, 733, 734, 735, 78, 1237,
+	1126, 1238, 753, 1666, 1180, 742, 1674, 2321, 912, 773,
+	2285, 1646, 2309, 2320, 2308, 1510, 1511, 1512, 982, 983,
+	984, 985, 986, 970, 971, 972, 987, 988, 973, 974,
+	975, 981, 989, 976, 977, 978, 979, 980, 992, 991,
+	990, 993, 994, 996, 995, 997, 1152, 1165, 1115, 1129,
+	1149, 1150, 1111, 874, 873, 1203, 1205, 1206, 2294, 1127,
+	2336, 1204, 79, 1633, 2266, 1634, 391, 1191, 1141, 1142,
+	1531, 875, 1216, 1132, 1133, 1179, 893, 894, 895, 896,
+	897, 898, 899, 892, 1175, 1084, 902, 2167, 1251, 826,
+	874, 873, 2307, 2118, 78, 1157, 1158, 2286, 1210, 1160,
+	2306, 831, 1010, 1696, 832, 874, 873, 1645, 875, 928,
+	788, 1174, 1214, 1738, 1736, 1163, 2165, 1128, 1041, 874,
+	873, 1740, 1737, 875, 1134, 1135, 1136, 2078, 1178, 874,
+	873, 2018, 787, 874, 873, 2285, 1885, 875, 1739, 1143,
+	1144, 1665, 1257, 1260, 1691, 1211, 1671, 875, 1469, 1269,
+	1159, 875, 1130, 1209, 540, 541, 1923, 1212, 2137, 1925,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 1235, 439, 1651, 1530, 1194, 1529,
+	925, 826, 1207, 2132, 874, 873, 2092, 2326, 1333, 1337,
+	880, 1796, 2010, 2323, 1008, 1247, 874, 873, 1008, 1177,
+	1925, 1195, 875, 2053, 1196, 2271, 826, 2131, 1240, 1241,
+	2010, 2268, 2010, 2170, 875, 1940, 1243, 1271, 2092, 2161,
+	2092, 2107, 468, 467, 470, 471, 472, 473, 557, 1936,
+	1265, 469, 474, 2092, 826, 1275, 1290, 1933, 1292, 1342,
+	900, 901, 893, 894, 895, 896, 897, 898, 899, 892,
+	1366, 1932, 902, 1373, 573, 1348, 1930, 1332, 1915, 1350,
+	2092, 2091, 2112, 1368, 2010, 2009, 1991, 826, 1497, 826,
+	1947, 1623, 1914, 1299, 1300, 925, 1101, 1913, 1304, 1902,
+	1901, 1307, 1126, 1898, 1899, 2111, 1312, 753, 1719, 753,
+	1346, 1898, 1897, 1525, 826, 1839, 1718, 1211, 1244, 1246,
+	1320, 826, 1245, 1466, 1254, 1367, 1463, 1450, 1449, 1245,
+	826, 2273, 1239, 1379, 1156, 1153, 1123, 1122, 1355, 1121,
+	1967, 1351, 1120, 1429, 1354, 1112, 1110, 1360, 1948, 1109,
+	1910, 1376, 1108, 1369, 1106, 1099, 1431, 1038, 1037, 2130,
+	95, 810, 739, 1288, 1289, 384, 382, 1888, 1015, 1015,
+	1295, 1296, 1297, 1298, 1986, 1425, 1426, 1427, 1428, 1828,
+	1430, 78, 1839, 1911, 1623, 1396, 1397, 1623, 1402, 1403,
+	1404, 1405, 1406, 1409, 1410, 1411, 1412, 1900, 1347, 1421,
+	1014, 1343, 1345, 83, 1010, 1853, 1416, 1417, 1418, 83,
+	1186, 1514, 1325, 1328, 1329, 1330, 1326, 1948, 1327, 1331,
+	1471, 1387, 1843, 1844, 1016, 1016, 1018, 1014, 1210, 891,
+	890, 900, 901, 893, 894, 895, 896, 897, 898, 899,
+	892, 1320, 1728, 902, 1839, 891, 890, 900, 901, 893,
+	894, 895, 896, 897, 898, 899, 892, 1245, 1637, 902,
+	1319, 1185, 1356, 1525, 1214, 1525, 1215, 925, 1551, 1548,
+	1547, 1161, 1014, 1448, 1454, 1211, 1465, 1187, 1470, 1460,
+	1168, 1464, 1344, 1367, 1113, 1020, 1474, 1325, 1328, 1329,
+	1330, 1326, 823, 1327, 1331, 1502, 1500, 1501, 822, 1320,
+	79, 2279, 568, 2269, 1437, 1851, 1439, 2135, 2023, 1395,
+	1519, 1516, 1517, 1518, 826, 1996, 1424, 1591, 1592, 1879,
+	836, 1008, 1008, 1008, 1008, 1008, 1515, 1843, 1844, 1509,
+	1420, 1641, 1443, 1522, 1415, 1414, 1102, 79, 1337, 807,
+	1615, 835, 1434, 2373, 2371, 2355, 1909, 1595, 1008, 1846,
+	1828, 883, 891, 890, 900, 901, 893, 894, 895, 896,
+	897, 898, 899, 892, 79, 1697, 902, 1542, 1475, 1849,
+	1117, 1605, 1973, 1603, 1496, 1848, 1606, 1602, 1604, 1625,
+	1601, 1626, 1609, 557, 1618, 434, 435, 1499, 927, 1010,
+	1607, 1617, 1329, 1330, 1010, 2303, 2275, 1761, 1010, 940,
+	1198, 868, 869, 1590, 2301, 1507, 1506, 1373, 2083, 1624,
+	1653, 891, 890, 900, 901, 893, 894, 895, 896, 897,
+	898, 899, 892, 1610, 1247, 902, 1596, 2012, 1652, 1599,
+	866, 1608, 1521, 1935, 1884, 1883, 78, 1101, 1638, 1643,
+	1524, 1526, 1597, 1598, 2211, 1600, 1528, 2214, 753, 2265,
+	753, 753, 1534, 2264, 2129, 1537, 1538, 1539, 1026, 2293,
+	2127, 2205, 1545, 446, 1546, 1683, 1636, 1685, 1686, 1687,
+	1688, 1640, 1628, 2204, 1552, 1553, 383, 1554, 1555, 1556,
+	1557, 1722, 1717, 1561, 1562, 1563, 1564, 1565, 828, 1684,
+	1033, 805, 789, 786, 1572, 1573, 1574, 785, 1576, 1577,
+	829, 1579, 1580, 1581, 1582, 740, 1584, 1585, 1586, 2316,
+	121, 2141, 1692, 121, 2140, 1984, 1189, 1190, 95, 121,
+	2062, 1468, 1699, 1438, 1116, 1886, 1758, 1611, 1612, 1694,
+	1700, 1675, 1676, 1459, 1677, 1678, 1679, 1681, 1682, 868,
+	869, 121, 1104, 818, 819, 2315, 1505, 2314, 1689, 1766,
+	2313, 2115, 2296, 121, 1504, 1723, 431, 121, 580, 2295,
+	1803, 121, 1720, 1210, 1729, 2262, 1724, 1644, 1727, 1649,
+	1558, 1560, 1793, 121, 1735, 580, 1742, 1566, 1567, 1568,
+	1569, 121, 1741, 1734, 1752, 1753, 1726, 1721, 1756, 2215,
+	1833, 2145, 78, 2082, 432, 83, 2144, 2065, 1215, 1623,
+	1702, 1703, 1704, 2374, 1767, 1768, 2375, 2374, 2375, 1772,
+	1211, 1549, 1786, 1770, 1536, 1855, 1595, 1533, 1774, 1148,
+	1859, 1860, 1861, 1787, 1838, 1788, 1789, 1829, 1790, 1791,
+	871, 2158, 1882, 1183, 568, 388, 1832, 390, 2187, 51,
+	1797, 1798, 1799, 1800, 1801, 1713, 85, 1715, 1716, 2189,
+	19, 54, 1131, 339, 1862, 1864, 2188, 18, 1670, 1858,
+	80, 1854, 1732, 1745, 1749, 2190, 20, 2191, 21, 2186,
+	15, 1766, 1, 1373, 1847, 1373, 1835, 811, 1834, 2263,
+	1151, 2185, 14, 2179, 10, 1876, 2198, 30, 1877, 2210,
+	1878, 2197, 29, 2212, 1856, 2196, 28, 2126, 1431, 1874,
+	2042, 1010, 1010, 1010, 1010, 1010, 1869, 2194, 25, 1857,
+	2027, 1907, 1908, 1868, 2026, 1771, 927, 1706, 1010, 2193,
+	24, 2195, 26, 2184, 13, 2181, 12, 1705, 1010, 2180,
+	11, 2178, 9, 1889, 1890, 806, 1166, 1912, 1733, 1283,
+	1893, 1484, 2220, 1380, 1370, 570, 91, 1896, 1451, 756,
+	349, 1377, 1658, 2213, 808, 1657, 1881, 1654, 2241, 1180,
+	477, 1672, 1392, 1809, 1810, 1656, 1655, 2208, 1815, 1659,
+	1046, 1818, 1044, 1927, 1045, 1043, 1823, 1048, 1047, 1660,
+	353, 1028, 1200, 1201, 2252, 872, 101, 55, 2054, 1748,
+	1478, 96, 102, 1968, 1946, 765, 1101, 355, 910, 1276,
+	1503, 1629, 1922, 1852, 555, 1921, 1928, 1949, 556, 548,
+	580, 580, 1891, 1939, 2280, 1918, 1892, 1926, 833, 2223,
+	1541, 939, 580, 1266, 451, 1613, 2226, 1202, 1931, 466,
+	1944, 465, 464, 461, 462, 1977, 1458, 927, 1193, 1587,
+	884, 1255, 1256, 1804, 1805, 1806, 1807, 1808, 1903, 1917,
+	121, 564, 449, 441, 1005, 577, 121, 998, 1467, 1919,
+	1324, 1957, 1951, 1322, 1321, 1118, 544, 1595, 1845, 1841,
+	1992, 1335, 729, 1004, 395, 68, 2005, 2006, 2007, 772,
+	368, 1969, 2152, 36, 389, 436, 27, 2015, 17, 778,
+	22, 16, 1476, 725, 40, 43, 2013, 1985, 42, 2003,
+	1701, 1440, 2251, 879, 1373, 1993, 2341, 792, 2362, 78,
+	2008, 2283, 1954, 1955, 32, 1956, 31, 2192, 1958, 2199,
+	1960, 2183, 2182, 2000, 2328, 23, 2327, 4, 2004, 815,
+	69, 33, 566, 2, 0, 1638, 0, 0, 0, 0,
+	0, 1365, 2039, 2040, 2041, 0, 0, 2014, 1008, 2016,
+	0, 0, 0, 0, 2049, 0, 0, 0, 0, 0,
+	1950, 0, 0, 0, 0, 0, 0, 2051, 1953, 0,
+	0, 0, 0, 0, 0, 2038, 2044, 2050, 0, 1962,
+	1963, 2067, 2068, 2058, 2045, 1431, 1874, 1833, 1766, 0,
+	2087, 2052, 1974, 1975, 1976, 2024, 2043, 0, 0, 0,
+	0, 2059, 1855, 0, 121, 121, 121, 0, 2011, 2066,
+	0, 1432, 0, 2090, 0, 0, 0, 0, 0, 0,
+	580, 0, 0, 0, 0, 0, 1987, 1988, 1989, 1990,
+	0, 0, 2017, 1832, 2019, 0, 2114, 0, 2116, 2084,
+	2089, 0, 0, 0, 0, 0, 0, 0, 2094, 2002,
+	2093, 2095, 1342, 2113, 2096, 0, 0, 2072, 2073, 2074,
+	2075, 2076, 2106, 2142, 2105, 2079, 2080, 0, 2100, 0,
+	0, 0, 0, 2117, 0, 2109, 2086, 2110, 0, 0,
+	0, 0, 0, 0, 0, 0, 1833, 0, 78, 0,
+	2070, 0, 0, 2136, 2133, 2134, 835, 2146, 0, 0,
+	0, 0, 0, 2139, 0, 0, 0, 577, 577, 0,
+	2147, 0, 0, 0, 0, 0, 0, 78, 0, 577,
+	0, 0, 2172, 2159, 0, 2164, 0, 0, 0, 0,
+	0, 1008, 1832, 0, 0, 0, 0, 2166, 2163, 1010,
+	0, 0, 2157, 0, 1813, 1814, 0, 1816, 1817, 0,
+	1819, 1820, 1821, 1822, 1523, 1824, 1825, 1826, 0, 0,
+	0, 0, 2077, 0, 0, 2217, 0, 0, 0, 0,
+	2081, 0, 0, 0, 2160, 0, 2218, 1544, 0, 580,
+	2231, 0, 0, 2230, 2247, 0, 1595, 0, 0, 2236,
+	0, 121, 2216, 0, 0, 121, 0, 2237, 2097, 2098,
+	2099, 121, 121, 580, 78, 0, 0, 0, 0, 1972,
+	580, 580, 580, 121, 121, 121, 0, 0, 0, 0,
+	121, 0, 0, 0, 0, 580, 580, 0, 0, 0,
+	0, 0, 0, 2219, 0, 2259, 2261, 0, 2256, 0,
+	0, 2274, 0, 0, 0, 0, 0, 2267, 891, 890,
+	900, 901, 893, 894, 895, 896, 897, 898, 899, 892,
+	0, 0, 902, 0, 0, 2148, 2149, 2150, 2151, 0,
+	2164, 0, 2155, 2156, 0, 0, 2298, 2225, 2229, 0,
+	0, 2290, 0, 0, 121, 580, 121, 78, 580, 0,
+	0, 0, 2305, 78, 2300, 0, 2304, 2302, 2319, 2299,
+	0, 2169, 0, 0, 2297, 2310, 0, 0, 2322, 0,
+	78, 564, 0, 0, 0, 78, 564, 1029, 2332, 0,
+	2331, 2312, 2338, 2330, 0, 2329, 0, 2317, 1010, 0,
+	0, 2350, 0, 0, 78, 121, 0, 78, 78, 2356,
+	0, 879, 78, 2319, 2335, 2232, 1966, 2365, 0, 0,
+	0, 0, 2368, 2238, 0, 0, 0, 0, 0, 78,
+	0, 2372, 78, 2370, 2319, 0, 0, 2383, 2357, 0,
+	2385, 2359, 0, 0, 0, 0, 0, 78, 2393, 78,
+	1979, 1980, 2319, 78, 2319, 0, 1981, 580, 0, 1982,
+	0, 0, 0, 0, 1983, 1965, 2380, 78, 0, 0,
+	78, 2340, 2319, 0, 0, 0, 2229, 78, 0, 2349,
+	0, 78, 2319, 2270, 0, 0, 2319, 2398, 0, 0,
+	0, 0, 0, 580, 580, 580, 0, 0, 0, 2278,
+	0, 0, 0, 0, 2407, 891, 890, 900, 901, 893,
+	894, 895, 896, 897, 898, 899, 892, 0, 0, 902,
+	0, 0, 1795, 0, 0, 0, 0, 0, 0, 0,
+	0, 2386, 0, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 121, 121, 0, 0, 1103, 121, 121, 0,
+	0, 121, 121, 121, 891, 890, 900, 901, 893, 894,
+	895, 896, 897, 898, 899, 892, 0, 0, 902, 0,
+	577, 580, 580, 0, 0, 0, 0, 577, 577, 577,
+	0, 1010, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 577, 577, 1964, 0, 0, 886, 0, 889,
+	0, 0, 1863, 0, 0, 0, 903, 904, 905, 906,
+	907, 908, 909, 1009, 887, 888, 885, 891, 890, 900,
+	901, 893, 894, 895, 896, 897, 898, 899, 892, 0,
+	0, 902, 0, 0, 0, 0, 0, 0, 0, 121,
+	580, 0, 580, 0, 0, 121, 0, 121, 121, 2400,
+	2401, 121, 577, 0, 0, 1182, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 0, 0, 0, 0, 371,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	121, 121, 121, 891, 890, 900, 901, 893, 894, 895,
+	896, 897, 898, 899, 892, 0, 0, 902, 0, 0,
+	0, 0, 121, 545, 121, 0, 0, 569, 0, 0,
+	0, 716, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 726, 0, 0, 0, 0, 0, 0,
+	0, 736, 0, 1941, 1769, 0, 0, 0, 0, 1248,
+	1253, 0, 0, 439, 1259, 1262, 1263, 1264, 0, 0,
+	0, 0, 0, 0, 1242, 891, 890, 900, 901, 893,
+	894, 895, 896, 897, 898, 899, 892, 0, 0, 902,
+	0, 0, 564, 0, 1281, 1971, 1284, 1285, 1286, 1287,
+	0, 0, 0, 1291, 0, 1293, 1294, 0, 0, 0,
+	1272, 1273, 1274, 1301, 1302, 1303, 0, 1305, 1306, 0,
+	1308, 1309, 1310, 1311, 0, 1313, 1314, 1315, 0, 0,
+	0, 0, 927, 0, 0, 0, 0, 0, 0, 1994,
+	0, 0, 1995, 0, 0, 1997, 0, 0, 0, 0,
+	0, 0, 0, 0, 927, 1550, 891, 890, 900, 901,
+	893, 894, 895, 896, 897, 898, 899, 892, 0, 0,
+	902, 564, 0, 0, 121, 121, 121, 121, 121, 0,
+	0, 1520, 0, 0, 0, 577, 121, 0, 577, 577,
+	0, 121, 0, 0, 0, 121, 0, 0, 0, 0,
+	0, 121, 891, 890, 900, 901, 893, 894, 895, 896,
+	897, 898, 899, 892, 0, 0, 902, 0, 0, 0,
+	0, 0, 0, 0, 0, 580, 891, 890, 900, 901,
+	893, 894, 895, 896, 897, 898, 899, 892, 0, 0,
+	902, 890, 900, 901, 893, 894, 895, 896, 897, 898,
+	899, 892, 0, 0, 902, 0, 0, 577, 0, 577,
+	34, 35, 70, 37, 38, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 61, 0, 0, 0, 0, 0,
+	76, 0, 0, 0, 39, 65, 66, 0, 580, 0,
+	0, 62, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 580, 121, 580, 580, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2108, 0, 0, 0, 49, 0,
+	813, 0, 79, 0, 0, 0, 821, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 577, 0, 0, 0, 0, 0, 0,
+	0, 580, 580, 0, 0, 0, 0, 121, 0, 0,
+	0, 0, 0, 0, 0, 0, 439, 580, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 927, 0, 0, 41, 72, 45, 44, 47, 0,
+	58, 0, 0, 0, 0, 1540, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 580, 0, 0, 48, 75, 74, 0,
+	0, 56, 57, 46, 0, 865, 0, 0, 0, 1570,
+	1571, 0, 0, 0, 1575, 0, 0, 1578, 0, 0,
+	0, 0, 1583, 2224, 2228, 580, 580, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 59, 60, 0, 580,
+	564, 0, 119, 0, 1000, 365, 1011, 0, 0, 50,
+	73, 119, 52, 53, 63, 0, 64, 0, 0, 580,
+	0, 580, 0, 580, 0, 580, 0, 0, 0, 2248,
+	2249, 0, 0, 398, 0, 0, 564, 0, 0, 0,
+	0, 0, 440, 0, 0, 547, 565, 0, 0, 119,
+	0, 0, 577, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 121, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 121, 0, 0, 0,
+	0, 0, 2228, 0, 0, 0, 0, 0, 0, 121,
+	0, 71, 0, 0, 2311, 1698, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 577, 580,
+	577, 577, 121, 580, 34, 0, 70, 37, 38, 0,
+	580, 580, 0, 0, 0, 0, 0, 0, 61, 0,
+	0, 0, 0, 0, 76, 0, 0, 0, 39, 77,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1759, 1760,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 545, 0, 2384, 577, 1119, 79, 0, 0, 0,
+	0, 0, 545, 0, 0, 0, 0, 0, 577, 0,
+	0, 0, 0, 1137, 1138, 1139, 0, 0, 0, 2200,
+	1140, 0, 2361, 2364, 2360, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 580, 0, 0, 0, 0,
+	1802, 0, 0, 580, 580, 580, 0, 0, 0, 0,
+	0, 0, 580, 0, 0, 0, 0, 0, 41, 72,
+	45, 44, 47, 580, 0, 0, 0, 0, 0, 564,
+	0, 0, 1182, 1837, 2201, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1176, 0, 0, 0, 0, 0,
+	48, 75, 74, 121, 0, 0, 1837, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 577, 0, 577, 0,
+	577, 0, 1872, 0, 0, 0, 0, 0, 0, 580,
+	0, 121, 0, 0, 0, 1199, 580, 0, 0, 0,
+	59, 60, 119, 2202, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 2203, 73, 0, 52, 53, 63, 0,
+	64, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 580, 0, 0, 0, 0,
+	580, 0, 0, 0, 121, 0, 121, 0, 121, 0,
+	0, 0, 0, 0, 580, 0, 0, 0, 34, 0,
+	70, 37, 38, 0, 0, 0, 0, 580, 0, 0,
+	0, 0, 61, 0, 0, 0, 0, 0, 76, 0,
+	0, 0, 39, 0, 0, 0, 1934, 0, 0, 0,
+	1938, 0, 0, 0, 0, 0, 0, 1942, 1943, 0,
+	0, 580, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 71, 0, 0, 0, 0,
+	79, 0, 0, 1318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 580, 0, 1349, 0,
+	0, 0, 0, 2200, 0, 0, 0, 0, 2409, 0,
+	0, 0, 0, 0, 0, 0, 119, 1007, 119, 0,
+	0, 0, 0, 77, 0, 0, 565, 0, 0, 0,
+	0, 565, 0, 0, 121, 0, 0, 0, 0, 580,
+	564, 0, 41, 72, 45, 44, 47, 0, 0, 0,
+	0, 0, 1999, 0, 0, 0, 0, 0, 2201, 0,
+	1999, 1999, 1999, 0, 0, 0, 0, 0, 0, 577,
+	0, 0, 121, 0, 48, 75, 74, 0, 0, 0,
+	1999, 46, 0, 0, 0, 0, 0, 0, 0, 1436,
+	0, 0, 0, 0, 0, 1444, 0, 1445, 1446, 0,
+	0, 1447, 0, 0, 0, 0, 580, 0, 0, 34,
+	0, 70, 37, 38, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 59, 60, 580, 2202, 0, 76,
+	0, 0, 1457, 39, 0, 0, 0, 2203, 73, 0,
+	52, 53, 63, 0, 64, 0, 2063, 0, 0, 0,
+	0, 0, 813, 577, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 580, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 580, 0, 0, 0, 0, 0, 0,
+	0, 0, 2088, 0, 2200, 580, 0, 1999, 0, 2405,
+	0, 0, 0, 119, 0, 0, 0, 119, 0, 0,
+	0, 1872, 0, 1125, 119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1872, 119, 119, 119, 0, 0,
+	0, 0, 119, 41, 72, 45, 44, 47, 0, 71,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2201,
+	0, 0, 0, 0, 0, 0, 0, 0, 2138, 0,
+	0, 0, 0, 0, 0, 48, 75, 74, 0, 0,
+	0, 0, 46, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 77, 0, 0,
+	0, 0, 0, 2162, 0, 0, 119, 0, 398, 0,
+	1068, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 59, 60, 0, 2202, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2203, 73,
+	0, 52, 53, 63, 0, 64, 1872, 0, 0, 0,
+	0, 1099, 0, 0, 0, 0, 0, 119, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1125, 564,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1055, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 577, 0, 1252, 1252, 0, 0, 0,
+	1252, 1252, 1252, 1252, 0, 0, 0, 565, 0, 0,
+	0, 0, 0, 2272, 0, 0, 0, 0, 0, 0,
+	71, 0, 1714, 0, 0, 1069, 0, 0, 0, 0,
+	1252, 1252, 1252, 1252, 1252, 1252, 0, 0, 1252, 1252,
+	1252, 1252, 1252, 0, 0, 0, 0, 0, 0, 1252,
+	1252, 1252, 0, 1252, 1252, 0, 1252, 1252, 1252, 1252,
+	1872, 1252, 1252, 1252, 0, 119, 0, 0, 77, 0,
+	1999, 0, 0, 0, 1007, 398, 0, 1762, 0, 1007,
+	119, 0, 577, 1007, 1352, 1125, 565, 0, 0, 0,
+	0, 0, 0, 1082, 1085, 1086, 1087, 1088, 1089, 1090,
+	1125, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 0, 1070,
+	1071, 1072, 1073, 1049, 1053, 1083, 1050, 1056, 1052, 1054,
+	1051, 0, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
+	1065, 1066, 1067, 1074, 1075, 1076, 1077, 1078, 1079, 1080,
+	1081, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 0, 0, 0, 0, 0, 119, 0, 119,
+	119, 0, 0, 119, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1068, 1455, 1456, 119, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1084, 119, 0, 398, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1125, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1906, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1916, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1920,
+	0, 0, 0, 0, 1055, 0, 0, 0, 0, 1252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1937, 0, 0, 0, 0, 0, 0, 0,
+	0, 1252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1069, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1252, 1252, 0, 0, 0,
+	1252, 0, 0, 1252, 0, 0, 0, 0, 1252, 0,
+	34, 0, 70, 37, 38, 565, 1007, 1007, 1007, 1007,
+	1007, 0, 0, 0, 61, 0, 0, 0, 398, 1252,
+	76, 0, 0, 1007, 39, 0, 0, 398, 0, 0,
+	0, 0, 0, 1007, 1082, 1085, 1086, 1087, 1088, 1089,
+	1090, 565, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 0,
+	1070, 1071, 1072, 1073, 1049, 1053, 1083, 1050, 1056, 1052,
+	1054, 1051, 79, 1057, 1058, 1059, 1060, 1061, 1062, 1063,
+	1064, 1065, 1066, 1067, 1074, 1075, 1076, 1077, 1078, 1079,
+	1080, 1081, 0, 0, 0, 2200, 0, 0, 0, 0,
+	2394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2025, 0, 0, 0, 0, 0, 34,
+	0, 70, 37, 38, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 61, 41, 72, 45, 44, 47, 76,
+	0, 0, 0, 39, 119, 0, 0, 0, 0, 0,
+	2201, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 48, 75, 74, 0,
+	0, 0, 0, 46, 0, 0, 0, 0, 0, 0,
+	0, 79, 0, 0, 1084, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 34, 0, 70, 37, 38, 119,
+	0, 0, 0, 0, 2200, 0, 0, 0, 61, 2377,
+	1252, 0, 0, 0, 76, 0, 59, 60, 39, 2202,
+	0, 1252, 0, 1125, 0, 0, 0, 0, 0, 2203,
+	73, 0, 52, 53, 63, 0, 64, 0, 0, 0,
+	0, 0, 0, 41, 72, 45, 44, 47, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 2366, 0, 2201,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 48, 75, 74, 0, 2200,
+	0, 0, 46, 0, 565, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 41, 72,
+	45, 44, 47, 0, 0, 59, 60, 0, 2202, 0,
+	0, 71, 0, 0, 2201, 0, 0, 0, 2203, 73,
+	0, 52, 53, 63, 0, 64, 0, 0, 0, 0,
+	48, 75, 74, 0, 0, 0, 0, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 34, 0, 70, 37,
+	38, 0, 2240, 0, 34, 0, 70, 37, 38, 77,
+	61, 0, 0, 0, 0, 0, 76, 0, 61, 0,
+	39, 0, 0, 119, 76, 0, 0, 0, 39, 0,
+	59, 60, 0, 2202, 0, 0, 0, 0, 119, 0,
+	0, 0, 0, 2203, 73, 0, 52, 53, 63, 0,
+	64, 119, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
+	71, 0, 0, 0, 119, 0, 0, 0, 0, 0,
+	0, 2200, 0, 0, 0, 0, 2318, 0, 0, 2200,
+	440, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 77, 0,
+	41, 72, 45, 44, 47, 0, 0, 0, 41, 72,
+	45, 44, 47, 0, 0, 0, 2201, 0, 0, 0,
+	0, 0, 0, 0, 2201, 71, 0, 0, 0, 0,
+	0, 0, 48, 75, 74, 0, 0, 0, 0, 46,
+	48, 75, 74, 0, 0, 565, 0, 46, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 77, 0, 0, 0, 0, 0, 0,
+	0, 0, 59, 60, 0, 2202, 0, 0, 0, 0,
+	59, 60, 0, 2202, 0, 2203, 73, 0, 52, 53,
+	63, 0, 64, 2203, 73, 0, 52, 53, 63, 0,
+	64, 0, 0, 0, 0, 119, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1007, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 71, 0, 0,
+	0, 0, 0, 0, 0, 71, 398, 0, 398, 0,
+	398, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 77, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 440, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	698, 678, 303, 636, 701, 608, 625, 712, 626, 629,
+	667, 594, 648, 236, 623, 595, 0, 612, 585, 619,
+	586, 609, 638, 167, 607, 680, 651, 700, 198, 663,
+	0, 158, 206, 204, 0, 0, 1007, 242, 300, 699,
+	644, 0, 707, 201, 0, 660, 325, 291, 220, 0,
+	0, 640, 687, 646, 676, 635, 669, 601, 659, 702,
+	624, 665, 703, 0, 565, 0, 2250, 0, 0, 0,
+	0, 0, 0, 0, 119, 148, 0, 662, 697, 621,
+	664, 666, 583, 661, 0, 589, 596, 711, 693, 615,
+	616, 617, 0, 0, 0, 0, 0, 0, 0, 639,
+	647, 673, 632, 0, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 657, 0, 0, 0, 597, 590, 0,
+	0, 637, 0, 0, 0, 600, 126, 614, 674, 0,
+	581, 177, 221, 138, 677, 692, 634, 191, 331, 696,
+	631, 630, 256, 0, 296, 180, 199, 142, 123, 136,
+	152, 179, 231, 265, 275, 622, 582, 681, 610, 620,
+	159, 618, 268, 240, 320, 0, 654, 246, 267, 202,
+	309, 258, 318, 319, 181, 301, 328, 334, 288, 168,
+	0, 128, 0, 253, 163, 195, 633, 668, 611, 156,
+	671, 658, 686, 287, 307, 143, 304, 219, 225, 153,
+	155, 154, 137, 282, 306, 147, 157, 292, 271, 297,
+	162, 0, 0, 2253, 2254, 2255, 0, 0, 0, 0,
+	129, 299, 317, 149, 279, 280, 335, 266, 131, 315,
+	295, 217, 192, 193, 130, 0, 263, 166, 176, 161,
+	235, 0, 175, 255, 312, 313, 160, 337, 139, 327,
+	133, 140, 326, 228, 0, 227, 329, 308, 316, 218,
+	210, 0, 132, 314, 216, 209, 197, 171, 184, 251,
+	205, 252, 185, 223, 222, 224, 207, 211, 233, 0,
+	587, 0, 293, 323, 338, 182, 127, 302, 332, 145,
+	606, 281, 305, 0, 0, 146, 174, 170, 250, 226,
+	141, 187, 290, 196, 203, 262, 336, 239, 269, 150,
+	322, 289, 604, 605, 602, 0, 603, 649, 650, 704,
+	705, 706, 675, 598, 0, 688, 689, 0, 679, 694,
+	695, 0, 0, 0, 670, 713, 627, 628, 588, 591,
+	592, 593, 599, 641, 642, 653, 656, 684, 683, 682,
+	685, 690, 709, 708, 710, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 652, 122, 134, 200,
+	714, 260, 173, 324, 584, 165, 0, 0, 643, 645,
+	655, 672, 124, 125, 135, 144, 151, 164, 169, 172,
+	178, 183, 186, 188, 189, 190, 194, 208, 212, 213,
+	214, 215, 229, 230, 232, 234, 237, 238, 241, 243,
+	244, 245, 247, 248, 249, 254, 257, 259, 261, 264,
+	270, 272, 273, 274, 276, 277, 278, 283, 284, 285,
+	286, 294, 298, 310, 311, 321, 330, 333, 691, 698,
+	678, 303, 636, 701, 608, 625, 712, 626, 629, 667,
+	594, 648, 236, 623, 595, 0, 612, 585, 619, 586,
+	609, 638, 167, 607, 680, 651, 700, 198, 663, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 699, 644,
+	0, 707, 201, 0, 660, 325, 291, 220, 0, 0,
+	640, 687, 646, 676, 635, 669, 601, 659, 702, 624,
+	665, 703, 0, 0, 0, 730, 0, 1374, 1375, 0,
+	0, 0, 0, 0, 148, 0, 662, 697, 621, 664,
+	666, 583, 661, 0, 589, 596, 711, 693, 615, 616,
+	617, 1639, 0, 0, 0, 0, 0, 0, 639, 647,
+	673, 632, 0, 0, 0, 0, 0, 0, 0, 0,
+	613, 0, 657, 0, 0, 0, 597, 590, 0, 0,
+	637, 0, 0, 0, 600, 126, 614, 674, 0, 581,
+	177, 221, 138, 677, 692, 634, 191, 331, 696, 631,
+	630, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 622, 582, 681, 610, 620, 159,
+	618, 268, 240, 320, 0, 654, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 633, 668, 611, 156, 671,
+	658, 686, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 587,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 606,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 604, 605, 602, 0, 603, 649, 650, 704, 705,
+	706, 675, 598, 0, 688, 689, 0, 679, 694, 695,
+	0, 0, 0, 670, 713, 627, 628, 588, 591, 592,
+	593, 599, 641, 642, 653, 656, 684, 683, 682, 685,
+	690, 709, 708, 710, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 652, 122, 134, 200, 714,
+	260, 173, 324, 584, 165, 0, 0, 643, 645, 655,
+	672, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 691, 698, 678,
+	303, 636, 701, 608, 625, 712, 626, 629, 667, 594,
+	648, 236, 623, 595, 0, 612, 585, 619, 586, 609,
+	638, 167, 607, 680, 651, 700, 198, 663, 0, 158,
+	206, 204, 0, 0, 0, 242, 300, 699, 644, 0,
+	707, 201, 0, 660, 325, 291, 220, 0, 0, 640,
+	687, 646, 676, 635, 669, 601, 659, 702, 624, 665,
+	703, 0, 0, 0, 730, 0, 1374, 1375, 0, 0,
+	0, 0, 0, 148, 0, 662, 697, 621, 664, 666,
+	583, 661, 0, 589, 596, 711, 693, 615, 616, 617,
+	0, 0, 0, 0, 0, 0, 0, 639, 647, 673,
+	632, 0, 0, 0, 0, 0, 0, 0, 0, 613,
+	0, 657, 0, 0, 0, 597, 590, 0, 0, 637,
+	0, 0, 0, 600, 126, 614, 674, 0, 581, 177,
+	221, 138, 677, 692, 634, 191, 331, 696, 631, 630,
+	256, 0, 296, 180, 199, 142, 123, 136, 152, 179,
+	231, 265, 275, 622, 582, 681, 610, 620, 159, 618,
+	268, 240, 320, 0, 654, 246, 267, 202, 309, 258,
+	318, 319, 181, 301, 328, 334, 288, 168, 0, 128,
+	0, 253, 163, 195, 633, 668, 611, 156, 671, 658,
+	686, 287, 307, 143, 304, 219, 225, 153, 155, 154,
+	137, 282, 306, 147, 157, 292, 271, 297, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 299,
+	317, 149, 279, 280, 335, 266, 131, 315, 295, 217,
+	192, 193, 130, 0, 263, 166, 176, 161, 235, 0,
+	175, 255, 312, 313, 160, 337, 139, 327, 133, 140,
+	326, 228, 0, 227, 329, 308, 316, 218, 210, 0,
+	132, 314, 216, 209, 197, 171, 184, 251, 205, 252,
+	185, 223, 222, 224, 207, 211, 233, 0, 587, 0,
+	293, 323, 338, 182, 127, 302, 332, 145, 606, 281,
+	305, 0, 0, 146, 174, 170, 250, 226, 141, 187,
+	290, 196, 203, 262, 336, 239, 269, 150, 322, 289,
+	604, 605, 602, 0, 603, 649, 650, 704, 705, 706,
+	675, 598, 0, 688, 689, 0, 679, 694, 695, 0,
+	0, 0, 670, 713, 627, 628, 588, 591, 592, 593,
+	599, 641, 642, 653, 656, 684, 683, 682, 685, 690,
+	709, 708, 710, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 652, 122, 134, 200, 714, 260,
+	173, 324, 584, 165, 0, 0, 643, 645, 655, 672,
+	124, 125, 135, 144, 151, 164, 169, 172, 178, 183,
+	186, 188, 189, 190, 194, 208, 212, 213, 214, 215,
+	229, 230, 232, 234, 237, 238, 241, 243, 244, 245,
+	247, 248, 249, 254, 257, 259, 261, 264, 270, 272,
+	273, 274, 276, 277, 278, 283, 284, 285, 286, 294,
+	298, 310, 311, 321, 330, 333, 691, 698, 678, 303,
+	636, 701, 608, 625, 712, 626, 629, 667, 594, 648,
+	236, 623, 595, 0, 612, 585, 619, 586, 609, 638,
+	167, 607, 680, 651, 700, 198, 663, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 699, 644, 0, 707,
+	201, 0, 660, 325, 291, 220, 0, 0, 640, 687,
+	646, 676, 635, 669, 601, 659, 702, 624, 665, 703,
+	0, 0, 0, 730, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 0, 662, 697, 621, 664, 666, 583,
+	661, 0, 589, 596, 711, 693, 615, 616, 617, 0,
+	0, 0, 0, 0, 0, 0, 639, 647, 673, 632,
+	0, 0, 0, 0, 0, 0, 2069, 0, 613, 0,
+	657, 0, 0, 0, 597, 590, 0, 0, 637, 0,
+	0, 0, 600, 126, 614, 674, 0, 581, 177, 221,
+	138, 677, 692, 634, 191, 331, 696, 631, 630, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 622, 582, 681, 610, 620, 159, 618, 268,
+	240, 320, 0, 654, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 633, 668, 611, 156, 671, 658, 686,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 587, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 606, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 604,
+	605, 602, 0, 603, 649, 650, 704, 705, 706, 675,
+	598, 0, 688, 689, 0, 679, 694, 695, 0, 0,
+	0, 670, 713, 627, 628, 588, 591, 592, 593, 599,
+	641, 642, 653, 656, 684, 683, 682, 685, 690, 709,
+	708, 710, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 652, 122, 134, 200, 714, 260, 173,
+	324, 584, 165, 0, 0, 643, 645, 655, 672, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 691, 698, 678, 303, 636,
+	701, 608, 625, 712, 626, 629, 667, 594, 648, 236,
+	623, 595, 0, 612, 585, 619, 586, 609, 638, 167,
+	607, 680, 651, 700, 198, 663, 0, 158, 206, 204,
+	0, 0, 0, 242, 300, 699, 644, 0, 707, 201,
+	0, 660, 325, 291, 220, 0, 0, 640, 687, 646,
+	676, 635, 669, 601, 659, 702, 624, 665, 703, 0,
+	0, 0, 445, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 0, 662, 697, 621, 664, 666, 583, 661,
+	0, 589, 596, 711, 693, 615, 616, 617, 0, 0,
+	0, 0, 0, 0, 0, 639, 647, 673, 632, 0,
+	0, 0, 0, 0, 0, 1773, 0, 613, 0, 657,
+	0, 0, 0, 597, 590, 0, 0, 637, 0, 0,
+	0, 600, 126, 614, 674, 0, 581, 177, 221, 138,
+	677, 692, 634, 191, 331, 696, 631, 630, 256, 0,
+	296, 180, 199, 142, 123, 136, 152, 179, 231, 265,
+	275, 622, 582, 681, 610, 620, 159, 618, 268, 240,
+	320, 0, 654, 246, 267, 202, 309, 258, 318, 319,
+	181, 301, 328, 334, 288, 168, 0, 128, 0, 253,
+	163, 195, 633, 668, 611, 156, 671, 658, 686, 287,
+	307, 143, 304, 219, 225, 153, 155, 154, 137, 282,
+	306, 147, 157, 292, 271, 297, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 299, 317, 149,
+	279, 280, 335, 266, 131, 315, 295, 217, 192, 193,
+	130, 0, 263, 166, 176, 161, 235, 0, 175, 255,
+	312, 313, 160, 337, 139, 327, 133, 140, 326, 228,
+	0, 227, 329, 308, 316, 218, 210, 0, 132, 314,
+	216, 209, 197, 171, 184, 251, 205, 252, 185, 223,
+	222, 224, 207, 211, 233, 0, 587, 0, 293, 323,
+	338, 182, 127, 302, 332, 145, 606, 281, 305, 0,
+	0, 146, 174, 170, 250, 226, 141, 187, 290, 196,
+	203, 262, 336, 239, 269, 150, 322, 289, 604, 605,
+	602, 0, 603, 649, 650, 704, 705, 706, 675, 598,
+	0, 688, 689, 0, 679, 694, 695, 0, 0, 0,
+	670, 713, 627, 628, 588, 591, 592, 593, 599, 641,
+	642, 653, 656, 684, 683, 682, 685, 690, 709, 708,
+	710, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 652, 122, 134, 200, 714, 260, 173, 324,
+	584, 165, 0, 0, 643, 645, 655, 672, 124, 125,
+	135, 144, 151, 164, 169, 172, 178, 183, 186, 188,
+	189, 190, 194, 208, 212, 213, 214, 215, 229, 230,
+	232, 234, 237, 238, 241, 243, 244, 245, 247, 248,
+	249, 254, 257, 259, 261, 264, 270, 272, 273, 274,
+	276, 277, 278, 283, 284, 285, 286, 294, 298, 310,
+	311, 321, 330, 333, 691, 698, 678, 303, 636, 701,
+	608, 625, 712, 626, 629, 667, 594, 648, 236, 623,
+	595, 0, 612, 585, 619, 586, 609, 638, 167, 607,
+	680, 651, 700, 198, 663, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 699, 644, 0, 707, 201, 0,
+	660, 325, 291, 220, 0, 0, 640, 687, 646, 676,
+	635, 669, 601, 659, 702, 624, 665, 703, 0, 0,
+	0, 730, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 662, 697, 621, 664, 666, 583, 661, 0,
+	589, 596, 711, 693, 615, 616, 617, 0, 0, 0,
+	0, 0, 0, 0, 639, 647, 673, 632, 0, 0,
+	0, 0, 0, 0, 1765, 0, 613, 0, 657, 0,
+	0, 0, 597, 590, 0, 0, 637, 0, 0, 0,
+	600, 126, 614, 674, 0, 581, 177, 221, 138, 677,
+	692, 634, 191, 331, 696, 631, 630, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	622, 582, 681, 610, 620, 159, 618, 268, 240, 320,
+	0, 654, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 633, 668, 611, 156, 671, 658, 686, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 587, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 606, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 604, 605, 602,
+	0, 603, 649, 650, 704, 705, 706, 675, 598, 0,
+	688, 689, 0, 679, 694, 695, 0, 0, 0, 670,
+	713, 627, 628, 588, 591, 592, 593, 599, 641, 642,
+	653, 656, 684, 683, 682, 685, 690, 709, 708, 710,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 652, 122, 134, 200, 714, 260, 173, 324, 584,
+	165, 0, 0, 643, 645, 655, 672, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 691, 698, 678, 303, 636, 701, 608,
+	625, 712, 626, 629, 667, 594, 648, 236, 623, 595,
+	0, 612, 585, 619, 586, 609, 638, 167, 607, 680,
+	651, 700, 198, 663, 0, 158, 206, 204, 0, 0,
+	0, 242, 300, 699, 644, 0, 707, 201, 0, 660,
+	325, 291, 220, 0, 0, 640, 687, 646, 676, 635,
+	669, 601, 659, 702, 624, 665, 703, 79, 0, 0,
+	730, 0, 0, 0, 0, 0, 0, 0, 0, 148,
+	0, 662, 697, 621, 664, 666, 583, 661, 0, 589,
+	596, 711, 693, 615, 616, 617, 0, 0, 0, 0,
+	0, 0, 0, 639, 647, 673, 632, 0, 0, 0,
+	0, 0, 0, 0, 0, 613, 0, 657, 0, 0,
+	0, 597, 590, 0, 0, 637, 0, 0, 0, 600,
+	126, 614, 674, 0, 581, 177, 221, 138, 677, 692,
+	634, 191, 331, 696, 631, 630, 256, 0, 296, 180,
+	199, 142, 123, 136, 152, 179, 231, 265, 275, 622,
+	582, 681, 610, 620, 159, 618, 268, 240, 320, 0,
+	654, 246, 267, 202, 309, 258, 318, 319, 181, 301,
+	328, 334, 288, 168, 0, 128, 0, 253, 163, 195,
+	633, 668, 611, 156, 671, 658, 686, 287, 307, 143,
+	304, 219, 225, 153, 155, 154, 137, 282, 306, 147,
+	157, 292, 271, 297, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 299, 317, 149, 279, 280,
+	335, 266, 131, 315, 295, 217, 192, 193, 130, 0,
+	263, 166, 176, 161, 235, 0, 175, 255, 312, 313,
+	160, 337, 139, 327, 133, 140, 326, 228, 0, 227,
+	329, 308, 316, 218, 210, 0, 132, 314, 216, 209,
+	197, 171, 184, 251, 205, 252, 185, 223, 222, 224,
+	207, 211, 233, 0, 587, 0, 293, 323, 338, 182,
+	127, 302, 332, 145, 606, 281, 305, 0, 0, 146,
+	174, 170, 250, 226, 141, 187, 290, 196, 203, 262,
+	336, 239, 269, 150, 322, 289, 604, 605, 602, 0,
+	603, 649, 650, 704, 705, 706, 675, 598, 0, 688,
+	689, 0, 679, 694, 695, 0, 0, 0, 670, 713,
+	627, 628, 588, 591, 592, 593, 599, 641, 642, 653,
+	656, 684, 683, 682, 685, 690, 709, 708, 710, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	652, 122, 134, 200, 714, 260, 173, 324, 584, 165,
+	0, 0, 643, 645, 655, 672, 124, 125, 135, 144,
+	151, 164, 169, 172, 178, 183, 186, 188, 189, 190,
+	194, 208, 212, 213, 214, 215, 229, 230, 232, 234,
+	237, 238, 241, 243, 244, 245, 247, 248, 249, 254,
+	257, 259, 261, 264, 270, 272, 273, 274, 276, 277,
+	278, 283, 284, 285, 286, 294, 298, 310, 311, 321,
+	330, 333, 691, 698, 678, 303, 636, 701, 608, 625,
+	712, 626, 629, 667, 594, 648, 236, 623, 595, 0,
+	612, 585, 619, 586, 609, 638, 167, 607, 680, 651,
+	700, 198, 663, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 699, 644, 0, 707, 201, 0, 660, 325,
+	291, 220, 0, 0, 640, 687, 646, 676, 635, 669,
+	601, 659, 702, 624, 665, 703, 0, 0, 0, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
+	662, 697, 621, 664, 666, 583, 661, 0, 589, 596,
+	711, 693, 615, 616, 617, 0, 0, 0, 0, 0,
+	0, 0, 639, 647, 673, 632, 0, 0, 0, 0,
+	0, 0, 1353, 0, 613, 0, 657, 0, 0, 0,
+	597, 590, 0, 0, 637, 0, 0, 0, 600, 126,
+	614, 674, 0, 581, 177, 221, 138, 677, 692, 634,
+	191, 331, 696, 631, 630, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 622, 582,
+	681, 610, 620, 159, 618, 268, 240, 320, 0, 654,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 633,
+	668, 611, 156, 671, 658, 686, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 587, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 606, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 604, 605, 602, 0, 603,
+	649, 650, 704, 705, 706, 675, 598, 0, 688, 689,
+	0, 679, 694, 695, 0, 0, 0, 670, 713, 627,
+	628, 588, 591, 592, 593, 599, 641, 642, 653, 656,
+	684, 683, 682, 685, 690, 709, 708, 710, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 652,
+	122, 134, 200, 714, 260, 173, 324, 584, 165, 0,
+	0, 643, 645, 655, 672, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 691, 698, 678, 303, 636, 701, 608, 625, 712,
+	626, 629, 667, 594, 648, 236, 623, 595, 0, 612,
+	585, 619, 586, 609, 638, 167, 607, 680, 651, 700,
+	198, 663, 0, 158, 206, 204, 0, 0, 0, 242,
+	300, 699, 644, 0, 707, 201, 0, 660, 325, 291,
+	220, 0, 0, 640, 687, 646, 676, 635, 669, 601,
+	659, 702, 624, 665, 703, 0, 0, 0, 445, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 0, 662,
+	697, 621, 664, 666, 583, 661, 0, 589, 596, 711,
+	693, 615, 616, 617, 0, 0, 0, 0, 0, 0,
+	0, 639, 647, 673, 632, 0, 0, 0, 0, 0,
+	0, 1208, 0, 613, 0, 657, 0, 0, 0, 597,
+	590, 0, 0, 637, 0, 0, 0, 600, 126, 614,
+	674, 0, 581, 177, 221, 138, 677, 692, 634, 191,
+	331, 696, 631, 630, 256, 0, 296, 180, 199, 142,
+	123, 136, 152, 179, 231, 265, 275, 622, 582, 681,
+	610, 620, 159, 618, 268, 240, 320, 0, 654, 246,
+	267, 202, 309, 258, 318, 319, 181, 301, 328, 334,
+	288, 168, 0, 128, 0, 253, 163, 195, 633, 668,
+	611, 156, 671, 658, 686, 287, 307, 143, 304, 219,
+	225, 153, 155, 154, 137, 282, 306, 147, 157, 292,
+	271, 297, 162, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 129, 299, 317, 149, 279, 280, 335, 266,
+	131, 315, 295, 217, 192, 193, 130, 0, 263, 166,
+	176, 161, 235, 0, 175, 255, 312, 313, 160, 337,
+	139, 327, 133, 140, 326, 228, 0, 227, 329, 308,
+	316, 218, 210, 0, 132, 314, 216, 209, 197, 171,
+	184, 251, 205, 252, 185, 223, 222, 224, 207, 211,
+	233, 0, 587, 0, 293, 323, 338, 182, 127, 302,
+	332, 145, 606, 281, 305, 0, 0, 146, 174, 170,
+	250, 226, 141, 187, 290, 196, 203, 262, 336, 239,
+	269, 150, 322, 289, 604, 605, 602, 0, 603, 649,
+	650, 704, 705, 706, 675, 598, 0, 688, 689, 0,
+	679, 694, 695, 0, 0, 0, 670, 713, 627, 628,
+	588, 591, 592, 593, 599, 641, 642, 653, 656, 684,
+	683, 682, 685, 690, 709, 708, 710, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 652, 122,
+	134, 200, 714, 260, 173, 324, 584, 165, 0, 0,
+	643, 645, 655, 672, 124, 125, 135, 144, 151, 164,
+	169, 172, 178, 183, 186, 188, 189, 190, 194, 208,
+	212, 213, 214, 215, 229, 230, 232, 234, 237, 238,
+	241, 243, 244, 245, 247, 248, 249, 254, 257, 259,
+	261, 264, 270, 272, 273, 274, 276, 277, 278, 283,
+	284, 285, 286, 294, 298, 310, 311, 321, 330, 333,
+	691, 698, 678, 303, 636, 701, 608, 625, 712, 626,
+	629, 667, 594, 648, 236, 623, 595, 0, 612, 585,
+	619, 586, 609, 638, 167, 607, 680, 651, 700, 198,
+	663, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	699, 644, 0, 707, 201, 0, 660, 325, 291, 220,
+	0, 0, 640, 687, 646, 676, 635, 669, 601, 659,
+	702, 624, 665, 703, 0, 0, 0, 730, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 662, 697,
+	621, 664, 666, 583, 661, 0, 589, 596, 711, 693,
+	615, 616, 617, 0, 0, 0, 0, 0, 0, 0,
+	639, 647, 673, 632, 0, 0, 0, 0, 0, 0,
+	0, 0, 613, 0, 657, 0, 0, 0, 597, 590,
+	0, 0, 637, 0, 0, 0, 600, 126, 614, 674,
+	0, 581, 177, 221, 138, 677, 692, 634, 191, 331,
+	696, 631, 630, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 622, 582, 681, 610,
+	620, 159, 618, 268, 240, 320, 0, 654, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 633, 668, 611,
+	156, 671, 658, 686, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 587, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 606, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 604, 605, 602, 0, 603, 649, 650,
+	704, 705, 706, 675, 598, 0, 688, 689, 0, 679,
+	694, 695, 0, 0, 0, 670, 713, 627, 628, 588,
+	591, 592, 593, 599, 641, 642, 653, 656, 684, 683,
+	682, 685, 690, 709, 708, 710, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 652, 122, 134,
+	200, 714, 260, 173, 324, 584, 165, 0, 0, 643,
+	645, 655, 672, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 691,
+	698, 678, 303, 636, 701, 608, 625, 712, 626, 629,
+	667, 594, 648, 236, 623, 595, 0, 612, 585, 619,
+	586, 609, 638, 167, 607, 680, 651, 700, 198, 663,
+	0, 158, 206, 204, 0, 0, 0, 242, 300, 699,
+	644, 0, 707, 201, 0, 660, 325, 291, 220, 0,
+	0, 640, 687, 646, 676, 635, 669, 601, 659, 702,
+	624, 665, 703, 0, 0, 0, 445, 0, 0, 0,
+	0, 0, 0, 0, 0, 148, 0, 662, 697, 621,
+	664, 666, 583, 661, 0, 589, 596, 711, 693, 615,
+	616, 617, 0, 0, 0, 0, 0, 0, 0, 639,
+	647, 673, 632, 0, 0, 0, 0, 0, 0, 0,
+	0, 613, 0, 657, 0, 0, 0, 597, 590, 0,
+	0, 637, 0, 0, 0, 600, 126, 614, 674, 0,
+	581, 177, 221, 138, 677, 692, 634, 191, 331, 696,
+	631, 630, 256, 0, 296, 180, 199, 142, 123, 136,
+	152, 179, 231, 265, 275, 622, 582, 681, 610, 620,
+	159, 618, 268, 240, 320, 0, 654, 246, 267, 202,
+	309, 258, 318, 319, 181, 301, 328, 334, 288, 168,
+	0, 128, 0, 253, 163, 195, 633, 668, 611, 156,
+	671, 658, 686, 287, 307, 143, 304, 219, 225, 153,
+	155, 154, 137, 282, 306, 147, 157, 292, 271, 297,
+	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	129, 299, 317, 149, 279, 280, 335, 266, 131, 315,
+	295, 217, 192, 193, 130, 0, 263, 166, 176, 161,
+	235, 0, 175, 255, 312, 313, 160, 337, 139, 327,
+	133, 140, 326, 228, 0, 227, 329, 308, 316, 218,
+	210, 0, 132, 314, 216, 209, 197, 171, 184, 251,
+	205, 252, 185, 223, 222, 224, 207, 211, 233, 0,
+	587, 0, 293, 323, 338, 182, 127, 302, 332, 145,
+	606, 281, 305, 0, 0, 146, 174, 170, 250, 226,
+	141, 187, 290, 196, 203, 262, 336, 239, 269, 150,
+	322, 289, 604, 605, 602, 0, 603, 649, 650, 704,
+	705, 706, 675, 598, 0, 688, 689, 0, 679, 694,
+	695, 0, 0, 0, 670, 713, 627, 628, 588, 591,
+	592, 593, 599, 641, 642, 653, 656, 684, 683, 682,
+	685, 690, 709, 708, 710, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 652, 122, 134, 200,
+	714, 260, 173, 324, 584, 165, 0, 0, 643, 645,
+	655, 672, 124, 125, 135, 144, 151, 164, 169, 172,
+	178, 183, 186, 188, 189, 190, 194, 208, 212, 213,
+	214, 215, 229, 230, 232, 234, 237, 238, 241, 243,
+	244, 245, 247, 248, 249, 254, 257, 259, 261, 264,
+	270, 272, 273, 274, 276, 277, 278, 283, 284, 285,
+	286, 294, 298, 310, 311, 321, 330, 333, 691, 698,
+	678, 303, 636, 701, 608, 625, 712, 626, 629, 667,
+	594, 648, 236, 623, 595, 0, 612, 585, 619, 586,
+	609, 638, 167, 607, 680, 651, 700, 198, 663, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 1386, 1390,
+	0, 707, 201, 0, 660, 325, 291, 220, 0, 0,
+	640, 687, 646, 676, 635, 669, 601, 659, 702, 624,
+	665, 703, 0, 0, 0, 574, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 0, 662, 697, 621, 664,
+	666, 583, 661, 0, 589, 596, 711, 693, 615, 616,
+	617, 0, 0, 0, 0, 0, 0, 0, 639, 647,
+	673, 632, 0, 0, 0, 0, 0, 0, 0, 0,
+	613, 0, 657, 0, 0, 0, 597, 590, 0, 0,
+	637, 0, 0, 0, 600, 126, 614, 674, 0, 581,
+	177, 221, 138, 677, 692, 1389, 191, 331, 696, 631,
+	630, 1383, 0, 1384, 1385, 199, 579, 123, 136, 1381,
+	1388, 231, 265, 275, 622, 582, 681, 610, 620, 159,
+	618, 268, 240, 320, 0, 654, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 633, 668, 611, 156, 671,
+	658, 686, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 587,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 606,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 604, 605, 602, 0, 603, 649, 650, 704, 705,
+	706, 675, 598, 0, 688, 689, 0, 679, 694, 695,
+	0, 0, 0, 670, 713, 627, 628, 588, 591, 592,
+	593, 599, 641, 642, 653, 656, 684, 683, 682, 685,
+	690, 709, 708, 710, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 652, 122, 134, 200, 714,
+	260, 173, 324, 584, 165, 0, 0, 643, 645, 655,
+	672, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 691, 698, 678,
+	303, 636, 701, 608, 625, 712, 626, 629, 667, 594,
+	648, 236, 623, 595, 0, 612, 585, 619, 586, 609,
+	638, 167, 607, 680, 651, 700, 198, 663, 0, 158,
+	206, 204, 0, 0, 0, 242, 300, 699, 644, 0,
+	707, 201, 0, 660, 325, 291, 220, 0, 0, 640,
+	687, 646, 676, 635, 669, 601, 659, 702, 624, 665,
+	703, 0, 0, 0, 120, 0, 0, 0, 0, 0,
+	0, 0, 0, 148, 0, 662, 697, 621, 664, 666,
+	583, 661, 0, 589, 596, 711, 693, 615, 616, 617,
+	0, 0, 0, 0, 0, 0, 0, 639, 647, 673,
+	632, 0, 0, 0, 0, 0, 0, 0, 0, 613,
+	0, 657, 0, 0, 0, 597, 590, 0, 0, 637,
+	0, 0, 0, 600, 126, 614, 674, 0, 581, 177,
+	221, 138, 677, 692, 634, 191, 331, 696, 631, 630,
+	256, 0, 296, 180, 199, 142, 123, 136, 152, 179,
+	231, 265, 275, 622, 582, 681, 610, 620, 159, 618,
+	268, 240, 320, 0, 654, 246, 267, 202, 309, 258,
+	318, 319, 181, 301, 328, 334, 288, 168, 0, 128,
+	0, 253, 163, 195, 633, 668, 611, 156, 671, 658,
+	686, 287, 307, 143, 304, 219, 225, 153, 155, 154,
+	137, 282, 306, 147, 157, 292, 271, 297, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 299,
+	317, 149, 279, 280, 335, 266, 131, 315, 295, 217,
+	192, 193, 130, 0, 263, 166, 176, 161, 235, 0,
+	175, 255, 312, 313, 160, 337, 139, 327, 133, 140,
+	326, 228, 0, 227, 329, 308, 316, 218, 210, 0,
+	132, 314, 216, 209, 197, 171, 184, 251, 205, 252,
+	185, 223, 222, 224, 207, 211, 233, 0, 587, 0,
+	293, 323, 338, 182, 127, 302, 332, 145, 606, 281,
+	305, 0, 0, 146, 174, 170, 250, 226, 141, 187,
+	290, 196, 203, 262, 336, 239, 269, 150, 322, 289,
+	604, 605, 602, 0, 603, 649, 650, 704, 705, 706,
+	675, 598, 0, 688, 689, 0, 679, 694, 695, 0,
+	0, 0, 670, 713, 627, 628, 588, 591, 592, 593,
+	599, 641, 642, 653, 656, 684, 683, 682, 685, 690,
+	709, 708, 710, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 652, 122, 134, 200, 714, 260,
+	173, 324, 584, 165, 0, 0, 643, 645, 655, 672,
+	124, 125, 135, 144, 151, 164, 169, 172, 178, 183,
+	186, 188, 189, 190, 194, 208, 212, 213, 214, 215,
+	229, 230, 232, 234, 237, 238, 241, 243, 244, 245,
+	247, 248, 249, 254, 257, 259, 261, 264, 270, 272,
+	273, 274, 276, 277, 278, 283, 284, 285, 286, 294,
+	298, 310, 311, 321, 330, 333, 691, 698, 678, 303,
+	636, 701, 608, 625, 712, 626, 629, 667, 594, 648,
+	236, 623, 595, 0, 612, 585, 619, 586, 609, 638,
+	167, 607, 680, 651, 700, 198, 663, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 699, 644, 0, 707,
+	201, 0, 660, 325, 291, 220, 0, 0, 640, 687,
+	646, 676, 635, 669, 601, 659, 702, 624, 665, 703,
+	0, 0, 0, 574, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 0, 662, 697, 621, 664, 666, 583,
+	661, 0, 589, 596, 711, 693, 615, 616, 617, 0,
+	0, 0, 0, 0, 0, 0, 639, 647, 673, 632,
+	0, 0, 0, 0, 0, 0, 0, 0, 613, 0,
+	657, 0, 0, 0, 597, 590, 0, 0, 637, 0,
+	0, 0, 600, 126, 614, 674, 0, 581, 177, 221,
+	138, 677, 692, 634, 191, 331, 696, 631, 630, 256,
+	0, 296, 180, 199, 579, 123, 136, 575, 179, 231,
+	265, 275, 622, 582, 681, 610, 620, 159, 618, 268,
+	240, 320, 0, 654, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 633, 668, 611, 156, 671, 658, 686,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 587, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 606, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 604,
+	605, 602, 0, 603, 649, 650, 704, 705, 706, 675,
+	598, 0, 688, 689, 0, 679, 694, 695, 0, 0,
+	0, 670, 713, 627, 628, 588, 591, 592, 593, 599,
+	641, 642, 653, 656, 684, 683, 682, 685, 690, 709,
+	708, 710, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 652, 122, 134, 200, 714, 260, 173,
+	324, 584, 165, 0, 0, 643, 645, 655, 672, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 691, 303, 509, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 236, 0, 0,
+	0, 0, 0, 447, 0, 0, 0, 167, 444, 0,
+	0, 0, 198, 0, 0, 158, 206, 204, 0, 0,
+	0, 242, 300, 0, 0, 0, 491, 201, 0, 0,
+	325, 291, 220, 0, 0, 0, 0, 480, 481, 0,
+	0, 0, 0, 0, 0, 1363, 0, 79, 0, 0,
+	445, 468, 467, 470, 471, 472, 473, 0, 0, 148,
+	469, 474, 475, 476, 1364, 0, 0, 442, 459, 0,
+	490, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 456, 457, 0, 0, 0, 0, 508, 0, 458,
+	0, 0, 453, 454, 455, 460, 0, 0, 0, 0,
+	126, 0, 0, 0, 0, 177, 221, 138, 482, 0,
+	0, 191, 331, 0, 0, 506, 256, 0, 296, 180,
+	199, 142, 123, 136, 152, 179, 231, 265, 275, 488,
+	0, 0, 0, 0, 159, 0, 268, 240, 320, 510,
+	0, 246, 267, 202, 309, 258, 318, 319, 181, 301,
+	328, 334, 288, 168, 0, 128, 0, 253, 163, 195,
+	0, 0, 0, 156, 0, 0, 0, 287, 307, 143,
+	304, 219, 225, 153, 155, 154, 137, 282, 306, 147,
+	157, 292, 271, 297, 162, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 129, 299, 317, 149, 279, 280,
+	335, 266, 131, 315, 295, 217, 192, 193, 130, 0,
+	263, 166, 176, 161, 235, 0, 175, 255, 312, 313,
+	160, 337, 139, 327, 133, 140, 326, 228, 0, 227,
+	329, 308, 316, 218, 210, 0, 132, 314, 216, 209,
+	197, 171, 184, 251, 205, 252, 185, 223, 222, 224,
+	207, 211, 233, 0, 0, 0, 293, 323, 338, 182,
+	127, 302, 332, 145, 0, 281, 305, 0, 0, 146,
+	174, 170, 250, 226, 141, 187, 290, 196, 203, 262,
+	336, 239, 269, 150, 322, 289, 492, 507, 498, 500,
+	499, 496, 497, 495, 494, 493, 511, 483, 484, 485,
+	486, 489, 0, 501, 502, 503, 504, 505, 0, 0,
+	0, 0, 524, 525, 526, 527, 528, 529, 530, 523,
+	531, 532, 533, 534, 535, 536, 537, 538, 539, 512,
+	513, 514, 515, 516, 517, 518, 519, 522, 520, 521,
+	487, 122, 134, 200, 0, 260, 173, 324, 0, 165,
+	0, 0, 0, 0, 0, 0, 124, 125, 135, 144,
+	151, 164, 169, 172, 178, 183, 186, 188, 189, 190,
+	194, 208, 212, 213, 214, 215, 229, 230, 232, 234,
+	237, 238, 241, 243, 244, 245, 247, 248, 249, 254,
+	257, 259, 261, 264, 270, 272, 273, 274, 276, 277,
+	278, 283, 284, 285, 286, 294, 298, 310, 311, 321,
+	330, 333, 34, 303, 509, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	447, 0, 0, 0, 167, 444, 0, 0, 0, 198,
+	0, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 491, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 445, 468, 467,
+	470, 471, 472, 473, 0, 0, 148, 469, 474, 475,
+	476, 0, 0, 0, 442, 459, 0, 490, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 456, 457,
+	0, 0, 0, 0, 508, 0, 458, 0, 0, 453,
+	454, 455, 460, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 482, 0, 0, 191, 331,
+	0, 0, 506, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 488, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 510, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 492, 507, 498, 500, 499, 496, 497,
+	495, 494, 493, 511, 483, 484, 485, 486, 489, 0,
+	501, 502, 503, 504, 505, 0, 0, 0, 0, 524,
+	525, 526, 527, 528, 529, 530, 523, 531, 532, 533,
+	534, 535, 536, 537, 538, 539, 512, 513, 514, 515,
+	516, 517, 518, 519, 522, 520, 521, 487, 122, 134,
+	200, 77, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 447, 0, 0, 0,
+	167, 444, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 491,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 445, 468, 467, 470, 471, 472, 473,
+	0, 0, 148, 469, 474, 475, 476, 0, 0, 0,
+	442, 459, 0, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 456, 457, 438, 0, 0, 0,
+	508, 0, 458, 0, 0, 453, 454, 455, 460, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 482, 0, 0, 191, 331, 0, 0, 506, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 488, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 510, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 492,
+	507, 498, 500, 499, 496, 497, 495, 494, 493, 511,
+	483, 484, 485, 486, 489, 0, 501, 502, 503, 504,
+	505, 0, 0, 0, 0, 524, 525, 526, 527, 528,
+	529, 530, 523, 531, 532, 533, 534, 535, 536, 537,
+	538, 539, 512, 513, 514, 515, 516, 517, 518, 519,
+	522, 520, 521, 487, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 509, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 447, 0, 0, 0, 167, 444, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 491, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 480, 481, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 826, 445,
+	468, 467, 470, 471, 472, 473, 0, 0, 148, 469,
+	474, 475, 476, 0, 0, 0, 442, 459, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	456, 457, 0, 0, 0, 0, 508, 0, 458, 0,
+	0, 453, 454, 455, 460, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 482, 0, 0,
+	191, 331, 0, 0, 506, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 488, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 510, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 492, 507, 498, 500, 499,
+	496, 497, 495, 494, 493, 511, 483, 484, 485, 486,
+	489, 0, 501, 502, 503, 504, 505, 0, 0, 0,
+	0, 524, 525, 526, 527, 528, 529, 530, 523, 531,
+	532, 533, 534, 535, 536, 537, 538, 539, 512, 513,
+	514, 515, 516, 517, 518, 519, 522, 520, 521, 487,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 509, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 447, 0,
+	0, 0, 167, 444, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 491, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 445, 468, 467, 470, 471,
+	472, 473, 0, 0, 148, 469, 474, 475, 476, 0,
+	0, 0, 442, 459, 0, 490, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 456, 457, 1250, 0,
+	0, 0, 508, 0, 458, 0, 0, 453, 454, 455,
+	460, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 482, 0, 0, 191, 331, 0, 0,
+	506, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 488, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 510, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 492, 507, 498, 500, 499, 496, 497, 495, 494,
+	493, 511, 483, 484, 485, 486, 489, 0, 501, 502,
+	503, 504, 505, 0, 0, 0, 0, 524, 525, 526,
+	527, 528, 529, 530, 523, 531, 532, 533, 534, 535,
+	536, 537, 538, 539, 512, 513, 514, 515, 516, 517,
+	518, 519, 522, 520, 521, 487, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 509, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 0, 447, 0, 0, 0, 167, 444,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 491, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 445, 468, 1261, 470, 471, 472, 473, 0, 0,
+	148, 469, 474, 475, 476, 0, 0, 0, 442, 459,
+	0, 490, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 456, 457, 1250, 0, 0, 0, 508, 0,
+	458, 0, 0, 453, 454, 455, 460, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 482,
+	0, 0, 191, 331, 0, 0, 506, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	488, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	510, 0, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 492, 507, 498,
+	500, 499, 496, 497, 495, 494, 493, 511, 483, 484,
+	485, 486, 489, 0, 501, 502, 503, 504, 505, 0,
+	0, 0, 0, 524, 525, 526, 527, 528, 529, 530,
+	523, 531, 532, 533, 534, 535, 536, 537, 538, 539,
+	512, 513, 514, 515, 516, 517, 518, 519, 522, 520,
+	521, 487, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 303, 509, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	447, 0, 0, 0, 167, 444, 0, 0, 0, 198,
+	0, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 491, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 445, 468, 1258,
+	470, 471, 472, 473, 0, 0, 148, 469, 474, 475,
+	476, 0, 0, 0, 442, 459, 0, 490, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 456, 457,
+	1250, 0, 0, 0, 508, 0, 458, 0, 0, 453,
+	454, 455, 460, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 482, 0, 0, 191, 331,
+	0, 0, 506, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 488, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 510, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 492, 507, 498, 500, 499, 496, 497,
+	495, 494, 493, 511, 483, 484, 485, 486, 489, 0,
+	501, 502, 503, 504, 505, 0, 0, 0, 0, 524,
+	525, 526, 527, 528, 529, 530, 523, 531, 532, 533,
+	534, 535, 536, 537, 538, 539, 512, 513, 514, 515,
+	516, 517, 518, 519, 522, 520, 521, 487, 122, 134,
+	200, 0, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 447, 0, 0, 0,
+	167, 444, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 491,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 1164, 445, 468, 467, 470, 471, 472, 473,
+	0, 0, 148, 469, 474, 475, 476, 0, 0, 0,
+	442, 459, 0, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 456, 457, 0, 0, 0, 0,
+	508, 0, 458, 0, 0, 453, 454, 455, 460, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 482, 0, 0, 191, 331, 0, 0, 506, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 488, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 510, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 492,
+	507, 498, 500, 499, 496, 497, 495, 494, 493, 511,
+	483, 484, 485, 486, 489, 0, 501, 502, 503, 504,
+	505, 0, 0, 0, 0, 524, 525, 526, 527, 528,
+	529, 530, 523, 531, 532, 533, 534, 535, 536, 537,
+	538, 539, 512, 513, 514, 515, 516, 517, 518, 519,
+	522, 520, 521, 487, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 509, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 447, 0, 0, 0, 167, 444, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 491, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 480, 481, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 445,
+	468, 467, 470, 471, 472, 473, 0, 0, 148, 469,
+	474, 475, 476, 0, 0, 0, 442, 459, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	456, 457, 0, 0, 0, 0, 508, 0, 458, 0,
+	0, 453, 454, 455, 460, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 482, 0, 0,
+	191, 331, 0, 0, 506, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 488, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 510, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 492, 507, 498, 500, 499,
+	496, 497, 495, 494, 493, 511, 483, 484, 485, 486,
+	489, 0, 501, 502, 503, 504, 505, 0, 0, 0,
+	0, 524, 525, 526, 527, 528, 529, 530, 523, 531,
+	532, 533, 534, 535, 536, 537, 538, 539, 512, 513,
+	514, 515, 516, 517, 518, 519, 522, 520, 521, 487,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 509, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 447, 0,
+	0, 0, 167, 444, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 491, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 480, 481, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 0, 0, 445, 468, 467, 470, 471,
+	472, 473, 0, 0, 148, 469, 474, 475, 476, 0,
+	0, 0, 442, 459, 0, 490, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 456, 457, 0, 0,
+	0, 0, 508, 0, 458, 0, 0, 453, 454, 455,
+	460, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 482, 0, 0, 191, 331, 0, 0,
+	506, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 488, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 510, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 492, 507, 498, 500, 499, 496, 497, 495, 494,
+	493, 511, 483, 484, 485, 486, 489, 0, 501, 502,
+	503, 504, 505, 0, 0, 0, 0, 837, 838, 839,
+	840, 841, 845, 846, 850, 851, 859, 858, 857, 860,
+	861, 863, 862, 864, 842, 843, 844, 847, 848, 849,
+	852, 853, 856, 854, 855, 487, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 509, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 491, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 480, 481,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 445, 468, 467, 470, 471, 472, 473, 0, 0,
+	148, 469, 474, 475, 476, 0, 0, 0, 0, 459,
+	0, 490, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 456, 457, 0, 0, 0, 0, 508, 0,
+	458, 0, 0, 453, 454, 455, 460, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 482,
+	0, 0, 191, 331, 0, 0, 506, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	488, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	510, 2387, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 492, 507, 498,
+	500, 499, 496, 497, 495, 494, 493, 511, 483, 484,
+	485, 486, 489, 0, 501, 502, 503, 504, 505, 0,
+	0, 0, 0, 524, 525, 526, 527, 528, 529, 530,
+	523, 531, 532, 533, 534, 535, 536, 537, 538, 539,
+	512, 513, 514, 515, 516, 517, 518, 519, 522, 520,
+	521, 487, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 303, 509, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 167, 0, 0, 0, 0, 198,
+	0, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 491, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 480, 481, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 826, 445, 468, 467,
+	470, 471, 472, 473, 0, 0, 148, 469, 474, 475,
+	476, 0, 0, 0, 0, 459, 0, 490, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 456, 457,
+	0, 0, 0, 0, 508, 0, 458, 0, 0, 453,
+	454, 455, 460, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 482, 0, 0, 191, 331,
+	0, 0, 506, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 488, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 510, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 492, 507, 498, 500, 499, 496, 497,
+	495, 494, 493, 511, 483, 484, 485, 486, 489, 0,
+	501, 502, 503, 504, 505, 0, 0, 0, 0, 524,
+	525, 526, 527, 528, 529, 530, 523, 531, 532, 533,
+	534, 535, 536, 537, 538, 539, 512, 513, 514, 515,
+	516, 517, 518, 519, 522, 520, 521, 487, 122, 134,
+	200, 0, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	509, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 0, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 491,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	480, 481, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 445, 468, 467, 470, 471, 472, 473,
+	0, 0, 148, 469, 474, 475, 476, 0, 0, 0,
+	0, 459, 0, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 456, 457, 0, 0, 0, 0,
+	508, 0, 458, 0, 0, 453, 454, 455, 460, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 482, 0, 0, 191, 331, 0, 0, 506, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 488, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 510, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 492,
+	507, 498, 500, 499, 496, 497, 495, 494, 493, 511,
+	483, 484, 485, 486, 489, 0, 501, 502, 503, 504,
+	505, 0, 0, 0, 0, 524, 525, 526, 527, 528,
+	529, 530, 523, 531, 532, 533, 534, 535, 536, 537,
+	538, 539, 512, 513, 514, 515, 516, 517, 518, 519,
+	522, 520, 521, 487, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 1341, 0, 0, 0, 0, 167, 0, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 0, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1343, 1345, 0, 0, 0, 0, 0, 120,
+	0, 400, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 0, 0, 0,
+	191, 331, 0, 1344, 0, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 0, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 0, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 403, 404, 405, 409, 410, 414, 415,
+	423, 422, 421, 424, 425, 427, 426, 428, 406, 407,
+	408, 411, 412, 413, 416, 417, 420, 418, 419, 0,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 1341, 0, 0,
+	0, 0, 167, 0, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 0, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1343, 1345,
+	0, 0, 0, 0, 0, 120, 0, 400, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 0, 0, 0, 191, 331, 0, 1344,
+	0, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 0, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 0, 0, 1339, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 403,
+	404, 405, 409, 410, 414, 415, 423, 422, 421, 424,
+	425, 427, 426, 428, 406, 407, 408, 411, 412, 413,
+	416, 417, 420, 418, 419, 0, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 877, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 0, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 878, 0, 881, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 874, 873, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 875, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 0,
+	0, 0, 191, 331, 0, 0, 0, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	0, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	0, 0, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 403, 404, 405, 409, 410,
+	414, 415, 423, 422, 421, 424, 425, 427, 426, 428,
+	406, 407, 408, 411, 412, 413, 416, 417, 420, 418,
+	419, 0, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 167, 0, 0, 0, 0, 198,
+	1616, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 0, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 400,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 0, 0, 0, 191, 331,
+	0, 0, 0, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 0, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 0, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 403, 404, 405, 409, 410, 414, 415, 423, 422,
+	421, 424, 425, 427, 426, 428, 406, 407, 408, 411,
+	412, 413, 416, 417, 420, 418, 419, 0, 122, 134,
+	200, 0, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 0, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 0,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 400, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 0, 0, 0, 191, 331, 0, 0, 0, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 0, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 0, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 403, 404, 405,
+	409, 410, 414, 415, 423, 422, 421, 424, 425, 427,
+	426, 428, 406, 407, 408, 411, 412, 413, 416, 417,
+	420, 418, 419, 0, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 167, 0, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 0, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 878,
+	0, 881, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 0, 0, 0,
+	191, 331, 0, 0, 0, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 0, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 0, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 403, 404, 405, 409, 410, 414, 415,
+	423, 422, 421, 424, 425, 427, 426, 428, 406, 407,
+	408, 411, 412, 413, 416, 417, 420, 418, 419, 0,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 0, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 0, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 730, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 891, 890, 900, 901, 893, 894, 895, 896,
+	897, 898, 899, 892, 0, 0, 902, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 0, 0, 0, 191, 331, 0, 0,
+	0, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 0, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 0, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 34, 303, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 236,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 167,
+	0, 0, 0, 0, 198, 0, 0, 158, 206, 204,
+	0, 0, 0, 242, 300, 0, 0, 0, 1336, 201,
+	0, 0, 325, 291, 220, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	0, 0, 120, 0, 0, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 126, 0, 0, 0, 0, 177, 221, 138,
+	0, 0, 0, 191, 331, 0, 0, 0, 256, 0,
+	296, 180, 199, 142, 123, 136, 152, 179, 231, 265,
+	275, 0, 0, 0, 0, 0, 159, 0, 268, 240,
+	320, 0, 0, 246, 267, 202, 309, 258, 318, 319,
+	181, 301, 328, 334, 288, 168, 0, 128, 0, 253,
+	163, 195, 0, 0, 0, 156, 0, 0, 0, 287,
+	307, 143, 304, 219, 225, 153, 155, 154, 137, 282,
+	306, 147, 157, 292, 271, 297, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 129, 299, 317, 149,
+	279, 280, 335, 266, 131, 315, 295, 217, 192, 193,
+	130, 0, 263, 166, 176, 161, 235, 0, 175, 255,
+	312, 313, 160, 337, 139, 327, 133, 140, 326, 228,
+	0, 227, 329, 308, 316, 218, 210, 0, 132, 314,
+	216, 209, 197, 171, 184, 251, 205, 252, 185, 223,
+	222, 224, 207, 211, 233, 0, 0, 0, 293, 323,
+	338, 182, 127, 302, 332, 145, 0, 281, 305, 0,
+	0, 146, 174, 170, 250, 226, 141, 187, 290, 196,
+	203, 262, 336, 239, 269, 150, 322, 289, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 122, 134, 200, 77, 260, 173, 324,
+	0, 165, 0, 0, 0, 0, 0, 0, 124, 125,
+	135, 144, 151, 164, 169, 172, 178, 183, 186, 188,
+	189, 190, 194, 208, 212, 213, 214, 215, 229, 230,
+	232, 234, 237, 238, 241, 243, 244, 245, 247, 248,
+	249, 254, 257, 259, 261, 264, 270, 272, 273, 274,
+	276, 277, 278, 283, 284, 285, 286, 294, 298, 310,
+	311, 321, 330, 333, 34, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 167, 0, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 0, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 730,
+	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 0, 0, 0,
+	191, 331, 0, 0, 0, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 0, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 0, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 134, 200, 77, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 1031, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 0, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 730, 0, 1030, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 0, 0, 0, 191, 331, 0, 0,
+	0, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 0, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 0, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 0, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
+	0, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 0,
+	0, 0, 191, 331, 0, 0, 0, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	0, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	0, 0, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 167, 0, 0, 0, 0, 198,
+	0, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 0, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 0, 0, 730, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 0, 0, 0, 191, 331,
+	0, 0, 0, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 0, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 0, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 134,
+	200, 0, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 999,
+	167, 0, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 0,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 120, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 0, 0, 0, 191, 331, 0, 0, 0, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 0, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 0, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 0, 0, 0, 542,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 167, 0, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 0, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 0, 0, 0,
+	191, 331, 0, 0, 0, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 0, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 0, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 140, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 207,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 226, 141, 187, 290, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 248, 249, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 0, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 0, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 120, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 0, 117, 0, 191, 331, 0, 0,
+	0, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 0, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 0, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 317, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 0, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 730, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 0,
+	0, 0, 191, 331, 0, 0, 0, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	0, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	0, 0, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 317, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 140, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 207, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 226, 141, 187, 290, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 248, 249,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333, 303, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 236, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 167, 0, 0, 0, 0, 198,
+	0, 0, 158, 206, 204, 0, 0, 0, 242, 300,
+	0, 0, 0, 0, 201, 0, 0, 325, 291, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 126, 0, 0,
+	0, 0, 177, 221, 138, 0, 0, 0, 191, 331,
+	0, 0, 0, 256, 0, 296, 180, 199, 142, 123,
+	136, 152, 179, 231, 265, 275, 0, 0, 0, 0,
+	0, 159, 0, 268, 240, 320, 0, 0, 246, 267,
+	202, 309, 258, 318, 319, 181, 301, 328, 334, 288,
+	168, 0, 128, 0, 253, 163, 195, 0, 0, 0,
+	156, 0, 0, 0, 287, 307, 143, 304, 219, 225,
+	153, 155, 154, 137, 282, 306, 147, 157, 292, 271,
+	297, 162, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 129, 299, 317, 149, 279, 280, 335, 266, 131,
+	315, 295, 217, 192, 193, 130, 0, 263, 166, 176,
+	161, 235, 0, 175, 255, 312, 313, 160, 337, 139,
+	327, 133, 140, 326, 228, 0, 227, 329, 308, 316,
+	218, 210, 0, 132, 314, 216, 209, 197, 171, 184,
+	251, 205, 252, 185, 223, 222, 224, 207, 211, 233,
+	0, 0, 0, 293, 323, 338, 182, 127, 302, 332,
+	145, 0, 281, 305, 0, 0, 146, 174, 170, 250,
+	226, 141, 187, 290, 196, 203, 262, 336, 239, 269,
+	150, 322, 289, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 122, 134,
+	200, 0, 260, 173, 324, 0, 165, 0, 0, 0,
+	0, 0, 0, 124, 125, 135, 144, 151, 164, 169,
+	172, 178, 183, 186, 188, 189, 190, 194, 208, 212,
+	213, 214, 215, 229, 230, 232, 234, 237, 238, 241,
+	243, 244, 245, 247, 248, 249, 254, 257, 259, 261,
+	264, 270, 272, 273, 274, 276, 277, 278, 283, 284,
+	285, 286, 294, 298, 310, 311, 321, 330, 333, 303,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	167, 0, 0, 0, 0, 198, 0, 0, 158, 206,
+	204, 0, 0, 0, 242, 300, 0, 0, 0, 0,
+	201, 0, 0, 325, 291, 220, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 445, 0, 0, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 126, 0, 0, 0, 0, 177, 221,
+	138, 0, 0, 0, 191, 331, 0, 0, 0, 256,
+	0, 296, 180, 199, 142, 123, 136, 152, 179, 231,
+	265, 275, 0, 0, 0, 0, 0, 159, 0, 268,
+	240, 320, 0, 0, 246, 267, 202, 309, 258, 318,
+	319, 181, 301, 328, 334, 288, 168, 0, 128, 0,
+	253, 163, 195, 0, 0, 0, 156, 0, 0, 0,
+	287, 307, 143, 304, 219, 225, 153, 155, 154, 137,
+	282, 306, 147, 157, 292, 271, 297, 162, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 129, 299, 317,
+	149, 279, 280, 335, 266, 131, 315, 295, 217, 192,
+	193, 130, 0, 263, 166, 176, 161, 235, 0, 175,
+	255, 312, 313, 160, 337, 139, 327, 133, 140, 326,
+	228, 0, 227, 329, 308, 316, 218, 210, 0, 132,
+	314, 216, 209, 197, 171, 184, 251, 205, 252, 185,
+	223, 222, 224, 207, 211, 233, 0, 0, 0, 293,
+	323, 338, 182, 127, 302, 332, 145, 0, 281, 305,
+	0, 0, 146, 174, 170, 250, 226, 141, 187, 290,
+	196, 203, 262, 336, 239, 269, 150, 322, 289, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 134, 200, 0, 260, 173,
+	324, 0, 165, 0, 0, 0, 0, 0, 0, 124,
+	125, 135, 144, 151, 164, 169, 172, 178, 183, 186,
+	188, 189, 190, 194, 208, 212, 213, 214, 215, 229,
+	230, 232, 234, 237, 238, 241, 243, 244, 245, 247,
+	248, 249, 254, 257, 259, 261, 264, 270, 272, 273,
+	274, 276, 277, 278, 283, 284, 285, 286, 294, 298,
+	310, 311, 321, 330, 333, 303, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 236, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 167, 0, 0, 0,
+	0, 198, 0, 0, 158, 206, 204, 0, 0, 0,
+	242, 300, 0, 0, 0, 0, 201, 0, 0, 325,
+	291, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 445,
+	0, 0, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 126,
+	0, 0, 0, 0, 177, 221, 138, 0, 0, 0,
+	191, 331, 0, 0, 0, 256, 0, 296, 180, 199,
+	142, 123, 136, 152, 179, 231, 265, 275, 0, 0,
+	0, 0, 0, 159, 0, 268, 240, 320, 0, 0,
+	246, 267, 202, 309, 258, 318, 319, 181, 301, 328,
+	334, 288, 168, 0, 128, 0, 253, 163, 195, 0,
+	0, 0, 156, 0, 0, 0, 287, 307, 143, 304,
+	219, 225, 153, 155, 154, 137, 282, 306, 147, 157,
+	292, 271, 297, 162, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 129, 299, 317, 149, 279, 280, 335,
+	266, 131, 315, 295, 217, 192, 193, 130, 0, 263,
+	166, 176, 161, 235, 0, 175, 255, 312, 313, 160,
+	337, 139, 327, 133, 562, 326, 228, 0, 227, 329,
+	308, 316, 218, 210, 0, 132, 314, 216, 209, 197,
+	171, 184, 251, 205, 252, 185, 223, 222, 224, 558,
+	211, 233, 0, 0, 0, 293, 323, 338, 182, 127,
+	302, 332, 145, 0, 281, 305, 0, 0, 146, 174,
+	170, 250, 563, 561, 552, 553, 196, 203, 262, 336,
+	239, 269, 150, 322, 289, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	122, 134, 200, 0, 260, 173, 324, 0, 165, 0,
+	0, 0, 0, 0, 0, 124, 125, 135, 144, 151,
+	164, 169, 172, 178, 183, 186, 188, 189, 190, 194,
+	208, 212, 213, 214, 215, 229, 230, 232, 234, 237,
+	238, 241, 243, 244, 245, 247, 559, 560, 254, 257,
+	259, 261, 264, 270, 272, 273, 274, 276, 277, 278,
+	283, 284, 285, 286, 294, 298, 310, 311, 321, 330,
+	333, 303, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 236, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 167, 0, 0, 0, 0, 198, 0, 0,
+	158, 206, 204, 0, 0, 0, 242, 300, 0, 0,
+	0, 0, 201, 0, 0, 325, 291, 220, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 445, 0, 0, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 126, 0, 0, 0, 0,
+	177, 221, 138, 0, 0, 0, 191, 331, 0, 0,
+	0, 256, 0, 296, 180, 199, 142, 123, 136, 152,
+	179, 231, 265, 275, 0, 0, 0, 0, 0, 159,
+	0, 268, 240, 320, 0, 0, 246, 267, 202, 309,
+	258, 318, 319, 181, 301, 328, 334, 288, 168, 0,
+	128, 0, 253, 163, 195, 0, 0, 0, 156, 0,
+	0, 0, 287, 307, 143, 304, 219, 225, 153, 155,
+	154, 137, 282, 306, 147, 157, 292, 271, 297, 162,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
+	299, 1025, 149, 279, 280, 335, 266, 131, 315, 295,
+	217, 192, 193, 130, 0, 263, 166, 176, 161, 235,
+	0, 175, 255, 312, 313, 160, 337, 139, 327, 133,
+	140, 326, 228, 0, 227, 329, 308, 316, 218, 210,
+	0, 132, 314, 216, 209, 197, 171, 184, 251, 205,
+	252, 185, 223, 222, 224, 207, 211, 233, 0, 0,
+	0, 293, 323, 338, 182, 127, 302, 332, 145, 0,
+	281, 305, 0, 0, 146, 174, 170, 250, 226, 141,
+	187, 290, 196, 203, 262, 336, 239, 269, 150, 322,
+	289, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 122, 134, 200, 0,
+	260, 173, 324, 0, 165, 0, 0, 0, 0, 0,
+	0, 124, 125, 135, 144, 151, 164, 169, 172, 178,
+	183, 186, 188, 189, 190, 194, 208, 212, 213, 214,
+	215, 229, 230, 232, 234, 237, 238, 241, 243, 244,
+	245, 247, 248, 249, 254, 257, 259, 261, 264, 270,
+	272, 273, 274, 276, 277, 278, 283, 284, 285, 286,
+	294, 298, 310, 311, 321, 330, 333, 303, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 236, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 198, 0, 0, 158, 206, 204, 0,
+	0, 0, 242, 300, 0, 0, 0, 0, 201, 0,
+	0, 325, 291, 220, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 445, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 177, 221, 138, 0,
+	0, 0, 191, 331, 0, 0, 0, 256, 0, 296,
+	180, 199, 142, 123, 136, 152, 179, 231, 265, 275,
+	0, 0, 0, 0, 0, 159, 0, 268, 240, 320,
+	0, 0, 246, 267, 202, 309, 258, 318, 319, 181,
+	301, 328, 334, 288, 168, 0, 128, 0, 253, 163,
+	195, 0, 0, 0, 156, 0, 0, 0, 287, 307,
+	143, 304, 219, 225, 153, 155, 154, 137, 282, 306,
+	147, 157, 292, 271, 297, 162, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 129, 299, 549, 149, 279,
+	280, 335, 266, 131, 315, 295, 217, 192, 193, 130,
+	0, 263, 166, 176, 161, 235, 0, 175, 255, 312,
+	313, 160, 337, 139, 327, 133, 562, 326, 228, 0,
+	227, 329, 308, 316, 218, 210, 0, 132, 314, 216,
+	209, 197, 171, 184, 251, 205, 252, 185, 223, 222,
+	224, 558, 211, 233, 0, 0, 0, 293, 323, 338,
+	182, 127, 302, 332, 145, 0, 281, 305, 0, 0,
+	146, 174, 170, 250, 563, 561, 552, 553, 196, 203,
+	262, 336, 239, 269, 150, 322, 289, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 122, 134, 200, 0, 260, 173, 324, 0,
+	165, 0, 0, 0, 0, 0, 0, 124, 125, 135,
+	144, 151, 164, 169, 172, 178, 183, 186, 188, 189,
+	190, 194, 208, 212, 213, 214, 215, 229, 230, 232,
+	234, 237, 238, 241, 243, 244, 245, 247, 559, 560,
+	254, 257, 259, 261, 264, 270, 272, 273, 274, 276,
+	277, 278, 283, 284, 285, 286, 294, 298, 310, 311,
+	321, 330, 333,
+}
+var yyPact = [...]int{
+
+	2864, -1000, -298, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1580, -1000, -1000, -1000, -1000, -1000, -1000,
+	647, 150, -1000, -1000, 290, 100, 23044, -46, 156, 23916,
+	-1000, -1000, -1000, 157, 227, 23916, -1000, -1000, -1000, 250,
+	330, 1095, 1440, 1094, 53, -84, -89, -1000, 1629, 1632,
+	-1000, -1000, 264, 65, -1000, -1000, -1000, 18682, 179, -1000,
+	-1000, -1000, 1539, 1578, 1331, -1000, 12142, 240, 240, 22608,
+	25660, -1000, 1628, 23916, 10832, -1000, 281, 23916, -160, 256,
+	256, 165, 289, -1000, 601, -1000, -1000, -1000, -1000, 23916,
+	257, 23480, 257, 257, 257, 257, 257, 23916, -1000, 490,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 288,
+	-1000, -1000, 1091, 1475, 720, 148, 7759, 7759, -1000, 653,
+	-1000, 160, 154, 149, 153, 55, 724, -1000, 7759, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 223, 271, 216, 179,
+	587, -1000, -1000, -1000, -1000, -1000, 1467, 1463, 879, 1462,
+	404, 1461, 1271, -53, -1000, 1090, 23916, -1000, -1000, 1296,
+	1522, 285, 23916, -1000, -1000, 1229, -1000, 1269, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 931,
+	1469, 847, 15194, 1380, -1000, -1000, 638, 1619, -1000, 17810,
+	489, -1000, 14758, 2439, 1232, -1000, -1000, 1232, -1000, -1000,
+	451, -1000, -1000, 16502, 16502, 16502, 16502, 16502, 16502, 16502,
+	16502, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1232, -1000, 11706,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	14758, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	22172, 21300, 23916, 1158, 1157, -1000, -1000, 471, 1216, -101,
+	25224, -1000, -1000, -1000, -1000, 24352, 20864, 585, -1000, -1000,
+	-1000, -1000, 1460, -1000, -1000, 469, -1000, 1580, -1000, -1000,
+	1088, 239, -1000, 4151, 1084, 498, -1000, -1000, -1000, 1268,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 23480, 1519, 273, 1083, 625,
+	1081, 1078, 1075, 256, 1074, 1215, 270, 23916, 1500, 1304,
+	-1000, 23916, 1071, 1068, 1066, 1065, -1000, 10393, 23916, 7759,
+	720, -1000, 899, 14758, 256, 256, 7759, 7759, 7759, 23916,
+	23916, 23916, -1000, -1000, -1000, -1000, 23916, -1000, -1000, 720,
+	720, 7759, 7759, 615, 1608, 615, 615, -1000, -1000, -1000,
+	-1000, 14758, -1000, 16502, -1000, -1000, 1064, 194, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1063, 404, 404, -1000, 897, 404,
+	1202, -1000, 568, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 404, -1000, 14322, -295, -1000,
+	-1000, 1211, -1000, 282, 1331, -1000, -1000, 179, -1000, -1000,
+	23916, 7759, 18682, 1232, 23480, -1000, -1000, -1000, 1624, 520,
+	1192, -1000, -1000, 1208, -1000, 642, 1491, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232, 1232,
+	1232, 1232, 1232, 1232, 1232, 468, 947, 1351, -1000, -1000,
+	-1000, 23916, -1000, 14758, 14758, 793, -1000, 19118, -1000, -1000,
+	-1000, -1000, 8637, 524, 16502, 814, 555, 16502, 16502, 16502,
+	16502, 16502, 16502, 16502, 16502, 16502, 16502, 16502, 16502, 16502,
+	16502, 16502, 748, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1061, -1000, 179, 970, 970, 503, 503, 503, 503,
+	503, 503, 503, 19554, 1518, 931, 1060, 565, 11706, 13014,
+	13014, 931, 14758, 14758, 13886, 13450, 13014, 13014, 1518, 580,
+	565, 24352, -1000, -1000, 16066, -1000, -1000, -1000, -1000, -1000,
+	931, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 23480,
+	23480, 23480, 16502, 125, 13014, 13014, 13014, 13014, 13014, 13014,
+	931, 931, 13014, 13014, 13014, 13014, 13014, 931, 931, 931,
+	931, 1518, 1518, 13014, 13014, 13014, 1518, 13014, 13014, 1518,
+	13014, 13014, 13014, 13014, 1518, 13014, 13014, 13014, 186, 23916,
+	-1000, 1230, 1231, -1000, -1000, -1000, 1495, 364, 19991, 17374,
+	-1000, 186, 1131, 21300, 23916, -1000, -1000, 21300, 23916, 8198,
+	24788, 1193, -1000, -108, -113, -101, -1000, -1000, 502, -1000,
+	-1000, -1000, 11269, -1000, 9515, 1539, 1331, 6003, 9954, -1000,
+	498, 1268, -1000, -67, -1000, -1000, -1000, 1241, -1000, 1241,
+	170, -8, 1241, 1241, 1241, 1241, 1241, -14, -14, -14,
+	-14, 26, -1000, -1000, -1000, -1000, -1000, 1267, 1266, -1000,
+	1241, 1241, 1241, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1262, 217, 1248, 1248, 1248, 1248, 1248, -1000, 1248,
+	213, -1000, 14758, 1275, -1000, 23916, 7759, 1499, 7759, 167,
+	1264, 23916, -1000, 23916, 23916, 1203, -1000, 23916, 1204, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1203, -1000, -1000,
+	-1000, 565, 1057, 1056, -1000, -1000, -1000, -1000, -1000, -1000,
+	637, -1000, -1000, -1000, -1000, 720, 23916, 23916, 23916, 1510,
+	720, 565, 577, -1000, -1000, 1055, -1000, 1202, 1202, -1000,
+	1202, 404, 970, 1202, -1000, 1053, 1494, 895, 23916, -1000,
+	18682, -58, -1000, -118, 1518, 931, 302, -1000, -1000, -1000,
+	185, 1019, 455, -1000, 1335, 847, 847, 15194, -1000, -1000,
+	-1000, -1000, 9515, 1535, -1000, 1359, 1358, 1272, -1000, -1000,
+	524, 614, -1000, -1000, 753, -1000, -1000, -1000, -1000, 454,
+	1232, -1000, 2728, -1000, -1000, -1000, -1000, 814, 16502, 16502,
+	16502, 577, 2728, 2704, 950, 2742, 503, 559, 559, 499,
+	499, 499, 499, 499, 784, 784, -1000, -1000, -1000, 931,
+	-1000, -1000, -1000, 13014, -1000, 14758, -1000, 931, 1044, -1000,
+	-1000, 565, 340, 1044, -1000, 930, 867, 583, 1606, 1044,
+	575, 1603, 1044, 1044, 1044, 13014, 645, -1000, 14758, 931,
+	-1000, 1244, 1201, 1200, 1600, 2658, 1199, -1000, -1000, -1000,
+	-1000, 1044, 931, 1196, 1044, 1044, 1044, 1044, -176, -176,
+	931, 1044, 931, 1044, 1044, -176, -176, -176, -176, 13014,
+	13014, 1044, 1044, 1044, 13014, 1044, 1044, 13014, 1044, 1044,
+	1044, 1044, 13014, 1044, 1044, 1044, 182, 1232, -1000, 24352,
+	21300, 21300, 21300, 21300, 21300, -1000, 1324, 1321, -1000, 1317,
+	1315, 1334, 18682, 13014, 1051, 931, 138, 19991, -1000, 1232,
+	-1000, 18246, 511, 279, 278, 276, 1587, 21300, 1172, -1000,
+	1172, -1000, 317, -1000, -1000, 24352, -101, -129, -1000, -1000,
+	1193, -1000, 812, -1000, -1000, 565, -1000, 314, 1469, 1518,
+	1189, 5564, -1000, -1000, -1000, -1000, 239, -1000, -1000, -1000,
+	1263, 497, -1000, 1394, 432, 432, 518, 925, 1365, -1000,
+	-1000, 676, -69, -1000, -1000, 752, -14, -14, 1241, 1241,
+	166, 1241, -1000, -14, -1000, -1000, -1000, 502, 1459, 502,
+	502, 502, 502, -14, 891, 891, -1000, -1000, -1000, -1000,
+	719, -1000, 1262, -1000, 685, -1000, -1000, -1000, -1000, 676,
+	-1000, -1000, 853, 1299, 23480, 179, 1507, -1000, -1000, -1000,
+	1592, -1000, -1000, 280, -1000, 267, -1000, 7759, 23916, 7759,
+	7759, 1587, 1045, 1037, -1000, -1000, -1000, 615, 720, 1447,
+	-1000, -1000, 16502, -1000, -1000, -1000, -1000, 186, 283, -1000,
+	-1000, -96, -1000, -1000, 1351, -1000, 1173, -1000, -1000, 786,
+	578, 597, 224, 224, -1000, 550, 224, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 178, 1503, 23480, 23480, 1344,
+	-1000, -1000, -1000, 23916, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 7320, 13014, -1000, 577, 2728, 2577, -1000,
+	16502, -1000, 931, 565, -1000, 13014, -1000, 6881, -1000, 155,
+	748, 155, 16502, 16502, -1000, 16502, 16502, -1000, -210, -1000,
+	1194, 533, -1000, 14758, 918, -1000, -1000, 16502, 16502, 16502,
+	16502, 16502, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 21736,
+	-1000, -176, -176, -176, -176, -176, -1000, -1000, -1000, -1000,
+	1044, 1044, -176, -176, -176, 1044, -176, -176, 1044, -176,
+	-176, -176, -176, 1044, -176, -176, -176, 1284, 24352, 1232,
+	-1000, 20428, 23480, 1175, -1000, 557, 1231, 1261, 1283, 1156,
+	-1000, -1000, -1000, -1000, 1319, -1000, 1313, -1000, -1000, 1237,
+	931, -1000, -1000, 1136, 1232, 23480, 16502, 511, -1000, 1232,
+	1232, 1232, 1580, 14758, 1172, -1000, -1000, 505, -1000, -1000,
+	-110, -119, -1000, -1000, -1000, 9076, -1000, 6003, -1000, 6003,
+	-1000, 23480, 237, -1000, 925, -1000, -1000, 925, -1000, 925,
+	-1000, -1000, -1000, 1251, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 634, 16502, 1623, -1000, 1390, -1000, 1389, 883,
+	1502, -73, -1000, -1000, 1097, 502, 502, -14, -1000, -1000,
+	1241, -1000, 502, -1000, 586, -1000, -1000, -1000, -1000, 502,
+	1042, -1000, 1034, 1128, -1000, 1030, 64, 23916, -1000, -1000,
+	-1000, 1280, -1000, -1000, -1000, 1080, 1114, -1000, 4151, 1026,
+	1021, 1007, 23916, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	720, -1000, 16502, 2728, -14, 23916, -1000, 1272, 302, -1000,
+	908, -1000, 925, 435, -1000, -1000, -1000, 1365, -1000, -1000,
+	308, 1005, -1000, 1000, 986, 23480, 1388, 978, 23916, 23480,
+	-1000, -1000, 949, 964, 14758, -1000, 23480, 23480, 1232, 311,
+	-1000, -1000, -1000, 1079, 12142, -1000, -1000, 931, -1000, 16502,
+	2728, -1000, -1000, -1000, 294, 931, 1241, 1241, -1000, 1241,
+	1248, -1000, 1241, 17, 1241, 13, 931, 931, 2505, 2376,
+	2327, 1121, 1232, -169, -1000, 565, 14758, 2140, 1303, 1244,
+	1244, 1244, -1000, 395, -1000, -1000, -1000, -1000, -1000, -176,
+	-176, -1000, -1000, -1000, -1000, -176, -1000, -1000, -176, -1000,
+	-1000, -1000, -1000, -176, -1000, -1000, -1000, -1000, 1487, 1113,
+	1105, -1000, -1000, 12578, 931, 1019, 1017, -1000, 1580, 24352,
+	14758, -1000, -1000, 14758, 1247, -1000, 14758, -1000, -1000, -1000,
+	-1000, 23480, 1495, 137, -1000, 14758, 1017, 1137, -1000, 23480,
+	23480, 23480, 1539, 565, -1000, -1000, -1000, -1000, 5564, -1000,
+	1015, -1000, 1241, 1382, -1000, 1365, -1000, -1000, -1000, 23480,
+	-1000, 2728, -63, -1000, -1000, -1000, 1232, -1000, -1000, -1000,
+	-1000, 502, -1000, -1000, -1000, -1000, -1000, -14, 878, -14,
+	683, -1000, 679, -1000, -1000, -243, 1240, -1000, 179, 23916,
+	73, 280, -1000, 4151, 4151, 4151, -1000, -1000, 2728, -90,
+	-1000, -1000, -1000, 949, 202, 3830, -1000, 1275, 432, 212,
+	-1000, -1000, -1000, -1000, -1000, 952, 446, -1000, 252, 202,
+	949, 565, 547, 1493, -1000, 23480, 1584, 21300, -1000, -1000,
+	-1000, 2728, 6442, -1000, -1000, 193, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 16502, 16502, 16502, 16502, 16502, 931,
+	874, 565, 16502, 16502, -1000, -1000, -1000, 931, 1577, -1000,
+	-1000, -1000, -1000, -1000, 1363, -1000, 1232, -1000, -1000, 184,
+	-1000, 23480, 1539, -1000, 565, 565, 23480, 565, 1011, -1000,
+	18682, 1232, 16938, -1000, 18682, 984, 984, 984, -1000, 325,
+	23480, 1491, -1000, 971, -1000, 14758, -1000, 502, -1000, 502,
+	1035, 1012, -1000, 23480, -1000, 1532, -1000, 73, -1000, 840,
+	115, 120, -1000, 114, 113, 112, 111, 109, -1000, -1000,
+	-1000, -1000, 1422, 1415, 1148, 957, -1000, -1000, 932, -1000,
+	-1000, 1239, 925, -1000, 907, -1000, -1000, 23480, -1000, 202,
+	1485, 1482, 1232, -1000, 1582, 1575, 1172, 12142, -1000, -1000,
+	-1000, -1000, 1244, 1244, 1244, 1244, 61, -1000, -1000, 1244,
+	1244, -1000, 14758, 1622, -1000, 1232, -1000, 179, -1000, -1000,
+	969, -1000, 23480, -1000, -1000, -1000, 511, -1000, -1000, -1000,
+	325, -1000, 865, 550, 834, -1000, -1000, 199, 839, -1000,
+	-1000, -1000, -1000, 963, -1000, 142, 4708, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1437, 1425, 126, 233, 1401, 1405, 1573,
+	21300, -1000, -1000, 676, 676, 23480, 1275, -1000, -1000, -1000,
+	16502, -1000, 171, -1000, 15194, 15194, 1584, -1000, -1000, -1000,
+	-1000, -1000, 931, 97, -219, -1000, -1000, 1188, 24352, 1105,
+	931, -1000, -1000, -1000, -1000, -1000, 677, -1000, 23916, -76,
+	325, 129, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	14758, 14758, 5125, 4708, -1000, -1000, -1000, -1000, 1237, 657,
+	1415, 1549, 1413, 1408, -1000, 811, 1172, 961, 1235, 2728,
+	956, -1000, 23480, -1000, 565, -1000, 1062, -1000, 565, -1000,
+	1582, -1000, -1000, 1343, -215, -222, 931, 1046, -1000, -1000,
+	1233, -1000, -1000, -1000, -1000, 325, 131, -1000, 863, 824,
+	87, 74, 532, -1000, -1000, -1000, -1000, -300, -1000, -1000,
+	1421, -1000, 805, -1000, 1543, 1536, -1000, 1584, 325, 23480,
+	-1000, 171, 1357, 15194, -1000, -1000, 1342, -1000, -1000, 23480,
+	-1000, 849, 841, 738, -1000, 14758, 4708, 1531, 1528, 1526,
+	1480, 9076, 4700, -1000, -1000, 760, 754, 1582, -1000, 943,
+	-1000, 163, -1000, -217, 937, 336, -1000, -1000, 619, 4708,
+	-1000, 787, -304, 232, 179, 500, 16502, -1000, -1000, -1000,
+	-1000, -1000, -1000, 325, 161, -220, 1279, -1000, -1000, -1000,
+	-1000, -1000, -1000, 4708, -1000, -306, 4708, 3208, -1000, -1000,
+	-1000, 4528, -1000, -1000, -1000, -1000, 80, -1000, -1000, 2728,
+	-1000, 1232, -223, 1278, 1277, 1597, -1000, -308, 4453, -309,
+	254, 4708, 612, -1000, 14758, -1000, 500, -1000, 15630, -1000,
+	-1000, 1599, -1000, 1593, 443, 443, 4344, 611, 4708, -1000,
+	-312, 249, 4708, -1000, 668, -1000, 1244, 931, -1000, -1000,
+	-1000, 214, 698, -1000, -1000, -1000, 3663, -1000, -314, 4708,
+	-1000, -1000, -1000, -1000, -1000, 231, 3472, -318, -1000, 225,
+	4708, -1000,
+}
+var yyPgo = [...]int{
+
+	0, 1933, 1932, 86, 1931, 151, 1930, 1929, 1927, 17,
+	14, 12, 19, 1926, 1731, 1729, 1725, 1723, 1925, 1721,
+	1924, 5, 1922, 1921, 1719, 1919, 1917, 1707, 1695, 1691,
+	1686, 1916, 1914, 34, 1911, 21, 1908, 4, 119, 129,
+	1907, 3, 1906, 1902, 8, 1901, 1900, 1683, 1898, 1895,
+	1894, 1893, 73, 1892, 1681, 1669, 1891, 1890, 1667, 1665,
+	1889, 1888, 1656, 1649, 1638, 1886, 166, 1885, 1884, 1883,
+	111, 77, 116, 1882, 1881, 1880, 88, 54, 1739, 89,
+	42, 83, 908, 1879, 23, 61, 1875, 1874, 105, 120,
+	1873, 125, 1871, 71, 380, 74, 1869, 1868, 140, 1866,
+	1865, 1864, 99, 1863, 1860, 2543, 1858, 1857, 122, 1854,
+	48, 41, 30, 1853, 1852, 1848, 1840, 1839, 113, 92,
+	1838, 1836, 110, 1834, 64, 1833, 1832, 146, 1831, 1829,
+	1827, 108, 59, 1826, 37, 1825, 46, 52, 1824, 27,
+	1823, 100, 1821, 1820, 36, 10, 1819, 31, 1818, 43,
+	1814, 107, 281, 570, 11, 32, 50, 47, 97, 84,
+	16, 26, 98, 78, 67, 38, 1809, 121, 1808, 65,
+	123, 102, 115, 117, 1804, 1801, 1800, 1799, 886, 1798,
+	1797, 104, 1795, 76, 128, 700, 148, 93, 1792, 72,
+	1791, 1790, 1789, 1788, 70, 101, 1787, 1786, 85, 197,
+	82, 1463, 132, 1760, 35, 124, 1785, 39, 1784, 1781,
+	3035, 90, 49, 81, 1780, 91, 28, 44, 1779, 51,
+	1778, 1777, 1775, 1774, 1772, 1770, 135, 1769, 1767, 1766,
+	1765, 134, 57, 1762, 1761, 1758, 106, 80, 1757, 1755,
+	1754, 1753, 1752, 96, 60, 112, 1751, 103, 94, 182,
+	1750, 62, 1749, 1748, 40, 33, 1746, 1745, 1744, 75,
+	66, 1743, 53, 29, 24, 58, 7, 56, 55, 1742,
+	22, 1741, 95, 2, 6, 9, 1738, 1736, 1735, 1727,
+	1717, 63, 1714, 1710, 45, 1700, 1697, 1693, 25, 1689,
+	1679, 1677, 109, 114, 1672, 1660, 0, 192, 126, 1658,
+	1653, 1651, 1646, 138,
+}
+var yyR1 = [...]int{
+
+	0, 294, 295, 295, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 65, 3, 3, 8, 4,
+	4, 4, 5, 5, 86, 87, 87, 91, 91, 6,
+	6, 7, 7, 9, 9, 69, 69, 10, 11, 11,
+	11, 11, 298, 298, 100, 100, 98, 98, 99, 99,
+	162, 162, 12, 12, 12, 167, 167, 172, 172, 172,
+	175, 175, 175, 175, 154, 155, 14, 14, 14, 14,
+	14, 14, 14, 14, 14, 14, 2, 279, 279, 280,
+	280, 281, 281, 281, 281, 282, 282, 283, 283, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 25,
+	197, 197, 45, 45, 46, 46, 46, 150, 150, 150,
+	13, 13, 13, 13, 13, 20, 22, 22, 34, 34,
+	35, 23, 23, 23, 23, 36, 36, 37, 26, 26,
+	26, 26, 26, 26, 43, 43, 43, 42, 42, 41,
+	41, 41, 41, 41, 41, 24, 24, 24, 24, 44,
+	44, 39, 39, 38, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 40, 40, 40, 27, 27, 27, 27,
+	27, 27, 19, 278, 278, 278, 33, 33, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 50, 256, 257, 257, 257,
+	257, 257, 257, 257, 257, 244, 244, 245, 245, 217,
+	217, 217, 217, 217, 217, 217, 217, 216, 216, 216,
+	216, 219, 222, 222, 220, 220, 220, 220, 220, 220,
+	220, 220, 220, 221, 221, 221, 221, 221, 221, 221,
+	221, 223, 223, 223, 223, 223, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 225, 225, 225, 225, 225, 225, 225, 225, 243,
+	243, 226, 226, 236, 236, 237, 237, 237, 233, 233,
+	234, 234, 238, 238, 229, 230, 239, 218, 299, 299,
+	235, 235, 235, 231, 231, 231, 232, 232, 232, 242,
+	242, 242, 242, 227, 246, 246, 266, 266, 265, 265,
+	264, 264, 264, 255, 255, 261, 261, 261, 261, 261,
+	261, 261, 250, 250, 250, 249, 249, 251, 251, 254,
+	254, 263, 263, 262, 247, 247, 267, 267, 267, 267,
+	267, 248, 248, 248, 268, 252, 252, 253, 253, 253,
+	274, 275, 273, 273, 273, 273, 273, 115, 115, 115,
+	258, 258, 258, 259, 259, 259, 260, 260, 260, 276,
+	276, 47, 47, 48, 53, 53, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 149,
+	149, 149, 49, 195, 195, 272, 272, 272, 271, 269,
+	269, 270, 270, 15, 51, 51, 16, 16, 16, 16,
+	16, 16, 16, 17, 17, 54, 300, 300, 300, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 55, 55, 83, 182,
+	182, 180, 180, 183, 183, 181, 181, 181, 184, 184,
+	184, 214, 214, 214, 56, 56, 61, 61, 64, 62,
+	63, 28, 29, 29, 29, 29, 30, 196, 196, 59,
+	59, 60, 60, 60, 60, 75, 75, 301, 301, 58,
+	58, 57, 57, 18, 302, 66, 67, 67, 68, 68,
+	68, 187, 187, 72, 72, 72, 70, 70, 70, 71,
+	71, 77, 77, 81, 81, 81, 81, 80, 80, 80,
+	80, 152, 152, 152, 153, 153, 206, 206, 206, 205,
+	205, 205, 205, 85, 85, 88, 88, 89, 89, 89,
+	89, 89, 89, 92, 135, 135, 109, 109, 110, 110,
+	110, 110, 110, 121, 121, 161, 161, 160, 160, 163,
+	163, 90, 90, 90, 90, 95, 95, 96, 96, 97,
+	97, 194, 194, 212, 212, 212, 101, 101, 101, 103,
+	102, 102, 102, 102, 104, 104, 106, 107, 107, 105,
+	105, 108, 111, 111, 111, 111, 112, 112, 82, 82,
+	82, 82, 82, 82, 82, 179, 179, 114, 114, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 130,
+	130, 130, 130, 130, 130, 116, 116, 116, 116, 116,
+	116, 116, 76, 76, 131, 131, 131, 94, 93, 93,
+	79, 79, 78, 78, 132, 132, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	125, 125, 129, 129, 129, 129, 129, 129, 129, 129,
+	129, 129, 129, 129, 129, 129, 129, 129, 129, 128,
+	128, 128, 128, 128, 128, 128, 128, 128, 128, 128,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	123, 123, 123, 123, 123, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 177, 177, 177, 177, 303,
+	303, 127, 126, 126, 126, 126, 126, 126, 126, 126,
+	73, 73, 73, 73, 73, 213, 213, 215, 215, 215,
+	215, 215, 215, 215, 215, 215, 215, 215, 215, 215,
+	142, 142, 74, 74, 140, 140, 141, 143, 143, 139,
+	139, 139, 118, 118, 118, 118, 118, 118, 118, 118,
+	120, 120, 120, 144, 144, 133, 133, 84, 84, 145,
+	145, 146, 146, 147, 147, 148, 148, 151, 151, 156,
+	156, 156, 157, 157, 157, 157, 122, 122, 158, 158,
+	158, 117, 117, 117, 117, 117, 117, 159, 159, 159,
+	159, 164, 164, 134, 134, 137, 137, 136, 138, 165,
+	165, 169, 166, 166, 170, 170, 170, 170, 173, 173,
+	174, 174, 174, 171, 171, 171, 168, 168, 168, 209,
+	209, 209, 176, 176, 188, 188, 185, 185, 186, 186,
+	178, 178, 228, 228, 191, 191, 191, 191, 191, 191,
+	191, 191, 193, 193, 192, 192, 192, 189, 189, 189,
+	190, 190, 207, 207, 203, 203, 208, 208, 204, 204,
+	210, 210, 211, 211, 277, 277, 240, 240, 287, 287,
+	241, 241, 288, 288, 290, 290, 285, 285, 286, 286,
+	289, 289, 31, 291, 291, 292, 292, 293, 293, 293,
+	293, 32, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 200, 200, 200, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 296, 297, 198, 199, 199, 199,
+}
+var yyR2 = [...]int{
+
+	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 11, 4, 7, 5, 1,
+	13, 3, 10, 12, 2, 1, 3, 3, 6, 1,
+	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
+	6, 6, 1, 1, 1, 3, 1, 3, 1, 3,
+	0, 4, 3, 4, 5, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 0, 0, 2, 3, 3, 11,
+	7, 9, 5, 5, 15, 11, 4, 0, 1, 1,
+	3, 2, 3, 3, 3, 0, 1, 1, 2, 2,
+	2, 2, 1, 2, 2, 3, 3, 3, 3, 4,
+	0, 3, 1, 1, 1, 1, 1, 0, 2, 2,
+	1, 1, 1, 1, 1, 4, 5, 8, 1, 2,
+	5, 7, 10, 8, 11, 1, 2, 5, 5, 5,
+	5, 6, 3, 5, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 1, 1, 2, 4, 2, 4, 2,
+	3, 1, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 4, 3,
+	2, 4, 3, 0, 2, 3, 1, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 5, 4, 1, 1, 2,
+	3, 4, 3, 3, 3, 3, 3, 3, 3, 0,
+	2, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 3, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 1, 4, 4, 3, 3,
+	2, 4, 5, 2, 3, 4, 2, 2, 3, 3,
+	3, 3, 3, 4, 1, 1, 1, 1, 1, 6,
+	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
+	0, 1, 1, 2, 2, 3, 1, 6, 0, 2,
+	0, 1, 1, 0, 3, 3, 0, 2, 2, 2,
+	1, 2, 1, 2, 5, 4, 0, 1, 1, 2,
+	2, 3, 2, 0, 1, 2, 4, 3, 3, 3,
+	2, 2, 1, 1, 1, 1, 1, 0, 1, 0,
+	1, 1, 3, 3, 3, 1, 10, 11, 11, 12,
+	12, 3, 2, 1, 5, 1, 1, 0, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	0, 1, 3, 1, 2, 3, 1, 1, 1, 0,
+	2, 1, 1, 5, 1, 3, 2, 5, 4, 3,
+	3, 3, 5, 3, 8, 10, 3, 3, 3, 5,
+	4, 5, 1, 2, 2, 4, 3, 6, 5, 0,
+	1, 2, 4, 0, 1, 1, 1, 1, 7, 1,
+	3, 8, 8, 3, 3, 5, 4, 6, 4, 4,
+	4, 4, 4, 3, 2, 4, 0, 1, 1, 4,
+	4, 3, 6, 6, 4, 4, 4, 4, 4, 4,
+	3, 3, 2, 6, 2, 4, 4, 4, 5, 7,
+	6, 5, 4, 2, 4, 3, 2, 3, 3, 1,
+	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
+	2, 0, 1, 1, 2, 1, 1, 1, 2, 1,
+	1, 2, 3, 4, 4, 5, 3, 1, 1, 3,
+	3, 1, 1, 1, 1, 0, 3, 1, 1, 2,
+	2, 2, 2, 2, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 0, 1, 1, 0, 1, 1, 0,
+	1, 3, 5, 1, 1, 3, 5, 1, 2, 3,
+	5, 2, 4, 7, 0, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 3, 1, 1, 1, 4, 1,
+	4, 6, 3, 2, 2, 4, 2, 6, 1, 4,
+	6, 3, 2, 0, 3, 0, 3, 1, 3, 1,
+	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
+	2, 0, 1, 1, 1, 1, 1, 2, 2, 1,
+	2, 3, 2, 3, 2, 2, 3, 2, 1, 1,
+	3, 3, 0, 5, 5, 5, 0, 2, 1, 3,
+	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
+	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
+	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 1, 1, 1, 3, 1, 3,
+	0, 1, 1, 3, 1, 3, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 1, 1, 1,
+	5, 6, 6, 6, 5, 5, 5, 6, 5, 5,
+	6, 5, 5, 5, 5, 6, 5, 5, 5, 4,
+	4, 5, 5, 5, 5, 5, 4, 4, 4, 4,
+	4, 4, 3, 6, 6, 6, 8, 8, 8, 8,
+	9, 4, 7, 5, 4, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	8, 8, 6, 6, 6, 1, 1, 1, 1, 0,
+	2, 3, 4, 4, 4, 4, 4, 4, 4, 4,
+	0, 3, 4, 7, 3, 1, 1, 2, 3, 3,
+	1, 2, 2, 1, 2, 1, 2, 2, 1, 2,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 1, 3, 1, 1, 0,
+	2, 1, 1, 0, 3, 1, 3, 2, 2, 0,
+	1, 1, 0, 2, 4, 4, 1, 1, 0, 2,
+	4, 2, 1, 3, 5, 4, 6, 1, 3, 3,
+	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
+	3, 3, 1, 3, 1, 2, 2, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 1, 2, 1, 1,
+	1, 1, 1, 1, 0, 1, 0, 2, 0, 3,
+	0, 1, 0, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 0, 1, 1, 1, 1, 1,
+	0, 1, 0, 2, 1, 1, 1, 3, 1, 1,
+	1, 1, 1, 1, 0, 2, 0, 1, 0, 4,
+	0, 1, 0, 3, 0, 3, 0, 4, 0, 3,
+	0, 3, 3, 1, 3, 2, 4, 1, 2, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 0, 1, 1,
+}
+var yyChk = [...]int{
+
+	-1000, -294, -1, -3, -8, -9, -10, -11, -12, -14,
+	-47, -15, -16, -17, -54, -55, -56, -61, -62, -63,
+	-59, -58, -57, -18, -24, -27, -19, -65, -28, -29,
+	-30, -31, -32, -4, 6, 7, -69, 9, 10, 30,
+	-50, 130, -48, -49, 133, 132, 169, 134, 162, 64,
+	225, -64, 228, 229, -301, -196, 167, 168, 136, 212,
+	213, 20, 37, 230, 232, 31, 32, -5, -86, -6,
+	8, 327, 131, 226, 164, 163, 26, 375, -296, 68,
+	-295, 445, -147, 15, -66, -302, -66, -66, -66, -66,
+	-66, -256, -194, 105, 68, 23, -190, 143, 86, 318,
+	139, -197, -188, -189, 189, 199, 44, 150, 149, 140,
+	140, 141, 143, 318, 139, 177, 176, 140, -105, -210,
+	71, -201, 372, 153, 387, 388, 131, 291, 186, 225,
+	239, 233, 267, 255, 373, 389, 154, 207, 138, 253,
+	256, 305, 152, 200, 390, 294, 300, 210, 80, 228,
+	314, 391, 155, 204, 206, 205, 194, 211, 36, 165,
+	251, 244, 215, 189, 392, 380, 242, 28, 184, 393,
+	302, 272, 394, 377, 301, 247, 243, 136, 395, 156,
+	150, 179, 290, 396, 273, 277, 397, 306, 398, 399,
+	400, 142, 237, 238, 401, 190, 308, 271, 33, 151,
+	374, 48, 174, 309, 38, 275, 37, 281, 402, 270,
+	265, 282, 403, 404, 405, 406, 269, 236, 264, 202,
+	53, 137, 279, 278, 280, 203, 304, 260, 258, 407,
+	408, 157, 409, 283, 410, 245, 18, 411, 412, 312,
+	168, 413, 42, 414, 415, 416, 172, 417, 418, 419,
+	303, 274, 276, 188, 420, 248, 147, 421, 176, 422,
+	376, 423, 310, 241, 424, 158, 232, 173, 167, 313,
+	425, 213, 426, 427, 428, 159, 429, 430, 431, 229,
+	230, 296, 208, 432, 433, 434, 435, 198, 183, 316,
+	307, 52, 212, 287, 436, 235, 149, 214, 437, 226,
+	43, 180, 292, 7, 201, 297, 209, 199, 262, 175,
+	438, 439, 249, 250, 268, 234, 263, 227, 177, 178,
+	169, 440, 315, 288, 378, 51, 257, 254, 181, 261,
+	441, 143, 293, 442, 182, 231, 311, 252, 289, -300,
+	283, 281, 123, 256, 305, 130, 295, 296, 302, -250,
+	303, 176, 179, -214, 140, -180, 294, 182, 71, 141,
+	142, 33, 307, 306, 298, -210, 144, 231, -75, 134,
+	170, -105, -198, -198, -198, -198, -44, 71, 214, -44,
+	30, 71, 71, 36, 71, 230, 297, 297, 6, -68,
+	5, -178, 143, 145, 227, -87, -91, -212, -210, -202,
+	73, 343, 344, 345, 346, 347, 360, 361, 362, 348,
+	349, 363, 364, 365, 350, 351, 366, 367, 369, 370,
+	368, 354, 353, 352, 355, 356, 358, 357, 359, -3,
+	-157, 17, 16, -72, 54, 55, -67, -80, 114, -82,
+	-210, -113, 88, -119, 29, 71, -201, 24, -118, -114,
+	-139, -138, -94, 123, 124, 125, 112, 113, 120, 89,
+	126, -125, -123, -124, -126, -128, -129, 73, 72, 81,
+	74, 75, 76, 77, 82, 83, 84, -203, -136, -296,
+	58, 59, 139, 328, 329, 330, 331, 371, 160, 332,
+	91, 47, 317, 326, 325, 324, 322, 323, 319, 321,
+	320, 334, 335, 336, 337, 338, 146, 318, 118, 8,
+	170, 327, 360, 361, 362, 363, 364, 365, 366, 367,
+	369, 370, 368, 350, 343, 344, 345, 346, 347, 348,
+	349, 351, 352, 353, 354, 355, 356, 357, 358, 359,
+	-178, -178, 11, -98, -99, -105, -108, -210, -166, 227,
+	-173, -170, 306, 307, -171, -174, -168, -139, 281, 418,
+	419, 305, 256, 304, -203, -210, -2, -5, 6, -105,
+	-257, -245, -248, -204, 71, 155, -268, -203, -200, 152,
+	-201, 135, 161, 87, 379, 23, 25, 285, 343, 90,
+	123, 344, 345, 346, 16, 20, 91, 122, 328, 347,
+	130, 62, 319, 321, 317, 318, 295, 29, 10, 26,
+	163, 193, 22, 116, 132, 94, 95, 96, 166, 24,
+	164, 84, 160, 19, 65, 11, 13, 341, 342, 14,
+	146, 145, 107, 191, 141, 60, 8, 126, 27, 104,
+	56, 348, 349, 383, 45, 384, 58, 105, 17, 322,
+	323, 31, 371, 350, 171, 385, 351, 118, 196, 63,
+	50, 88, 82, 34, 85, 66, 86, 15, 192, 61,
+	339, 195, 386, 106, 133, 327, 59, 139, 6, 333,
+	30, 162, 354, 353, 352, 355, 197, 57, 330, 331,
+	356, 443, 140, 93, 334, 335, 144, 83, 5, 44,
+	32, 9, 64, 67, 324, 325, 326, 47, 358, 357,
+	359, 92, 12, 340, 375, 141, -105, 327, -186, 146,
+	-186, 177, 176, 140, 97, -51, -105, -185, 146, -203,
+	71, -185, -185, -185, -185, -185, -105, 127, 140, 71,
+	30, -184, 105, 12, 318, 139, 71, 176, 179, 140,
+	177, 143, -199, -296, -204, -199, -252, 11, 107, 180,
+	180, 180, 181, 180, -183, -182, 178, 300, 301, 297,
+	299, 12, -83, 105, -199, 71, 230, 144, -60, -3,
+	-11, -9, -10, -3, 97, 30, 30, 73, 51, 30,
+	-39, -38, -40, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 30, -278, 68, -240, 281,
+	71, -291, -292, -105, -66, -7, -5, -296, 21, 22,
+	140, -105, 69, 23, -296, -297, 70, -158, 19, 31,
+	-122, 74, 77, -148, -151, -82, -202, 343, 344, 345,
+	346, 347, 360, 361, 362, 348, 349, 363, 364, 365,
+	350, 351, 366, 367, 369, 370, 368, 354, 353, 352,
+	355, 356, 358, 357, 359, -210, 50, -70, 21, 22,
+	79, 11, -206, 87, 86, 104, -205, 23, 71, -201,
+	-202, 73, 127, -82, -116, 107, 88, 105, 106, 90,
+	109, 108, 119, 112, 113, 114, 115, 116, 117, 118,
+	110, 111, 122, 97, 98, 99, 100, 101, 102, 103,
+	-179, -296, -94, -296, 128, 129, -119, -119, -119, -119,
+	-119, -119, -119, -119, -296, -3, -132, -82, -296, -296,
+	-296, -296, -296, -296, -296, -296, -296, -296, -296, -142,
+	-82, -296, -303, -127, -296, -303, -127, -303, -127, -303,
+	-296, -303, -127, -303, -127, -303, -303, -127, -303, -296,
+	-296, -296, -296, -296, -296, -296, -296, -296, -296, -296,
+	-296, -296, -296, -296, -296, -296, -296, -296, -296, -296,
+	-296, -296, -296, -296, -296, -296, -296, -296, -296, -296,
+	-296, -296, -296, -296, -296, -296, -296, -296, -107, 27,
+	-105, -85, -88, -89, -90, -109, -93, -210, -296, -105,
+	-94, -105, -98, -298, 69, 11, 67, -298, 69, 127,
+	69, -167, -172, 308, 310, 227, -171, -171, -209, -203,
+	73, 29, 97, 30, 127, -147, -66, 70, 69, -248,
+	155, 152, -216, -222, -224, -223, -225, -220, -221, 253,
+	256, 260, 258, 254, 259, 123, 257, 262, 263, 264,
+	265, 266, 267, 268, 269, 270, 271, 272, 30, 165,
+	249, 250, 251, 252, 273, 274, 275, 276, 277, 278,
+	279, 280, 233, 255, 373, 234, 235, 236, 237, 238,
+	239, 241, 242, 243, 244, 245, 246, 247, -219, 71,
+	71, -268, 68, -203, 23, 143, 71, 88, 71, 71,
+	71, -186, 71, 69, 144, -98, 24, 66, -100, -105,
+	71, 71, 71, 71, -211, -210, -200, -98, -199, -184,
+	73, -82, -186, -186, -199, -199, -199, -105, -105, -105,
+	-105, -184, -184, -199, -199, -181, 11, 107, 11, -181,
+	-181, -82, -119, 71, 71, 230, 71, -39, -39, 73,
+	-39, 69, 97, -39, 70, -132, -277, 444, 69, -293,
+	23, 310, 311, 282, -72, -3, -105, -199, -91, -93,
+	-296, -159, -203, 9, 107, 69, 18, 69, -156, 25,
+	26, -156, 127, -120, 51, 74, 77, -187, 49, -105,
+	-82, -82, -130, 82, 88, 83, 84, -205, 114, -211,
+	-204, -200, -119, -131, -136, -94, 78, 107, 105, 106,
+	90, -119, -119, -119, -119, -119, -119, -119, -119, -119,
+	-119, -119, -119, -119, -119, -119, -213, 71, 73, 71,
+	-118, -118, -203, -70, -297, 69, -297, -3, -78, -81,
+	114, -82, -210, -78, -297, -82, -82, -139, 73, -78,
+	-139, 73, -78, -78, -78, -70, -140, -141, 92, -139,
+	-297, -119, -203, -203, -203, -119, -177, 165, 249, 251,
+	250, -78, -79, -78, -78, -78, -78, -78, -297, -297,
+	-81, -78, -81, -78, -78, -297, -297, -297, -297, -70,
+	-70, -78, -78, -78, -70, -78, -78, -70, -78, -78,
+	-78, -78, -70, -78, -78, -78, -162, 172, -105, 30,
+	69, -101, -103, -102, -104, 56, 60, 62, 57, 58,
+	59, 63, -194, -296, -85, -92, 47, -296, -110, 172,
+	-111, 23, -212, 64, 145, 65, -162, 67, -85, -105,
+	-85, -108, -210, 114, -170, -173, 69, 309, 311, 312,
+	-167, -232, 122, 66, 85, -82, -204, -211, -157, -72,
+	-258, -259, -260, -204, 73, 74, -245, -246, -247, -248,
+	-261, 155, -267, 147, 149, 150, 44, -249, 156, 141,
+	45, -217, -233, 288, -226, 68, -226, -226, 261, 253,
+	256, 254, -226, -226, -226, -226, -226, -231, 256, -231,
+	-231, -231, -231, 254, 68, 68, -226, -226, -226, -236,
+	68, -236, 248, -237, 68, -237, -237, -237, -237, -217,
+	-237, -268, -82, -207, 67, -154, -105, -199, 24, -199,
+	-45, 186, 161, 68, -105, -105, -105, -105, 69, 71,
+	71, -253, 11, 107, -184, -210, -210, -105, -121, 23,
+	-184, -76, 166, 71, -38, -118, 70, -106, 27, 73,
+	-292, -212, 281, 311, -70, -297, -53, -52, -191, 135,
+	132, 133, 137, 138, -271, 285, 131, 256, 80, 29,
+	15, 328, 172, 378, 71, 173, -297, 69, 127, 52,
+	-122, -122, -151, -176, 19, 11, 47, 47, -71, 57,
+	82, 83, 84, 127, -296, -131, -119, -119, -119, -76,
+	87, -297, -79, -82, -297, 69, -297, 127, -297, 69,
+	67, 23, 11, 11, -297, 11, 11, -297, -297, -297,
+	-78, -143, -141, 94, -82, -297, -297, 69, 69, 11,
+	107, 69, -297, -297, -297, -297, -297, -297, -152, 339,
+	-152, -297, -297, -297, -297, -297, -152, -152, -152, -152,
+	-78, -78, -297, -297, -297, -78, -297, -297, -78, -297,
+	-297, -297, -297, -78, -297, -297, -297, -117, 30, 47,
+	-3, -296, -296, -165, -169, -139, -88, -89, -89, -88,
+	-89, 56, 56, 56, 61, 56, 61, 56, -102, -212,
+	-79, -297, -297, -135, 185, -296, 34, -212, -111, 141,
+	141, 141, -112, 12, -85, -112, -112, 127, -172, -175,
+	313, 310, 316, 71, 73, 127, -158, 69, -260, 97,
+	-248, 68, 71, 45, -249, 141, 45, -251, -249, -249,
+	-254, 71, -254, 45, -238, -229, -230, -239, -242, -227,
+	-218, 82, 88, 29, 66, 285, 147, 45, 44, 80,
+	-299, 290, -234, 289, 74, -231, -231, -226, -226, -226,
+	261, -226, -231, -232, 30, -232, -232, -232, -232, -231,
+	-243, 73, -243, 74, -236, 74, 70, 66, -203, -3,
+	23, -46, 8, 9, 10, -279, -280, -281, 71, 107,
+	191, 192, 144, -199, -105, -199, -199, -112, 71, 71,
+	-181, -184, 34, -119, -162, 140, -293, -187, 69, -198,
+	-195, -272, -249, -276, -247, -248, 148, 156, 147, 172,
+	155, -195, -272, 155, 152, -249, 156, 148, -192, -249,
+	144, 23, -195, -195, -255, 97, -195, 172, 23, -203,
+	-203, 53, -105, -77, -155, 114, -204, -79, -76, 87,
+	-119, -297, -81, 114, -211, -215, 123, 253, 165, 251,
+	244, 271, 258, 287, 249, 288, -213, -215, -119, -119,
+	-119, -119, 372, -147, 96, -82, 93, -119, -119, -119,
+	-119, -119, -203, -296, -152, -152, -152, -152, -152, -297,
+	-297, -153, -152, -153, -153, -297, -153, -153, -297, -153,
+	-153, -153, -153, -297, -153, -153, -153, -164, 66, -165,
+	-134, -137, -136, -296, -3, -159, -163, -203, -112, 69,
+	97, -96, -95, 66, 67, -97, 66, -95, 56, 56,
+	-161, 68, -297, 69, -136, -296, -163, -119, -111, -296,
+	-296, -296, -147, -82, -112, 310, 314, 315, -259, -260,
+	-263, -262, -203, 147, -267, 156, -254, -254, -254, 68,
+	82, -119, 9, 45, 45, 73, 23, 291, 70, -232,
+	-232, -231, -226, -232, 71, 123, -232, 70, 69, 70,
+	69, 70, 69, -115, 393, 88, -105, -154, -154, 66,
+	70, 69, -216, 71, 71, 71, -105, -184, -119, -231,
+	-105, -71, -52, 68, -244, 71, -198, -254, -189, 71,
+	71, -198, 71, 71, -203, 45, 71, -105, -203, -244,
+	71, -82, -203, -203, -93, 127, -112, 11, 69, -80,
+	-297, -119, 127, -297, -226, -226, -226, -237, -226, 238,
+	-226, 238, -297, -297, 19, 19, 19, 19, -296, -74,
+	333, -82, 69, 69, -297, -297, -297, -147, 172, -153,
+	-153, -153, -153, -153, 28, -164, 69, -297, -297, -297,
+	-297, 69, -147, -169, -82, -82, 68, -82, -160, -203,
+	-194, 185, -297, -111, -194, -160, -160, -160, -157, 70,
+	69, -226, 45, -160, -124, -296, -232, -231, 73, -231,
+	74, 74, 393, 68, -3, -105, -282, -283, -284, 80,
+	374, 88, 193, 194, 157, 195, 196, 197, -281, -216,
+	-216, -216, -285, -183, -77, -244, -149, 160, 161, -216,
+	-219, -207, -251, 71, -193, 144, 23, 144, -149, -244,
+	30, 132, 27, -203, -144, 13, -85, -155, -155, 114,
+	-231, 71, -119, -119, -119, -119, -119, -297, 73, -119,
+	-119, -297, 16, 45, -137, 47, -3, -296, -203, -157,
+	-160, 70, 69, -212, -136, -110, -212, -297, -297, -297,
+	-265, -264, 67, 151, 80, -262, -156, 70, -82, -232,
+	-232, 70, 70, -263, -154, 19, -154, -284, 73, 197,
+	193, 197, 197, 197, 197, 198, -286, 38, -288, 39,
+	11, 70, 71, -217, -217, 68, -254, 71, -203, -149,
+	29, 29, -296, -145, 14, 16, -112, -80, -297, -297,
+	-297, -297, -73, 107, 375, -297, -297, -132, 9, -134,
+	-3, 70, -203, -111, -264, 71, -255, 73, 158, -297,
+	70, 184, -21, -3, -9, -10, -11, -12, -14, -47,
+	-15, -16, -22, -23, -17, -54, -55, -64, -62, -63,
+	-59, -58, -26, -24, -27, -19, -28, -29, -30, -25,
+	91, 146, 215, 225, 36, 36, 189, 190, -228, 145,
+	-289, 43, -287, -241, 42, 16, -85, -263, -207, -119,
+	-269, -270, 172, -146, -82, -202, -133, -84, -82, -202,
+	-144, -155, -297, 373, 63, 376, -147, -165, -297, 74,
+	-105, -235, 293, 292, -266, -265, 185, -154, -82, -82,
+	71, -43, -208, 218, 219, 220, -204, -33, -21, -161,
+	74, -288, 16, -290, 40, 41, 73, -112, 70, 68,
+	-297, 69, -203, 69, -145, 53, 374, 377, -297, 68,
+	-150, 187, 188, -34, -35, 92, 93, 216, 217, 221,
+	-216, 69, 445, 38, 73, 16, 16, -144, -266, -263,
+	-270, 47, -84, 53, -160, -154, 71, 71, 96, 94,
+	-35, -82, -33, 19, 19, 19, 29, -204, 96, -21,
+	73, 73, -145, 70, 174, 375, 70, -13, -20, -12,
+	-9, -10, -11, 225, 91, -33, 93, 445, -44, 74,
+	-3, -42, -41, 74, -44, 223, 88, 224, 71, -119,
+	-266, 175, 376, -274, -275, 66, -154, -33, 445, -33,
+	96, 94, -36, -37, 95, -21, 69, 222, -296, 377,
+	-275, 66, -274, 66, 10, 9, 445, 96, 445, 146,
+	-33, 96, 94, -37, -82, -41, -119, 171, -273, 159,
+	154, 157, 30, -273, 96, 91, 445, 146, -33, 93,
+	-297, -297, 153, 29, 82, 96, 445, -33, 146, 96,
+	445, 146,
+}
+var yyDef = [...]int{
+
+	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
+	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
+	31, 32, 33, -2, 544, 544, 544, 544, 544, 544,
+	621, -2, 411, 412, 0, 0, 0, 466, -2, 515,
+	516, 517, 519, 520, 535, -2, 1373, 1373, 1373, 0,
+	186, 0, 0, 0, 0, 0, 0, 39, 0, 0,
+	55, 56, 930, 0, 538, 527, 528, 0, 0, 1371,
+	1, 3, 872, 0, 553, 546, 0, 930, 930, 0,
+	0, 86, 0, 0, 0, 622, 0, 0, 0, 928,
+	928, 0, 0, 951, 0, 925, 947, 948, 949, 0,
+	926, 0, 926, 926, 926, 926, 926, 0, 464, 639,
+	960, 961, 1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133,
+	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
+	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
+	1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163,
+	1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173,
+	1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183,
+	1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193,
+	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
+	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
+	1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223,
+	1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233,
+	1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243,
+	1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253,
+	1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263,
+	1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273,
+	1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283,
+	1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293,
+	1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303,
+	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
+	1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323,
+	1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333,
+	1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342, 0,
+	467, 468, 0, 0, 508, 0, 1374, 1374, 482, 0,
+	484, 0, 0, 0, 0, 0, 493, 496, 1374, 362,
+	363, 364, 512, 513, 502, 514, 0, 0, 0, 0,
+	0, 539, 540, 541, 542, 543, 165, 167, 0, 187,
+	0, 190, 193, 966, 521, 0, 0, 991, 544, 0,
+	548, 0, 0, 931, 518, 44, 45, 0, 623, 624,
+	625, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
+	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361,
+	1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 0,
+	878, 0, 0, 556, 554, 555, 545, 0, 567, 576,
+	0, 648, 0, 653, 655, -2, -2, 0, 696, 697,
+	698, 699, 700, 0, 0, 0, 0, 0, 0, 0,
+	0, 724, 725, 726, 727, 728, 729, 842, 843, 844,
+	845, 846, 847, 848, 849, 657, 658, 839, 898, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	830, 0, 799, 799, 799, 799, 799, 799, 799, 799,
+	799, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 66, 68, 639, 72, 1321,
+	0, 902, -2, -2, 904, 0, 0, 0, -2, -2,
+	-2, -2, 1144, -2, 839, 0, 87, 863, 544, 88,
+	0, 227, 228, 0, 954, 1156, 383, 958, 959, 1146,
+	955, 992, 993, 994, 995, 996, 997, 998, 999, 1000,
+	1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010,
+	1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020,
+	1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040,
+	1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050,
+	1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060,
+	1061, 1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070,
+	1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080,
+	1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090,
+	1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100,
+	1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110,
+	1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120,
+	1121, 1122, 1123, 1124, 1125, 0, 0, 0, 0, 0,
+	0, 0, 0, 928, 0, 453, 0, 0, 0, 0,
+	954, 0, 0, 0, 0, 0, 463, 0, 0, 1374,
+	508, 471, 0, 0, 928, 928, 1374, 1374, 1374, 0,
+	0, 0, 480, 1375, 1376, 481, 0, 385, 386, 508,
+	508, 1374, 1374, 505, 0, 505, 505, 503, 504, 499,
+	500, 0, 495, 0, 497, 522, 0, 0, 529, 531,
+	532, 533, 534, 530, 0, 0, 0, 169, 0, 0,
+	189, 171, 0, 174, 175, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 185, 0, 192, 0, 964, 967,
+	526, 982, 983, 0, 553, 41, 51, 0, 549, 550,
+	0, 1374, 0, 0, 0, 50, 1372, 36, 0, 0,
+	873, 876, 877, 864, 865, 869, 869, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 1369, 1370, 0, 0, 551, 557, 558,
+	547, 0, 568, 0, 0, 0, 577, 0, 579, 580,
+	581, 582, 0, 651, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 675, 676, 677, 678, 679, 680, 681,
+	654, 0, 668, 0, 0, 0, 716, 717, 718, 719,
+	720, 721, 722, 0, 556, 0, 0, 694, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 556, 0,
+	831, 0, 775, 784, 0, 776, 785, 777, 786, 778,
+	0, 779, 787, 780, 788, 781, 782, 789, 783, 0,
+	0, 0, 0, 0, 0, 690, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 556, 556, 0, 0, 0, 556, 0, 0, 556,
+	0, 0, 0, 0, 556, 0, 0, 0, 70, 0,
+	638, 0, 583, 585, 586, 587, -2, 639, 0, 642,
+	688, -2, 0, 0, 0, 62, 63, 0, 0, 0,
+	0, 73, 75, 0, 0, 1321, 905, 906, 336, 919,
+	920, 921, 0, 917, 0, 872, 553, 400, 0, 229,
+	0, 0, 239, 318, 248, 249, 250, 311, 253, 311,
+	311, 0, 311, 311, 311, 311, 311, 333, 333, 333,
+	333, 333, 294, 295, 296, 297, 298, 0, 0, 271,
+	311, 311, 311, 275, 301, 302, 303, 304, 305, 306,
+	307, 308, 254, 255, 256, 257, 258, 259, 260, 261,
+	262, 313, 313, 315, 315, 315, 315, 315, 239, 315,
+	0, 382, 0, 952, 84, 0, 1374, 0, 1374, 0,
+	0, 0, 121, 0, 0, 456, 927, 0, 458, 64,
+	459, 460, 461, 462, 640, 962, 963, 465, 469, 470,
+	509, 510, 0, 0, 474, 475, 476, 477, 478, 479,
+	387, 485, 486, 487, 492, 508, 0, 0, 0, 603,
+	508, 494, 682, 524, 523, 0, 536, 166, 168, 170,
+	188, 0, 0, 191, 194, 0, 0, 0, 0, 985,
+	0, 987, 989, 0, 556, 0, 0, 442, 46, 47,
+	0, 0, 887, 879, 0, 0, 0, 0, 867, 870,
+	871, 868, 0, 0, 850, 0, 0, 559, 552, 38,
+	649, 650, 652, 669, 0, 671, 673, 578, 569, 0,
+	840, -2, 659, 660, 684, 685, 686, 0, 0, 0,
+	0, 682, 664, 0, 701, 702, 703, 704, 705, 706,
+	707, 708, 709, 710, 711, 712, 715, 815, 816, 0,
+	713, 714, 723, 690, 687, 0, 897, 0, 0, 692,
+	563, 564, 0, 0, 762, 0, 0, 698, 842, 0,
+	698, 842, 0, 0, 0, 0, 837, 834, 0, 0,
+	800, 0, 0, 0, 0, 0, 0, 795, 796, 797,
+	798, 0, 0, 691, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 637, 0,
+	0, 0, 0, 0, 0, 626, 0, 0, 629, 0,
+	0, 0, 0, 690, 0, 0, 0, 0, 596, 1250,
+	598, 0, 642, 0, 0, 0, 646, 0, 646, 67,
+	646, 69, 0, 641, 903, 0, 0, 0, 78, 79,
+	74, 907, 0, 913, 914, 915, 840, 0, 878, 556,
+	226, 401, 403, 406, 407, 408, 230, 232, 233, 234,
+	0, 1156, 375, 1260, 1300, -2, -2, 369, 1183, -2,
+	-2, -2, 320, 319, 252, 0, 333, 333, 311, 311,
+	311, 311, 280, 333, 283, 286, 287, 336, 0, 336,
+	336, 336, 336, 333, 0, 0, 272, 273, 274, 263,
+	0, 264, 313, 266, 0, 267, 268, 269, 270, -2,
+	251, 381, 0, 0, 0, 0, 0, 92, 929, 93,
+	0, 122, 123, 97, 225, 0, 454, 1374, 0, 1374,
+	1374, 646, 0, 0, 488, 506, 507, 505, 508, 0,
+	491, 498, 0, 525, 172, 173, 195, 70, 0, 965,
+	984, 0, 988, 990, 551, 52, 413, 414, 1373, -2,
+	443, 944, 443, 443, 432, 353, 443, 934, 935, 936,
+	937, 938, 939, 940, 941, 0, 0, 0, 0, 0,
+	874, 875, 866, 0, 922, 923, 851, 852, 85, 560,
+	670, 672, 674, 0, 690, 661, 682, 665, 0, 662,
+	0, 656, 0, 695, -2, 0, 760, 0, 761, 0,
+	0, 0, 0, 0, 808, 0, 0, 809, 0, 771,
+	863, 0, 835, 0, 0, 774, 801, 0, 0, 0,
+	0, 0, 802, 803, 804, 805, 806, 807, 749, 0,
+	750, 0, 0, 0, 0, 0, 756, 757, 758, 759,
+	0, 0, 574, 574, 574, 0, 574, 574, 0, 574,
+	574, 574, 574, 0, 574, 574, 574, 891, 0, 0,
+	882, 0, 0, 646, 899, 0, 584, 617, 619, 0,
+	614, 627, 628, 630, 0, 632, 0, 634, 635, 605,
+	0, 592, 689, 593, 0, 0, 0, 642, 602, 0,
+	0, 0, 863, 0, 646, 60, 61, 0, 76, 77,
+	0, 0, 83, 337, 338, 0, 96, 0, 404, 0,
+	231, 0, 0, 355, 369, 365, 366, 369, 368, 369,
+	360, 370, 361, 0, 240, 241, 242, 243, 244, 245,
+	246, 322, 0, 0, 0, 326, 0, 340, 342, 0,
+	0, 0, 247, 321, 0, 336, 336, 333, 278, 279,
+	311, 284, 336, 288, 0, 289, 290, 291, 292, 336,
+	0, 309, 0, 0, 265, 0, 397, 0, 953, 84,
+	84, 0, 124, 125, 126, 0, 98, 99, 0, 0,
+	0, 0, 0, 457, 65, 472, 473, 483, 388, 389,
+	508, 490, 0, 683, 333, 0, 986, 559, 0, 416,
+	0, 1373, 369, 0, 433, 434, 444, 445, 446, 447,
+	0, 0, 1373, 0, 0, 0, 445, 0, 0, 0,
+	945, 946, 0, 0, 0, 354, 0, 0, 0, 889,
+	888, 880, 37, 646, 0, 570, 841, 0, 663, 0,
+	666, 730, 693, 565, 0, 0, 311, 311, 820, 311,
+	315, 823, 311, 825, 311, 828, 0, 0, 0, 0,
+	0, 0, 0, 832, 773, 838, 0, 0, 0, 0,
+	0, 0, 571, 863, 751, 752, 753, 754, 755, 574,
+	574, 734, 575, 735, 736, 574, 738, 739, 574, 741,
+	742, 743, 744, 574, 746, 747, 748, 53, 0, 891,
+	881, 893, 895, 0, 0, 0, 0, 609, 863, 0,
+	0, 611, 618, 0, 0, 612, 0, 613, 631, 633,
+	588, 0, -2, 0, 594, 0, 0, -2, 601, 0,
+	0, 0, 872, 647, 59, 80, 81, 82, 402, 405,
+	0, 371, 311, 0, 374, 0, 357, 358, 359, 0,
+	323, 324, 0, 339, 341, 343, 0, 329, 312, 276,
+	277, 336, 285, 281, 334, 335, 293, 333, 0, 333,
+	0, 316, 0, 384, 398, 0, 0, 90, 0, 0,
+	105, 0, 101, 0, 0, 0, 455, 489, 604, 976,
+	636, 85, 415, 0, 439, 0, 419, 952, 367, 410,
+	420, 421, 426, 427, 428, 0, 0, 423, 0, 439,
+	0, 436, 0, 0, 48, 0, 853, 0, 85, 85,
+	731, 667, 0, 763, 817, 333, 821, 822, 824, 826,
+	827, 829, 765, 764, 0, 0, 0, 0, 0, 0,
+	0, 836, 0, 0, 792, 793, 794, 0, 0, 732,
+	733, 737, 740, 745, 0, 54, 0, 896, -2, 0,
+	71, 0, 872, 900, 901, 615, 0, 620, 0, 607,
+	0, 0, 642, 599, 0, 0, 0, 0, 58, 345,
+	0, 869, 356, 0, 325, 0, 282, 336, 310, 336,
+	0, 0, 399, 0, 84, 0, 84, 106, 107, 0,
+	0, 0, 112, 0, 0, 0, 0, 0, 100, 102,
+	103, 104, 978, 972, 0, 0, 418, 440, 0, 239,
+	239, 0, 369, 435, 0, 942, 943, 0, 430, 439,
+	0, 0, 0, 890, 859, 0, 646, 0, 561, 566,
+	818, 819, 0, 0, 0, 0, 810, 772, 833, 0,
+	0, 572, 0, 0, 894, 0, 885, 0, 610, 57,
+	0, 606, 0, 591, 595, 597, 642, 643, 644, 645,
+	344, 348, 0, 353, 0, 372, 373, 0, 0, 299,
+	300, 314, 317, 0, 91, 0, 0, 108, 109, 110,
+	111, 113, 114, 0, 0, 0, 932, 980, -2, 0,
+	0, 417, 441, -2, -2, 0, 952, 422, 429, 431,
+	0, 438, 0, 42, 0, 0, 853, 85, 766, 768,
+	767, 769, 0, 0, 0, 790, 791, 863, 0, 884,
+	0, 616, 608, 600, 349, 350, 0, 352, 0, 330,
+	346, 0, 84, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	0, 0, 0, 0, 115, 116, 117, 118, 605, 0,
+	972, 0, 974, 0, 971, 0, 646, 0, 0, 437,
+	0, 449, 0, 860, 861, 862, 854, 855, 857, 858,
+	859, 562, 770, 0, 0, 0, 0, 892, -2, 351,
+	0, 327, 331, 332, 89, 347, 127, 95, 0, 0,
+	954, 0, 0, 154, 155, 156, 956, 0, 196, 35,
+	0, 979, 0, 977, 0, 0, 973, 853, 346, 0,
+	448, 0, 0, 0, 43, 811, 0, 814, 573, 0,
+	84, 0, 0, 0, 138, 0, 0, 0, 0, 0,
+	152, 0, 0, 933, 981, 0, 0, 859, 424, 0,
+	450, 0, 856, 812, 0, 0, 128, 129, 0, 0,
+	139, 0, 0, 0, 0, 0, 0, 957, 119, 197,
+	975, 969, 40, 346, 0, 0, 376, 84, 130, 131,
+	132, 133, 134, 0, 136, 0, 0, 0, 148, 149,
+	150, 0, 157, 159, 160, 161, 0, 163, 164, 153,
+	425, 0, 0, 377, 378, 0, 94, 0, 0, 0,
+	0, 0, 0, 145, 0, 151, 0, 162, 0, 813,
+	379, 0, 380, 0, 0, 0, 0, 0, 140, 141,
+	0, 0, 0, 146, 0, 158, 0, 0, 390, 392,
+	393, 0, 0, 391, 135, 137, 0, 143, 0, 0,
+	451, 452, 394, 395, 396, 0, 0, 0, 142, 0,
+	147, 144,
+}
+var yyTok1 = [...]int{
+
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 89, 3, 3, 3, 117, 109, 3,
+	68, 70, 114, 112, 69, 113, 127, 115, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 445,
+	98, 97, 99, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 119, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 108, 3, 120,
+}
+var yyTok2 = [...]int{
+
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 71, 72, 73, 74,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 90, 91, 92, 93, 94, 95,
+	96, 100, 101, 102, 103, 104, 105, 106, 107, 110,
+	111, 116, 118, 121, 122, 123, 124, 125, 126, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 242, 243, 244, 245, 246, 247, 248,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	269, 270, 271, 272, 273, 274,
+}
+var yyTok3 = [...]int{
+	57600, 275, 57601, 276, 57602, 277, 57603, 278, 57604, 279,
+	57605, 280, 57606, 281, 57607, 282, 57608, 283, 57609, 284,
+	57610, 285, 57611, 286, 57612, 287, 57613, 288, 57614, 289,
+	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
+	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
+	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	57645, 320, 57646, 321, 57647, 322, 57648, 323, 57649, 324,
+	57650, 325, 57651, 326, 57652, 327, 57653, 328, 57654, 329,
+	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
+	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
+	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 57679, 354,
+	57680, 355, 57681, 356, 57682, 357, 57683, 358, 57684, 359,
+	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
+	57690, 365, 57691, 366, 57692, 367, 57693, 368, 57694, 369,
+	57695, 370, 57696, 371, 57697, 372, 57698, 373, 57699, 374,
+	57700, 375, 57701, 376, 57702, 377, 57703, 378, 57704, 379,
+	57705, 380, 57706, 381, 57707, 382, 57708, 383, 57709, 384,
+	57710, 385, 57711, 386, 57712, 387, 57713, 388, 57714, 389,
+	57715, 390, 57716, 391, 57717, 392, 57718, 393, 57719, 394,
+	57720, 395, 57721, 396, 57722, 397, 57723, 398, 57724, 399,
+	57725, 400, 57726, 401, 57727, 402, 57728, 403, 57729, 404,
+	57730, 405, 57731, 406, 57732, 407, 57733, 408, 57734, 409,
+	57735, 410, 57736, 411, 57737, 412, 57738, 413, 57739, 414,
+	57740, 415, 57741, 416, 57742, 417, 57743, 418, 57744, 419,
+	57745, 420, 57746, 421, 57747, 422, 57748, 423, 57749, 424,
+	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
+	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
+	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:425
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:430
+		{
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:431
+		{
+		}
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:435
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 34:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:468
+		{
+			setParseTree(yylex, nil)
+		}
+	case 35:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:474
+		{
+			yyVAL.statement = &Load{Local: yyDollar[3].boolVal, Infile: yyDollar[4].str, Table: yyDollar[5].tableName, Partition: yyDollar[6].partitions, Charset: yyDollar[7].str, Fields: yyDollar[8].Fields, Lines: yyDollar[9].Lines, IgnoreNum: yyDollar[10].sqlVal, Columns: yyDollar[11].columns}
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:480
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 37:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:487
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 38:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:493
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:500
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 40:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:504
+		{
+			yyVAL.selStmt = &Select{CommonTableExprs: yyDollar[1].tableExprs, Comments: Comments(yyDollar[3].bytes2), Cache: yyDollar[4].str, Distinct: yyDollar[5].str, Hints: yyDollar[7].str, SelectExprs: yyDollar[8].selectExprs, From: yyDollar[10].tableExprs, Where: NewWhere(WhereStr, yyDollar[11].expr), GroupBy: GroupBy(yyDollar[12].exprs), Having: NewWhere(HavingStr, yyDollar[13].expr)}
+			if yyDollar[6].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 41:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:511
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 42:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:517
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 43:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:524
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: yyDollar[9].tableExprs, Where: NewWhere(WhereStr, yyDollar[10].expr), GroupBy: GroupBy(yyDollar[11].exprs), Having: NewWhere(HavingStr, yyDollar[12].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 44:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:533
+		{
+			yyVAL.tableExprs = yyDollar[2].tableExprs
+		}
+	case 45:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:539
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:543
+		{
+			yyVAL.tableExprs = append(yyDollar[1].tableExprs, yyDollar[3].tableExpr)
+		}
+	case 47:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:549
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[3].simpleTableExpr, As: yyDollar[1].tableIdent}, nil}
+		}
+	case 48:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:553
+		{
+			yyVAL.tableExpr = &CommonTableExpr{&AliasedTableExpr{Expr: yyDollar[6].simpleTableExpr, As: yyDollar[1].tableIdent}, yyDollar[3].columns}
+		}
+	case 49:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:559
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:563
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:569
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 52:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 53:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:579
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].assignExprs)
+			yyVAL.statement = ins
+		}
+	case 54:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:591
+		{
+			cols := make(Columns, 0, len(yyDollar[7].assignExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].assignExprs))
+			for _, updateList := range yyDollar[7].assignExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].assignExprs)}
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:603
+		{
+			yyVAL.str = InsertStr
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:607
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 57:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:613
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].assignExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+		}
+	case 58:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:619
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 59:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:623
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 60:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:627
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 61:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:631
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:636
+		{
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:637
+		{
+		}
+	case 64:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:641
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
+		}
+	case 65:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:645
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 66:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:651
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 67:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:655
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:661
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 69:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:665
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 70:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:670
+		{
+			yyVAL.partitions = nil
+		}
+	case 71:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:674
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 72:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:680
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setVarExprs}
+		}
+	case 73:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:684
+		{
+			for i := 0; i < len(yyDollar[4].setVarExprs); i++ {
+				yyDollar[4].setVarExprs[i].Scope = SetScope_None
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setVarExprs}
+		}
+	case 74:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:691
+		{
+			for i := 0; i < len(yyDollar[5].setVarExprs); i++ {
+				yyDollar[5].setVarExprs[i].Scope = yyDollar[3].setScope
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[5].setVarExprs}
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:700
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 76:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:704
+		{
+			yyVAL.setVarExprs = append(yyVAL.setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 77:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:710
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
+		}
+	case 78:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:714
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 79:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:718
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 80:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:724
+		{
+			yyVAL.str = IsolationLevelRepeatableRead
+		}
+	case 81:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:728
+		{
+			yyVAL.str = IsolationLevelReadCommitted
+		}
+	case 82:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:732
+		{
+			yyVAL.str = IsolationLevelReadUncommitted
+		}
+	case 83:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:736
+		{
+			yyVAL.str = IsolationLevelSerializable
+		}
+	case 84:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:741
+		{
+			yyVAL.int = yyPosition(yylex)
+		}
+	case 85:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:746
+		{
+			yyVAL.int = yyOldPosition(yylex)
+		}
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:752
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			if len(yyDollar[1].ddl.TableSpec.Constraints) > 0 {
+				yyDollar[1].ddl.ConstraintAction = AddStr
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 87:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:761
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[3].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:766
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 89:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:771
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, IndexSpec: &IndexSpec{Action: CreateStr, ToName: yyDollar[4].colIdent, Using: yyDollar[5].colIdent, Type: yyDollar[2].str, Columns: yyDollar[9].indexColumns, Options: yyDollar[11].indexOptions}}
+		}
+	case 90:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:775
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[3].tableName.ToViewName(), ViewExpr: yyDollar[6].selStmt, SubStatementPositionStart: yyDollar[5].int, SubStatementPositionEnd: yyDollar[7].int - 1}
+		}
+	case 91:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:779
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[5].tableName.ToViewName(), ViewExpr: yyDollar[8].selStmt, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[9].int - 1, OrReplace: true}
+		}
+	case 92:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:783
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 93:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:791
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 94:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:799
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[8].tableName, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes), Time: yyDollar[5].str, Event: yyDollar[6].str, Order: yyDollar[12].triggerOrder, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 95:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:803
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes), Definer: yyDollar[2].str, Params: yyDollar[6].procedureParams, Characteristics: yyDollar[8].characteristics, Body: yyDollar[10].statement}, SubStatementPositionStart: yyDollar[9].int, SubStatementPositionEnd: yyDollar[11].int - 1}
+		}
+	case 96:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:810
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 97:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:818
+		{
+			yyVAL.procedureParams = nil
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:822
+		{
+			yyVAL.procedureParams = yyDollar[1].procedureParams
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:828
+		{
+			yyVAL.procedureParams = []ProcedureParam{yyDollar[1].procedureParam}
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:832
+		{
+			yyVAL.procedureParams = append(yyVAL.procedureParams, yyDollar[3].procedureParam)
+		}
+	case 101:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:838
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[1].bytes), Type: yyDollar[2].columnType}
+		}
+	case 102:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:842
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 103:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:846
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 104:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:850
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 105:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:855
+		{
+			yyVAL.characteristics = nil
+		}
+	case 106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:859
+		{
+			yyVAL.characteristics = yyDollar[1].characteristics
+		}
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:865
+		{
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:869
+		{
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[2].characteristic)
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:875
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:879
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:883
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:887
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:891
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:895
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 115:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:899
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 116:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:903
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:907
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:911
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 119:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:917
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 120:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:922
+		{
+			yyVAL.str = ""
+		}
+	case 121:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:926
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:932
+		{
+			yyVAL.str = BeforeStr
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:936
+		{
+			yyVAL.str = AfterStr
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:942
+		{
+			yyVAL.str = InsertStr
+		}
+	case 125:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:946
+		{
+			yyVAL.str = UpdateStr
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:950
+		{
+			yyVAL.str = DeleteStr
+		}
+	case 127:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:955
+		{
+			yyVAL.triggerOrder = nil
+		}
+	case 128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:959
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:963
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:969
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 135:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:979
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 136:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:985
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases}
+		}
+	case 137:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:989
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases, Else: yyDollar[5].statements}
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:995
+		{
+			yyVAL.caseStatementCases = []CaseStatementCase{yyDollar[1].caseStatementCase}
+		}
+	case 139:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:999
+		{
+			yyVAL.caseStatementCases = append(yyVAL.caseStatementCases, yyDollar[2].caseStatementCase)
+		}
+	case 140:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1005
+		{
+			yyVAL.caseStatementCase = CaseStatementCase{Case: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 141:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1011
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 142:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1016
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[7].statements}
+		}
+	case 143:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1021
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 144:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1027
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[8].statements}
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1035
+		{
+			yyVAL.ifStatementConditions = []IfStatementCondition{yyDollar[1].ifStatementCondition}
+		}
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1039
+		{
+			yyVAL.ifStatementConditions = append(yyVAL.ifStatementConditions, yyDollar[2].ifStatementCondition)
+		}
+	case 147:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1045
+		{
+			yyVAL.ifStatementCondition = IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 148:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1051
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 149:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1055
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 150:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1059
+		{
+			yyVAL.statement = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].selStmt}}
+		}
+	case 151:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1063
+		{
+			yyVAL.statement = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].declareHandlerAction, ConditionValues: yyDollar[5].declareHandlerConditions, Statement: yyDollar[6].statement}}
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1067
+		{
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 153:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1071
+		{
+			yyDollar[3].columnType.Default = yyDollar[5].expr
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1078
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Continue
+		}
+	case 155:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1082
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Exit
+		}
+	case 156:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1086
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Undo
+		}
+	case 157:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1092
+		{
+			yyVAL.declareHandlerConditions = []DeclareHandlerCondition{yyDollar[1].declareHandlerCondition}
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1096
+		{
+			yyVAL.declareHandlerConditions = append(yyVAL.declareHandlerConditions, yyDollar[3].declareHandlerCondition)
+		}
+	case 159:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1102
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 160:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1106
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 161:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1110
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1114
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1118
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1122
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 165:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1128
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 166:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1132
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 167:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1136
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 168:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1140
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1146
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1150
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 171:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1156
+		{
+			yyVAL.signalInfos = []SignalInfo{yyDollar[1].signalInfo}
+		}
+	case 172:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1160
+		{
+			yyVAL.signalInfos = append(yyVAL.signalInfos, yyDollar[3].signalInfo)
+		}
+	case 173:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1166
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: yyDollar[3].expr.(*SQLVal)}
+		}
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1172
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ClassOrigin
+		}
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1176
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SubclassOrigin
+		}
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1180
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MessageText
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1184
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MysqlErrno
+		}
+	case 178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1188
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintCatalog
+		}
+	case 179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1192
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintSchema
+		}
+	case 180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1196
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintName
+		}
+	case 181:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1200
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CatalogName
+		}
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1204
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SchemaName
+		}
+	case 183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1208
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_TableName
+		}
+	case 184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1212
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ColumnName
+		}
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1216
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CursorName
+		}
+	case 186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1222
+		{
+			yyVAL.statement = &Resignal{}
+		}
+	case 187:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1226
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 188:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1230
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1234
+		{
+			yyVAL.statement = &Resignal{Signal{Info: yyDollar[3].signalInfos}}
+		}
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1238
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1242
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1248
+		{
+			yyVAL.statement = &Call{FuncName: string(yyDollar[2].bytes), Params: yyDollar[3].exprs}
+		}
+	case 193:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1253
+		{
+			yyVAL.exprs = nil
+		}
+	case 194:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1257
+		{
+			yyVAL.exprs = nil
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1261
+		{
+			yyVAL.exprs = yyDollar[2].exprs
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1267
+		{
+			yyVAL.statements = Statements{yyDollar[1].statement}
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1271
+		{
+			yyVAL.statements = append(yyVAL.statements, yyDollar[3].statement)
+		}
+	case 198:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1277
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 225:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1309
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, IfNotExists: ne, Temporary: neTemp}
+			setDDL(yylex, yyVAL.ddl)
+		}
+	case 226:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1326
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+		}
+	case 227:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1333
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 228:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1338
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
+		}
+	case 229:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1343
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1349
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1353
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
+		}
+	case 232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1358
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1362
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 234:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1366
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1372
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1380
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1390
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1398
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 239:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1407
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 240:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1411
+		{
+			opt := ColumnType{NotNull: yyDollar[2].boolVal, sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 241:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1420
+		{
+			opt := ColumnType{Default: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 242:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1429
+		{
+			opt := ColumnType{OnUpdate: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 243:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1438
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].boolVal, sawai: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1447
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].colKeyOpt}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 245:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1456
+		{
+			opt := ColumnType{Comment: yyDollar[2].sqlVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1465
+		{
+			if err := yyDollar[1].columnType.merge(yyDollar[2].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 247:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1475
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 251:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1488
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].LengthScaleOption.Length, Scale: yyDollar[2].LengthScaleOption.Scale}
+		}
+	case 252:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1494
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].sqlVal
+		}
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1499
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1505
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1509
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1513
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 257:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1517
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1521
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1525
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 260:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1529
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 261:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1533
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 262:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1537
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 263:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1543
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 264:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1549
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 265:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1555
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 266:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1561
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 267:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1567
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 268:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1573
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 269:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1579
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 270:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1585
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 271:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1593
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 272:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1597
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 273:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1601
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 274:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1605
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 275:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1609
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 276:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1615
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 277:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1619
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 278:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1623
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 279:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1627
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 280:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1631
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 281:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1635
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 282:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1639
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
+		}
+	case 283:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1643
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1647
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 285:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1651
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 286:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1655
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 287:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1659
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 288:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1663
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 289:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1667
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1671
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 291:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1675
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1679
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 293:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1683
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 294:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1687
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 295:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1691
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1695
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 297:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1699
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1703
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 299:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1707
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 300:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1712
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1718
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1722
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1726
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1730
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1734
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1738
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1742
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1746
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1752
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[1].bytes))
+		}
+	case 310:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1757
+		{
+			yyVAL.strs = append(yyDollar[1].strs, string(yyDollar[3].bytes))
+		}
+	case 311:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1762
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 312:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1766
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 313:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1771
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 314:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1775
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 315:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1783
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1787
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 317:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1793
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 318:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1801
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 319:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1805
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 320:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1810
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1814
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1821
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 323:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1825
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 324:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1831
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 325:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1837
+		{
+			yyVAL.optVal = yyDollar[3].expr
+		}
+	case 326:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1843
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 327:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1849
+		{
+			yyVAL.columnType = ColumnType{GeneratedExpr: yyDollar[4].expr, Stored: yyDollar[6].boolVal}
+		}
+	case 328:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1854
+		{
+		}
+	case 329:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1856
+		{
+		}
+	case 330:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1859
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1863
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 332:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1867
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 333:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1872
+		{
+			yyVAL.str = ""
+		}
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1880
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 336:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1885
+		{
+			yyVAL.str = ""
+		}
+	case 337:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1889
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 338:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1893
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 339:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1899
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1903
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 341:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1907
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 342:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1911
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 343:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1917
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 344:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1923
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 345:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1927
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 346:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1932
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1936
+		{
+			yyVAL.indexOptions = yyDollar[1].indexOptions
+		}
+	case 348:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1942
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 349:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1946
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 350:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1952
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 351:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1956
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 352:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1961
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 353:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1967
+		{
+			yyVAL.str = ""
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1971
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 355:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1977
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 356:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1981
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1985
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+		}
+	case 358:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1989
+		{
+			typ := string(yyDollar[1].bytes)
+			if yyDollar[2].str != "" {
+				typ += " " + yyDollar[2].str
+			}
+			yyVAL.indexInfo = &IndexInfo{Type: typ, Name: NewColIdent(yyDollar[3].str), Fulltext: true}
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1997
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
+		}
+	case 360:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2001
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
+		}
+	case 361:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2005
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+		}
+	case 362:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2011
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 363:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2015
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2019
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 365:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2025
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 366:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2029
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 367:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2034
+		{
+			yyVAL.str = ""
+		}
+	case 368:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2038
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 369:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2043
+		{
+			yyVAL.str = ""
+		}
+	case 370:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2047
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2053
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2057
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2063
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2069
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2073
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 376:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2079
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
+		}
+	case 377:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2083
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
+		}
+	case 378:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2087
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 379:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2091
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
+		}
+	case 380:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2095
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[12].ReferenceAction, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 381:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2101
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 382:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2105
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[2].constraintInfo}
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2109
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 384:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2115
+		{
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2121
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2125
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 387:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2130
+		{
+			yyVAL.str = ""
+		}
+	case 388:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2134
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2138
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 390:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2144
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 391:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2150
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 392:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2156
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 393:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2160
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2164
+		{
+			yyVAL.ReferenceAction = NoAction
+		}
+	case 395:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2168
+		{
+			yyVAL.ReferenceAction = SetDefault
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2172
+		{
+			yyVAL.ReferenceAction = SetNull
+		}
+	case 397:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2177
+		{
+			yyVAL.boolean = true
+		}
+	case 398:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2181
+		{
+			yyVAL.boolean = true
+		}
+	case 399:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2185
+		{
+			yyVAL.boolean = false
+		}
+	case 400:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2190
+		{
+			yyVAL.str = ""
+		}
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2194
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 402:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2198
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 403:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2206
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 404:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2210
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 405:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2214
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 406:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2220
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 407:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2224
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 408:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2228
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 409:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2233
+		{
+			yyVAL.str = ""
+		}
+	case 410:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2237
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 413:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2247
+		{
+			for i := 0; i < len(yyDollar[5].ddls); i++ {
+				if yyDollar[5].ddls[i].Action == RenameStr {
+					yyDollar[5].ddls[i].FromTables = append(TableNames{yyDollar[4].tableName}, yyDollar[5].ddls[i].FromTables...)
+				} else {
+					yyDollar[5].ddls[i].Table = yyDollar[4].tableName
+				}
+			}
+			yyVAL.statement = &MultiAlterDDL{Table: yyDollar[4].tableName, Statements: yyDollar[5].ddls}
+		}
+	case 414:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2260
+		{
+			yyVAL.ddls = []*DDL{yyDollar[1].ddl}
+		}
+	case 415:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2264
+		{
+			yyVAL.ddls = append(yyVAL.ddls, yyDollar[3].ddl)
+		}
+	case 416:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2270
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 417:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2274
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			ddl.Column = yyDollar[4].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 418:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2281
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 419:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2288
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 420:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2292
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 421:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2296
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 422:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2300
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 423:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2304
+		{
+			// Change this to a rename statement
+			yyVAL.ddl = &DDL{Action: RenameStr, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 424:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2309
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[3].str), Using: yyDollar[4].colIdent, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}}
+		}
+	case 425:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2313
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[5].str), Type: yyDollar[3].str, Using: yyDollar[6].colIdent, Columns: yyDollar[8].indexColumns, Options: yyDollar[10].indexOptions}}
+		}
+	case 426:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2317
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 427:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2322
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 428:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2327
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 429:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2331
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: RenameStr, FromName: yyDollar[3].colIdent, ToName: yyDollar[5].colIdent}}
+		}
+	case 430:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2335
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ModifyStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 431:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2342
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ChangeStr, TableSpec: &TableSpec{}, Column: NewColIdent(string(yyDollar[3].bytes)), ColumnOrder: yyDollar[5].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2348
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, PartitionSpec: yyDollar[1].partSpec}
+		}
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2352
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 434:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2358
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 435:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2364
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[4].bytes), Details: &ForeignKeyDefinition{}}}}}
+		}
+	case 436:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2369
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: yyDollar[3].expr}}
+		}
+	case 437:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2373
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: SetStr, Column: yyDollar[3].colIdent, Value: yyDollar[6].expr}}
+		}
+	case 438:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2377
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: DropStr, Column: yyDollar[3].colIdent}}
+		}
+	case 439:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2382
+		{
+			yyVAL.columnOrder = nil
+		}
+	case 440:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2386
+		{
+			yyVAL.columnOrder = &ColumnOrder{First: true}
+		}
+	case 441:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2390
+		{
+			yyVAL.columnOrder = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 442:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2396
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
+		}
+	case 443:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2402
+		{
+		}
+	case 444:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2404
+		{
+		}
+	case 448:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2413
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 449:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2419
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 450:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2423
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 451:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2429
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 452:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2433
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 453:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2439
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 454:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2445
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 455:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2449
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 456:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2457
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 457:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2465
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 458:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2469
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromViews: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 459:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2477
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2485
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 461:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2493
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 462:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2501
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 463:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2511
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 464:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2515
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 465:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2520
+		{
+			yyVAL.statement = &Analyze{Tables: yyDollar[4].tableNames}
+		}
+	case 466:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2526
+		{
+		}
+	case 467:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2528
+		{
+		}
+	case 468:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2530
+		{
+		}
+	case 469:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2534
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 470:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2539
+		{
+			yyVAL.statement = &Show{Type: CharsetStr, Filter: yyDollar[4].showFilter}
+		}
+	case 471:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2543
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 472:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2547
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 473:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2551
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 474:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2556
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2560
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 476:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2564
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 477:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2568
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 478:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2572
+		{
+			yyVAL.statement = &Show{Type: CreateTriggerStr, Table: yyDollar[4].tableName}
+		}
+	case 479:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2576
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 480:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2580
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 481:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2584
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 482:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2588
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 483:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2592
+		{
+			yyVAL.statement = &Show{Type: IndexStr, Table: yyDollar[4].tableName, Database: yyDollar[5].str, ShowIndexFilterOpt: yyDollar[6].expr}
+		}
+	case 484:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2596
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 485:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2600
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 486:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2604
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 487:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2608
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 488:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2612
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Database: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+		}
+	case 489:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2616
+		{
+			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
+		}
+	case 490:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2621
+		{
+			// this is ugly, but I couldn't find a better way for now
+			if yyDollar[3].str == "processlist" {
+				yyVAL.statement = &Show{Type: yyDollar[3].str}
+			} else {
+				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[6].showFilter, AsOf: yyDollar[5].expr}
+				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
+			}
+		}
+	case 491:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2631
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
+		}
+	case 492:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2635
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 493:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2639
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 494:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2643
+		{
+			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			showCollationFilterOpt := yyDollar[4].expr
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+		}
+	case 495:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2649
+		{
+			// Cannot dereference $3 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			cmp := yyDollar[3].expr.(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			var ex Expr = cmp
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &ex}
+		}
+	case 496:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2657
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 497:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2667
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 498:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2673
+		{
+			yyVAL.expr = &ComparisonExpr{Operator: LikeStr, Right: yyDollar[2].expr, Escape: yyDollar[3].expr}
+		}
+	case 499:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 500:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2683
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 501:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2689
+		{
+			yyVAL.str = ""
+		}
+	case 502:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2693
+		{
+			yyVAL.str = "full "
+		}
+	case 503:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2699
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2703
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 505:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2709
+		{
+			yyVAL.str = ""
+		}
+	case 506:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2713
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 507:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2717
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 508:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2723
+		{
+			yyVAL.showFilter = nil
+		}
+	case 509:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2727
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 510:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2731
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 511:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2737
+		{
+			yyVAL.str = ""
+		}
+	case 512:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2741
+		{
+			yyVAL.str = SessionStr
+		}
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2745
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 514:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2751
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 515:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2755
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 516:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2761
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 517:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2765
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 518:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2771
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2777
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 520:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2783
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 521:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2789
+		{
+			yyVAL.statement = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 522:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2795
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 523:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2799
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 524:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2803
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 525:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2807
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 526:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2813
+		{
+			yyVAL.statement = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2818
+		{
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2819
+		{
+		}
+	case 529:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2823
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 530:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2827
+		{
+			yyVAL.statement = &Explain{Analyze: true, ExplainFormat: TreeStr, Statement: yyDollar[3].selStmt}
+		}
+	case 531:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2833
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 535:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2841
+		{
+			yyVAL.str = ""
+		}
+	case 536:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2845
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2856
+		{
+			yyVAL.statement = &Show{Type: "columns", OnTable: yyDollar[2].tableName}
+		}
+	case 540:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2860
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 541:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2866
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 542:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2870
+		{
+			yyVAL.statement = &OtherAdmin{}
+		}
+	case 543:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2876
+		{
+			yyVAL.statement = &DDL{Action: FlushStr}
+		}
+	case 544:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2880
+		{
+			setAllowComments(yylex, true)
+		}
+	case 545:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2884
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 546:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2890
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 547:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2894
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 548:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2900
+		{
+			yyVAL.str = UnionStr
+		}
+	case 549:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2904
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 550:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2908
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 551:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2913
+		{
+			yyVAL.byt = 0
+		}
+	case 552:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2917
+		{
+			yyVAL.byt = 1
+		}
+	case 553:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2922
+		{
+			yyVAL.str = ""
+		}
+	case 554:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2926
+		{
+			yyVAL.str = SQLNoCacheStr
+		}
+	case 555:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2930
+		{
+			yyVAL.str = SQLCacheStr
+		}
+	case 556:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2935
+		{
+			yyVAL.str = ""
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2939
+		{
+			yyVAL.str = ""
+		}
+	case 558:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2943
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 559:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2948
+		{
+			yyVAL.str = ""
+		}
+	case 560:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2952
+		{
+			yyVAL.str = StraightJoinHint
+		}
+	case 561:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2958
+		{
+			if ae, ok := yyDollar[2].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].int
+				ae.EndParsePos = yyDollar[3].int - 1
+			}
+			yyVAL.selectExprs = SelectExprs{yyDollar[2].selectExpr}
+		}
+	case 562:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2966
+		{
+			if ae, ok := yyDollar[4].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].int
+				ae.EndParsePos = yyDollar[5].int - 1
+			}
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[4].selectExpr)
+		}
+	case 563:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2977
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2981
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 565:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2985
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 566:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2989
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2995
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 568:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2999
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 569:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3003
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 570:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3007
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 571:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3014
+		{
+			yyVAL.over = &Over{WindowName: yyDollar[2].colIdent}
+		}
+	case 572:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3018
+		{
+			yyVAL.over = &Over{OrderBy: yyDollar[3].orderBy}
+		}
+	case 573:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3022
+		{
+			yyVAL.over = &Over{PartitionBy: yyDollar[5].exprs, OrderBy: yyDollar[6].orderBy}
+		}
+	case 574:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3027
+		{
+			yyVAL.over = nil
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3031
+		{
+			yyVAL.over = yyDollar[1].over
+		}
+	case 576:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3036
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 577:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3040
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 578:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3044
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 579:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3050
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 580:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3054
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 581:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3058
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 582:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3062
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 583:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3068
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 584:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3072
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 587:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3082
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 588:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3086
+		{
+			switch n := yyDollar[1].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].simpleTableExpr, As: yyDollar[3].tableIdent}
+		}
+	case 589:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3096
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 590:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3102
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: &TableFuncExpr{Name: NewColIdent(yyDollar[1].tableIdent.String()), Exprs: yyDollar[3].selectExprs}}
+		}
+	case 591:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3106
+		{
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: &TableFuncExpr{Name: NewColIdent(yyDollar[1].tableIdent.String()), Exprs: yyDollar[3].selectExprs}, As: yyDollar[6].tableIdent}
+		}
+	case 592:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3110
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 593:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3116
+		{
+			yyVAL.simpleTableExpr = &ValuesStatement{Rows: yyDollar[2].values}
+		}
+	case 594:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3122
+		{
+			yyVAL.values = Values{yyDollar[2].valTuple}
+		}
+	case 595:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3126
+		{
+			yyVAL.values = append(yyVAL.values, yyDollar[4].valTuple)
+		}
+	case 596:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3132
+		{
+			yyVAL.aliasedTableName = yyDollar[2].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+		}
+	case 597:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3137
+		{
+			yyVAL.aliasedTableName = yyDollar[6].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Partitions = yyDollar[4].partitions
+		}
+	case 598:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3148
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Hints: yyDollar[1].indexHints}
+		}
+	case 599:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3152
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, Hints: yyDollar[4].indexHints}
+		}
+	case 600:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3156
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, As: yyDollar[5].tableIdent, Hints: yyDollar[6].indexHints}
+		}
+	case 601:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3160
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 602:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3164
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[1].tableIdent, Hints: yyDollar[2].indexHints}
+		}
+	case 603:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3175
+		{
+			yyVAL.expr = nil
+		}
+	case 604:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3179
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 605:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3184
+		{
+			yyVAL.columns = nil
+		}
+	case 606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3188
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 607:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3194
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 608:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3198
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3204
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 610:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3208
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 611:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3221
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 612:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3225
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 613:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3229
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 614:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3233
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 615:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3239
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 616:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3241
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 617:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3245
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 618:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3247
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 619:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3251
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 620:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3253
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 621:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3256
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 622:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3258
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3263
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 625:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3267
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 626:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3273
+		{
+			yyVAL.str = JoinStr
+		}
+	case 627:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3277
+		{
+			yyVAL.str = JoinStr
+		}
+	case 628:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3281
+		{
+			yyVAL.str = JoinStr
+		}
+	case 629:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3287
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 630:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3293
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 631:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3297
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 632:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3301
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 633:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3305
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 634:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3311
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 635:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3315
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3325
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 637:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3331
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 638:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3335
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 639:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3341
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 640:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3345
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 641:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3351
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 642:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3356
+		{
+			yyVAL.indexHints = nil
+		}
+	case 643:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3360
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 644:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3364
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 645:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3368
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 646:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3373
+		{
+			yyVAL.expr = nil
+		}
+	case 647:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3377
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 648:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3383
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 649:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3387
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 650:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3391
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 651:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3395
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 652:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3399
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 653:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3403
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 654:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3407
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 655:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3413
+		{
+			yyVAL.str = ""
+		}
+	case 656:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3417
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3423
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 658:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3427
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 659:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3433
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 660:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3437
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 661:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3441
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 662:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3445
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 663:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3449
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 664:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3453
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 665:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3457
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 666:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3461
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 667:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3465
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 668:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3469
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 669:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3475
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 670:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3479
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 671:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3483
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 672:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3487
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 673:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3491
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 674:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3495
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3501
+		{
+			yyVAL.str = EqualStr
+		}
+	case 676:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3505
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 677:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3509
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 678:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3513
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 679:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3517
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 680:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3521
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 681:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3525
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 682:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3530
+		{
+			yyVAL.expr = nil
+		}
+	case 683:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3534
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 684:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3540
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 685:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3544
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 686:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3548
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 687:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3554
+		{
+			yyVAL.subquery = &Subquery{Select: yyDollar[2].selStmt}
+		}
+	case 688:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3560
+		{
+			yyVAL.simpleTableExpr = yyDollar[1].subquery
+		}
+	case 689:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3564
+		{
+			yyVAL.simpleTableExpr = yyDollar[2].simpleTableExpr
+		}
+	case 690:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3570
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 692:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3577
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 693:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3581
+		{
+			yyVAL.selectExprs = append(yyDollar[1].selectExprs, yyDollar[3].selectExpr)
+		}
+	case 694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3587
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 695:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3591
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 696:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3597
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 697:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3601
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 698:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3605
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 699:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3609
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 700:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3613
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 701:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3617
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 702:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3621
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 703:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3625
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 704:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3629
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 705:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3633
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 706:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3637
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 707:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3641
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 708:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3645
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 709:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3649
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 710:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3653
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 711:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3657
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 712:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3661
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 713:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3665
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 714:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3669
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 715:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3673
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 716:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3677
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 717:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3681
+		{
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 718:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3685
+		{
+			yyVAL.expr = &UnaryExpr{Operator: IntroducerStr(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
+		}
+	case 719:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3689
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 720:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3697
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 721:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3711
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 722:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3715
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 723:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3719
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 730:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3739
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: yyDollar[3].str == DistinctStr, Exprs: yyDollar[4].selectExprs}
+		}
+	case 731:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3743
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 732:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3753
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 733:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3757
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 734:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3761
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 735:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3765
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 736:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3769
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 737:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3773
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 738:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3777
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 739:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3781
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 740:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3785
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 741:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3789
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 742:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3793
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 743:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3797
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 744:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3801
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 745:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3805
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 746:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3809
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 747:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3813
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 748:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3817
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 749:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3826
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 750:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3830
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 751:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3834
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 752:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3838
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 753:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3842
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 754:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3846
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 755:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3850
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 756:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3854
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 757:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3858
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 758:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3862
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 759:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3866
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 760:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3877
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 761:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3881
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 762:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3885
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 763:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3889
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 764:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3893
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 765:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3897
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 766:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3901
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 767:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3905
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 768:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3909
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 769:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3913
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 770:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:3917
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 771:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3921
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 772:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3925
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+		}
+	case 773:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3929
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 774:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3933
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 775:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3943
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 776:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3947
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3951
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3956
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 779:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3961
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 780:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3966
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3972
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 782:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3977
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 783:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3981
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 784:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3986
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 785:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3990
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 786:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3994
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 787:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3999
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 788:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4004
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 789:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4009
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 790:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4013
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 791:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4017
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 792:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4021
+		{
+			yyVAL.expr = &ExtractFuncExpr{Unit: yyDollar[3].colIdent.String(), Expr: yyDollar[5].expr}
+		}
+	case 793:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4025
+		{
+			yyVAL.expr = &PositionExpr{Substr: yyDollar[3].expr, Str: yyDollar[5].expr}
+		}
+	case 794:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4029
+		{
+			yyVAL.expr = &GetFormatExpr{Type: string(yyDollar[3].bytes), Expr: yyDollar[5].expr}
+		}
+	case 801:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4046
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 802:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4056
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 803:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4060
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 804:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4064
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 805:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4068
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 806:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4072
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 807:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4076
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 808:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4080
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 809:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4084
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 810:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4090
+		{
+			yyVAL.str = ""
+		}
+	case 811:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4094
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 812:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4098
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 813:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4102
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 814:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4106
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 815:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4112
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 816:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4116
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 817:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4122
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 818:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4126
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 819:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4130
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 820:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4134
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4138
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 822:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4142
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4148
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 824:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4152
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 825:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4156
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 826:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4160
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 827:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4164
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4168
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 829:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4172
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 830:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4177
+		{
+			yyVAL.expr = nil
+		}
+	case 831:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4181
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 832:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4186
+		{
+			yyVAL.str = string("")
+		}
+	case 833:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4190
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 834:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4196
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 835:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4200
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 836:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4206
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 837:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4211
+		{
+			yyVAL.expr = nil
+		}
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4215
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 839:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4221
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 840:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4225
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 841:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4229
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 842:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4235
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 843:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4239
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4243
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 845:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4247
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 846:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4251
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4255
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 848:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4259
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 849:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4263
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 850:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4269
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 851:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4273
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 852:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4277
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 853:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4282
+		{
+			yyVAL.exprs = nil
+		}
+	case 854:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4286
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 855:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4292
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 856:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4296
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4302
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4306
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 859:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4311
+		{
+			yyVAL.expr = nil
+		}
+	case 860:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4315
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 861:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4321
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 862:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4325
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 863:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4330
+		{
+			yyVAL.orderBy = nil
+		}
+	case 864:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4334
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4340
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 866:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4344
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 867:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4350
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 868:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4354
+		{
+			yyVAL.order = &Order{Expr: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Direction: yyDollar[2].str}
+		}
+	case 869:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4359
+		{
+			yyVAL.str = AscScr
+		}
+	case 870:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4363
+		{
+			yyVAL.str = AscScr
+		}
+	case 871:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4367
+		{
+			yyVAL.str = DescScr
+		}
+	case 872:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4372
+		{
+			yyVAL.limit = nil
+		}
+	case 873:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4376
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 874:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4380
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 875:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4384
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 876:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4390
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 877:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4394
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 878:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4399
+		{
+			yyVAL.str = ""
+		}
+	case 879:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4403
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 880:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4407
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 881:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4420
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 882:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4424
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 883:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4428
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 884:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4433
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 885:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4437
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 886:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4441
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 887:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4448
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 888:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4452
+		{
+			yyVAL.columns = Columns{yyDollar[3].colIdent}
+		}
+	case 889:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4456
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 890:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4460
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
+		}
+	case 891:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4465
+		{
+			yyVAL.assignExprs = nil
+		}
+	case 892:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4469
+		{
+			yyVAL.assignExprs = yyDollar[5].assignExprs
+		}
+	case 893:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4475
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 894:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4479
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 895:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4485
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 896:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4489
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 897:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4495
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 898:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4501
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 899:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4511
+		{
+			yyVAL.assignExprs = AssignmentExprs{yyDollar[1].assignExpr}
+		}
+	case 900:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4515
+		{
+			yyVAL.assignExprs = append(yyDollar[1].assignExprs, yyDollar[3].assignExpr)
+		}
+	case 901:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4521
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 902:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4527
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 903:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4531
+		{
+			yyVAL.setVarExprs = append(yyDollar[1].setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 904:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4537
+		{
+			colName, scope, err := VarScopeForColName(yyDollar[1].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].setVarExpr.Name = colName
+			yyDollar[1].setVarExpr.Scope = scope
+			yyVAL.setVarExpr = yyDollar[1].setVarExpr
+		}
+	case 905:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4548
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 906:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4561
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 907:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4574
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr, Scope: SetScope_Session}
+		}
+	case 908:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4580
+		{
+			yyVAL.setScope = SetScope_Global
+		}
+	case 909:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4584
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 910:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4590
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4594
+		{
+			yyVAL.setScope = SetScope_Persist
+		}
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4598
+		{
+			yyVAL.setScope = SetScope_PersistOnly
+		}
+	case 913:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4604
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 914:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4608
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 915:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4612
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 917:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4619
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4626
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 920:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4630
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 921:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4634
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 924:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4643
+		{
+			yyVAL.byt = 0
+		}
+	case 925:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4645
+		{
+			yyVAL.byt = 1
+		}
+	case 926:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4648
+		{
+			yyVAL.byt = 0
+		}
+	case 927:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4650
+		{
+			yyVAL.byt = 1
+		}
+	case 928:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4653
+		{
+			yyVAL.byt = 0
+		}
+	case 929:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4655
+		{
+			yyVAL.byt = 1
+		}
+	case 930:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4658
+		{
+			yyVAL.str = ""
+		}
+	case 931:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4660
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 932:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4663
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 933:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4665
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 934:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4669
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 935:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4671
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 936:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4673
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4675
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 938:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4677
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 939:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4679
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 940:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4681
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 941:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4683
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 942:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4687
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 943:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4689
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 944:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4692
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 945:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4694
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 946:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4696
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 947:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4700
+		{
+			yyVAL.str = UniqueStr
+		}
+	case 948:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4702
+		{
+			yyVAL.str = FulltextStr
+		}
+	case 949:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4704
+		{
+			yyVAL.str = SpatialStr
+		}
+	case 950:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4707
+		{
+			yyVAL.str = ""
+		}
+	case 951:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4709
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 952:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4712
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 953:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4714
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 954:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4718
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 955:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4722
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 956:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4728
+		{
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
+		}
+	case 957:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4732
+		{
+			yyVAL.colIdents = append(yyVAL.colIdents, yyDollar[3].colIdent)
+		}
+	case 959:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4739
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 960:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 961:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4749
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 963:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4756
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 964:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4761
+		{
+			yyVAL.str = string("")
+		}
+	case 965:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4763
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 966:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4766
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 967:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4768
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 968:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4771
+		{
+			yyVAL.EnclosedBy = nil
+		}
+	case 969:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4775
+		{
+			yyVAL.EnclosedBy = &EnclosedBy{Optionally: yyDollar[1].boolVal, Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 970:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4780
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 971:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4784
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 972:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4789
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 973:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4793
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 974:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4798
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 975:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4802
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 976:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4807
+		{
+			yyVAL.Fields = nil
+		}
+	case 977:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4811
+		{
+			yyVAL.Fields = &Fields{TerminatedBy: yyDollar[2].sqlVal, EnclosedBy: yyDollar[3].EnclosedBy, EscapedBy: yyDollar[4].sqlVal}
+		}
+	case 978:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4816
+		{
+			yyVAL.Lines = nil
+		}
+	case 979:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4820
+		{
+			yyVAL.Lines = &Lines{StartingBy: yyDollar[2].sqlVal, TerminatedBy: yyDollar[3].sqlVal}
+		}
+	case 980:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4825
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 981:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4829
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 982:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4835
+		{
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
+		}
+	case 983:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4841
+		{
+			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
+		}
+	case 984:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4845
+		{
+			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
+		}
+	case 985:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4851
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName}, Lock: yyDollar[2].lockType}
+		}
+	case 986:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4855
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[3].tableIdent}, Lock: yyDollar[4].lockType}
+		}
+	case 987:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4861
+		{
+			yyVAL.lockType = LockRead
+		}
+	case 988:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4865
+		{
+			yyVAL.lockType = LockReadLocal
+		}
+	case 989:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4869
+		{
+			yyVAL.lockType = LockWrite
+		}
+	case 990:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4873
+		{
+			yyVAL.lockType = LockLowPriorityWrite
+		}
+	case 991:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4879
+		{
+			yyVAL.statement = &UnlockTables{}
+		}
+	case 1371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5288
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 1372:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5297
+		{
+			decNesting(yylex)
+		}
+	case 1373:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5302
+		{
+			skipToEnd(yylex)
+		}
+	case 1374:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5307
+		{
+			skipToEnd(yylex)
+		}
+	case 1375:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5311
+		{
+			skipToEnd(yylex)
+		}
+	case 1376:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5315
+		{
+			skipToEnd(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}